@@ -3,11 +3,14 @@ package binance
 import (
 	"context"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // GetAccountService get account info
 type GetAccountService struct {
-	c *Client
+	c  *Client
+	ws *WsAPIClient
 }
 
 // Do send request
@@ -17,7 +20,12 @@ func (s *GetAccountService) Do(ctx context.Context, opts ...RequestOption) (res
 		endpoint: "/api/v3/account",
 		secType:  secTypeSigned,
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	var data []byte
+	if s.ws != nil {
+		data, err = s.ws.callAPI(ctx, r, opts...)
+	} else {
+		data, err = s.c.callAPI(ctx, r, opts...)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +60,16 @@ type Balance struct {
 	Locked string `json:"locked"`
 }
 
+// FreeDecimal return Free as a decimal
+func (b *Balance) FreeDecimal() common.Decimal {
+	return common.ToDecimal(b.Free)
+}
+
+// LockedDecimal return Locked as a decimal
+func (b *Balance) LockedDecimal() common.Decimal {
+	return common.ToDecimal(b.Locked)
+}
+
 // GetAccountSnapshotService all account orders; active, canceled, or filled
 type GetAccountSnapshotService struct {
 	c           *Client
@@ -0,0 +1,420 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// DefaultAccountReconcileInterval is the delay between the account snapshots of an AccountTracker
+const DefaultAccountReconcileInterval = 5 * time.Minute
+
+// BalanceChange define a change of a balance tracked by an AccountTracker
+type BalanceChange struct {
+	Asset      string
+	Free       common.Decimal
+	Locked     common.Decimal
+	PrevFree   common.Decimal
+	PrevLocked common.Decimal
+	Time       int64 // time of the event or snapshot, in milliseconds
+}
+
+// BalanceDriftError is passed to the ErrHandler when a reconciliation finds a balance differing from
+// the tracked one, which is replaced by the balance of the snapshot
+type BalanceDriftError struct {
+	Asset         string
+	Free          common.Decimal
+	Locked        common.Decimal
+	TrackedFree   common.Decimal
+	TrackedLocked common.Decimal
+}
+
+// Error return a description of the drift
+func (e *BalanceDriftError) Error() string {
+	return fmt.Sprintf("account tracker: %s balance drifted, free %s locked %s instead of free %s locked %s",
+		e.Asset, e.Free, e.Locked, e.TrackedFree, e.TrackedLocked)
+}
+
+// trackedBalance is a balance with the time of its last update
+type trackedBalance struct {
+	free   common.Decimal
+	locked common.Decimal
+	time   int64
+}
+
+// AccountTracker maintain the balances of the spot account by applying the outboundAccountPosition
+// and balanceUpdate events of the user data stream on top of an account snapshot. Events older than
+// the snapshot or than the last update of an asset are ignored. A new snapshot is fetched after the
+// stream reconnects, and periodically to reconcile the balances, reporting drift.
+type AccountTracker struct {
+	c                 *Client
+	reconcileInterval time.Duration
+	bufferLimit       int
+	retryDelay        time.Duration
+
+	mu          sync.RWMutex
+	synced      bool
+	balances    map[string]*trackedBalance
+	buffer      []*WsUserDataEvent
+	subscribers map[int]func(change *BalanceChange)
+	nextID      int
+
+	stream  *UserDataStream
+	resyncC chan struct{}
+	stopC   chan struct{}
+	doneC   chan struct{}
+	once    sync.Once
+}
+
+// NewAccountTracker init an account tracker, call Start to start tracking the balances, or feed
+// HandleEvent with the events of a user data stream and call Sync
+func (c *Client) NewAccountTracker() *AccountTracker {
+	return &AccountTracker{
+		c:                 c,
+		reconcileInterval: DefaultAccountReconcileInterval,
+		bufferLimit:       1000,
+		retryDelay:        time.Second,
+		balances:          make(map[string]*trackedBalance),
+		subscribers:       make(map[int]func(change *BalanceChange)),
+		resyncC:           make(chan struct{}, 1),
+		stopC:             make(chan struct{}),
+		doneC:             make(chan struct{}),
+	}
+}
+
+// ReconcileInterval set the delay between reconciliations, DefaultAccountReconcileInterval by default
+func (t *AccountTracker) ReconcileInterval(d time.Duration) *AccountTracker {
+	t.reconcileInterval = d
+	return t
+}
+
+// BufferLimit set the number of events kept while the balances are out of sync, 1000 by default.
+// When the limit is hit, the oldest half is dropped, the next snapshot being newer than them.
+func (t *AccountTracker) BufferLimit(limit int) *AccountTracker {
+	t.bufferLimit = limit
+	return t
+}
+
+// Start open the user data stream of the account, sync the balances and keep them up to date until
+// ctx is done or Stop is called. Snapshot errors and BalanceDriftError are reported to errHandler
+// along with stream errors.
+func (t *AccountTracker) Start(ctx context.Context, errHandler ErrHandler) error {
+	t.stream = t.c.NewUserDataStream(t.HandleEvent, errHandler).OnResync(t.resync)
+	if err := t.stream.Start(ctx); err != nil {
+		return err
+	}
+	if err := t.Sync(ctx); err != nil {
+		t.stream.Stop()
+		return err
+	}
+	go t.run(ctx, errHandler)
+	return nil
+}
+
+// Stop close the user data stream and stop tracking the balances
+func (t *AccountTracker) Stop() {
+	t.once.Do(func() { close(t.stopC) })
+	<-t.doneC
+}
+
+// Done is closed once the tracker is stopped
+func (t *AccountTracker) Done() <-chan struct{} {
+	return t.doneC
+}
+
+// run reconcile the balances periodically, and sync them after the stream reconnects, waiting
+// retryDelay between failed snapshots
+func (t *AccountTracker) run(ctx context.Context, errHandler ErrHandler) {
+	defer close(t.doneC)
+	defer t.stream.Stop()
+	reconcile := time.NewTicker(t.reconcileInterval)
+	defer reconcile.Stop()
+	var retryC <-chan time.Time
+	for {
+		select {
+		case <-t.stopC:
+			return
+		case <-ctx.Done():
+			return
+		case <-t.stream.Done():
+			return
+		case <-t.resyncC:
+			retryC = nil
+			if err := t.Sync(ctx); err != nil {
+				errHandler(err)
+				retryC = time.After(t.retryDelay)
+			}
+		case <-retryC:
+			retryC = nil
+			t.resync()
+		case <-reconcile.C:
+			if err := t.Reconcile(ctx, errHandler); err != nil {
+				errHandler(err)
+			}
+		}
+	}
+}
+
+// resync mark the balances out of sync and schedule a new snapshot
+func (t *AccountTracker) resync() {
+	t.mu.Lock()
+	t.synced = false
+	t.mu.Unlock()
+	select {
+	case t.resyncC <- struct{}{}:
+	default:
+	}
+}
+
+// Sync fetch an account snapshot, replace the balances with it and apply the events received since
+// the balances went out of sync
+func (t *AccountTracker) Sync(ctx context.Context) error {
+	account, err := t.c.NewGetAccountService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	t.notify(t.applySnapshot(account, nil))
+	return nil
+}
+
+// Reconcile fetch an account snapshot and replace the balances that weren't updated since with the
+// ones of the snapshot, passing a BalanceDriftError to errHandler for each balance that differs
+func (t *AccountTracker) Reconcile(ctx context.Context, errHandler ErrHandler) error {
+	account, err := t.c.NewGetAccountService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	var drifts []*BalanceDriftError
+	t.notify(t.applySnapshot(account, &drifts))
+	for _, drift := range drifts {
+		errHandler(drift)
+	}
+	return nil
+}
+
+// applySnapshot replace the balances with the ones of account, collecting the differences in drifts
+// if not nil, then apply the buffered events. It returns the changes to notify.
+func (t *AccountTracker) applySnapshot(account *Account, drifts *[]*BalanceDriftError) []*BalanceChange {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	updateTime := int64(account.UpdateTime)
+	remote := make(map[string]*trackedBalance, len(account.Balances))
+	for _, b := range account.Balances {
+		remote[b.Asset] = &trackedBalance{
+			free:   common.ToDecimal(b.Free),
+			locked: common.ToDecimal(b.Locked),
+			time:   updateTime,
+		}
+	}
+	// balances missing from the snapshot are empty
+	for asset := range t.balances {
+		if _, ok := remote[asset]; !ok {
+			remote[asset] = &trackedBalance{time: updateTime}
+		}
+	}
+
+	var changes []*BalanceChange
+	for _, asset := range sortedAssets(remote) {
+		b := remote[asset]
+		local, ok := t.balances[asset]
+		if ok && local.time > updateTime {
+			continue // updated by an event newer than the snapshot
+		}
+		if !ok {
+			local = new(trackedBalance)
+		}
+		if local.free.Equal(b.free) && local.locked.Equal(b.locked) {
+			local.time = updateTime
+			t.balances[asset] = local
+			continue
+		}
+		if drifts != nil && t.synced {
+			*drifts = append(*drifts, &BalanceDriftError{
+				Asset:         asset,
+				Free:          b.free,
+				Locked:        b.locked,
+				TrackedFree:   local.free,
+				TrackedLocked: local.locked,
+			})
+		}
+		changes = append(changes, t.set(asset, b.free, b.locked, updateTime))
+	}
+
+	buffer := t.buffer
+	t.buffer = nil
+	for _, event := range buffer {
+		changes = append(changes, t.apply(event)...)
+	}
+	t.synced = true
+	return changes
+}
+
+// HandleEvent apply an event of the user data stream of the account, buffering it until the
+// balances are synced
+func (t *AccountTracker) HandleEvent(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeOutboundAccountPosition && event.Event != UserDataEventTypeBalanceUpdate {
+		return
+	}
+	t.mu.Lock()
+	if !t.synced {
+		t.buffer = append(t.buffer, event)
+		if t.bufferLimit > 0 && len(t.buffer) > t.bufferLimit {
+			// copy the newest events so that the dropped ones can be collected
+			t.buffer = append([]*WsUserDataEvent(nil), t.buffer[len(t.buffer)-(t.bufferLimit+1)/2:]...)
+		}
+		t.mu.Unlock()
+		return
+	}
+	changes := t.apply(event)
+	t.mu.Unlock()
+	t.notify(changes)
+}
+
+// apply update the balances with event, ignoring the updates older than the balance
+func (t *AccountTracker) apply(event *WsUserDataEvent) []*BalanceChange {
+	var changes []*BalanceChange
+	switch event.Event {
+	case UserDataEventTypeOutboundAccountPosition:
+		updateTime := event.AccountUpdateTime
+		if updateTime == 0 {
+			updateTime = event.Time
+		}
+		for _, u := range event.AccountUpdate.WsAccountUpdates {
+			if b, ok := t.balances[u.Asset]; ok && b.time > updateTime {
+				continue
+			}
+			free, locked := common.ToDecimal(u.Free), common.ToDecimal(u.Locked)
+			if b, ok := t.balances[u.Asset]; ok && b.free.Equal(free) && b.locked.Equal(locked) {
+				b.time = updateTime
+				continue
+			}
+			changes = append(changes, t.set(u.Asset, free, locked, updateTime))
+		}
+	case UserDataEventTypeBalanceUpdate:
+		updateTime := event.TransactionTime
+		if updateTime == 0 {
+			updateTime = event.Time
+		}
+		b, ok := t.balances[event.BalanceUpdate.Asset]
+		if !ok {
+			b = new(trackedBalance)
+		} else if b.time >= updateTime {
+			break // already in the balance
+		}
+		free := b.free.Add(common.ToDecimal(event.BalanceUpdate.Change))
+		changes = append(changes, t.set(event.BalanceUpdate.Asset, free, b.locked, updateTime))
+	}
+	return changes
+}
+
+// set update the balance of asset, returning the change
+func (t *AccountTracker) set(asset string, free, locked common.Decimal, updateTime int64) *BalanceChange {
+	b, ok := t.balances[asset]
+	if !ok {
+		b = new(trackedBalance)
+		t.balances[asset] = b
+	}
+	change := &BalanceChange{
+		Asset:      asset,
+		Free:       free,
+		Locked:     locked,
+		PrevFree:   b.free,
+		PrevLocked: b.locked,
+		Time:       updateTime,
+	}
+	b.free, b.locked, b.time = free, locked, updateTime
+	return change
+}
+
+// notify pass the changes to the subscribers, outside of the lock so that they can query the tracker
+func (t *AccountTracker) notify(changes []*BalanceChange) {
+	if len(changes) == 0 {
+		return
+	}
+	t.mu.RLock()
+	subscribers := make([]func(change *BalanceChange), 0, len(t.subscribers))
+	ids := make([]int, 0, len(t.subscribers))
+	for id := range t.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		subscribers = append(subscribers, t.subscribers[id])
+	}
+	t.mu.RUnlock()
+	for _, change := range changes {
+		for _, f := range subscribers {
+			f(change)
+		}
+	}
+}
+
+// Subscribe call f with every change of a balance, until unsubscribe is called
+func (t *AccountTracker) Subscribe(f func(change *BalanceChange)) (unsubscribe func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := t.nextID
+	t.nextID++
+	t.subscribers[id] = f
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.subscribers, id)
+	}
+}
+
+// Synced report whether the balances reflect the account. Queries on a tracker out of sync return
+// the last known balances.
+func (t *AccountTracker) Synced() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.synced
+}
+
+// Free return the free balance of asset, zero if unknown
+func (t *AccountTracker) Free(asset string) common.Decimal {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if b, ok := t.balances[asset]; ok {
+		return b.free
+	}
+	return common.Decimal{}
+}
+
+// Locked return the locked balance of asset, zero if unknown
+func (t *AccountTracker) Locked(asset string) common.Decimal {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if b, ok := t.balances[asset]; ok {
+		return b.locked
+	}
+	return common.Decimal{}
+}
+
+// Balances return the non-zero balances, sorted by asset
+func (t *AccountTracker) Balances() []Balance {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	balances := []Balance{}
+	for _, asset := range sortedAssets(t.balances) {
+		b := t.balances[asset]
+		if b.free.IsZero() && b.locked.IsZero() {
+			continue
+		}
+		balances = append(balances, Balance{Asset: asset, Free: b.free.String(), Locked: b.locked.String()})
+	}
+	return balances
+}
+
+func sortedAssets(balances map[string]*trackedBalance) []string {
+	assets := make([]string, 0, len(balances))
+	for asset := range balances {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	return assets
+}
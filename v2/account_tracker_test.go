@@ -0,0 +1,236 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type accountTrackerTestSuite struct {
+	baseTestSuite
+}
+
+func TestAccountTracker(t *testing.T) {
+	suite.Run(t, new(accountTrackerTestSuite))
+}
+
+func accountSnapshot(updateTime int64, balances string) []byte {
+	return []byte(fmt.Sprintf(`{"canTrade":true,"updateTime":%d,"accountType":"SPOT","balances":[%s]}`, updateTime, balances))
+}
+
+func (s *accountTrackerTestSuite) mockSnapshots(snapshots ...[]byte) {
+	s.client.Client.do = s.client.do
+	for _, data := range snapshots {
+		s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(data, http.StatusOK), nil).Once()
+	}
+}
+
+func positionEvent(updateTime int64, asset, free, locked string) *WsUserDataEvent {
+	return &WsUserDataEvent{
+		Event:             UserDataEventTypeOutboundAccountPosition,
+		Time:              updateTime + 1,
+		AccountUpdateTime: updateTime,
+		AccountUpdate: WsAccountUpdateList{
+			WsAccountUpdates: []WsAccountUpdate{{Asset: asset, Free: free, Locked: locked}},
+		},
+	}
+}
+
+func balanceEvent(clearTime int64, asset, change string) *WsUserDataEvent {
+	return &WsUserDataEvent{
+		Event:           UserDataEventTypeBalanceUpdate,
+		Time:            clearTime + 1,
+		TransactionTime: clearTime,
+		BalanceUpdate:   WsBalanceUpdate{Asset: asset, Change: change},
+	}
+}
+
+func (s *accountTrackerTestSuite) TestSync() {
+	s.mockSnapshots(accountSnapshot(1000, `{"asset":"BTC","free":"1.00000000","locked":"0.50000000"},
+		{"asset":"USDT","free":"100.00","locked":"0.00"}`))
+	tracker := s.client.NewAccountTracker()
+	var changes []*BalanceChange
+	tracker.Subscribe(func(change *BalanceChange) {
+		changes = append(changes, change)
+	})
+
+	// buffered while waiting for the snapshot
+	tracker.HandleEvent(positionEvent(900, "BTC", "2.00000000", "0.00000000"))
+	tracker.HandleEvent(balanceEvent(1100, "USDT", "-40.00"))
+	tracker.HandleEvent(&WsUserDataEvent{Event: UserDataEventTypeExecutionReport, Time: 1200})
+	s.False(tracker.Synced())
+	s.True(tracker.Free("BTC").IsZero())
+
+	s.Require().NoError(tracker.Sync(newContext()))
+	s.True(tracker.Synced())
+	s.Equal("1.00000000", tracker.Free("BTC").String())
+	s.Equal("0.50000000", tracker.Locked("BTC").String())
+	s.Equal("60.00", tracker.Free("USDT").String())
+	s.True(tracker.Free("ETH").IsZero())
+	s.Equal([]Balance{
+		{Asset: "BTC", Free: "1.00000000", Locked: "0.50000000"},
+		{Asset: "USDT", Free: "60.00", Locked: "0.00"},
+	}, tracker.Balances())
+
+	s.Require().Len(changes, 3)
+	s.Equal("BTC", changes[0].Asset)
+	s.True(changes[0].PrevFree.IsZero())
+	s.Equal(int64(1000), changes[0].Time)
+	s.Equal("USDT", changes[2].Asset)
+	s.Equal("100.00", changes[2].PrevFree.String())
+	s.Equal("60.00", changes[2].Free.String())
+	s.Equal(int64(1100), changes[2].Time)
+}
+
+func (s *accountTrackerTestSuite) TestEventOrder() {
+	s.mockSnapshots(accountSnapshot(1000, `{"asset":"BTC","free":"1.00000000","locked":"0.00000000"}`))
+	tracker := s.client.NewAccountTracker()
+	s.Require().NoError(tracker.Sync(newContext()))
+	var changes []*BalanceChange
+	unsubscribe := tracker.Subscribe(func(change *BalanceChange) {
+		// subscribers may query the tracker
+		s.Equal(change.Free, tracker.Free(change.Asset))
+		changes = append(changes, change)
+	})
+
+	tracker.HandleEvent(balanceEvent(1200, "BTC", "0.10000000"))
+	tracker.HandleEvent(positionEvent(1200, "BTC", "1.10000000", "0.00000000"))
+	// older than the last update
+	tracker.HandleEvent(positionEvent(1100, "BTC", "5.00000000", "0.00000000"))
+	tracker.HandleEvent(balanceEvent(1150, "BTC", "1.00000000"))
+	s.Equal("1.10000000", tracker.Free("BTC").String())
+
+	tracker.HandleEvent(positionEvent(1300, "BTC", "0.60000000", "0.50000000"))
+	tracker.HandleEvent(positionEvent(1300, "ETH", "2.00000000", "0.00000000"))
+	s.Equal("0.60000000", tracker.Free("BTC").String())
+	s.Equal("0.50000000", tracker.Locked("BTC").String())
+	s.Equal("2.00000000", tracker.Free("ETH").String())
+	s.Len(changes, 3)
+
+	unsubscribe()
+	tracker.HandleEvent(balanceEvent(1400, "ETH", "1.00000000"))
+	s.Equal("3.00000000", tracker.Free("ETH").String())
+	s.Len(changes, 3)
+}
+
+func (s *accountTrackerTestSuite) TestReconcile() {
+	s.mockSnapshots(
+		accountSnapshot(1000, `{"asset":"BTC","free":"1.00000000","locked":"0.00000000"},
+			{"asset":"ETH","free":"2.00000000","locked":"0.00000000"},
+			{"asset":"BNB","free":"3.00000000","locked":"0.00000000"}`),
+		accountSnapshot(2000, `{"asset":"BTC","free":"0.90000000","locked":"0.00000000"},
+			{"asset":"ETH","free":"2.00000000","locked":"0.00000000"},
+			{"asset":"BNB","free":"3.50000000","locked":"0.00000000"}`),
+	)
+	tracker := s.client.NewAccountTracker()
+	s.Require().NoError(tracker.Sync(newContext()))
+	// newer than the second snapshot
+	tracker.HandleEvent(positionEvent(2100, "BNB", "4.00000000", "0.00000000"))
+
+	var errs []error
+	s.Require().NoError(tracker.Reconcile(newContext(), func(err error) {
+		errs = append(errs, err)
+	}))
+	s.Require().Len(errs, 1)
+	drift, ok := errs[0].(*BalanceDriftError)
+	s.Require().True(ok)
+	s.Equal("BTC", drift.Asset)
+	s.Equal("0.90000000", drift.Free.String())
+	s.Equal("1.00000000", drift.TrackedFree.String())
+	s.Equal("account tracker: BTC balance drifted, free 0.90000000 locked 0.00000000 instead of free 1.00000000 locked 0.00000000", drift.Error())
+	s.Equal("0.90000000", tracker.Free("BTC").String())
+	s.Equal("4.00000000", tracker.Free("BNB").String())
+	s.True(tracker.Free("BTC").Equal(common.MustParseDecimal("0.9")))
+}
+
+func (s *accountTrackerTestSuite) TestStart() {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	var mu sync.Mutex
+	var handler WsHandler
+	wsServe = func(cfg *WsConfig, h WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		handler = h
+		doneC, stopC = make(chan struct{}), make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+	s.mockSnapshots(
+		[]byte(`{"listenKey":"key1"}`),
+		accountSnapshot(1000, `{"asset":"BTC","free":"1.00000000","locked":"0.00000000"}`),
+		[]byte(`{}`),
+	)
+
+	tracker := s.client.NewAccountTracker().ReconcileInterval(time.Hour)
+	s.Require().NoError(tracker.Start(newContext(), func(err error) {}))
+	mu.Lock()
+	handler([]byte(`{"e":"balanceUpdate","E":1573200697110,"a":"BTC","d":"0.50000000","T":1573200697068}`))
+	mu.Unlock()
+	s.Equal("1.50000000", tracker.Free("BTC").String())
+	tracker.Stop()
+	<-tracker.Done()
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *accountTrackerTestSuite) TestBufferLimit() {
+	s.mockSnapshots(accountSnapshot(1000, `{"asset":"USDT","free":"100.00","locked":"0.00"}`))
+	tracker := s.client.NewAccountTracker().BufferLimit(4)
+	for i := int64(1); i <= 4; i++ {
+		tracker.HandleEvent(balanceEvent(1000+i, "USDT", "1.00"))
+	}
+	s.Len(tracker.buffer, 4)
+
+	// the oldest events are dropped
+	tracker.HandleEvent(balanceEvent(1005, "USDT", "1.00"))
+	s.Require().Len(tracker.buffer, 2)
+	s.Equal(int64(1004), tracker.buffer[0].TransactionTime)
+	s.Require().NoError(tracker.Sync(newContext()))
+	s.Equal("102.00", tracker.Free("USDT").String())
+}
+
+func (s *accountTrackerTestSuite) TestRetryDelay() {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	wsServe = func(cfg *WsConfig, h WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		doneC, stopC = make(chan struct{}), make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+	s.mockSnapshots(
+		[]byte(`{"listenKey":"key1"}`),
+		accountSnapshot(1000, `{"asset":"BTC","free":"1.00000000","locked":"0.00000000"}`),
+	)
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{"code":-1003,"msg":"Too many requests."}`), http.StatusTooManyRequests), nil).Once()
+	s.mockSnapshots(accountSnapshot(2000, `{"asset":"BTC","free":"2.00000000","locked":"0.00000000"}`), []byte(`{}`))
+
+	tracker := s.client.NewAccountTracker().ReconcileInterval(time.Hour)
+	tracker.retryDelay = 100 * time.Millisecond
+	var mu sync.Mutex
+	var failed time.Time
+	s.Require().NoError(tracker.Start(newContext(), func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		failed = time.Now()
+	}))
+	tracker.resync()
+	s.Eventually(tracker.Synced, time.Second, 5*time.Millisecond)
+	mu.Lock()
+	s.False(failed.IsZero())
+	s.GreaterOrEqual(time.Since(failed), tracker.retryDelay)
+	mu.Unlock()
+	s.Equal("2.00000000", tracker.Free("BTC").String())
+	tracker.Stop()
+	s.client.AssertNumberOfCalls(s.T(), "do", 5)
+}
@@ -0,0 +1,128 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// AmendOrderKeepPriorityService reduce the quantity of an open order without losing its priority
+// in the order book
+type AmendOrderKeepPriorityService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	newClientOrderID  *string
+	newQuantity       string
+}
+
+// Symbol set symbol
+func (s *AmendOrderKeepPriorityService) Symbol(symbol string) *AmendOrderKeepPriorityService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderId
+func (s *AmendOrderKeepPriorityService) OrderID(orderID int64) *AmendOrderKeepPriorityService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderId
+func (s *AmendOrderKeepPriorityService) OrigClientOrderID(origClientOrderID string) *AmendOrderKeepPriorityService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderId, the new client order id of the order
+func (s *AmendOrderKeepPriorityService) NewClientOrderID(newClientOrderID string) *AmendOrderKeepPriorityService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewQuantity set newQty, which must be greater than 0 and less than the quantity of the order
+func (s *AmendOrderKeepPriorityService) NewQuantity(newQuantity string) *AmendOrderKeepPriorityService {
+	s.newQuantity = newQuantity
+	return s
+}
+
+// Do send request
+func (s *AmendOrderKeepPriorityService) Do(ctx context.Context, opts ...RequestOption) (res *AmendOrderKeepPriorityResponse, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/api/v3/order/amend/keepPriority",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("symbol", s.symbol)
+	r.setFormParam("newQty", s.newQuantity)
+	if s.orderID != nil {
+		r.setFormParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.setFormParam("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.newClientOrderID != nil {
+		r.setFormParam("newClientOrderId", *s.newClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AmendOrderKeepPriorityResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AmendOrderKeepPriorityResponse define amend order keep priority response
+type AmendOrderKeepPriorityResponse struct {
+	TransactTime int64               `json:"transactTime"`
+	ExecutionID  int64               `json:"executionId"`
+	AmendedOrder *AmendedOrder       `json:"amendedOrder"`
+	ListStatus   *AmendedOrderStatus `json:"listStatus"` // for orders of an order list
+}
+
+// AmendedOrder define the state of an amended order
+type AmendedOrder struct {
+	Symbol                   string                      `json:"symbol"`
+	OrderID                  int64                       `json:"orderId"`
+	OrderListID              int64                       `json:"orderListId"`
+	OrigClientOrderID        string                      `json:"origClientOrderId"`
+	ClientOrderID            string                      `json:"clientOrderId"`
+	Price                    string                      `json:"price"`
+	Quantity                 string                      `json:"qty"`
+	ExecutedQuantity         string                      `json:"executedQty"`
+	PreventedQuantity        string                      `json:"preventedQty"`
+	QuoteOrderQuantity       string                      `json:"quoteOrderQty"`
+	CummulativeQuoteQuantity string                      `json:"cumulativeQuoteQty"`
+	Status                   OrderStatusType             `json:"status"`
+	TimeInForce              TimeInForceType             `json:"timeInForce"`
+	Type                     OrderType                   `json:"type"`
+	Side                     SideType                    `json:"side"`
+	WorkingTime              int64                       `json:"workingTime"`
+	SelfTradePreventionMode  SelfTradePreventionModeType `json:"selfTradePreventionMode"`
+}
+
+// QuantityDecimal return Quantity as a decimal
+func (o *AmendedOrder) QuantityDecimal() common.Decimal {
+	return common.ToDecimal(o.Quantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (o *AmendedOrder) ExecutedQuantityDecimal() common.Decimal {
+	return common.ToDecimal(o.ExecutedQuantity)
+}
+
+// AmendedOrderStatus define the status of the order list of an amended order
+type AmendedOrderStatus struct {
+	OrderListID       int64       `json:"orderListId"`
+	ContingencyType   string      `json:"contingencyType"`
+	ListOrderStatus   string      `json:"listOrderStatus"`
+	ListClientOrderID string      `json:"listClientOrderId"`
+	Symbol            string      `json:"symbol"`
+	Orders            []*OCOOrder `json:"orders"`
+}
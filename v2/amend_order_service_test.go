@@ -0,0 +1,112 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type amendOrderServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestAmendOrderService(t *testing.T) {
+	suite.Run(t, new(amendOrderServiceTestSuite))
+}
+
+func (s *amendOrderServiceTestSuite) TestAmendOrderKeepPriority() {
+	data := []byte(`{
+		"transactTime": 1741924229819,
+		"executionId": 60,
+		"amendedOrder": {
+			"symbol": "BTCUSDT",
+			"orderId": 23,
+			"orderListId": 4,
+			"origClientOrderId": "xbxXh5SSwaHS7oUEOCI88B",
+			"clientOrderId": "e6jgE5OHnxNSoZFxvxzplk",
+			"price": "6.00000000",
+			"qty": "5.00000000",
+			"executedQty": "0.00000000",
+			"preventedQty": "0.00000000",
+			"quoteOrderQty": "0.00000000",
+			"cumulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL",
+			"workingTime": 1741924204920,
+			"selfTradePreventionMode": "NONE"
+		},
+		"listStatus": {
+			"orderListId": 4,
+			"contingencyType": "OTO",
+			"listOrderStatus": "EXECUTING",
+			"listClientOrderId": "bcxhRnMUEg2ZvP3aT5Llsd",
+			"symbol": "BTCUSDT",
+			"orders": [
+				{"symbol": "BTCUSDT", "orderId": 22, "clientOrderId": "iBuoKDqT1AEYwYypLyGd8B"},
+				{"symbol": "BTCUSDT", "orderId": 23, "clientOrderId": "e6jgE5OHnxNSoZFxvxzplk"}
+			]
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":            "BTCUSDT",
+			"origClientOrderId": "xbxXh5SSwaHS7oUEOCI88B",
+			"newClientOrderId":  "e6jgE5OHnxNSoZFxvxzplk",
+			"newQty":            "5.00000000",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").
+		OrigClientOrderID("xbxXh5SSwaHS7oUEOCI88B").NewClientOrderID("e6jgE5OHnxNSoZFxvxzplk").
+		NewQuantity("5.00000000").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1741924229819), res.TransactTime)
+	r.Equal(int64(60), res.ExecutionID)
+	r.Equal(&AmendedOrder{
+		Symbol:                   "BTCUSDT",
+		OrderID:                  23,
+		OrderListID:              4,
+		OrigClientOrderID:        "xbxXh5SSwaHS7oUEOCI88B",
+		ClientOrderID:            "e6jgE5OHnxNSoZFxvxzplk",
+		Price:                    "6.00000000",
+		Quantity:                 "5.00000000",
+		ExecutedQuantity:         "0.00000000",
+		PreventedQuantity:        "0.00000000",
+		QuoteOrderQuantity:       "0.00000000",
+		CummulativeQuoteQuantity: "0.00000000",
+		Status:                   OrderStatusTypeNew,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeSell,
+		WorkingTime:              1741924204920,
+		SelfTradePreventionMode:  "NONE",
+	}, res.AmendedOrder)
+	r.Equal("OTO", res.ListStatus.ContingencyType)
+	r.Len(res.ListStatus.Orders, 2)
+	r.Equal(int64(22), res.ListStatus.Orders[0].OrderID)
+}
+
+func (s *amendOrderServiceTestSuite) TestAmendOrderKeepPriorityByID() {
+	data := []byte(`{"transactTime": 1, "executionId": 2, "amendedOrder": {"symbol": "BTCUSDT", "orderId": 23, "orderListId": -1}}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":  "BTCUSDT",
+			"orderId": 23,
+			"newQty":  "1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").OrderID(23).NewQuantity("1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Nil(res.ListStatus)
+	s.r().Equal(int64(-1), res.AmendedOrder.OrderListID)
+}
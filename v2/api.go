@@ -0,0 +1,83 @@
+package binance
+
+//go:generate go run ./internal/apigen
+
+// MarketDataAPI define the market data services of Client
+type MarketDataAPI interface {
+	NewPingService() PingServiceAPI
+	NewServerTimeService() ServerTimeServiceAPI
+	NewExchangeInfoService() ExchangeInfoServiceAPI
+	NewDepthService() DepthServiceAPI
+	NewAggTradesService() AggTradesServiceAPI
+	NewRecentTradesService() RecentTradesServiceAPI
+	NewHistoricalTradesService() HistoricalTradesServiceAPI
+	NewKlinesService() KlinesServiceAPI
+	NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI
+	NewListPricesService() ListPricesServiceAPI
+	NewListBookTickersService() ListBookTickersServiceAPI
+	NewListSymbolTickerService() ListSymbolTickerServiceAPI
+	NewAveragePriceService() AveragePriceServiceAPI
+}
+
+// TradingAPI define the order and account services of Client
+type TradingAPI interface {
+	NewCreateOrderService() CreateOrderServiceAPI
+	NewCreateOCOService() CreateOCOServiceAPI
+	NewCreateOrderListOCOService() CreateOrderListOCOServiceAPI
+	NewCreateOrderListOTOService() CreateOrderListOTOServiceAPI
+	NewCreateOrderListOTOCOService() CreateOrderListOTOCOServiceAPI
+	NewGetOrderListService() GetOrderListServiceAPI
+	NewListAllOrderListsService() ListAllOrderListsServiceAPI
+	NewCancelOCOService() CancelOCOServiceAPI
+	NewGetOrderService() GetOrderServiceAPI
+	NewCancelOrderService() CancelOrderServiceAPI
+	NewCancelReplaceOrderService() CancelReplaceOrderServiceAPI
+	NewAmendOrderKeepPriorityService() AmendOrderKeepPriorityServiceAPI
+	NewCancelOpenOrdersService() CancelOpenOrdersServiceAPI
+	NewListOpenOrdersService() ListOpenOrdersServiceAPI
+	NewListOpenOcoService() ListOpenOcoServiceAPI
+	NewListOrdersService() ListOrdersServiceAPI
+	NewListTradesService() ListTradesServiceAPI
+	NewListPreventedMatchesService() ListPreventedMatchesServiceAPI
+	NewGetAccountService() GetAccountServiceAPI
+}
+
+// WalletAPI define the wallet services of Client
+type WalletAPI interface {
+	NewGetAccountSnapshotService() GetAccountSnapshotServiceAPI
+	NewListDepositsService() ListDepositsServiceAPI
+	NewGetDepositAddressService() GetDepositsAddressServiceAPI
+	NewCreateWithdrawService() CreateWithdrawServiceAPI
+	NewListWithdrawsService() ListWithdrawsServiceAPI
+	NewGetAssetDetailService() GetAssetDetailServiceAPI
+	NewGetAllCoinsInfoService() GetAllCoinsInfoServiceAPI
+	NewTradeFeeService() TradeFeeServiceAPI
+	NewUserUniversalTransferService() CreateUserUniversalTransferServiceAPI
+	NewListDustLogService() ListDustLogServiceAPI
+	NewListDustService() ListDustServiceAPI
+	NewDustTransferService() DustTransferServiceAPI
+	NewAssetDividendService() AssetDividendServiceAPI
+}
+
+// UserStreamAPI define the user data stream services of Client
+type UserStreamAPI interface {
+	NewStartUserStreamService() StartUserStreamServiceAPI
+	NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI
+	NewCloseUserStreamService() CloseUserStreamServiceAPI
+	NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) UserDataStreamAPI
+}
+
+// API define the services of Client covered by the interfaces above, returned by Client.API and
+// implemented by the fakes of binancetest
+type API interface {
+	MarketDataAPI
+	TradingAPI
+	WalletAPI
+	UserStreamAPI
+}
+
+// API return the services of c behind the interfaces above, so that code depending on them can be
+// tested with a fake of binancetest
+func (c *Client) API() API {
+	return clientAPI{c}
+}
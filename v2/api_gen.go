@@ -0,0 +1,2234 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package binance
+
+import (
+	"context"
+	"time"
+)
+
+type clientAPI struct {
+	c *Client
+}
+
+func (a clientAPI) NewPingService() PingServiceAPI {
+	return pingServiceAPI{a.c.NewPingService()}
+}
+
+func (a clientAPI) NewServerTimeService() ServerTimeServiceAPI {
+	return serverTimeServiceAPI{a.c.NewServerTimeService()}
+}
+
+func (a clientAPI) NewExchangeInfoService() ExchangeInfoServiceAPI {
+	return exchangeInfoServiceAPI{a.c.NewExchangeInfoService()}
+}
+
+func (a clientAPI) NewDepthService() DepthServiceAPI {
+	return depthServiceAPI{a.c.NewDepthService()}
+}
+
+func (a clientAPI) NewAggTradesService() AggTradesServiceAPI {
+	return aggTradesServiceAPI{a.c.NewAggTradesService()}
+}
+
+func (a clientAPI) NewRecentTradesService() RecentTradesServiceAPI {
+	return recentTradesServiceAPI{a.c.NewRecentTradesService()}
+}
+
+func (a clientAPI) NewHistoricalTradesService() HistoricalTradesServiceAPI {
+	return historicalTradesServiceAPI{a.c.NewHistoricalTradesService()}
+}
+
+func (a clientAPI) NewKlinesService() KlinesServiceAPI {
+	return klinesServiceAPI{a.c.NewKlinesService()}
+}
+
+func (a clientAPI) NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI {
+	return listPriceChangeStatsServiceAPI{a.c.NewListPriceChangeStatsService()}
+}
+
+func (a clientAPI) NewListPricesService() ListPricesServiceAPI {
+	return listPricesServiceAPI{a.c.NewListPricesService()}
+}
+
+func (a clientAPI) NewListBookTickersService() ListBookTickersServiceAPI {
+	return listBookTickersServiceAPI{a.c.NewListBookTickersService()}
+}
+
+func (a clientAPI) NewListSymbolTickerService() ListSymbolTickerServiceAPI {
+	return listSymbolTickerServiceAPI{a.c.NewListSymbolTickerService()}
+}
+
+func (a clientAPI) NewAveragePriceService() AveragePriceServiceAPI {
+	return averagePriceServiceAPI{a.c.NewAveragePriceService()}
+}
+
+func (a clientAPI) NewCreateOrderService() CreateOrderServiceAPI {
+	return createOrderServiceAPI{a.c.NewCreateOrderService()}
+}
+
+func (a clientAPI) NewCreateOCOService() CreateOCOServiceAPI {
+	return createOCOServiceAPI{a.c.NewCreateOCOService()}
+}
+
+func (a clientAPI) NewCreateOrderListOCOService() CreateOrderListOCOServiceAPI {
+	return createOrderListOCOServiceAPI{a.c.NewCreateOrderListOCOService()}
+}
+
+func (a clientAPI) NewCreateOrderListOTOService() CreateOrderListOTOServiceAPI {
+	return createOrderListOTOServiceAPI{a.c.NewCreateOrderListOTOService()}
+}
+
+func (a clientAPI) NewCreateOrderListOTOCOService() CreateOrderListOTOCOServiceAPI {
+	return createOrderListOTOCOServiceAPI{a.c.NewCreateOrderListOTOCOService()}
+}
+
+func (a clientAPI) NewGetOrderListService() GetOrderListServiceAPI {
+	return getOrderListServiceAPI{a.c.NewGetOrderListService()}
+}
+
+func (a clientAPI) NewListAllOrderListsService() ListAllOrderListsServiceAPI {
+	return listAllOrderListsServiceAPI{a.c.NewListAllOrderListsService()}
+}
+
+func (a clientAPI) NewCancelOCOService() CancelOCOServiceAPI {
+	return cancelOCOServiceAPI{a.c.NewCancelOCOService()}
+}
+
+func (a clientAPI) NewGetOrderService() GetOrderServiceAPI {
+	return getOrderServiceAPI{a.c.NewGetOrderService()}
+}
+
+func (a clientAPI) NewCancelOrderService() CancelOrderServiceAPI {
+	return cancelOrderServiceAPI{a.c.NewCancelOrderService()}
+}
+
+func (a clientAPI) NewCancelReplaceOrderService() CancelReplaceOrderServiceAPI {
+	return cancelReplaceOrderServiceAPI{a.c.NewCancelReplaceOrderService()}
+}
+
+func (a clientAPI) NewAmendOrderKeepPriorityService() AmendOrderKeepPriorityServiceAPI {
+	return amendOrderKeepPriorityServiceAPI{a.c.NewAmendOrderKeepPriorityService()}
+}
+
+func (a clientAPI) NewCancelOpenOrdersService() CancelOpenOrdersServiceAPI {
+	return cancelOpenOrdersServiceAPI{a.c.NewCancelOpenOrdersService()}
+}
+
+func (a clientAPI) NewListOpenOrdersService() ListOpenOrdersServiceAPI {
+	return listOpenOrdersServiceAPI{a.c.NewListOpenOrdersService()}
+}
+
+func (a clientAPI) NewListOpenOcoService() ListOpenOcoServiceAPI {
+	return listOpenOcoServiceAPI{a.c.NewListOpenOcoService()}
+}
+
+func (a clientAPI) NewListOrdersService() ListOrdersServiceAPI {
+	return listOrdersServiceAPI{a.c.NewListOrdersService()}
+}
+
+func (a clientAPI) NewListTradesService() ListTradesServiceAPI {
+	return listTradesServiceAPI{a.c.NewListTradesService()}
+}
+
+func (a clientAPI) NewListPreventedMatchesService() ListPreventedMatchesServiceAPI {
+	return listPreventedMatchesServiceAPI{a.c.NewListPreventedMatchesService()}
+}
+
+func (a clientAPI) NewGetAccountService() GetAccountServiceAPI {
+	return getAccountServiceAPI{a.c.NewGetAccountService()}
+}
+
+func (a clientAPI) NewGetAccountSnapshotService() GetAccountSnapshotServiceAPI {
+	return getAccountSnapshotServiceAPI{a.c.NewGetAccountSnapshotService()}
+}
+
+func (a clientAPI) NewListDepositsService() ListDepositsServiceAPI {
+	return listDepositsServiceAPI{a.c.NewListDepositsService()}
+}
+
+func (a clientAPI) NewGetDepositAddressService() GetDepositsAddressServiceAPI {
+	return getDepositsAddressServiceAPI{a.c.NewGetDepositAddressService()}
+}
+
+func (a clientAPI) NewCreateWithdrawService() CreateWithdrawServiceAPI {
+	return createWithdrawServiceAPI{a.c.NewCreateWithdrawService()}
+}
+
+func (a clientAPI) NewListWithdrawsService() ListWithdrawsServiceAPI {
+	return listWithdrawsServiceAPI{a.c.NewListWithdrawsService()}
+}
+
+func (a clientAPI) NewGetAssetDetailService() GetAssetDetailServiceAPI {
+	return getAssetDetailServiceAPI{a.c.NewGetAssetDetailService()}
+}
+
+func (a clientAPI) NewGetAllCoinsInfoService() GetAllCoinsInfoServiceAPI {
+	return getAllCoinsInfoServiceAPI{a.c.NewGetAllCoinsInfoService()}
+}
+
+func (a clientAPI) NewTradeFeeService() TradeFeeServiceAPI {
+	return tradeFeeServiceAPI{a.c.NewTradeFeeService()}
+}
+
+func (a clientAPI) NewUserUniversalTransferService() CreateUserUniversalTransferServiceAPI {
+	return createUserUniversalTransferServiceAPI{a.c.NewUserUniversalTransferService()}
+}
+
+func (a clientAPI) NewListDustLogService() ListDustLogServiceAPI {
+	return listDustLogServiceAPI{a.c.NewListDustLogService()}
+}
+
+func (a clientAPI) NewListDustService() ListDustServiceAPI {
+	return listDustServiceAPI{a.c.NewListDustService()}
+}
+
+func (a clientAPI) NewDustTransferService() DustTransferServiceAPI {
+	return dustTransferServiceAPI{a.c.NewDustTransferService()}
+}
+
+func (a clientAPI) NewAssetDividendService() AssetDividendServiceAPI {
+	return assetDividendServiceAPI{a.c.NewAssetDividendService()}
+}
+
+func (a clientAPI) NewStartUserStreamService() StartUserStreamServiceAPI {
+	return startUserStreamServiceAPI{a.c.NewStartUserStreamService()}
+}
+
+func (a clientAPI) NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI {
+	return keepaliveUserStreamServiceAPI{a.c.NewKeepaliveUserStreamService()}
+}
+
+func (a clientAPI) NewCloseUserStreamService() CloseUserStreamServiceAPI {
+	return closeUserStreamServiceAPI{a.c.NewCloseUserStreamService()}
+}
+
+func (a clientAPI) NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) UserDataStreamAPI {
+	return userDataStreamAPI{a.c.NewUserDataStream(handler, errHandler)}
+}
+
+// PingServiceAPI define the setters and Do of PingService
+type PingServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type pingServiceAPI struct {
+	s *PingService
+}
+
+func (a pingServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// ServerTimeServiceAPI define the setters and Do of ServerTimeService
+type ServerTimeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (int64, error)
+}
+
+type serverTimeServiceAPI struct {
+	s *ServerTimeService
+}
+
+func (a serverTimeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ExchangeInfoServiceAPI define the setters and Do of ExchangeInfoService
+type ExchangeInfoServiceAPI interface {
+	Symbol(symbol string) ExchangeInfoServiceAPI
+	Symbols(symbols ...string) ExchangeInfoServiceAPI
+	Permissions(permissions ...string) ExchangeInfoServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error)
+}
+
+type exchangeInfoServiceAPI struct {
+	s *ExchangeInfoService
+}
+
+func (a exchangeInfoServiceAPI) Symbol(symbol string) ExchangeInfoServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a exchangeInfoServiceAPI) Symbols(symbols ...string) ExchangeInfoServiceAPI {
+	a.s.Symbols(symbols...)
+	return a
+}
+
+func (a exchangeInfoServiceAPI) Permissions(permissions ...string) ExchangeInfoServiceAPI {
+	a.s.Permissions(permissions...)
+	return a
+}
+
+func (a exchangeInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// DepthServiceAPI define the setters and Do of DepthService
+type DepthServiceAPI interface {
+	Symbol(symbol string) DepthServiceAPI
+	Limit(limit int) DepthServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error)
+}
+
+type depthServiceAPI struct {
+	s *DepthService
+}
+
+func (a depthServiceAPI) Symbol(symbol string) DepthServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a depthServiceAPI) Limit(limit int) DepthServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a depthServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// AggTradesServiceAPI define the setters and Do of AggTradesService
+type AggTradesServiceAPI interface {
+	Symbol(symbol string) AggTradesServiceAPI
+	FromID(fromID int64) AggTradesServiceAPI
+	StartTime(startTime int64) AggTradesServiceAPI
+	EndTime(endTime int64) AggTradesServiceAPI
+	Limit(limit int) AggTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*AggTrade, error)
+}
+
+type aggTradesServiceAPI struct {
+	s *AggTradesService
+}
+
+func (a aggTradesServiceAPI) Symbol(symbol string) AggTradesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a aggTradesServiceAPI) FromID(fromID int64) AggTradesServiceAPI {
+	a.s.FromID(fromID)
+	return a
+}
+
+func (a aggTradesServiceAPI) StartTime(startTime int64) AggTradesServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a aggTradesServiceAPI) EndTime(endTime int64) AggTradesServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a aggTradesServiceAPI) Limit(limit int) AggTradesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a aggTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*AggTrade, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// RecentTradesServiceAPI define the setters and Do of RecentTradesService
+type RecentTradesServiceAPI interface {
+	Symbol(symbol string) RecentTradesServiceAPI
+	Limit(limit int) RecentTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error)
+}
+
+type recentTradesServiceAPI struct {
+	s *RecentTradesService
+}
+
+func (a recentTradesServiceAPI) Symbol(symbol string) RecentTradesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a recentTradesServiceAPI) Limit(limit int) RecentTradesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a recentTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// HistoricalTradesServiceAPI define the setters and Do of HistoricalTradesService
+type HistoricalTradesServiceAPI interface {
+	Symbol(symbol string) HistoricalTradesServiceAPI
+	Limit(limit int) HistoricalTradesServiceAPI
+	FromID(fromID int64) HistoricalTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error)
+}
+
+type historicalTradesServiceAPI struct {
+	s *HistoricalTradesService
+}
+
+func (a historicalTradesServiceAPI) Symbol(symbol string) HistoricalTradesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a historicalTradesServiceAPI) Limit(limit int) HistoricalTradesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a historicalTradesServiceAPI) FromID(fromID int64) HistoricalTradesServiceAPI {
+	a.s.FromID(fromID)
+	return a
+}
+
+func (a historicalTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// KlinesServiceAPI define the setters and Do of KlinesService
+type KlinesServiceAPI interface {
+	Symbol(symbol string) KlinesServiceAPI
+	Interval(interval string) KlinesServiceAPI
+	Limit(limit int) KlinesServiceAPI
+	StartTime(startTime int64) KlinesServiceAPI
+	EndTime(endTime int64) KlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type klinesServiceAPI struct {
+	s *KlinesService
+}
+
+func (a klinesServiceAPI) Symbol(symbol string) KlinesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a klinesServiceAPI) Interval(interval string) KlinesServiceAPI {
+	a.s.Interval(interval)
+	return a
+}
+
+func (a klinesServiceAPI) Limit(limit int) KlinesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a klinesServiceAPI) StartTime(startTime int64) KlinesServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a klinesServiceAPI) EndTime(endTime int64) KlinesServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a klinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListPriceChangeStatsServiceAPI define the setters and Do of ListPriceChangeStatsService
+type ListPriceChangeStatsServiceAPI interface {
+	Symbol(symbol string) ListPriceChangeStatsServiceAPI
+	Symbols(symbols []string) ListPriceChangeStatsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error)
+}
+
+type listPriceChangeStatsServiceAPI struct {
+	s *ListPriceChangeStatsService
+}
+
+func (a listPriceChangeStatsServiceAPI) Symbol(symbol string) ListPriceChangeStatsServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listPriceChangeStatsServiceAPI) Symbols(symbols []string) ListPriceChangeStatsServiceAPI {
+	a.s.Symbols(symbols)
+	return a
+}
+
+func (a listPriceChangeStatsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListPricesServiceAPI define the setters and Do of ListPricesService
+type ListPricesServiceAPI interface {
+	Symbol(symbol string) ListPricesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error)
+	Symbols(symbols []string) ListPricesServiceAPI
+}
+
+type listPricesServiceAPI struct {
+	s *ListPricesService
+}
+
+func (a listPricesServiceAPI) Symbol(symbol string) ListPricesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listPricesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+func (a listPricesServiceAPI) Symbols(symbols []string) ListPricesServiceAPI {
+	a.s.Symbols(symbols)
+	return a
+}
+
+// ListBookTickersServiceAPI define the setters and Do of ListBookTickersService
+type ListBookTickersServiceAPI interface {
+	Symbol(symbol string) ListBookTickersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error)
+}
+
+type listBookTickersServiceAPI struct {
+	s *ListBookTickersService
+}
+
+func (a listBookTickersServiceAPI) Symbol(symbol string) ListBookTickersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listBookTickersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListSymbolTickerServiceAPI define the setters and Do of ListSymbolTickerService
+type ListSymbolTickerServiceAPI interface {
+	Symbol(symbol string) ListSymbolTickerServiceAPI
+	Symbols(symbols []string) ListSymbolTickerServiceAPI
+	WindowSize(windowSize string) ListSymbolTickerServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SymbolTicker, error)
+}
+
+type listSymbolTickerServiceAPI struct {
+	s *ListSymbolTickerService
+}
+
+func (a listSymbolTickerServiceAPI) Symbol(symbol string) ListSymbolTickerServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listSymbolTickerServiceAPI) Symbols(symbols []string) ListSymbolTickerServiceAPI {
+	a.s.Symbols(symbols)
+	return a
+}
+
+func (a listSymbolTickerServiceAPI) WindowSize(windowSize string) ListSymbolTickerServiceAPI {
+	a.s.WindowSize(windowSize)
+	return a
+}
+
+func (a listSymbolTickerServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SymbolTicker, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// AveragePriceServiceAPI define the setters and Do of AveragePriceService
+type AveragePriceServiceAPI interface {
+	Symbol(symbol string) AveragePriceServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*AvgPrice, error)
+}
+
+type averagePriceServiceAPI struct {
+	s *AveragePriceService
+}
+
+func (a averagePriceServiceAPI) Symbol(symbol string) AveragePriceServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a averagePriceServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*AvgPrice, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CreateOrderServiceAPI define the setters and Do of CreateOrderService
+type CreateOrderServiceAPI interface {
+	Symbol(symbol string) CreateOrderServiceAPI
+	Side(side SideType) CreateOrderServiceAPI
+	Type(orderType OrderType) CreateOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI
+	Quantity(quantity string) CreateOrderServiceAPI
+	QuoteOrderQty(quoteOrderQty string) CreateOrderServiceAPI
+	Price(price string) CreateOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI
+	StopPrice(stopPrice string) CreateOrderServiceAPI
+	TrailingDelta(trailingDelta string) CreateOrderServiceAPI
+	IcebergQuantity(icebergQuantity string) CreateOrderServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error)
+}
+
+type createOrderServiceAPI struct {
+	s *CreateOrderService
+}
+
+func (a createOrderServiceAPI) Symbol(symbol string) CreateOrderServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a createOrderServiceAPI) Side(side SideType) CreateOrderServiceAPI {
+	a.s.Side(side)
+	return a
+}
+
+func (a createOrderServiceAPI) Type(orderType OrderType) CreateOrderServiceAPI {
+	a.s.Type(orderType)
+	return a
+}
+
+func (a createOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI {
+	a.s.TimeInForce(timeInForce)
+	return a
+}
+
+func (a createOrderServiceAPI) Quantity(quantity string) CreateOrderServiceAPI {
+	a.s.Quantity(quantity)
+	return a
+}
+
+func (a createOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) CreateOrderServiceAPI {
+	a.s.QuoteOrderQty(quoteOrderQty)
+	return a
+}
+
+func (a createOrderServiceAPI) Price(price string) CreateOrderServiceAPI {
+	a.s.Price(price)
+	return a
+}
+
+func (a createOrderServiceAPI) NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI {
+	a.s.NewClientOrderID(newClientOrderID)
+	return a
+}
+
+func (a createOrderServiceAPI) StopPrice(stopPrice string) CreateOrderServiceAPI {
+	a.s.StopPrice(stopPrice)
+	return a
+}
+
+func (a createOrderServiceAPI) TrailingDelta(trailingDelta string) CreateOrderServiceAPI {
+	a.s.TrailingDelta(trailingDelta)
+	return a
+}
+
+func (a createOrderServiceAPI) IcebergQuantity(icebergQuantity string) CreateOrderServiceAPI {
+	a.s.IcebergQuantity(icebergQuantity)
+	return a
+}
+
+func (a createOrderServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderServiceAPI {
+	a.s.NewOrderRespType(newOrderRespType)
+	return a
+}
+
+func (a createOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderServiceAPI {
+	a.s.SelfTradePreventionMode(selfTradePreventionMode)
+	return a
+}
+
+func (a createOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CreateOCOServiceAPI define the setters and Do of CreateOCOService
+type CreateOCOServiceAPI interface {
+	Symbol(symbol string) CreateOCOServiceAPI
+	Side(side SideType) CreateOCOServiceAPI
+	Quantity(quantity string) CreateOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) CreateOCOServiceAPI
+	LimitClientOrderID(limitClientOrderID string) CreateOCOServiceAPI
+	Price(price string) CreateOCOServiceAPI
+	LimitIcebergQuantity(limitIcebergQty string) CreateOCOServiceAPI
+	StopClientOrderID(stopClientOrderID string) CreateOCOServiceAPI
+	StopPrice(stopPrice string) CreateOCOServiceAPI
+	StopLimitPrice(stopLimitPrice string) CreateOCOServiceAPI
+	StopIcebergQty(stopIcebergQty string) CreateOCOServiceAPI
+	StopLimitTimeInForce(stopLimitTimeInForce TimeInForceType) CreateOCOServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CreateOCOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOCOResponse, error)
+}
+
+type createOCOServiceAPI struct {
+	s *CreateOCOService
+}
+
+func (a createOCOServiceAPI) Symbol(symbol string) CreateOCOServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a createOCOServiceAPI) Side(side SideType) CreateOCOServiceAPI {
+	a.s.Side(side)
+	return a
+}
+
+func (a createOCOServiceAPI) Quantity(quantity string) CreateOCOServiceAPI {
+	a.s.Quantity(quantity)
+	return a
+}
+
+func (a createOCOServiceAPI) ListClientOrderID(listClientOrderID string) CreateOCOServiceAPI {
+	a.s.ListClientOrderID(listClientOrderID)
+	return a
+}
+
+func (a createOCOServiceAPI) LimitClientOrderID(limitClientOrderID string) CreateOCOServiceAPI {
+	a.s.LimitClientOrderID(limitClientOrderID)
+	return a
+}
+
+func (a createOCOServiceAPI) Price(price string) CreateOCOServiceAPI {
+	a.s.Price(price)
+	return a
+}
+
+func (a createOCOServiceAPI) LimitIcebergQuantity(limitIcebergQty string) CreateOCOServiceAPI {
+	a.s.LimitIcebergQuantity(limitIcebergQty)
+	return a
+}
+
+func (a createOCOServiceAPI) StopClientOrderID(stopClientOrderID string) CreateOCOServiceAPI {
+	a.s.StopClientOrderID(stopClientOrderID)
+	return a
+}
+
+func (a createOCOServiceAPI) StopPrice(stopPrice string) CreateOCOServiceAPI {
+	a.s.StopPrice(stopPrice)
+	return a
+}
+
+func (a createOCOServiceAPI) StopLimitPrice(stopLimitPrice string) CreateOCOServiceAPI {
+	a.s.StopLimitPrice(stopLimitPrice)
+	return a
+}
+
+func (a createOCOServiceAPI) StopIcebergQty(stopIcebergQty string) CreateOCOServiceAPI {
+	a.s.StopIcebergQty(stopIcebergQty)
+	return a
+}
+
+func (a createOCOServiceAPI) StopLimitTimeInForce(stopLimitTimeInForce TimeInForceType) CreateOCOServiceAPI {
+	a.s.StopLimitTimeInForce(stopLimitTimeInForce)
+	return a
+}
+
+func (a createOCOServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CreateOCOServiceAPI {
+	a.s.NewOrderRespType(newOrderRespType)
+	return a
+}
+
+func (a createOCOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOCOResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CreateOrderListOCOServiceAPI define the setters and Do of CreateOrderListOCOService
+type CreateOrderListOCOServiceAPI interface {
+	Symbol(symbol string) CreateOrderListOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) CreateOrderListOCOServiceAPI
+	Side(side SideType) CreateOrderListOCOServiceAPI
+	Quantity(quantity string) CreateOrderListOCOServiceAPI
+	AboveType(aboveType OrderType) CreateOrderListOCOServiceAPI
+	AboveClientOrderID(aboveClientOrderID string) CreateOrderListOCOServiceAPI
+	AbovePrice(abovePrice string) CreateOrderListOCOServiceAPI
+	AboveStopPrice(aboveStopPrice string) CreateOrderListOCOServiceAPI
+	AboveTrailingDelta(aboveTrailingDelta string) CreateOrderListOCOServiceAPI
+	AboveIcebergQuantity(aboveIcebergQty string) CreateOrderListOCOServiceAPI
+	AboveTimeInForce(aboveTimeInForce TimeInForceType) CreateOrderListOCOServiceAPI
+	BelowType(belowType OrderType) CreateOrderListOCOServiceAPI
+	BelowClientOrderID(belowClientOrderID string) CreateOrderListOCOServiceAPI
+	BelowPrice(belowPrice string) CreateOrderListOCOServiceAPI
+	BelowStopPrice(belowStopPrice string) CreateOrderListOCOServiceAPI
+	BelowTrailingDelta(belowTrailingDelta string) CreateOrderListOCOServiceAPI
+	BelowIcebergQuantity(belowIcebergQty string) CreateOrderListOCOServiceAPI
+	BelowTimeInForce(belowTimeInForce TimeInForceType) CreateOrderListOCOServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderListOCOServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderListOCOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOrderListResponse, error)
+}
+
+type createOrderListOCOServiceAPI struct {
+	s *CreateOrderListOCOService
+}
+
+func (a createOrderListOCOServiceAPI) Symbol(symbol string) CreateOrderListOCOServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) ListClientOrderID(listClientOrderID string) CreateOrderListOCOServiceAPI {
+	a.s.ListClientOrderID(listClientOrderID)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) Side(side SideType) CreateOrderListOCOServiceAPI {
+	a.s.Side(side)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) Quantity(quantity string) CreateOrderListOCOServiceAPI {
+	a.s.Quantity(quantity)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) AboveType(aboveType OrderType) CreateOrderListOCOServiceAPI {
+	a.s.AboveType(aboveType)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) AboveClientOrderID(aboveClientOrderID string) CreateOrderListOCOServiceAPI {
+	a.s.AboveClientOrderID(aboveClientOrderID)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) AbovePrice(abovePrice string) CreateOrderListOCOServiceAPI {
+	a.s.AbovePrice(abovePrice)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) AboveStopPrice(aboveStopPrice string) CreateOrderListOCOServiceAPI {
+	a.s.AboveStopPrice(aboveStopPrice)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) AboveTrailingDelta(aboveTrailingDelta string) CreateOrderListOCOServiceAPI {
+	a.s.AboveTrailingDelta(aboveTrailingDelta)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) AboveIcebergQuantity(aboveIcebergQty string) CreateOrderListOCOServiceAPI {
+	a.s.AboveIcebergQuantity(aboveIcebergQty)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) AboveTimeInForce(aboveTimeInForce TimeInForceType) CreateOrderListOCOServiceAPI {
+	a.s.AboveTimeInForce(aboveTimeInForce)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) BelowType(belowType OrderType) CreateOrderListOCOServiceAPI {
+	a.s.BelowType(belowType)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) BelowClientOrderID(belowClientOrderID string) CreateOrderListOCOServiceAPI {
+	a.s.BelowClientOrderID(belowClientOrderID)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) BelowPrice(belowPrice string) CreateOrderListOCOServiceAPI {
+	a.s.BelowPrice(belowPrice)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) BelowStopPrice(belowStopPrice string) CreateOrderListOCOServiceAPI {
+	a.s.BelowStopPrice(belowStopPrice)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) BelowTrailingDelta(belowTrailingDelta string) CreateOrderListOCOServiceAPI {
+	a.s.BelowTrailingDelta(belowTrailingDelta)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) BelowIcebergQuantity(belowIcebergQty string) CreateOrderListOCOServiceAPI {
+	a.s.BelowIcebergQuantity(belowIcebergQty)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) BelowTimeInForce(belowTimeInForce TimeInForceType) CreateOrderListOCOServiceAPI {
+	a.s.BelowTimeInForce(belowTimeInForce)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderListOCOServiceAPI {
+	a.s.NewOrderRespType(newOrderRespType)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderListOCOServiceAPI {
+	a.s.SelfTradePreventionMode(selfTradePreventionMode)
+	return a
+}
+
+func (a createOrderListOCOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderListResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CreateOrderListOTOServiceAPI define the setters and Do of CreateOrderListOTOService
+type CreateOrderListOTOServiceAPI interface {
+	Symbol(symbol string) CreateOrderListOTOServiceAPI
+	ListClientOrderID(listClientOrderID string) CreateOrderListOTOServiceAPI
+	WorkingType(workingType OrderType) CreateOrderListOTOServiceAPI
+	WorkingSide(workingSide SideType) CreateOrderListOTOServiceAPI
+	WorkingClientOrderID(workingClientOrderID string) CreateOrderListOTOServiceAPI
+	WorkingPrice(workingPrice string) CreateOrderListOTOServiceAPI
+	WorkingQuantity(workingQuantity string) CreateOrderListOTOServiceAPI
+	WorkingIcebergQuantity(workingIcebergQty string) CreateOrderListOTOServiceAPI
+	WorkingTimeInForce(workingTimeInForce TimeInForceType) CreateOrderListOTOServiceAPI
+	PendingType(pendingType OrderType) CreateOrderListOTOServiceAPI
+	PendingSide(pendingSide SideType) CreateOrderListOTOServiceAPI
+	PendingClientOrderID(pendingClientOrderID string) CreateOrderListOTOServiceAPI
+	PendingPrice(pendingPrice string) CreateOrderListOTOServiceAPI
+	PendingStopPrice(pendingStopPrice string) CreateOrderListOTOServiceAPI
+	PendingTrailingDelta(pendingTrailingDelta string) CreateOrderListOTOServiceAPI
+	PendingQuantity(pendingQuantity string) CreateOrderListOTOServiceAPI
+	PendingIcebergQuantity(pendingIcebergQty string) CreateOrderListOTOServiceAPI
+	PendingTimeInForce(pendingTimeInForce TimeInForceType) CreateOrderListOTOServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderListOTOServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderListOTOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOrderListResponse, error)
+}
+
+type createOrderListOTOServiceAPI struct {
+	s *CreateOrderListOTOService
+}
+
+func (a createOrderListOTOServiceAPI) Symbol(symbol string) CreateOrderListOTOServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) ListClientOrderID(listClientOrderID string) CreateOrderListOTOServiceAPI {
+	a.s.ListClientOrderID(listClientOrderID)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) WorkingType(workingType OrderType) CreateOrderListOTOServiceAPI {
+	a.s.WorkingType(workingType)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) WorkingSide(workingSide SideType) CreateOrderListOTOServiceAPI {
+	a.s.WorkingSide(workingSide)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) WorkingClientOrderID(workingClientOrderID string) CreateOrderListOTOServiceAPI {
+	a.s.WorkingClientOrderID(workingClientOrderID)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) WorkingPrice(workingPrice string) CreateOrderListOTOServiceAPI {
+	a.s.WorkingPrice(workingPrice)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) WorkingQuantity(workingQuantity string) CreateOrderListOTOServiceAPI {
+	a.s.WorkingQuantity(workingQuantity)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) WorkingIcebergQuantity(workingIcebergQty string) CreateOrderListOTOServiceAPI {
+	a.s.WorkingIcebergQuantity(workingIcebergQty)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) WorkingTimeInForce(workingTimeInForce TimeInForceType) CreateOrderListOTOServiceAPI {
+	a.s.WorkingTimeInForce(workingTimeInForce)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) PendingType(pendingType OrderType) CreateOrderListOTOServiceAPI {
+	a.s.PendingType(pendingType)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) PendingSide(pendingSide SideType) CreateOrderListOTOServiceAPI {
+	a.s.PendingSide(pendingSide)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) PendingClientOrderID(pendingClientOrderID string) CreateOrderListOTOServiceAPI {
+	a.s.PendingClientOrderID(pendingClientOrderID)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) PendingPrice(pendingPrice string) CreateOrderListOTOServiceAPI {
+	a.s.PendingPrice(pendingPrice)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) PendingStopPrice(pendingStopPrice string) CreateOrderListOTOServiceAPI {
+	a.s.PendingStopPrice(pendingStopPrice)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) PendingTrailingDelta(pendingTrailingDelta string) CreateOrderListOTOServiceAPI {
+	a.s.PendingTrailingDelta(pendingTrailingDelta)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) PendingQuantity(pendingQuantity string) CreateOrderListOTOServiceAPI {
+	a.s.PendingQuantity(pendingQuantity)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) PendingIcebergQuantity(pendingIcebergQty string) CreateOrderListOTOServiceAPI {
+	a.s.PendingIcebergQuantity(pendingIcebergQty)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) PendingTimeInForce(pendingTimeInForce TimeInForceType) CreateOrderListOTOServiceAPI {
+	a.s.PendingTimeInForce(pendingTimeInForce)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderListOTOServiceAPI {
+	a.s.NewOrderRespType(newOrderRespType)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderListOTOServiceAPI {
+	a.s.SelfTradePreventionMode(selfTradePreventionMode)
+	return a
+}
+
+func (a createOrderListOTOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderListResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CreateOrderListOTOCOServiceAPI define the setters and Do of CreateOrderListOTOCOService
+type CreateOrderListOTOCOServiceAPI interface {
+	Symbol(symbol string) CreateOrderListOTOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) CreateOrderListOTOCOServiceAPI
+	WorkingType(workingType OrderType) CreateOrderListOTOCOServiceAPI
+	WorkingSide(workingSide SideType) CreateOrderListOTOCOServiceAPI
+	WorkingClientOrderID(workingClientOrderID string) CreateOrderListOTOCOServiceAPI
+	WorkingPrice(workingPrice string) CreateOrderListOTOCOServiceAPI
+	WorkingQuantity(workingQuantity string) CreateOrderListOTOCOServiceAPI
+	WorkingIcebergQuantity(workingIcebergQty string) CreateOrderListOTOCOServiceAPI
+	WorkingTimeInForce(workingTimeInForce TimeInForceType) CreateOrderListOTOCOServiceAPI
+	PendingSide(pendingSide SideType) CreateOrderListOTOCOServiceAPI
+	PendingQuantity(pendingQuantity string) CreateOrderListOTOCOServiceAPI
+	PendingAboveType(pendingAboveType OrderType) CreateOrderListOTOCOServiceAPI
+	PendingAboveClientOrderID(pendingAboveClientOrderID string) CreateOrderListOTOCOServiceAPI
+	PendingAbovePrice(pendingAbovePrice string) CreateOrderListOTOCOServiceAPI
+	PendingAboveStopPrice(pendingAboveStopPrice string) CreateOrderListOTOCOServiceAPI
+	PendingAboveTrailingDelta(pendingAboveTrailingDelta string) CreateOrderListOTOCOServiceAPI
+	PendingAboveIcebergQuantity(pendingAboveIcebergQty string) CreateOrderListOTOCOServiceAPI
+	PendingAboveTimeInForce(pendingAboveTimeInForce TimeInForceType) CreateOrderListOTOCOServiceAPI
+	PendingBelowType(pendingBelowType OrderType) CreateOrderListOTOCOServiceAPI
+	PendingBelowClientOrderID(pendingBelowClientOrderID string) CreateOrderListOTOCOServiceAPI
+	PendingBelowPrice(pendingBelowPrice string) CreateOrderListOTOCOServiceAPI
+	PendingBelowStopPrice(pendingBelowStopPrice string) CreateOrderListOTOCOServiceAPI
+	PendingBelowTrailingDelta(pendingBelowTrailingDelta string) CreateOrderListOTOCOServiceAPI
+	PendingBelowIcebergQuantity(pendingBelowIcebergQty string) CreateOrderListOTOCOServiceAPI
+	PendingBelowTimeInForce(pendingBelowTimeInForce TimeInForceType) CreateOrderListOTOCOServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderListOTOCOServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderListOTOCOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOrderListResponse, error)
+}
+
+type createOrderListOTOCOServiceAPI struct {
+	s *CreateOrderListOTOCOService
+}
+
+func (a createOrderListOTOCOServiceAPI) Symbol(symbol string) CreateOrderListOTOCOServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) ListClientOrderID(listClientOrderID string) CreateOrderListOTOCOServiceAPI {
+	a.s.ListClientOrderID(listClientOrderID)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) WorkingType(workingType OrderType) CreateOrderListOTOCOServiceAPI {
+	a.s.WorkingType(workingType)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) WorkingSide(workingSide SideType) CreateOrderListOTOCOServiceAPI {
+	a.s.WorkingSide(workingSide)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) WorkingClientOrderID(workingClientOrderID string) CreateOrderListOTOCOServiceAPI {
+	a.s.WorkingClientOrderID(workingClientOrderID)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) WorkingPrice(workingPrice string) CreateOrderListOTOCOServiceAPI {
+	a.s.WorkingPrice(workingPrice)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) WorkingQuantity(workingQuantity string) CreateOrderListOTOCOServiceAPI {
+	a.s.WorkingQuantity(workingQuantity)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) WorkingIcebergQuantity(workingIcebergQty string) CreateOrderListOTOCOServiceAPI {
+	a.s.WorkingIcebergQuantity(workingIcebergQty)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) WorkingTimeInForce(workingTimeInForce TimeInForceType) CreateOrderListOTOCOServiceAPI {
+	a.s.WorkingTimeInForce(workingTimeInForce)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingSide(pendingSide SideType) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingSide(pendingSide)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingQuantity(pendingQuantity string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingQuantity(pendingQuantity)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingAboveType(pendingAboveType OrderType) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingAboveType(pendingAboveType)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingAboveClientOrderID(pendingAboveClientOrderID string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingAboveClientOrderID(pendingAboveClientOrderID)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingAbovePrice(pendingAbovePrice string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingAbovePrice(pendingAbovePrice)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingAboveStopPrice(pendingAboveStopPrice string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingAboveStopPrice(pendingAboveStopPrice)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingAboveTrailingDelta(pendingAboveTrailingDelta string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingAboveTrailingDelta(pendingAboveTrailingDelta)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingAboveIcebergQuantity(pendingAboveIcebergQty string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingAboveIcebergQuantity(pendingAboveIcebergQty)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingAboveTimeInForce(pendingAboveTimeInForce TimeInForceType) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingAboveTimeInForce(pendingAboveTimeInForce)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingBelowType(pendingBelowType OrderType) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingBelowType(pendingBelowType)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingBelowClientOrderID(pendingBelowClientOrderID string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingBelowClientOrderID(pendingBelowClientOrderID)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingBelowPrice(pendingBelowPrice string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingBelowPrice(pendingBelowPrice)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingBelowStopPrice(pendingBelowStopPrice string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingBelowStopPrice(pendingBelowStopPrice)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingBelowTrailingDelta(pendingBelowTrailingDelta string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingBelowTrailingDelta(pendingBelowTrailingDelta)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingBelowIcebergQuantity(pendingBelowIcebergQty string) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingBelowIcebergQuantity(pendingBelowIcebergQty)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) PendingBelowTimeInForce(pendingBelowTimeInForce TimeInForceType) CreateOrderListOTOCOServiceAPI {
+	a.s.PendingBelowTimeInForce(pendingBelowTimeInForce)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CreateOrderListOTOCOServiceAPI {
+	a.s.NewOrderRespType(newOrderRespType)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderListOTOCOServiceAPI {
+	a.s.SelfTradePreventionMode(selfTradePreventionMode)
+	return a
+}
+
+func (a createOrderListOTOCOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderListResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetOrderListServiceAPI define the setters and Do of GetOrderListService
+type GetOrderListServiceAPI interface {
+	OrderListID(orderListID int64) GetOrderListServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetOrderListServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*OrderList, error)
+}
+
+type getOrderListServiceAPI struct {
+	s *GetOrderListService
+}
+
+func (a getOrderListServiceAPI) OrderListID(orderListID int64) GetOrderListServiceAPI {
+	a.s.OrderListID(orderListID)
+	return a
+}
+
+func (a getOrderListServiceAPI) OrigClientOrderID(origClientOrderID string) GetOrderListServiceAPI {
+	a.s.OrigClientOrderID(origClientOrderID)
+	return a
+}
+
+func (a getOrderListServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*OrderList, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListAllOrderListsServiceAPI define the setters and Do of ListAllOrderListsService
+type ListAllOrderListsServiceAPI interface {
+	FromID(fromID int64) ListAllOrderListsServiceAPI
+	StartTime(startTime int64) ListAllOrderListsServiceAPI
+	EndTime(endTime int64) ListAllOrderListsServiceAPI
+	Limit(limit int) ListAllOrderListsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*OrderList, error)
+}
+
+type listAllOrderListsServiceAPI struct {
+	s *ListAllOrderListsService
+}
+
+func (a listAllOrderListsServiceAPI) FromID(fromID int64) ListAllOrderListsServiceAPI {
+	a.s.FromID(fromID)
+	return a
+}
+
+func (a listAllOrderListsServiceAPI) StartTime(startTime int64) ListAllOrderListsServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listAllOrderListsServiceAPI) EndTime(endTime int64) ListAllOrderListsServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listAllOrderListsServiceAPI) Limit(limit int) ListAllOrderListsServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listAllOrderListsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*OrderList, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CancelOCOServiceAPI define the setters and Do of CancelOCOService
+type CancelOCOServiceAPI interface {
+	Symbol(symbol string) CancelOCOServiceAPI
+	ListClientOrderID(listClientOrderID string) CancelOCOServiceAPI
+	OrderListID(orderListID int64) CancelOCOServiceAPI
+	NewClientOrderID(newClientOrderID string) CancelOCOServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelOCOResponse, error)
+}
+
+type cancelOCOServiceAPI struct {
+	s *CancelOCOService
+}
+
+func (a cancelOCOServiceAPI) Symbol(symbol string) CancelOCOServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a cancelOCOServiceAPI) ListClientOrderID(listClientOrderID string) CancelOCOServiceAPI {
+	a.s.ListClientOrderID(listClientOrderID)
+	return a
+}
+
+func (a cancelOCOServiceAPI) OrderListID(orderListID int64) CancelOCOServiceAPI {
+	a.s.OrderListID(orderListID)
+	return a
+}
+
+func (a cancelOCOServiceAPI) NewClientOrderID(newClientOrderID string) CancelOCOServiceAPI {
+	a.s.NewClientOrderID(newClientOrderID)
+	return a
+}
+
+func (a cancelOCOServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelOCOResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetOrderServiceAPI define the setters and Do of GetOrderService
+type GetOrderServiceAPI interface {
+	Symbol(symbol string) GetOrderServiceAPI
+	OrderID(orderID int64) GetOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getOrderServiceAPI struct {
+	s *GetOrderService
+}
+
+func (a getOrderServiceAPI) Symbol(symbol string) GetOrderServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a getOrderServiceAPI) OrderID(orderID int64) GetOrderServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a getOrderServiceAPI) OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI {
+	a.s.OrigClientOrderID(origClientOrderID)
+	return a
+}
+
+func (a getOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CancelOrderServiceAPI define the setters and Do of CancelOrderService
+type CancelOrderServiceAPI interface {
+	Symbol(symbol string) CancelOrderServiceAPI
+	OrderID(orderID int64) CancelOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CancelOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error)
+}
+
+type cancelOrderServiceAPI struct {
+	s *CancelOrderService
+}
+
+func (a cancelOrderServiceAPI) Symbol(symbol string) CancelOrderServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a cancelOrderServiceAPI) OrderID(orderID int64) CancelOrderServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a cancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI {
+	a.s.OrigClientOrderID(origClientOrderID)
+	return a
+}
+
+func (a cancelOrderServiceAPI) NewClientOrderID(newClientOrderID string) CancelOrderServiceAPI {
+	a.s.NewClientOrderID(newClientOrderID)
+	return a
+}
+
+func (a cancelOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CancelReplaceOrderServiceAPI define the setters and Do of CancelReplaceOrderService
+type CancelReplaceOrderServiceAPI interface {
+	Symbol(symbol string) CancelReplaceOrderServiceAPI
+	Side(side SideType) CancelReplaceOrderServiceAPI
+	Type(orderType OrderType) CancelReplaceOrderServiceAPI
+	CancelReplaceMode(mode CancelReplaceModeType) CancelReplaceOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CancelReplaceOrderServiceAPI
+	Quantity(quantity string) CancelReplaceOrderServiceAPI
+	QuoteOrderQty(quoteOrderQty string) CancelReplaceOrderServiceAPI
+	Price(price string) CancelReplaceOrderServiceAPI
+	CancelNewClientOrderID(cancelNewClientOrderID string) CancelReplaceOrderServiceAPI
+	CancelOrigClientOrderID(cancelOrigClientOrderID string) CancelReplaceOrderServiceAPI
+	CancelOrderID(cancelOrderID int64) CancelReplaceOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CancelReplaceOrderServiceAPI
+	StopPrice(stopPrice string) CancelReplaceOrderServiceAPI
+	TrailingDelta(trailingDelta string) CancelReplaceOrderServiceAPI
+	IcebergQuantity(icebergQuantity string) CancelReplaceOrderServiceAPI
+	NewOrderRespType(newOrderRespType NewOrderRespType) CancelReplaceOrderServiceAPI
+	CancelRestrictions(cancelRestrictions CancelRestrictionsType) CancelReplaceOrderServiceAPI
+	OrderRateLimitExceededMode(mode OrderRateLimitExceededModeType) CancelReplaceOrderServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CancelReplaceOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelReplaceOrderResponse, error)
+}
+
+type cancelReplaceOrderServiceAPI struct {
+	s *CancelReplaceOrderService
+}
+
+func (a cancelReplaceOrderServiceAPI) Symbol(symbol string) CancelReplaceOrderServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) Side(side SideType) CancelReplaceOrderServiceAPI {
+	a.s.Side(side)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) Type(orderType OrderType) CancelReplaceOrderServiceAPI {
+	a.s.Type(orderType)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) CancelReplaceMode(mode CancelReplaceModeType) CancelReplaceOrderServiceAPI {
+	a.s.CancelReplaceMode(mode)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CancelReplaceOrderServiceAPI {
+	a.s.TimeInForce(timeInForce)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) Quantity(quantity string) CancelReplaceOrderServiceAPI {
+	a.s.Quantity(quantity)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) QuoteOrderQty(quoteOrderQty string) CancelReplaceOrderServiceAPI {
+	a.s.QuoteOrderQty(quoteOrderQty)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) Price(price string) CancelReplaceOrderServiceAPI {
+	a.s.Price(price)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) CancelNewClientOrderID(cancelNewClientOrderID string) CancelReplaceOrderServiceAPI {
+	a.s.CancelNewClientOrderID(cancelNewClientOrderID)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) CancelOrigClientOrderID(cancelOrigClientOrderID string) CancelReplaceOrderServiceAPI {
+	a.s.CancelOrigClientOrderID(cancelOrigClientOrderID)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) CancelOrderID(cancelOrderID int64) CancelReplaceOrderServiceAPI {
+	a.s.CancelOrderID(cancelOrderID)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) NewClientOrderID(newClientOrderID string) CancelReplaceOrderServiceAPI {
+	a.s.NewClientOrderID(newClientOrderID)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) StopPrice(stopPrice string) CancelReplaceOrderServiceAPI {
+	a.s.StopPrice(stopPrice)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) TrailingDelta(trailingDelta string) CancelReplaceOrderServiceAPI {
+	a.s.TrailingDelta(trailingDelta)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) IcebergQuantity(icebergQuantity string) CancelReplaceOrderServiceAPI {
+	a.s.IcebergQuantity(icebergQuantity)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) NewOrderRespType(newOrderRespType NewOrderRespType) CancelReplaceOrderServiceAPI {
+	a.s.NewOrderRespType(newOrderRespType)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) CancelRestrictions(cancelRestrictions CancelRestrictionsType) CancelReplaceOrderServiceAPI {
+	a.s.CancelRestrictions(cancelRestrictions)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) OrderRateLimitExceededMode(mode OrderRateLimitExceededModeType) CancelReplaceOrderServiceAPI {
+	a.s.OrderRateLimitExceededMode(mode)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CancelReplaceOrderServiceAPI {
+	a.s.SelfTradePreventionMode(selfTradePreventionMode)
+	return a
+}
+
+func (a cancelReplaceOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelReplaceOrderResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// AmendOrderKeepPriorityServiceAPI define the setters and Do of AmendOrderKeepPriorityService
+type AmendOrderKeepPriorityServiceAPI interface {
+	Symbol(symbol string) AmendOrderKeepPriorityServiceAPI
+	OrderID(orderID int64) AmendOrderKeepPriorityServiceAPI
+	OrigClientOrderID(origClientOrderID string) AmendOrderKeepPriorityServiceAPI
+	NewClientOrderID(newClientOrderID string) AmendOrderKeepPriorityServiceAPI
+	NewQuantity(newQuantity string) AmendOrderKeepPriorityServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*AmendOrderKeepPriorityResponse, error)
+}
+
+type amendOrderKeepPriorityServiceAPI struct {
+	s *AmendOrderKeepPriorityService
+}
+
+func (a amendOrderKeepPriorityServiceAPI) Symbol(symbol string) AmendOrderKeepPriorityServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a amendOrderKeepPriorityServiceAPI) OrderID(orderID int64) AmendOrderKeepPriorityServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a amendOrderKeepPriorityServiceAPI) OrigClientOrderID(origClientOrderID string) AmendOrderKeepPriorityServiceAPI {
+	a.s.OrigClientOrderID(origClientOrderID)
+	return a
+}
+
+func (a amendOrderKeepPriorityServiceAPI) NewClientOrderID(newClientOrderID string) AmendOrderKeepPriorityServiceAPI {
+	a.s.NewClientOrderID(newClientOrderID)
+	return a
+}
+
+func (a amendOrderKeepPriorityServiceAPI) NewQuantity(newQuantity string) AmendOrderKeepPriorityServiceAPI {
+	a.s.NewQuantity(newQuantity)
+	return a
+}
+
+func (a amendOrderKeepPriorityServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*AmendOrderKeepPriorityResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CancelOpenOrdersServiceAPI define the setters and Do of CancelOpenOrdersService
+type CancelOpenOrdersServiceAPI interface {
+	Symbol(symbol string) CancelOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelOpenOrdersResponse, error)
+}
+
+type cancelOpenOrdersServiceAPI struct {
+	s *CancelOpenOrdersService
+}
+
+func (a cancelOpenOrdersServiceAPI) Symbol(symbol string) CancelOpenOrdersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a cancelOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelOpenOrdersResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListOpenOrdersServiceAPI define the setters and Do of ListOpenOrdersService
+type ListOpenOrdersServiceAPI interface {
+	Symbol(symbol string) ListOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOpenOrdersServiceAPI struct {
+	s *ListOpenOrdersService
+}
+
+func (a listOpenOrdersServiceAPI) Symbol(symbol string) ListOpenOrdersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListOpenOcoServiceAPI define the setters and Do of ListOpenOcoService
+type ListOpenOcoServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*Oco, error)
+}
+
+type listOpenOcoServiceAPI struct {
+	s *ListOpenOcoService
+}
+
+func (a listOpenOcoServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Oco, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListOrdersServiceAPI define the setters and Do of ListOrdersService
+type ListOrdersServiceAPI interface {
+	Symbol(symbol string) ListOrdersServiceAPI
+	OrderID(orderID int64) ListOrdersServiceAPI
+	StartTime(startTime int64) ListOrdersServiceAPI
+	EndTime(endTime int64) ListOrdersServiceAPI
+	Limit(limit int) ListOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOrdersServiceAPI struct {
+	s *ListOrdersService
+}
+
+func (a listOrdersServiceAPI) Symbol(symbol string) ListOrdersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listOrdersServiceAPI) OrderID(orderID int64) ListOrdersServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a listOrdersServiceAPI) StartTime(startTime int64) ListOrdersServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listOrdersServiceAPI) EndTime(endTime int64) ListOrdersServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listOrdersServiceAPI) Limit(limit int) ListOrdersServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListTradesServiceAPI define the setters and Do of ListTradesService
+type ListTradesServiceAPI interface {
+	Symbol(symbol string) ListTradesServiceAPI
+	StartTime(startTime int64) ListTradesServiceAPI
+	EndTime(endTime int64) ListTradesServiceAPI
+	Limit(limit int) ListTradesServiceAPI
+	FromID(fromID int64) ListTradesServiceAPI
+	OrderId(OrderId int64) ListTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*TradeV3, error)
+}
+
+type listTradesServiceAPI struct {
+	s *ListTradesService
+}
+
+func (a listTradesServiceAPI) Symbol(symbol string) ListTradesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listTradesServiceAPI) StartTime(startTime int64) ListTradesServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listTradesServiceAPI) EndTime(endTime int64) ListTradesServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listTradesServiceAPI) Limit(limit int) ListTradesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listTradesServiceAPI) FromID(fromID int64) ListTradesServiceAPI {
+	a.s.FromID(fromID)
+	return a
+}
+
+func (a listTradesServiceAPI) OrderId(OrderId int64) ListTradesServiceAPI {
+	a.s.OrderId(OrderId)
+	return a
+}
+
+func (a listTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*TradeV3, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListPreventedMatchesServiceAPI define the setters and Do of ListPreventedMatchesService
+type ListPreventedMatchesServiceAPI interface {
+	Symbol(symbol string) ListPreventedMatchesServiceAPI
+	PreventedMatchID(preventedMatchID int64) ListPreventedMatchesServiceAPI
+	OrderID(orderID int64) ListPreventedMatchesServiceAPI
+	FromPreventedMatchID(fromPreventedMatchID int64) ListPreventedMatchesServiceAPI
+	Limit(limit int) ListPreventedMatchesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PreventedMatch, error)
+}
+
+type listPreventedMatchesServiceAPI struct {
+	s *ListPreventedMatchesService
+}
+
+func (a listPreventedMatchesServiceAPI) Symbol(symbol string) ListPreventedMatchesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listPreventedMatchesServiceAPI) PreventedMatchID(preventedMatchID int64) ListPreventedMatchesServiceAPI {
+	a.s.PreventedMatchID(preventedMatchID)
+	return a
+}
+
+func (a listPreventedMatchesServiceAPI) OrderID(orderID int64) ListPreventedMatchesServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a listPreventedMatchesServiceAPI) FromPreventedMatchID(fromPreventedMatchID int64) ListPreventedMatchesServiceAPI {
+	a.s.FromPreventedMatchID(fromPreventedMatchID)
+	return a
+}
+
+func (a listPreventedMatchesServiceAPI) Limit(limit int) ListPreventedMatchesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listPreventedMatchesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PreventedMatch, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetAccountServiceAPI define the setters and Do of GetAccountService
+type GetAccountServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*Account, error)
+}
+
+type getAccountServiceAPI struct {
+	s *GetAccountService
+}
+
+func (a getAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Account, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetAccountSnapshotServiceAPI define the setters and Do of GetAccountSnapshotService
+type GetAccountSnapshotServiceAPI interface {
+	Type(accountType string) GetAccountSnapshotServiceAPI
+	StartTime(startTime int64) GetAccountSnapshotServiceAPI
+	EndTime(endTime int64) GetAccountSnapshotServiceAPI
+	Limit(limit int) GetAccountSnapshotServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Snapshot, error)
+}
+
+type getAccountSnapshotServiceAPI struct {
+	s *GetAccountSnapshotService
+}
+
+func (a getAccountSnapshotServiceAPI) Type(accountType string) GetAccountSnapshotServiceAPI {
+	a.s.Type(accountType)
+	return a
+}
+
+func (a getAccountSnapshotServiceAPI) StartTime(startTime int64) GetAccountSnapshotServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a getAccountSnapshotServiceAPI) EndTime(endTime int64) GetAccountSnapshotServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a getAccountSnapshotServiceAPI) Limit(limit int) GetAccountSnapshotServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a getAccountSnapshotServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Snapshot, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListDepositsServiceAPI define the setters and Do of ListDepositsService
+type ListDepositsServiceAPI interface {
+	Coin(coin string) ListDepositsServiceAPI
+	Status(status int) ListDepositsServiceAPI
+	StartTime(startTime int64) ListDepositsServiceAPI
+	EndTime(endTime int64) ListDepositsServiceAPI
+	Offset(offset int) ListDepositsServiceAPI
+	Limit(limit int) ListDepositsServiceAPI
+	TxID(id string) ListDepositsServiceAPI
+	Do(ctx context.Context) ([]*Deposit, error)
+}
+
+type listDepositsServiceAPI struct {
+	s *ListDepositsService
+}
+
+func (a listDepositsServiceAPI) Coin(coin string) ListDepositsServiceAPI {
+	a.s.Coin(coin)
+	return a
+}
+
+func (a listDepositsServiceAPI) Status(status int) ListDepositsServiceAPI {
+	a.s.Status(status)
+	return a
+}
+
+func (a listDepositsServiceAPI) StartTime(startTime int64) ListDepositsServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listDepositsServiceAPI) EndTime(endTime int64) ListDepositsServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listDepositsServiceAPI) Offset(offset int) ListDepositsServiceAPI {
+	a.s.Offset(offset)
+	return a
+}
+
+func (a listDepositsServiceAPI) Limit(limit int) ListDepositsServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listDepositsServiceAPI) TxID(id string) ListDepositsServiceAPI {
+	a.s.TxID(id)
+	return a
+}
+
+func (a listDepositsServiceAPI) Do(ctx context.Context) ([]*Deposit, error) {
+	return a.s.Do(ctx)
+}
+
+// GetDepositsAddressServiceAPI define the setters and Do of GetDepositsAddressService
+type GetDepositsAddressServiceAPI interface {
+	Coin(coin string) GetDepositsAddressServiceAPI
+	Network(network string) GetDepositsAddressServiceAPI
+	Do(ctx context.Context) (*GetDepositAddressResponse, error)
+}
+
+type getDepositsAddressServiceAPI struct {
+	s *GetDepositsAddressService
+}
+
+func (a getDepositsAddressServiceAPI) Coin(coin string) GetDepositsAddressServiceAPI {
+	a.s.Coin(coin)
+	return a
+}
+
+func (a getDepositsAddressServiceAPI) Network(network string) GetDepositsAddressServiceAPI {
+	a.s.Network(network)
+	return a
+}
+
+func (a getDepositsAddressServiceAPI) Do(ctx context.Context) (*GetDepositAddressResponse, error) {
+	return a.s.Do(ctx)
+}
+
+// CreateWithdrawServiceAPI define the setters and Do of CreateWithdrawService
+type CreateWithdrawServiceAPI interface {
+	Coin(v string) CreateWithdrawServiceAPI
+	WithdrawOrderID(v string) CreateWithdrawServiceAPI
+	Network(v string) CreateWithdrawServiceAPI
+	Address(v string) CreateWithdrawServiceAPI
+	AddressTag(v string) CreateWithdrawServiceAPI
+	Amount(v string) CreateWithdrawServiceAPI
+	TransactionFeeFlag(v bool) CreateWithdrawServiceAPI
+	Name(v string) CreateWithdrawServiceAPI
+	Do(ctx context.Context) (*CreateWithdrawResponse, error)
+}
+
+type createWithdrawServiceAPI struct {
+	s *CreateWithdrawService
+}
+
+func (a createWithdrawServiceAPI) Coin(v string) CreateWithdrawServiceAPI {
+	a.s.Coin(v)
+	return a
+}
+
+func (a createWithdrawServiceAPI) WithdrawOrderID(v string) CreateWithdrawServiceAPI {
+	a.s.WithdrawOrderID(v)
+	return a
+}
+
+func (a createWithdrawServiceAPI) Network(v string) CreateWithdrawServiceAPI {
+	a.s.Network(v)
+	return a
+}
+
+func (a createWithdrawServiceAPI) Address(v string) CreateWithdrawServiceAPI {
+	a.s.Address(v)
+	return a
+}
+
+func (a createWithdrawServiceAPI) AddressTag(v string) CreateWithdrawServiceAPI {
+	a.s.AddressTag(v)
+	return a
+}
+
+func (a createWithdrawServiceAPI) Amount(v string) CreateWithdrawServiceAPI {
+	a.s.Amount(v)
+	return a
+}
+
+func (a createWithdrawServiceAPI) TransactionFeeFlag(v bool) CreateWithdrawServiceAPI {
+	a.s.TransactionFeeFlag(v)
+	return a
+}
+
+func (a createWithdrawServiceAPI) Name(v string) CreateWithdrawServiceAPI {
+	a.s.Name(v)
+	return a
+}
+
+func (a createWithdrawServiceAPI) Do(ctx context.Context) (*CreateWithdrawResponse, error) {
+	return a.s.Do(ctx)
+}
+
+// ListWithdrawsServiceAPI define the setters and Do of ListWithdrawsService
+type ListWithdrawsServiceAPI interface {
+	Coin(coin string) ListWithdrawsServiceAPI
+	WithdrawOrderId(withdrawOrderId string) ListWithdrawsServiceAPI
+	Status(status int) ListWithdrawsServiceAPI
+	StartTime(startTime int64) ListWithdrawsServiceAPI
+	EndTime(endTime int64) ListWithdrawsServiceAPI
+	Offset(offset int) ListWithdrawsServiceAPI
+	Limit(limit int) ListWithdrawsServiceAPI
+	Do(ctx context.Context) ([]*Withdraw, error)
+}
+
+type listWithdrawsServiceAPI struct {
+	s *ListWithdrawsService
+}
+
+func (a listWithdrawsServiceAPI) Coin(coin string) ListWithdrawsServiceAPI {
+	a.s.Coin(coin)
+	return a
+}
+
+func (a listWithdrawsServiceAPI) WithdrawOrderId(withdrawOrderId string) ListWithdrawsServiceAPI {
+	a.s.WithdrawOrderId(withdrawOrderId)
+	return a
+}
+
+func (a listWithdrawsServiceAPI) Status(status int) ListWithdrawsServiceAPI {
+	a.s.Status(status)
+	return a
+}
+
+func (a listWithdrawsServiceAPI) StartTime(startTime int64) ListWithdrawsServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listWithdrawsServiceAPI) EndTime(endTime int64) ListWithdrawsServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listWithdrawsServiceAPI) Offset(offset int) ListWithdrawsServiceAPI {
+	a.s.Offset(offset)
+	return a
+}
+
+func (a listWithdrawsServiceAPI) Limit(limit int) ListWithdrawsServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listWithdrawsServiceAPI) Do(ctx context.Context) ([]*Withdraw, error) {
+	return a.s.Do(ctx)
+}
+
+// GetAssetDetailServiceAPI define the setters and Do of GetAssetDetailService
+type GetAssetDetailServiceAPI interface {
+	Asset(asset string) GetAssetDetailServiceAPI
+	Do(ctx context.Context) (map[string]AssetDetail, error)
+}
+
+type getAssetDetailServiceAPI struct {
+	s *GetAssetDetailService
+}
+
+func (a getAssetDetailServiceAPI) Asset(asset string) GetAssetDetailServiceAPI {
+	a.s.Asset(asset)
+	return a
+}
+
+func (a getAssetDetailServiceAPI) Do(ctx context.Context) (map[string]AssetDetail, error) {
+	return a.s.Do(ctx)
+}
+
+// GetAllCoinsInfoServiceAPI define the setters and Do of GetAllCoinsInfoService
+type GetAllCoinsInfoServiceAPI interface {
+	Do(ctx context.Context) ([]*CoinInfo, error)
+}
+
+type getAllCoinsInfoServiceAPI struct {
+	s *GetAllCoinsInfoService
+}
+
+func (a getAllCoinsInfoServiceAPI) Do(ctx context.Context) ([]*CoinInfo, error) {
+	return a.s.Do(ctx)
+}
+
+// TradeFeeServiceAPI define the setters and Do of TradeFeeService
+type TradeFeeServiceAPI interface {
+	Symbol(symbol string) TradeFeeServiceAPI
+	Do(ctx context.Context) ([]*TradeFeeDetails, error)
+}
+
+type tradeFeeServiceAPI struct {
+	s *TradeFeeService
+}
+
+func (a tradeFeeServiceAPI) Symbol(symbol string) TradeFeeServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a tradeFeeServiceAPI) Do(ctx context.Context) ([]*TradeFeeDetails, error) {
+	return a.s.Do(ctx)
+}
+
+// CreateUserUniversalTransferServiceAPI define the setters and Do of CreateUserUniversalTransferService
+type CreateUserUniversalTransferServiceAPI interface {
+	Type(v string) CreateUserUniversalTransferServiceAPI
+	Asset(v string) CreateUserUniversalTransferServiceAPI
+	Amount(v float64) CreateUserUniversalTransferServiceAPI
+	FromSymbol(v string) CreateUserUniversalTransferServiceAPI
+	ToSymbol(v string) CreateUserUniversalTransferServiceAPI
+	Do(ctx context.Context) (*CreateUserUniversalTransferResponse, error)
+}
+
+type createUserUniversalTransferServiceAPI struct {
+	s *CreateUserUniversalTransferService
+}
+
+func (a createUserUniversalTransferServiceAPI) Type(v string) CreateUserUniversalTransferServiceAPI {
+	a.s.Type(v)
+	return a
+}
+
+func (a createUserUniversalTransferServiceAPI) Asset(v string) CreateUserUniversalTransferServiceAPI {
+	a.s.Asset(v)
+	return a
+}
+
+func (a createUserUniversalTransferServiceAPI) Amount(v float64) CreateUserUniversalTransferServiceAPI {
+	a.s.Amount(v)
+	return a
+}
+
+func (a createUserUniversalTransferServiceAPI) FromSymbol(v string) CreateUserUniversalTransferServiceAPI {
+	a.s.FromSymbol(v)
+	return a
+}
+
+func (a createUserUniversalTransferServiceAPI) ToSymbol(v string) CreateUserUniversalTransferServiceAPI {
+	a.s.ToSymbol(v)
+	return a
+}
+
+func (a createUserUniversalTransferServiceAPI) Do(ctx context.Context) (*CreateUserUniversalTransferResponse, error) {
+	return a.s.Do(ctx)
+}
+
+// ListDustLogServiceAPI define the setters and Do of ListDustLogService
+type ListDustLogServiceAPI interface {
+	StartTime(startTime int64) ListDustLogServiceAPI
+	EndTime(endTime int64) ListDustLogServiceAPI
+	Do(ctx context.Context) (*DustResult, error)
+}
+
+type listDustLogServiceAPI struct {
+	s *ListDustLogService
+}
+
+func (a listDustLogServiceAPI) StartTime(startTime int64) ListDustLogServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listDustLogServiceAPI) EndTime(endTime int64) ListDustLogServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listDustLogServiceAPI) Do(ctx context.Context) (*DustResult, error) {
+	return a.s.Do(ctx)
+}
+
+// ListDustServiceAPI define the setters and Do of ListDustService
+type ListDustServiceAPI interface {
+	Do(ctx context.Context) (*ListDustResponse, error)
+}
+
+type listDustServiceAPI struct {
+	s *ListDustService
+}
+
+func (a listDustServiceAPI) Do(ctx context.Context) (*ListDustResponse, error) {
+	return a.s.Do(ctx)
+}
+
+// DustTransferServiceAPI define the setters and Do of DustTransferService
+type DustTransferServiceAPI interface {
+	Asset(asset []string) DustTransferServiceAPI
+	Do(ctx context.Context) (*DustTransferResponse, error)
+}
+
+type dustTransferServiceAPI struct {
+	s *DustTransferService
+}
+
+func (a dustTransferServiceAPI) Asset(asset []string) DustTransferServiceAPI {
+	a.s.Asset(asset)
+	return a
+}
+
+func (a dustTransferServiceAPI) Do(ctx context.Context) (*DustTransferResponse, error) {
+	return a.s.Do(ctx)
+}
+
+// AssetDividendServiceAPI define the setters and Do of AssetDividendService
+type AssetDividendServiceAPI interface {
+	Asset(asset string) AssetDividendServiceAPI
+	Limit(limit int) AssetDividendServiceAPI
+	StartTime(startTime int64) AssetDividendServiceAPI
+	EndTime(endTime int64) AssetDividendServiceAPI
+	Do(ctx context.Context) (*DividendResponseWrapper, error)
+}
+
+type assetDividendServiceAPI struct {
+	s *AssetDividendService
+}
+
+func (a assetDividendServiceAPI) Asset(asset string) AssetDividendServiceAPI {
+	a.s.Asset(asset)
+	return a
+}
+
+func (a assetDividendServiceAPI) Limit(limit int) AssetDividendServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a assetDividendServiceAPI) StartTime(startTime int64) AssetDividendServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a assetDividendServiceAPI) EndTime(endTime int64) AssetDividendServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a assetDividendServiceAPI) Do(ctx context.Context) (*DividendResponseWrapper, error) {
+	return a.s.Do(ctx)
+}
+
+// StartUserStreamServiceAPI define the setters and Do of StartUserStreamService
+type StartUserStreamServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+type startUserStreamServiceAPI struct {
+	s *StartUserStreamService
+}
+
+func (a startUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// KeepaliveUserStreamServiceAPI define the setters and Do of KeepaliveUserStreamService
+type KeepaliveUserStreamServiceAPI interface {
+	ListenKey(listenKey string) KeepaliveUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type keepaliveUserStreamServiceAPI struct {
+	s *KeepaliveUserStreamService
+}
+
+func (a keepaliveUserStreamServiceAPI) ListenKey(listenKey string) KeepaliveUserStreamServiceAPI {
+	a.s.ListenKey(listenKey)
+	return a
+}
+
+func (a keepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// CloseUserStreamServiceAPI define the setters and Do of CloseUserStreamService
+type CloseUserStreamServiceAPI interface {
+	ListenKey(listenKey string) CloseUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type closeUserStreamServiceAPI struct {
+	s *CloseUserStreamService
+}
+
+func (a closeUserStreamServiceAPI) ListenKey(listenKey string) CloseUserStreamServiceAPI {
+	a.s.ListenKey(listenKey)
+	return a
+}
+
+func (a closeUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// UserDataStreamAPI define the methods of UserDataStream
+type UserDataStreamAPI interface {
+	Margin() UserDataStreamAPI
+	IsolatedMargin(symbol string) UserDataStreamAPI
+	OnResync(f func()) UserDataStreamAPI
+	KeepaliveInterval(d time.Duration) UserDataStreamAPI
+	Start(ctx context.Context) error
+	Stop()
+	Done() <-chan struct{}
+	ListenKey() string
+}
+
+type userDataStreamAPI struct {
+	s *UserDataStream
+}
+
+func (a userDataStreamAPI) Margin() UserDataStreamAPI {
+	a.s.Margin()
+	return a
+}
+
+func (a userDataStreamAPI) IsolatedMargin(symbol string) UserDataStreamAPI {
+	a.s.IsolatedMargin(symbol)
+	return a
+}
+
+func (a userDataStreamAPI) OnResync(f func()) UserDataStreamAPI {
+	a.s.OnResync(f)
+	return a
+}
+
+func (a userDataStreamAPI) KeepaliveInterval(d time.Duration) UserDataStreamAPI {
+	a.s.KeepaliveInterval(d)
+	return a
+}
+
+func (a userDataStreamAPI) Start(ctx context.Context) error {
+	return a.s.Start(ctx)
+}
+
+func (a userDataStreamAPI) Stop() {
+	a.s.Stop()
+}
+
+func (a userDataStreamAPI) Done() <-chan struct{} {
+	return a.s.Done()
+}
+
+func (a userDataStreamAPI) ListenKey() string {
+	return a.s.ListenKey()
+}
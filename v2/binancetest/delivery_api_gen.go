@@ -0,0 +1,1396 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package binancetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2/delivery"
+)
+
+// DeliveryAPI is an in-memory fake of delivery.API. Its services record the arguments of their setters and
+// answer Do with the FakeService of the same name, its user data streams are served by
+// UserDataStream. The zero value is ready to use.
+type DeliveryAPI struct {
+	PingService                      FakeService[struct{}]
+	ServerTimeService                FakeService[int64]
+	ExchangeInfoService              FakeService[*delivery.ExchangeInfo]
+	KlinesService                    FakeService[[]*delivery.Kline]
+	ListPriceChangeStatsService      FakeService[[]*delivery.PriceChangeStats]
+	ListPricesService                FakeService[[]*delivery.SymbolPrice]
+	ListBookTickersService           FakeService[[]*delivery.BookTicker]
+	ListLiquidationOrdersService     FakeService[[]*delivery.LiquidationOrder]
+	DepthService                     FakeService[*delivery.DepthResponse]
+	RecentTradesService              FakeService[[]*delivery.Trade]
+	HistoricalTradesService          FakeService[[]*delivery.Trade]
+	AggTradesService                 FakeService[[]*delivery.AggTrade]
+	PremiumIndexService              FakeService[[]*delivery.PremiumIndex]
+	FundingRateService               FakeService[[]*delivery.FundingRate]
+	GetOpenInterestService           FakeService[*delivery.OpenInterest]
+	OpenInterestStatisticsService    FakeService[[]*delivery.OpenInterestStatistic]
+	BasisService                     FakeService[[]*delivery.Basis]
+	TakerBuySellVolumeService        FakeService[[]*delivery.TakerBuySellVolume]
+	LongShortRatioService            FakeService[[]*delivery.LongShortRatio]
+	CreateOrderService               FakeService[*delivery.CreateOrderResponse]
+	GetOrderService                  FakeService[*delivery.Order]
+	CancelOrderService               FakeService[*delivery.CancelOrderResponse]
+	CancelAllOpenOrdersService       FakeService[struct{}]
+	ListOpenOrdersService            FakeService[[]*delivery.Order]
+	ListOrdersService                FakeService[[]*delivery.Order]
+	ChangeLeverageService            FakeService[*delivery.SymbolLeverage]
+	ChangeMarginTypeService          FakeService[struct{}]
+	UpdatePositionMarginService      FakeService[struct{}]
+	ChangePositionModeService        FakeService[struct{}]
+	GetPositionModeService           FakeService[*delivery.PositionMode]
+	CreateBatchOrdersService         FakeService[*delivery.CreateBatchOrdersResponse]
+	CancelMultiplesOrdersService     FakeService[*delivery.CancelMultipleOrdersResponse]
+	ModifyOrderService               FakeService[*delivery.Order]
+	ListUserLiquidationOrdersService FakeService[[]*delivery.UserLiquidationOrder]
+	GetADLQuantileService            FakeService[[]*delivery.ADLQuantile]
+	GetAccountService                FakeService[*delivery.Account]
+	GetBalanceService                FakeService[[]*delivery.Balance]
+	GetPositionRiskService           FakeService[[]*delivery.PositionRisk]
+	ListAccountTradeService          FakeService[[]*delivery.AccountTrade]
+	GetIncomeHistoryService          FakeService[[]*delivery.IncomeHistory]
+	CommissionRateService            FakeService[*delivery.CommissionRate]
+	GetLeverageBracketService        FakeService[[]*delivery.LeverageBracket]
+	StartUserStreamService           FakeService[string]
+	KeepaliveUserStreamService       FakeService[struct{}]
+	CloseUserStreamService           FakeService[struct{}]
+	UserDataStream                   FakeUserDataStream[*delivery.WsUserDataEvent]
+}
+
+var _ delivery.API = (*DeliveryAPI)(nil)
+
+// NewPingService implement delivery.API
+func (a *DeliveryAPI) NewPingService() delivery.PingServiceAPI {
+	return &deliveryPingService{f: &a.PingService, args: make(Args)}
+}
+
+// NewServerTimeService implement delivery.API
+func (a *DeliveryAPI) NewServerTimeService() delivery.ServerTimeServiceAPI {
+	return &deliveryServerTimeService{f: &a.ServerTimeService, args: make(Args)}
+}
+
+// NewExchangeInfoService implement delivery.API
+func (a *DeliveryAPI) NewExchangeInfoService() delivery.ExchangeInfoServiceAPI {
+	return &deliveryExchangeInfoService{f: &a.ExchangeInfoService, args: make(Args)}
+}
+
+// NewKlinesService implement delivery.API
+func (a *DeliveryAPI) NewKlinesService() delivery.KlinesServiceAPI {
+	return &deliveryKlinesService{f: &a.KlinesService, args: make(Args)}
+}
+
+// NewListPriceChangeStatsService implement delivery.API
+func (a *DeliveryAPI) NewListPriceChangeStatsService() delivery.ListPriceChangeStatsServiceAPI {
+	return &deliveryListPriceChangeStatsService{f: &a.ListPriceChangeStatsService, args: make(Args)}
+}
+
+// NewListPricesService implement delivery.API
+func (a *DeliveryAPI) NewListPricesService() delivery.ListPricesServiceAPI {
+	return &deliveryListPricesService{f: &a.ListPricesService, args: make(Args)}
+}
+
+// NewListBookTickersService implement delivery.API
+func (a *DeliveryAPI) NewListBookTickersService() delivery.ListBookTickersServiceAPI {
+	return &deliveryListBookTickersService{f: &a.ListBookTickersService, args: make(Args)}
+}
+
+// NewListLiquidationOrdersService implement delivery.API
+func (a *DeliveryAPI) NewListLiquidationOrdersService() delivery.ListLiquidationOrdersServiceAPI {
+	return &deliveryListLiquidationOrdersService{f: &a.ListLiquidationOrdersService, args: make(Args)}
+}
+
+// NewDepthService implement delivery.API
+func (a *DeliveryAPI) NewDepthService() delivery.DepthServiceAPI {
+	return &deliveryDepthService{f: &a.DepthService, args: make(Args)}
+}
+
+// NewRecentTradesService implement delivery.API
+func (a *DeliveryAPI) NewRecentTradesService() delivery.RecentTradesServiceAPI {
+	return &deliveryRecentTradesService{f: &a.RecentTradesService, args: make(Args)}
+}
+
+// NewHistoricalTradesService implement delivery.API
+func (a *DeliveryAPI) NewHistoricalTradesService() delivery.HistoricalTradesServiceAPI {
+	return &deliveryHistoricalTradesService{f: &a.HistoricalTradesService, args: make(Args)}
+}
+
+// NewAggTradesService implement delivery.API
+func (a *DeliveryAPI) NewAggTradesService() delivery.AggTradesServiceAPI {
+	return &deliveryAggTradesService{f: &a.AggTradesService, args: make(Args)}
+}
+
+// NewPremiumIndexService implement delivery.API
+func (a *DeliveryAPI) NewPremiumIndexService() delivery.PremiumIndexServiceAPI {
+	return &deliveryPremiumIndexService{f: &a.PremiumIndexService, args: make(Args)}
+}
+
+// NewFundingRateService implement delivery.API
+func (a *DeliveryAPI) NewFundingRateService() delivery.FundingRateServiceAPI {
+	return &deliveryFundingRateService{f: &a.FundingRateService, args: make(Args)}
+}
+
+// NewGetOpenInterestService implement delivery.API
+func (a *DeliveryAPI) NewGetOpenInterestService() delivery.GetOpenInterestServiceAPI {
+	return &deliveryGetOpenInterestService{f: &a.GetOpenInterestService, args: make(Args)}
+}
+
+// NewOpenInterestStatisticsService implement delivery.API
+func (a *DeliveryAPI) NewOpenInterestStatisticsService() delivery.OpenInterestStatisticsServiceAPI {
+	return &deliveryOpenInterestStatisticsService{f: &a.OpenInterestStatisticsService, args: make(Args)}
+}
+
+// NewBasisService implement delivery.API
+func (a *DeliveryAPI) NewBasisService() delivery.BasisServiceAPI {
+	return &deliveryBasisService{f: &a.BasisService, args: make(Args)}
+}
+
+// NewTakerBuySellVolumeService implement delivery.API
+func (a *DeliveryAPI) NewTakerBuySellVolumeService() delivery.TakerBuySellVolumeServiceAPI {
+	return &deliveryTakerBuySellVolumeService{f: &a.TakerBuySellVolumeService, args: make(Args)}
+}
+
+// NewLongShortRatioService implement delivery.API
+func (a *DeliveryAPI) NewLongShortRatioService() delivery.LongShortRatioServiceAPI {
+	return &deliveryLongShortRatioService{f: &a.LongShortRatioService, args: make(Args)}
+}
+
+// NewCreateOrderService implement delivery.API
+func (a *DeliveryAPI) NewCreateOrderService() delivery.CreateOrderServiceAPI {
+	return &deliveryCreateOrderService{f: &a.CreateOrderService, args: make(Args)}
+}
+
+// NewGetOrderService implement delivery.API
+func (a *DeliveryAPI) NewGetOrderService() delivery.GetOrderServiceAPI {
+	return &deliveryGetOrderService{f: &a.GetOrderService, args: make(Args)}
+}
+
+// NewCancelOrderService implement delivery.API
+func (a *DeliveryAPI) NewCancelOrderService() delivery.CancelOrderServiceAPI {
+	return &deliveryCancelOrderService{f: &a.CancelOrderService, args: make(Args)}
+}
+
+// NewCancelAllOpenOrdersService implement delivery.API
+func (a *DeliveryAPI) NewCancelAllOpenOrdersService() delivery.CancelAllOpenOrdersServiceAPI {
+	return &deliveryCancelAllOpenOrdersService{f: &a.CancelAllOpenOrdersService, args: make(Args)}
+}
+
+// NewListOpenOrdersService implement delivery.API
+func (a *DeliveryAPI) NewListOpenOrdersService() delivery.ListOpenOrdersServiceAPI {
+	return &deliveryListOpenOrdersService{f: &a.ListOpenOrdersService, args: make(Args)}
+}
+
+// NewListOrdersService implement delivery.API
+func (a *DeliveryAPI) NewListOrdersService() delivery.ListOrdersServiceAPI {
+	return &deliveryListOrdersService{f: &a.ListOrdersService, args: make(Args)}
+}
+
+// NewChangeLeverageService implement delivery.API
+func (a *DeliveryAPI) NewChangeLeverageService() delivery.ChangeLeverageServiceAPI {
+	return &deliveryChangeLeverageService{f: &a.ChangeLeverageService, args: make(Args)}
+}
+
+// NewChangeMarginTypeService implement delivery.API
+func (a *DeliveryAPI) NewChangeMarginTypeService() delivery.ChangeMarginTypeServiceAPI {
+	return &deliveryChangeMarginTypeService{f: &a.ChangeMarginTypeService, args: make(Args)}
+}
+
+// NewUpdatePositionMarginService implement delivery.API
+func (a *DeliveryAPI) NewUpdatePositionMarginService() delivery.UpdatePositionMarginServiceAPI {
+	return &deliveryUpdatePositionMarginService{f: &a.UpdatePositionMarginService, args: make(Args)}
+}
+
+// NewChangePositionModeService implement delivery.API
+func (a *DeliveryAPI) NewChangePositionModeService() delivery.ChangePositionModeServiceAPI {
+	return &deliveryChangePositionModeService{f: &a.ChangePositionModeService, args: make(Args)}
+}
+
+// NewGetPositionModeService implement delivery.API
+func (a *DeliveryAPI) NewGetPositionModeService() delivery.GetPositionModeServiceAPI {
+	return &deliveryGetPositionModeService{f: &a.GetPositionModeService, args: make(Args)}
+}
+
+// NewCreateBatchOrdersService implement delivery.API
+func (a *DeliveryAPI) NewCreateBatchOrdersService() delivery.CreateBatchOrdersServiceAPI {
+	return &deliveryCreateBatchOrdersService{f: &a.CreateBatchOrdersService, args: make(Args)}
+}
+
+// NewCancelMultipleOrdersService implement delivery.API
+func (a *DeliveryAPI) NewCancelMultipleOrdersService() delivery.CancelMultiplesOrdersServiceAPI {
+	return &deliveryCancelMultiplesOrdersService{f: &a.CancelMultiplesOrdersService, args: make(Args)}
+}
+
+// NewModifyOrderService implement delivery.API
+func (a *DeliveryAPI) NewModifyOrderService() delivery.ModifyOrderServiceAPI {
+	return &deliveryModifyOrderService{f: &a.ModifyOrderService, args: make(Args)}
+}
+
+// NewListUserLiquidationOrdersService implement delivery.API
+func (a *DeliveryAPI) NewListUserLiquidationOrdersService() delivery.ListUserLiquidationOrdersServiceAPI {
+	return &deliveryListUserLiquidationOrdersService{f: &a.ListUserLiquidationOrdersService, args: make(Args)}
+}
+
+// NewGetADLQuantileService implement delivery.API
+func (a *DeliveryAPI) NewGetADLQuantileService() delivery.GetADLQuantileServiceAPI {
+	return &deliveryGetADLQuantileService{f: &a.GetADLQuantileService, args: make(Args)}
+}
+
+// NewGetAccountService implement delivery.API
+func (a *DeliveryAPI) NewGetAccountService() delivery.GetAccountServiceAPI {
+	return &deliveryGetAccountService{f: &a.GetAccountService, args: make(Args)}
+}
+
+// NewGetBalanceService implement delivery.API
+func (a *DeliveryAPI) NewGetBalanceService() delivery.GetBalanceServiceAPI {
+	return &deliveryGetBalanceService{f: &a.GetBalanceService, args: make(Args)}
+}
+
+// NewGetPositionRiskService implement delivery.API
+func (a *DeliveryAPI) NewGetPositionRiskService() delivery.GetPositionRiskServiceAPI {
+	return &deliveryGetPositionRiskService{f: &a.GetPositionRiskService, args: make(Args)}
+}
+
+// NewListAccountTradeService implement delivery.API
+func (a *DeliveryAPI) NewListAccountTradeService() delivery.ListAccountTradeServiceAPI {
+	return &deliveryListAccountTradeService{f: &a.ListAccountTradeService, args: make(Args)}
+}
+
+// NewGetIncomeHistoryService implement delivery.API
+func (a *DeliveryAPI) NewGetIncomeHistoryService() delivery.GetIncomeHistoryServiceAPI {
+	return &deliveryGetIncomeHistoryService{f: &a.GetIncomeHistoryService, args: make(Args)}
+}
+
+// NewCommissionRateService implement delivery.API
+func (a *DeliveryAPI) NewCommissionRateService() delivery.CommissionRateServiceAPI {
+	return &deliveryCommissionRateService{f: &a.CommissionRateService, args: make(Args)}
+}
+
+// NewGetLeverageBracketService implement delivery.API
+func (a *DeliveryAPI) NewGetLeverageBracketService() delivery.GetLeverageBracketServiceAPI {
+	return &deliveryGetLeverageBracketService{f: &a.GetLeverageBracketService, args: make(Args)}
+}
+
+// NewStartUserStreamService implement delivery.API
+func (a *DeliveryAPI) NewStartUserStreamService() delivery.StartUserStreamServiceAPI {
+	return &deliveryStartUserStreamService{f: &a.StartUserStreamService, args: make(Args)}
+}
+
+// NewKeepaliveUserStreamService implement delivery.API
+func (a *DeliveryAPI) NewKeepaliveUserStreamService() delivery.KeepaliveUserStreamServiceAPI {
+	return &deliveryKeepaliveUserStreamService{f: &a.KeepaliveUserStreamService, args: make(Args)}
+}
+
+// NewCloseUserStreamService implement delivery.API
+func (a *DeliveryAPI) NewCloseUserStreamService() delivery.CloseUserStreamServiceAPI {
+	return &deliveryCloseUserStreamService{f: &a.CloseUserStreamService, args: make(Args)}
+}
+
+// NewUserDataStream implement delivery.API
+func (a *DeliveryAPI) NewUserDataStream(handler delivery.WsUserDataHandler, errHandler delivery.ErrHandler) delivery.UserDataStreamAPI {
+	return &deliveryUserDataStream{s: a.UserDataStream.newStream(handler, errHandler)}
+}
+
+type deliveryPingService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *deliveryPingService) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type deliveryServerTimeService struct {
+	f    *FakeService[int64]
+	args Args
+}
+
+func (s *deliveryServerTimeService) Do(ctx context.Context, opts ...delivery.RequestOption) (int64, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryExchangeInfoService struct {
+	f    *FakeService[*delivery.ExchangeInfo]
+	args Args
+}
+
+func (s *deliveryExchangeInfoService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.ExchangeInfo, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryKlinesService struct {
+	f    *FakeService[[]*delivery.Kline]
+	args Args
+}
+
+func (s *deliveryKlinesService) Symbol(symbol string) delivery.KlinesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryKlinesService) Interval(interval string) delivery.KlinesServiceAPI {
+	s.args["Interval"] = interval
+	return s
+}
+
+func (s *deliveryKlinesService) Limit(limit int) delivery.KlinesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryKlinesService) StartTime(startTime int64) delivery.KlinesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryKlinesService) EndTime(endTime int64) delivery.KlinesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryKlinesService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Kline, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryListPriceChangeStatsService struct {
+	f    *FakeService[[]*delivery.PriceChangeStats]
+	args Args
+}
+
+func (s *deliveryListPriceChangeStatsService) Symbol(symbol string) delivery.ListPriceChangeStatsServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryListPriceChangeStatsService) Pair(pair string) delivery.ListPriceChangeStatsServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryListPriceChangeStatsService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.PriceChangeStats, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryListPricesService struct {
+	f    *FakeService[[]*delivery.SymbolPrice]
+	args Args
+}
+
+func (s *deliveryListPricesService) Symbol(symbol string) delivery.ListPricesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryListPricesService) Pair(pair string) delivery.ListPricesServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryListPricesService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.SymbolPrice, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryListBookTickersService struct {
+	f    *FakeService[[]*delivery.BookTicker]
+	args Args
+}
+
+func (s *deliveryListBookTickersService) Symbol(symbol string) delivery.ListBookTickersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryListBookTickersService) Pair(pair string) delivery.ListBookTickersServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryListBookTickersService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.BookTicker, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryListLiquidationOrdersService struct {
+	f    *FakeService[[]*delivery.LiquidationOrder]
+	args Args
+}
+
+func (s *deliveryListLiquidationOrdersService) Symbol(symbol string) delivery.ListLiquidationOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryListLiquidationOrdersService) Pair(pair string) delivery.ListLiquidationOrdersServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryListLiquidationOrdersService) StartTime(startTime int64) delivery.ListLiquidationOrdersServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryListLiquidationOrdersService) EndTime(endTime int64) delivery.ListLiquidationOrdersServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryListLiquidationOrdersService) Limit(limit int) delivery.ListLiquidationOrdersServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryListLiquidationOrdersService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.LiquidationOrder, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryDepthService struct {
+	f    *FakeService[*delivery.DepthResponse]
+	args Args
+}
+
+func (s *deliveryDepthService) Symbol(symbol string) delivery.DepthServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryDepthService) Limit(limit int) delivery.DepthServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryDepthService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.DepthResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryRecentTradesService struct {
+	f    *FakeService[[]*delivery.Trade]
+	args Args
+}
+
+func (s *deliveryRecentTradesService) Symbol(symbol string) delivery.RecentTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryRecentTradesService) Limit(limit int) delivery.RecentTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryRecentTradesService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Trade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryHistoricalTradesService struct {
+	f    *FakeService[[]*delivery.Trade]
+	args Args
+}
+
+func (s *deliveryHistoricalTradesService) Symbol(symbol string) delivery.HistoricalTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryHistoricalTradesService) Limit(limit int) delivery.HistoricalTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryHistoricalTradesService) FromID(fromID int64) delivery.HistoricalTradesServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *deliveryHistoricalTradesService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Trade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryAggTradesService struct {
+	f    *FakeService[[]*delivery.AggTrade]
+	args Args
+}
+
+func (s *deliveryAggTradesService) Symbol(symbol string) delivery.AggTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryAggTradesService) FromID(fromID int64) delivery.AggTradesServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *deliveryAggTradesService) StartTime(startTime int64) delivery.AggTradesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryAggTradesService) EndTime(endTime int64) delivery.AggTradesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryAggTradesService) Limit(limit int) delivery.AggTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryAggTradesService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.AggTrade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryPremiumIndexService struct {
+	f    *FakeService[[]*delivery.PremiumIndex]
+	args Args
+}
+
+func (s *deliveryPremiumIndexService) Symbol(symbol string) delivery.PremiumIndexServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryPremiumIndexService) Pair(pair string) delivery.PremiumIndexServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryPremiumIndexService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.PremiumIndex, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryFundingRateService struct {
+	f    *FakeService[[]*delivery.FundingRate]
+	args Args
+}
+
+func (s *deliveryFundingRateService) Symbol(symbol string) delivery.FundingRateServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryFundingRateService) StartTime(startTime int64) delivery.FundingRateServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryFundingRateService) EndTime(endTime int64) delivery.FundingRateServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryFundingRateService) Limit(limit int) delivery.FundingRateServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryFundingRateService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.FundingRate, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryGetOpenInterestService struct {
+	f    *FakeService[*delivery.OpenInterest]
+	args Args
+}
+
+func (s *deliveryGetOpenInterestService) Symbol(symbol string) delivery.GetOpenInterestServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryGetOpenInterestService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.OpenInterest, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryOpenInterestStatisticsService struct {
+	f    *FakeService[[]*delivery.OpenInterestStatistic]
+	args Args
+}
+
+func (s *deliveryOpenInterestStatisticsService) Pair(pair string) delivery.OpenInterestStatisticsServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryOpenInterestStatisticsService) ContractType(contractType delivery.ContractType) delivery.OpenInterestStatisticsServiceAPI {
+	s.args["ContractType"] = contractType
+	return s
+}
+
+func (s *deliveryOpenInterestStatisticsService) Period(period string) delivery.OpenInterestStatisticsServiceAPI {
+	s.args["Period"] = period
+	return s
+}
+
+func (s *deliveryOpenInterestStatisticsService) Limit(limit int) delivery.OpenInterestStatisticsServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryOpenInterestStatisticsService) StartTime(startTime int64) delivery.OpenInterestStatisticsServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryOpenInterestStatisticsService) EndTime(endTime int64) delivery.OpenInterestStatisticsServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryOpenInterestStatisticsService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.OpenInterestStatistic, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryBasisService struct {
+	f    *FakeService[[]*delivery.Basis]
+	args Args
+}
+
+func (s *deliveryBasisService) Pair(pair string) delivery.BasisServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryBasisService) ContractType(contractType delivery.ContractType) delivery.BasisServiceAPI {
+	s.args["ContractType"] = contractType
+	return s
+}
+
+func (s *deliveryBasisService) Period(period string) delivery.BasisServiceAPI {
+	s.args["Period"] = period
+	return s
+}
+
+func (s *deliveryBasisService) Limit(limit int) delivery.BasisServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryBasisService) StartTime(startTime int64) delivery.BasisServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryBasisService) EndTime(endTime int64) delivery.BasisServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryBasisService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Basis, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryTakerBuySellVolumeService struct {
+	f    *FakeService[[]*delivery.TakerBuySellVolume]
+	args Args
+}
+
+func (s *deliveryTakerBuySellVolumeService) Pair(pair string) delivery.TakerBuySellVolumeServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryTakerBuySellVolumeService) ContractType(contractType delivery.ContractType) delivery.TakerBuySellVolumeServiceAPI {
+	s.args["ContractType"] = contractType
+	return s
+}
+
+func (s *deliveryTakerBuySellVolumeService) Period(period string) delivery.TakerBuySellVolumeServiceAPI {
+	s.args["Period"] = period
+	return s
+}
+
+func (s *deliveryTakerBuySellVolumeService) Limit(limit int) delivery.TakerBuySellVolumeServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryTakerBuySellVolumeService) StartTime(startTime int64) delivery.TakerBuySellVolumeServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryTakerBuySellVolumeService) EndTime(endTime int64) delivery.TakerBuySellVolumeServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryTakerBuySellVolumeService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.TakerBuySellVolume, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryLongShortRatioService struct {
+	f    *FakeService[[]*delivery.LongShortRatio]
+	args Args
+}
+
+func (s *deliveryLongShortRatioService) Pair(pair string) delivery.LongShortRatioServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryLongShortRatioService) Period(period string) delivery.LongShortRatioServiceAPI {
+	s.args["Period"] = period
+	return s
+}
+
+func (s *deliveryLongShortRatioService) Limit(limit int) delivery.LongShortRatioServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryLongShortRatioService) StartTime(startTime int64) delivery.LongShortRatioServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryLongShortRatioService) EndTime(endTime int64) delivery.LongShortRatioServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryLongShortRatioService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.LongShortRatio, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryCreateOrderService struct {
+	f    *FakeService[*delivery.CreateOrderResponse]
+	args Args
+}
+
+func (s *deliveryCreateOrderService) Symbol(symbol string) delivery.CreateOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryCreateOrderService) Side(side delivery.SideType) delivery.CreateOrderServiceAPI {
+	s.args["Side"] = side
+	return s
+}
+
+func (s *deliveryCreateOrderService) PositionSide(positionSide delivery.PositionSideType) delivery.CreateOrderServiceAPI {
+	s.args["PositionSide"] = positionSide
+	return s
+}
+
+func (s *deliveryCreateOrderService) Type(orderType delivery.OrderType) delivery.CreateOrderServiceAPI {
+	s.args["Type"] = orderType
+	return s
+}
+
+func (s *deliveryCreateOrderService) TimeInForce(timeInForce delivery.TimeInForceType) delivery.CreateOrderServiceAPI {
+	s.args["TimeInForce"] = timeInForce
+	return s
+}
+
+func (s *deliveryCreateOrderService) Quantity(quantity string) delivery.CreateOrderServiceAPI {
+	s.args["Quantity"] = quantity
+	return s
+}
+
+func (s *deliveryCreateOrderService) ReduceOnly(reduceOnly bool) delivery.CreateOrderServiceAPI {
+	s.args["ReduceOnly"] = reduceOnly
+	return s
+}
+
+func (s *deliveryCreateOrderService) Price(price string) delivery.CreateOrderServiceAPI {
+	s.args["Price"] = price
+	return s
+}
+
+func (s *deliveryCreateOrderService) NewClientOrderID(newClientOrderID string) delivery.CreateOrderServiceAPI {
+	s.args["NewClientOrderID"] = newClientOrderID
+	return s
+}
+
+func (s *deliveryCreateOrderService) StopPrice(stopPrice string) delivery.CreateOrderServiceAPI {
+	s.args["StopPrice"] = stopPrice
+	return s
+}
+
+func (s *deliveryCreateOrderService) WorkingType(workingType delivery.WorkingType) delivery.CreateOrderServiceAPI {
+	s.args["WorkingType"] = workingType
+	return s
+}
+
+func (s *deliveryCreateOrderService) ActivationPrice(activationPrice string) delivery.CreateOrderServiceAPI {
+	s.args["ActivationPrice"] = activationPrice
+	return s
+}
+
+func (s *deliveryCreateOrderService) CallbackRate(callbackRate string) delivery.CreateOrderServiceAPI {
+	s.args["CallbackRate"] = callbackRate
+	return s
+}
+
+func (s *deliveryCreateOrderService) PriceProtect(priceProtect bool) delivery.CreateOrderServiceAPI {
+	s.args["PriceProtect"] = priceProtect
+	return s
+}
+
+func (s *deliveryCreateOrderService) NewOrderResponseType(newOrderResponseType delivery.NewOrderRespType) delivery.CreateOrderServiceAPI {
+	s.args["NewOrderResponseType"] = newOrderResponseType
+	return s
+}
+
+func (s *deliveryCreateOrderService) ClosePosition(closePosition bool) delivery.CreateOrderServiceAPI {
+	s.args["ClosePosition"] = closePosition
+	return s
+}
+
+func (s *deliveryCreateOrderService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CreateOrderResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryGetOrderService struct {
+	f    *FakeService[*delivery.Order]
+	args Args
+}
+
+func (s *deliveryGetOrderService) Symbol(symbol string) delivery.GetOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryGetOrderService) OrderID(orderID int64) delivery.GetOrderServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *deliveryGetOrderService) OrigClientOrderID(origClientOrderID string) delivery.GetOrderServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *deliveryGetOrderService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryCancelOrderService struct {
+	f    *FakeService[*delivery.CancelOrderResponse]
+	args Args
+}
+
+func (s *deliveryCancelOrderService) Symbol(symbol string) delivery.CancelOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryCancelOrderService) OrderID(orderID int64) delivery.CancelOrderServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *deliveryCancelOrderService) OrigClientOrderID(origClientOrderID string) delivery.CancelOrderServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *deliveryCancelOrderService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CancelOrderResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryCancelAllOpenOrdersService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *deliveryCancelAllOpenOrdersService) Symbol(symbol string) delivery.CancelAllOpenOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryCancelAllOpenOrdersService) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type deliveryListOpenOrdersService struct {
+	f    *FakeService[[]*delivery.Order]
+	args Args
+}
+
+func (s *deliveryListOpenOrdersService) Symbol(symbol string) delivery.ListOpenOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryListOpenOrdersService) Pair(pair string) delivery.ListOpenOrdersServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryListOpenOrdersService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryListOrdersService struct {
+	f    *FakeService[[]*delivery.Order]
+	args Args
+}
+
+func (s *deliveryListOrdersService) Symbol(symbol string) delivery.ListOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryListOrdersService) Pair(pair string) delivery.ListOrdersServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryListOrdersService) OrderID(orderID int64) delivery.ListOrdersServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *deliveryListOrdersService) StartTime(startTime int64) delivery.ListOrdersServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryListOrdersService) EndTime(endTime int64) delivery.ListOrdersServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryListOrdersService) Limit(limit int) delivery.ListOrdersServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryListOrdersService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryChangeLeverageService struct {
+	f    *FakeService[*delivery.SymbolLeverage]
+	args Args
+}
+
+func (s *deliveryChangeLeverageService) Symbol(symbol string) delivery.ChangeLeverageServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryChangeLeverageService) Leverage(leverage int) delivery.ChangeLeverageServiceAPI {
+	s.args["Leverage"] = leverage
+	return s
+}
+
+func (s *deliveryChangeLeverageService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.SymbolLeverage, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryChangeMarginTypeService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *deliveryChangeMarginTypeService) Symbol(symbol string) delivery.ChangeMarginTypeServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryChangeMarginTypeService) MarginType(marginType delivery.MarginType) delivery.ChangeMarginTypeServiceAPI {
+	s.args["MarginType"] = marginType
+	return s
+}
+
+func (s *deliveryChangeMarginTypeService) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type deliveryUpdatePositionMarginService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *deliveryUpdatePositionMarginService) Symbol(symbol string) delivery.UpdatePositionMarginServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryUpdatePositionMarginService) PositionSide(positionSide delivery.PositionSideType) delivery.UpdatePositionMarginServiceAPI {
+	s.args["PositionSide"] = positionSide
+	return s
+}
+
+func (s *deliveryUpdatePositionMarginService) Amount(amount string) delivery.UpdatePositionMarginServiceAPI {
+	s.args["Amount"] = amount
+	return s
+}
+
+func (s *deliveryUpdatePositionMarginService) Type(actionType int) delivery.UpdatePositionMarginServiceAPI {
+	s.args["Type"] = actionType
+	return s
+}
+
+func (s *deliveryUpdatePositionMarginService) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type deliveryChangePositionModeService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *deliveryChangePositionModeService) DualSide(dualSide bool) delivery.ChangePositionModeServiceAPI {
+	s.args["DualSide"] = dualSide
+	return s
+}
+
+func (s *deliveryChangePositionModeService) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type deliveryGetPositionModeService struct {
+	f    *FakeService[*delivery.PositionMode]
+	args Args
+}
+
+func (s *deliveryGetPositionModeService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.PositionMode, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryCreateBatchOrdersService struct {
+	f    *FakeService[*delivery.CreateBatchOrdersResponse]
+	args Args
+}
+
+func (s *deliveryCreateBatchOrdersService) OrderList(orders []*delivery.CreateOrderService) delivery.CreateBatchOrdersServiceAPI {
+	s.args["OrderList"] = orders
+	return s
+}
+
+func (s *deliveryCreateBatchOrdersService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CreateBatchOrdersResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryCancelMultiplesOrdersService struct {
+	f    *FakeService[*delivery.CancelMultipleOrdersResponse]
+	args Args
+}
+
+func (s *deliveryCancelMultiplesOrdersService) Symbol(symbol string) delivery.CancelMultiplesOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryCancelMultiplesOrdersService) OrderIDList(orderIDList []int64) delivery.CancelMultiplesOrdersServiceAPI {
+	s.args["OrderIDList"] = orderIDList
+	return s
+}
+
+func (s *deliveryCancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDList []string) delivery.CancelMultiplesOrdersServiceAPI {
+	s.args["OrigClientOrderIDList"] = origClientOrderIDList
+	return s
+}
+
+func (s *deliveryCancelMultiplesOrdersService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CancelMultipleOrdersResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryModifyOrderService struct {
+	f    *FakeService[*delivery.Order]
+	args Args
+}
+
+func (s *deliveryModifyOrderService) Symbol(symbol string) delivery.ModifyOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryModifyOrderService) Side(side delivery.SideType) delivery.ModifyOrderServiceAPI {
+	s.args["Side"] = side
+	return s
+}
+
+func (s *deliveryModifyOrderService) OrderID(orderID int64) delivery.ModifyOrderServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *deliveryModifyOrderService) OrigClientOrderID(origClientOrderID string) delivery.ModifyOrderServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *deliveryModifyOrderService) Quantity(quantity string) delivery.ModifyOrderServiceAPI {
+	s.args["Quantity"] = quantity
+	return s
+}
+
+func (s *deliveryModifyOrderService) Price(price string) delivery.ModifyOrderServiceAPI {
+	s.args["Price"] = price
+	return s
+}
+
+func (s *deliveryModifyOrderService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryListUserLiquidationOrdersService struct {
+	f    *FakeService[[]*delivery.UserLiquidationOrder]
+	args Args
+}
+
+func (s *deliveryListUserLiquidationOrdersService) Symbol(symbol string) delivery.ListUserLiquidationOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryListUserLiquidationOrdersService) AutoCloseType(autoCloseType delivery.ForceOrderCloseType) delivery.ListUserLiquidationOrdersServiceAPI {
+	s.args["AutoCloseType"] = autoCloseType
+	return s
+}
+
+func (s *deliveryListUserLiquidationOrdersService) StartTime(startTime int64) delivery.ListUserLiquidationOrdersServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryListUserLiquidationOrdersService) EndTime(endTime int64) delivery.ListUserLiquidationOrdersServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryListUserLiquidationOrdersService) Limit(limit int) delivery.ListUserLiquidationOrdersServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryListUserLiquidationOrdersService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.UserLiquidationOrder, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryGetADLQuantileService struct {
+	f    *FakeService[[]*delivery.ADLQuantile]
+	args Args
+}
+
+func (s *deliveryGetADLQuantileService) Symbol(symbol string) delivery.GetADLQuantileServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryGetADLQuantileService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.ADLQuantile, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryGetAccountService struct {
+	f    *FakeService[*delivery.Account]
+	args Args
+}
+
+func (s *deliveryGetAccountService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.Account, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryGetBalanceService struct {
+	f    *FakeService[[]*delivery.Balance]
+	args Args
+}
+
+func (s *deliveryGetBalanceService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.Balance, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryGetPositionRiskService struct {
+	f    *FakeService[[]*delivery.PositionRisk]
+	args Args
+}
+
+func (s *deliveryGetPositionRiskService) MarginAsset(marginAsset string) delivery.GetPositionRiskServiceAPI {
+	s.args["MarginAsset"] = marginAsset
+	return s
+}
+
+func (s *deliveryGetPositionRiskService) Pair(pair string) delivery.GetPositionRiskServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryGetPositionRiskService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.PositionRisk, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryListAccountTradeService struct {
+	f    *FakeService[[]*delivery.AccountTrade]
+	args Args
+}
+
+func (s *deliveryListAccountTradeService) Symbol(symbol string) delivery.ListAccountTradeServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryListAccountTradeService) Pair(pair string) delivery.ListAccountTradeServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *deliveryListAccountTradeService) OrderID(orderID int64) delivery.ListAccountTradeServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *deliveryListAccountTradeService) StartTime(startTime int64) delivery.ListAccountTradeServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryListAccountTradeService) EndTime(endTime int64) delivery.ListAccountTradeServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryListAccountTradeService) FromID(fromID int64) delivery.ListAccountTradeServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *deliveryListAccountTradeService) Limit(limit int) delivery.ListAccountTradeServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryListAccountTradeService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.AccountTrade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryGetIncomeHistoryService struct {
+	f    *FakeService[[]*delivery.IncomeHistory]
+	args Args
+}
+
+func (s *deliveryGetIncomeHistoryService) Symbol(symbol string) delivery.GetIncomeHistoryServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryGetIncomeHistoryService) IncomeType(incomeType string) delivery.GetIncomeHistoryServiceAPI {
+	s.args["IncomeType"] = incomeType
+	return s
+}
+
+func (s *deliveryGetIncomeHistoryService) StartTime(startTime int64) delivery.GetIncomeHistoryServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *deliveryGetIncomeHistoryService) EndTime(endTime int64) delivery.GetIncomeHistoryServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *deliveryGetIncomeHistoryService) Limit(limit int64) delivery.GetIncomeHistoryServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *deliveryGetIncomeHistoryService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.IncomeHistory, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryCommissionRateService struct {
+	f    *FakeService[*delivery.CommissionRate]
+	args Args
+}
+
+func (s *deliveryCommissionRateService) Symbol(symbol string) delivery.CommissionRateServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryCommissionRateService) Do(ctx context.Context, opts ...delivery.RequestOption) (*delivery.CommissionRate, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryGetLeverageBracketService struct {
+	f    *FakeService[[]*delivery.LeverageBracket]
+	args Args
+}
+
+func (s *deliveryGetLeverageBracketService) Symbol(symbol string) delivery.GetLeverageBracketServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *deliveryGetLeverageBracketService) Do(ctx context.Context, opts ...delivery.RequestOption) ([]*delivery.LeverageBracket, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryStartUserStreamService struct {
+	f    *FakeService[string]
+	args Args
+}
+
+func (s *deliveryStartUserStreamService) Do(ctx context.Context, opts ...delivery.RequestOption) (string, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type deliveryKeepaliveUserStreamService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *deliveryKeepaliveUserStreamService) ListenKey(listenKey string) delivery.KeepaliveUserStreamServiceAPI {
+	s.args["ListenKey"] = listenKey
+	return s
+}
+
+func (s *deliveryKeepaliveUserStreamService) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type deliveryCloseUserStreamService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *deliveryCloseUserStreamService) ListenKey(listenKey string) delivery.CloseUserStreamServiceAPI {
+	s.args["ListenKey"] = listenKey
+	return s
+}
+
+func (s *deliveryCloseUserStreamService) Do(ctx context.Context, opts ...delivery.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type deliveryUserDataStream struct {
+	s *fakeStream[*delivery.WsUserDataEvent]
+}
+
+func (s *deliveryUserDataStream) OnResync(f func()) delivery.UserDataStreamAPI {
+	s.s.set("OnResync", f)
+	return s
+}
+
+func (s *deliveryUserDataStream) KeepaliveInterval(d time.Duration) delivery.UserDataStreamAPI {
+	s.s.set("KeepaliveInterval", d)
+	return s
+}
+
+func (s *deliveryUserDataStream) Start(ctx context.Context) error {
+	return s.s.Start(ctx)
+}
+
+func (s *deliveryUserDataStream) Stop() {
+	s.s.Stop()
+}
+
+func (s *deliveryUserDataStream) Done() <-chan struct{} {
+	return s.s.Done()
+}
+
+func (s *deliveryUserDataStream) ListenKey() string {
+	return s.s.ListenKey()
+}
@@ -0,0 +1,161 @@
+package binancetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Call is a request received by a Fake
+type Call struct {
+	Method   string
+	Endpoint string
+	APIKey   string
+	// Params hold the query and form parameters, without timestamp and signature
+	Params url.Values
+}
+
+// FakeHandler answer a call to an endpoint of a Fake. The response is written as JSON, or as is
+// for a json.RawMessage. A *common.APIError is returned to the client as the error of Binance, with
+// its StatusCode or 400 and Header, any other error as a transport error.
+type FakeHandler func(c *Call) (interface{}, error)
+
+// Fake is an in-memory transport answering the requests of the spot, futures and delivery clients
+// with canned responses, for unit tests which don't need the matching engine of Server:
+//
+//	fake := binancetest.NewFake()
+//	fake.Respond(http.MethodGet, "/api/v3/ticker/price", json.RawMessage(`[{"symbol":"BTCUSDT","price":"30000"}]`))
+//	client := binance.NewClient("key", "secret", binance.WithHTTPClient(fake.HTTPClient()))
+//
+// Its methods may be called while requests are served.
+type Fake struct {
+	mu       sync.Mutex
+	handlers map[string]FakeHandler
+	calls    []*Call
+}
+
+// NewFake create a Fake without handlers
+func NewFake() *Fake {
+	return &Fake{handlers: make(map[string]FakeHandler)}
+}
+
+// Handle set the handler of an endpoint, such as "POST" and "/api/v3/order"
+func (f *Fake) Handle(method, endpoint string, handler FakeHandler) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handlers[method+" "+endpoint] = handler
+}
+
+// Respond answer every call to an endpoint with res
+func (f *Fake) Respond(method, endpoint string, res interface{}) {
+	f.Handle(method, endpoint, func(c *Call) (interface{}, error) {
+		return res, nil
+	})
+}
+
+// Fail answer every call to an endpoint with an API error
+func (f *Fake) Fail(method, endpoint string, code int64, msg string) {
+	f.Handle(method, endpoint, func(c *Call) (interface{}, error) {
+		return nil, &common.APIError{Code: code, Message: msg}
+	})
+}
+
+// Calls return the calls received by an endpoint in order, or every call if endpoint is empty
+func (f *Fake) Calls(endpoint string) []*Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []*Call
+	for _, c := range f.calls {
+		if endpoint == "" || c.Endpoint == endpoint {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// HTTPClient return an HTTP client sending its requests to the fake, to be passed to WithHTTPClient
+func (f *Fake) HTTPClient() *http.Client {
+	return &http.Client{Transport: f}
+}
+
+// RoundTrip implement http.RoundTripper
+func (f *Fake) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(r.Body); err != nil {
+			return nil, err
+		}
+		r.Body.Close()
+	}
+	params := r.URL.Query()
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range form {
+		params[k] = append(params[k], v...)
+	}
+	params.Del("timestamp")
+	params.Del(signatureParam)
+	c := &Call{
+		Method:   r.Method,
+		Endpoint: r.URL.Path,
+		APIKey:   r.Header.Get(apiKeyHeader),
+		Params:   params,
+	}
+
+	f.mu.Lock()
+	f.calls = append(f.calls, c)
+	handler, ok := f.handlers[r.Method+" "+r.URL.Path]
+	f.mu.Unlock()
+	if !ok {
+		return fakeResponse(r, http.StatusNotFound, &common.APIError{
+			Code:    ErrorCodeUnknown,
+			Message: fmt.Sprintf("binancetest: no handler for %s %s", r.Method, r.URL.Path),
+		}, nil)
+	}
+	res, err := handler(c)
+	if apiErr, ok := err.(*common.APIError); ok {
+		status := http.StatusBadRequest
+		if apiErr.StatusCode != 0 {
+			status = apiErr.StatusCode
+		}
+		return fakeResponse(r, status, apiErr, apiErr.Header)
+	} else if err != nil {
+		return nil, err
+	}
+	return fakeResponse(r, http.StatusOK, res, nil)
+}
+
+func fakeResponse(r *http.Request, status int, res interface{}, header http.Header) (*http.Response, error) {
+	data, ok := res.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(res); err != nil {
+			return nil, err
+		}
+	}
+	header = header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Type", "application/json")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       r,
+	}, nil
+}
@@ -0,0 +1,193 @@
+package binancetest
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Args hold the arguments of the setters called on a service of a fake API before Do, by setter
+// name such as "Symbol". A setter without argument is recorded as true, and one with several as a
+// slice of them.
+type Args map[string]interface{}
+
+// FakeService answer the Do calls of a service of SpotAPI, FuturesAPI or DeliveryAPI, R being the
+// response of the service, or struct{} for the services returning an error only. Its methods may be
+// called while requests are served.
+type FakeService[R any] struct {
+	mu    sync.Mutex
+	fn    func(ctx context.Context, args Args) (R, error)
+	calls []Args
+}
+
+// Func answer the Do calls with fn
+func (f *FakeService[R]) Func(fn func(ctx context.Context, args Args) (R, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fn = fn
+}
+
+// Return answer every Do call with res and err. Without a call to Return or Func, Do returns the
+// zero value of R.
+func (f *FakeService[R]) Return(res R, err error) {
+	f.Func(func(ctx context.Context, args Args) (R, error) {
+		return res, err
+	})
+}
+
+// Calls return the arguments of the Do calls received in order
+func (f *FakeService[R]) Calls() []Args {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Args(nil), f.calls...)
+}
+
+func (f *FakeService[R]) do(ctx context.Context, args Args) (res R, err error) {
+	// copy the arguments in case the service is reused
+	call := make(Args, len(args))
+	for k, v := range args {
+		call[k] = v
+	}
+	f.mu.Lock()
+	f.calls = append(f.calls, call)
+	fn := f.fn
+	f.mu.Unlock()
+	if fn == nil {
+		return res, nil
+	}
+	return fn(ctx, call)
+}
+
+// ErrStreamStopped is returned by the Start of a user data stream of a fake API which was stopped
+var ErrStreamStopped = errors.New("binancetest: user data stream stopped")
+
+// FakeUserDataStream serve the user data streams of a fake API, E being the user data event of
+// the client package. Events are delivered to the streams started and not stopped, in the calling
+// goroutine.
+type FakeUserDataStream[E any] struct {
+	mu       sync.Mutex
+	key      string
+	startErr error
+	streams  []*fakeStream[E]
+}
+
+// ListenKey set the listen key of the streams started next, "listenKey" by default
+func (f *FakeUserDataStream[E]) ListenKey(key string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.key = key
+}
+
+// StartError make the streams started next fail with err, such as an API error
+func (f *FakeUserDataStream[E]) StartError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.startErr = err
+}
+
+// Send deliver event to the handlers of the running streams
+func (f *FakeUserDataStream[E]) Send(event E) {
+	for _, s := range f.running() {
+		s.handler(event)
+	}
+}
+
+// SendError deliver err to the error handlers of the running streams
+func (f *FakeUserDataStream[E]) SendError(err error) {
+	for _, s := range f.running() {
+		s.errHandler(err)
+	}
+}
+
+// Calls return the arguments of the setters of the streams started in order
+func (f *FakeUserDataStream[E]) Calls() []Args {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []Args
+	for _, s := range f.streams {
+		if s.started {
+			calls = append(calls, s.args)
+		}
+	}
+	return calls
+}
+
+func (f *FakeUserDataStream[E]) running() []*fakeStream[E] {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var streams []*fakeStream[E]
+	for _, s := range f.streams {
+		if s.started && !s.stopped {
+			streams = append(streams, s)
+		}
+	}
+	return streams
+}
+
+func (f *FakeUserDataStream[E]) newStream(handler func(E), errHandler func(error)) *fakeStream[E] {
+	s := &fakeStream[E]{
+		f:          f,
+		handler:    handler,
+		errHandler: errHandler,
+		args:       make(Args),
+		doneC:      make(chan struct{}),
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.streams = append(f.streams, s)
+	return s
+}
+
+// fakeStream is a user data stream of a fake API, guarded by the mutex of its FakeUserDataStream
+type fakeStream[E any] struct {
+	f          *FakeUserDataStream[E]
+	handler    func(E)
+	errHandler func(error)
+	args       Args
+	key        string
+	started    bool
+	stopped    bool
+	doneC      chan struct{}
+}
+
+func (s *fakeStream[E]) set(name string, value interface{}) {
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	s.args[name] = value
+}
+
+func (s *fakeStream[E]) Start(ctx context.Context) error {
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	switch {
+	case s.stopped:
+		return ErrStreamStopped
+	case s.f.startErr != nil:
+		return s.f.startErr
+	}
+	s.key = s.f.key
+	if s.key == "" {
+		s.key = "listenKey"
+	}
+	s.started = true
+	return nil
+}
+
+func (s *fakeStream[E]) Stop() {
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	if !s.stopped {
+		s.stopped = true
+		close(s.doneC)
+	}
+}
+
+func (s *fakeStream[E]) Done() <-chan struct{} {
+	return s.doneC
+}
+
+func (s *fakeStream[E]) ListenKey() string {
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	return s.key
+}
@@ -0,0 +1,120 @@
+package binancetest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/binancetest"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+type fakeAPITestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestFakeAPI(t *testing.T) {
+	suite.Run(t, new(fakeAPITestSuite))
+}
+
+func (s *fakeAPITestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *fakeAPITestSuite) TestSpot() {
+	api := new(binancetest.SpotAPI)
+	api.CreateOrderService.Func(func(ctx context.Context, args binancetest.Args) (*binance.CreateOrderResponse, error) {
+		return &binance.CreateOrderResponse{
+			Symbol:  args["Symbol"].(string),
+			OrderID: 1,
+			Status:  binance.OrderStatusTypeFilled,
+		}, nil
+	})
+
+	res, err := buyAtMarket(s.ctx, api, "BTCUSDT", "30")
+	s.Require().NoError(err)
+	s.Equal("BTCUSDT", res.Symbol)
+	s.Equal(binance.OrderStatusTypeFilled, res.Status)
+	s.Equal([]binancetest.Args{{
+		"Symbol":        "BTCUSDT",
+		"Side":          binance.SideTypeBuy,
+		"Type":          binance.OrderTypeMarket,
+		"QuoteOrderQty": "30",
+	}}, api.CreateOrderService.Calls())
+
+	// services without response are answered with their error only
+	s.Require().NoError(api.NewPingService().Do(s.ctx))
+	api.PingService.Return(struct{}{}, errors.New("connection reset"))
+	s.EqualError(api.NewPingService().Do(s.ctx), "connection reset")
+	s.Len(api.PingService.Calls(), 2)
+
+	// without an answer, Do returns the zero value
+	depth, err := api.NewDepthService().Symbol("BTCUSDT").Do(s.ctx)
+	s.NoError(err)
+	s.Nil(depth)
+}
+
+func (s *fakeAPITestSuite) TestFutures() {
+	var api futures.TradingAPI = new(binancetest.FuturesAPI)
+	fake := api.(*binancetest.FuturesAPI)
+	fake.CreateOrderService.Return(nil, &common.APIError{Code: binancetest.ErrorCodeMarginInsufficient, Message: "Margin is insufficient."})
+
+	_, err := api.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("1").ReduceOnly(true).Do(s.ctx)
+	s.True(common.IsAPIErrorCode(err, binancetest.ErrorCodeMarginInsufficient), "got %v", err)
+	calls := fake.CreateOrderService.Calls()
+	s.Require().Len(calls, 1)
+	s.Equal(true, calls[0]["ReduceOnly"])
+	s.Equal("1", calls[0]["Quantity"])
+}
+
+func (s *fakeAPITestSuite) TestDelivery() {
+	api := new(binancetest.DeliveryAPI)
+	api.ListPricesService.Return([]*delivery.SymbolPrice{{Symbol: "BTCUSD_PERP", Pair: "BTCUSD", Price: "30000.0"}}, nil)
+
+	prices, err := api.NewListPricesService().Pair("BTCUSD").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(prices, 1)
+	s.Equal("30000.0", prices[0].Price)
+	s.Equal([]binancetest.Args{{"Pair": "BTCUSD"}}, api.ListPricesService.Calls())
+}
+
+func (s *fakeAPITestSuite) TestUserDataStream() {
+	api := new(binancetest.SpotAPI)
+	var events []*binance.WsUserDataEvent
+	var errs []error
+	stream := api.NewUserDataStream(func(event *binance.WsUserDataEvent) {
+		events = append(events, event)
+	}, func(err error) {
+		errs = append(errs, err)
+	}).Margin().KeepaliveInterval(time.Minute)
+
+	// events are only delivered to started streams
+	api.UserDataStream.Send(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeOutboundAccountPosition})
+	s.Empty(events)
+	s.Require().NoError(stream.Start(s.ctx))
+	s.Equal("listenKey", stream.ListenKey())
+	s.Equal([]binancetest.Args{{"Margin": true, "KeepaliveInterval": time.Minute}}, api.UserDataStream.Calls())
+
+	api.UserDataStream.Send(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeExecutionReport})
+	api.UserDataStream.SendError(errors.New("read: connection reset"))
+	s.Require().Len(events, 1)
+	s.Equal(binance.UserDataEventTypeExecutionReport, events[0].Event)
+	s.Len(errs, 1)
+
+	stream.Stop()
+	<-stream.Done()
+	api.UserDataStream.Send(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeExecutionReport})
+	s.Len(events, 1)
+	s.Equal(binancetest.ErrStreamStopped, stream.Start(s.ctx))
+
+	api.UserDataStream.StartError(errors.New("start failed"))
+	s.EqualError(api.NewUserDataStream(nil, nil).Start(s.ctx), "start failed")
+}
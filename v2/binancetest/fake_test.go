@@ -0,0 +1,119 @@
+package binancetest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/binancetest"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+type fakeTestSuite struct {
+	suite.Suite
+	fake *binancetest.Fake
+	ctx  context.Context
+}
+
+func TestFake(t *testing.T) {
+	suite.Run(t, new(fakeTestSuite))
+}
+
+func (s *fakeTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.fake = binancetest.NewFake()
+}
+
+// buyAtMarket is code under test, which only needs the trading services of a client
+func buyAtMarket(ctx context.Context, api binance.TradingAPI, symbol, quoteQty string) (*binance.CreateOrderResponse, error) {
+	return api.NewCreateOrderService().Symbol(symbol).Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).QuoteOrderQty(quoteQty).Do(ctx)
+}
+
+func (s *fakeTestSuite) TestSpot() {
+	s.fake.Handle(http.MethodPost, "/api/v3/order", func(c *binancetest.Call) (interface{}, error) {
+		return &binance.CreateOrderResponse{
+			Symbol:           c.Params.Get("symbol"),
+			OrderID:          1,
+			Status:           binance.OrderStatusTypeFilled,
+			ExecutedQuantity: "0.001",
+		}, nil
+	})
+	c := binance.NewClient(apiKey, secretKey, binance.WithHTTPClient(s.fake.HTTPClient()))
+
+	res, err := buyAtMarket(s.ctx, c.API(), "BTCUSDT", "30")
+	s.Require().NoError(err)
+	s.Equal("BTCUSDT", res.Symbol)
+	s.Equal(binance.OrderStatusTypeFilled, res.Status)
+
+	calls := s.fake.Calls("/api/v3/order")
+	s.Require().Len(calls, 1)
+	s.Equal(http.MethodPost, calls[0].Method)
+	s.Equal(apiKey, calls[0].APIKey)
+	s.Equal(url.Values{
+		"symbol":        {"BTCUSDT"},
+		"side":          {"BUY"},
+		"type":          {"MARKET"},
+		"quoteOrderQty": {"30"},
+	}, calls[0].Params)
+}
+
+func (s *fakeTestSuite) TestRawResponse() {
+	s.fake.Respond(http.MethodGet, "/api/v3/klines", json.RawMessage(`[[1499040000000,"0.01634790","0.80000000",
+		"0.01575800","0.01577100","148976.11427815",1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","0"]]`))
+	var api binance.MarketDataAPI = binance.NewClient("", "", binance.WithHTTPClient(s.fake.HTTPClient())).API()
+
+	klines, err := api.NewKlinesService().Symbol("LTCBTC").Interval("15m").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(klines, 1)
+	s.Equal("0.80000000", klines[0].High)
+}
+
+func (s *fakeTestSuite) TestErrors() {
+	s.fake.Fail(http.MethodPost, "/fapi/v1/order", binancetest.ErrorCodeMarginInsufficient, "Margin is insufficient.")
+	s.fake.Handle(http.MethodGet, "/fapi/v2/balance", func(c *binancetest.Call) (interface{}, error) {
+		return nil, errors.New("connection reset")
+	})
+	c := futures.NewClient(apiKey, secretKey, futures.WithHTTPClient(s.fake.HTTPClient()))
+
+	_, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("1").Do(s.ctx)
+	s.True(common.IsAPIErrorCode(err, binancetest.ErrorCodeMarginInsufficient), "got %v", err)
+	_, err = c.NewGetBalanceService().Do(s.ctx)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "connection reset")
+
+	_, err = c.NewGetPositionRiskService().Do(s.ctx)
+	s.True(common.IsAPIErrorCode(err, binancetest.ErrorCodeUnknown), "got %v", err)
+	s.Contains(err.Error(), "binancetest: no handler for GET /fapi/v2/positionRisk")
+	s.Len(s.fake.Calls(""), 3)
+
+	s.fake.Handle(http.MethodGet, "/fapi/v1/openOrders", func(c *binancetest.Call) (interface{}, error) {
+		return nil, &common.APIError{Code: -1003, Message: "Too many requests.",
+			StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"10"}}}
+	})
+	_, err = c.NewListOpenOrdersService().Do(s.ctx)
+	s.Require().True(errors.Is(err, common.ErrRateLimited), "got %v", err)
+	apiErr, _ := common.AsAPIError(err)
+	s.Equal(10*time.Second, apiErr.RetryAfter())
+}
+
+func (s *fakeTestSuite) TestDelivery() {
+	s.fake.Respond(http.MethodGet, "/dapi/v1/ticker/price", []*delivery.SymbolPrice{{Symbol: "BTCUSD_PERP", Pair: "BTCUSD", Price: "30000.0"}})
+	var api delivery.MarketDataAPI = delivery.NewClient("", "", delivery.WithHTTPClient(s.fake.HTTPClient())).API()
+
+	prices, err := api.NewListPricesService().Pair("BTCUSD").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(prices, 1)
+	s.Equal("30000.0", prices[0].Price)
+	s.Equal(url.Values{"pair": {"BTCUSD"}}, s.fake.Calls("/dapi/v1/ticker/price")[0].Params)
+}
@@ -0,0 +1,652 @@
+package binancetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// futuresLeverage is the leverage of every futures position, in cross margin one-way mode
+const futuresLeverage = 20
+
+// futures stop order types, resting until a trade reaches their stop price. Trailing stop orders
+// have none and never trigger.
+var futuresStopOrderTypes = map[string]bool{
+	"STOP":                 true,
+	"STOP_MARKET":          true,
+	"TAKE_PROFIT":          true,
+	"TAKE_PROFIT_MARKET":   true,
+	"TRAILING_STOP_MARKET": true,
+}
+
+func (s *Server) registerFutures() {
+	m := s.Futures
+	s.handle(http.MethodGet, "/fapi/v1/ping", secTypeNone, func(c *call) (interface{}, *apiError) {
+		return struct{}{}, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/time", secTypeNone, func(c *call) (interface{}, *apiError) {
+		return map[string]interface{}{"serverTime": c.now}, nil
+	})
+	s.handle(http.MethodGet, "/fapi/v1/exchangeInfo", secTypeNone, m.futuresExchangeInfo)
+	s.handle(http.MethodGet, "/fapi/v1/depth", secTypeNone, m.depth)
+	s.handle(http.MethodPost, "/fapi/v1/order", secTypeSigned, m.futuresNewOrder)
+	s.handle(http.MethodGet, "/fapi/v1/order", secTypeSigned, m.futuresGetOrder)
+	s.handle(http.MethodDelete, "/fapi/v1/order", secTypeSigned, m.futuresCancelOrder)
+	s.handle(http.MethodPost, "/fapi/v1/batchOrders", secTypeSigned, m.futuresNewBatchOrders)
+	s.handle(http.MethodDelete, "/fapi/v1/batchOrders", secTypeSigned, m.futuresCancelBatchOrders)
+	s.handle(http.MethodGet, "/fapi/v1/openOrders", secTypeSigned, m.futuresOpenOrders)
+	s.handle(http.MethodDelete, "/fapi/v1/allOpenOrders", secTypeSigned, m.futuresCancelAllOpenOrders)
+	s.handle(http.MethodGet, "/fapi/v2/balance", secTypeSigned, m.futuresBalance)
+	s.handle(http.MethodGet, "/fapi/v2/account", secTypeSigned, m.futuresAccount)
+	s.handle(http.MethodGet, "/fapi/v2/positionRisk", secTypeSigned, m.futuresPositionRisk)
+	s.handle(http.MethodGet, "/fapi/v1/userTrades", secTypeSigned, m.futuresUserTrades)
+	s.handle(http.MethodPost, "/fapi/v1/listenKey", secTypeAPIKey, m.futuresNewListenKey)
+	s.handle(http.MethodPut, "/fapi/v1/listenKey", secTypeAPIKey, m.futuresKeepaliveListenKey)
+	s.handle(http.MethodDelete, "/fapi/v1/listenKey", secTypeAPIKey, m.futuresCloseListenKey)
+}
+
+func (m *Market) futuresExchangeInfo(c *call) (interface{}, *apiError) {
+	var symbols []map[string]interface{}
+	for _, sym := range m.sortedSymbols() {
+		filters := []map[string]interface{}{
+			{"filterType": "PRICE_FILTER", "minPrice": sym.MinPrice, "maxPrice": sym.MaxPrice, "tickSize": sym.TickSize},
+			{"filterType": "LOT_SIZE", "minQty": sym.MinQty, "maxQty": sym.MaxQty, "stepSize": sym.StepSize},
+			{"filterType": "MARKET_LOT_SIZE", "minQty": sym.MinQty, "maxQty": sym.MaxQty, "stepSize": sym.StepSize},
+			{"filterType": "MAX_NUM_ORDERS", "limit": 200},
+			{"filterType": "MAX_NUM_ALGO_ORDERS", "limit": 10},
+		}
+		if sym.MinNotional != "" {
+			filters = append(filters, map[string]interface{}{"filterType": "MIN_NOTIONAL", "notional": sym.MinNotional})
+		}
+		symbols = append(symbols, map[string]interface{}{
+			"symbol":                sym.Symbol,
+			"pair":                  sym.Symbol,
+			"contractType":          "PERPETUAL",
+			"deliveryDate":          4133404800000,
+			"onboardDate":           1569398400000,
+			"status":                "TRADING",
+			"maintMarginPercent":    "2.5000",
+			"requiredMarginPercent": "5.0000",
+			"baseAsset":             sym.BaseAsset,
+			"quoteAsset":            sym.QuoteAsset,
+			"marginAsset":           sym.QuoteAsset,
+			"pricePrecision":        common.MustParseDecimal(sym.TickSize).Trim().Scale(),
+			"quantityPrecision":     common.MustParseDecimal(sym.StepSize).Trim().Scale(),
+			"baseAssetPrecision":    8,
+			"quotePrecision":        8,
+			"underlyingType":        "COIN",
+			"settlePlan":            0,
+			"triggerProtect":        "0.0500",
+			"orderType":             []string{"LIMIT", "MARKET", "STOP", "STOP_MARKET", "TAKE_PROFIT", "TAKE_PROFIT_MARKET", "TRAILING_STOP_MARKET"},
+			"timeInForce":           []string{"GTC", "IOC", "FOK", "GTX"},
+			"filters":               filters,
+		})
+	}
+	return map[string]interface{}{
+		"timezone":        "UTC",
+		"serverTime":      c.now,
+		"rateLimits":      rateLimits(2400, 300),
+		"exchangeFilters": []interface{}{},
+		"symbols":         symbols,
+	}, nil
+}
+
+// markPrice return the middle of the best bid and ask of a symbol, or its last price
+func (b *book) markPrice() common.Decimal {
+	if len(b.bids) > 0 && len(b.asks) > 0 {
+		return b.bids[0].price.Add(b.asks[0].price).Div(common.NewDecimal(2, 0), 8, common.RoundHalfUp).Trim()
+	}
+	return b.lastPrice
+}
+
+// margin return the wallet balance, unrealized profit and margin used by the positions and
+// open orders of an account settled in asset
+func (m *Market) margin(a *account, asset string) (wallet, unrealized, used common.Decimal) {
+	leverage := common.NewDecimal(futuresLeverage, 0)
+	for symbol, p := range a.positions {
+		if m.symbols[symbol].QuoteAsset != asset || p.amount.Sign() == 0 {
+			continue
+		}
+		unrealized = unrealized.Add(p.amount.Mul(m.books[symbol].markPrice().Sub(p.entryPrice)))
+		used = used.Add(p.amount.Abs().Mul(p.entryPrice).Div(leverage, 8, common.RoundUp))
+	}
+	for _, o := range m.openOrders(a, "") {
+		if m.symbols[o.symbol].QuoteAsset == asset && !o.reduceOnly {
+			used = used.Add(o.remaining().Mul(o.price).Div(leverage, 8, common.RoundUp))
+		}
+	}
+	return a.wallets[asset], unrealized, used
+}
+
+func (m *Market) futuresNewOrder(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	o := &order{account: c.account, symbol: sym.Symbol, time: c.now, updateTime: c.now, positionSide: "BOTH"}
+	if o.side, apiErr = stringParam(c.params, "side", true); apiErr != nil {
+		return nil, apiErr
+	}
+	if o.side != sideBuy && o.side != sideSell {
+		return nil, newError(ErrorCodeInvalidSide, "Invalid side.")
+	}
+	if o.orderType, apiErr = stringParam(c.params, "type", true); apiErr != nil {
+		return nil, apiErr
+	}
+	limit := o.orderType == orderTypeLimit || o.orderType == "STOP" || o.orderType == "TAKE_PROFIT"
+	if !limit && o.orderType != orderTypeMarket && !futuresStopOrderTypes[o.orderType] {
+		return nil, newError(ErrorCodeInvalidOrderType, "Invalid orderType.")
+	}
+	if side := c.params.Get("positionSide"); side != "" && side != "BOTH" {
+		return nil, newError(ErrorCodePositionSideMismatch, "Order's position side does not match user's setting.")
+	}
+	if o.timeInForce, apiErr = stringParam(c.params, "timeInForce", limit); apiErr != nil {
+		return nil, apiErr
+	}
+	o.closePosition = c.params.Get("closePosition") == "true"
+	if o.origQty, apiErr = decimalParam(c.params, "quantity", !o.closePosition); apiErr != nil {
+		return nil, apiErr
+	}
+	if o.price, apiErr = decimalParam(c.params, "price", limit); apiErr != nil {
+		return nil, apiErr
+	}
+	stop := futuresStopOrderTypes[o.orderType] && o.orderType != "TRAILING_STOP_MARKET"
+	if o.stopPrice, apiErr = decimalParam(c.params, "stopPrice", stop); apiErr != nil {
+		return nil, apiErr
+	}
+	o.reduceOnly = c.params.Get("reduceOnly") == "true"
+	o.clientOrderID = c.params.Get("newClientOrderId")
+	for _, open := range m.openOrders(c.account, sym.Symbol) {
+		if o.clientOrderID != "" && open.clientOrderID == o.clientOrderID {
+			return nil, newError(ErrorCodeDuplicateClientOrderID, "ClientOrderId is duplicated.")
+		}
+	}
+
+	b := m.books[sym.Symbol]
+	params := &common.OrderParams{
+		Symbol:         sym.Symbol,
+		Buy:            o.buy(),
+		Market:         o.orderType == orderTypeMarket,
+		Quantity:       c.params.Get("quantity"),
+		Price:          c.params.Get("price"),
+		StopPrice:      c.params.Get("stopPrice"),
+		ReferencePrice: b.referencePrice(o.buy()),
+	}
+	filters := sym.filters()
+	if o.reduceOnly {
+		filters.MinNotional = ""
+	}
+	if o.closePosition {
+		params.Quantity = ""
+	}
+	if err := common.ValidateOrder(filters, params, false); err != nil {
+		return nil, futuresFilterError(err.(*common.OrderValidationError).Errors[0], sym)
+	}
+	if o.triggeredBy(b.lastPrice) {
+		return nil, newError(ErrorCodeImmediateTrigger, "Order would immediately trigger.")
+	}
+
+	// a reduce only order is capped to the position it reduces
+	pos := c.account.position(sym.Symbol)
+	if o.reduceOnly {
+		if pos.amount.Sign() == 0 || (pos.amount.Sign() > 0) == o.buy() {
+			return nil, newError(ErrorCodeReduceOnlyReject, "ReduceOnly Order is rejected.")
+		}
+		if o.origQty.GreaterThan(pos.amount.Abs()) {
+			o.origQty = pos.amount.Abs()
+		}
+	}
+	qty := o.origQty
+
+	var fills []fill
+	switch {
+	case futuresStopOrderTypes[o.orderType]:
+	case o.orderType == orderTypeMarket:
+		fills = b.take(o.buy(), common.Decimal{}, qty, common.Decimal{}, common.MustParseDecimal(sym.StepSize))
+	default:
+		fills = b.take(o.buy(), o.price, qty, common.Decimal{}, common.MustParseDecimal(sym.StepSize))
+	}
+	filledQty, filledQuote := sumFills(fills)
+	expire := false
+	switch {
+	case o.timeInForce == timeInForceGTX && len(fills) > 0:
+		fills, filledQty, filledQuote, expire = nil, common.Decimal{}, common.Decimal{}, true
+	case o.timeInForce == timeInForceFOK && filledQty.LessThan(qty):
+		fills, filledQty, filledQuote, expire = nil, common.Decimal{}, common.Decimal{}, true
+	case o.timeInForce == timeInForceIOC || o.orderType == orderTypeMarket:
+		expire = filledQty.LessThan(o.origQty)
+	}
+
+	// check the margin of the part of the order opening a position
+	if !o.reduceOnly && !expire {
+		opening := o.origQty
+		if pos.amount.Sign() != 0 && (pos.amount.Sign() > 0) != o.buy() {
+			opening = opening.Sub(pos.amount.Abs())
+		}
+		if opening.Sign() > 0 {
+			price := o.price
+			if price.Sign() == 0 && filledQty.Sign() > 0 {
+				price = filledQuote.Div(filledQty, 8, common.RoundUp)
+			}
+			wallet, unrealized, used := m.margin(c.account, sym.QuoteAsset)
+			cost := opening.Mul(price).Div(common.NewDecimal(futuresLeverage, 0), 8, common.RoundUp)
+			if wallet.Add(unrealized).Sub(used).LessThan(cost) {
+				return nil, newError(ErrorCodeMarginInsufficient, "Margin is insufficient.")
+			}
+		}
+	}
+
+	m.nextOrderID++
+	o.id = m.nextOrderID
+	if o.clientOrderID == "" {
+		o.clientOrderID = newClientOrderID(o.id)
+	}
+	o.status = statusNew
+	m.orders = append(m.orders, o)
+	m.reportOrder(o, executionTypeNew, nil, c.now)
+	b.consume(o.buy(), fills)
+	m.execute(o, fills, false, c.now)
+	if expire && o.status != statusFilled {
+		o.status = statusExpired
+		m.reportOrder(o, executionTypeExpired, nil, c.now)
+	}
+	if len(fills) > 0 {
+		m.reportAccount(c.account, sym.Symbol, c.now)
+	}
+	return m.futuresOrderJSON(o), nil
+}
+
+// futuresNewBatchOrders place up to 5 orders, returning each order or its error
+func (m *Market) futuresNewBatchOrders(c *call) (interface{}, *apiError) {
+	batch, apiErr := batchParam(c, "batchOrders")
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	res := []interface{}{}
+	for _, params := range batch {
+		o, apiErr := m.futuresNewOrder(&call{account: c.account, params: params, now: c.now})
+		if apiErr != nil {
+			res = append(res, map[string]interface{}{"code": apiErr.code, "msg": apiErr.msg})
+			continue
+		}
+		res = append(res, o)
+	}
+	return res, nil
+}
+
+// futuresCancelBatchOrders cancel up to 10 orders by orderIdList or origClientOrderIdList,
+// returning each order or its error
+func (m *Market) futuresCancelBatchOrders(c *call) (interface{}, *apiError) {
+	var ids []interface{}
+	for _, name := range []string{"orderIdList", "origClientOrderIdList"} {
+		if v := c.params.Get(name); v != "" {
+			if err := json.Unmarshal([]byte(v), &ids); err != nil || len(ids) > 10 {
+				return nil, newError(ErrorCodeIllegalChars, illegalCharsMessage, name)
+			}
+			res := []interface{}{}
+			for _, id := range ids {
+				params := url.Values{"symbol": {c.params.Get("symbol")}}
+				if name == "orderIdList" {
+					params.Set("orderId", fmt.Sprint(id))
+				} else {
+					params.Set("origClientOrderId", fmt.Sprint(id))
+				}
+				o, apiErr := m.futuresCancelOrder(&call{account: c.account, params: params, now: c.now})
+				if apiErr != nil {
+					res = append(res, map[string]interface{}{"code": apiErr.code, "msg": apiErr.msg})
+					continue
+				}
+				res = append(res, o)
+			}
+			return res, nil
+		}
+	}
+	return nil, newError(ErrorCodeMandatoryParam, "Param 'origClientOrderIdList' or 'orderIdList' must be sent, but both were empty/null!")
+}
+
+// batchParam parse a JSON list of at most 5 orders into their parameters
+func batchParam(c *call, name string) ([]url.Values, *apiError) {
+	v, apiErr := stringParam(c.params, name, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	var orders []map[string]interface{}
+	if err := json.Unmarshal([]byte(v), &orders); err != nil {
+		return nil, newError(ErrorCodeIllegalChars, illegalCharsMessage, name)
+	}
+	if len(orders) == 0 || len(orders) > 5 {
+		return nil, newError(ErrorCodeUnknown, "Param '%s' must contain 1 to 5 orders.", name)
+	}
+	res := make([]url.Values, len(orders))
+	for i, o := range orders {
+		res[i] = url.Values{}
+		for k, v := range o {
+			res[i].Set(k, fmt.Sprint(v))
+		}
+	}
+	return res, nil
+}
+
+// futuresFilterError map a filter violation to the error of the futures API
+func futuresFilterError(err *common.FilterError, sym *Symbol) *apiError {
+	switch {
+	case err.Filter == common.FilterTypePrice && err.Violation == common.FilterViolationTickSize:
+		return newError(ErrorCodeInvalidTickSize, "Price not increased by tick size.")
+	case err.Filter == common.FilterTypeLotSize && err.Violation == common.FilterViolationStepSize:
+		return newError(ErrorCodeBadPrecision, "Precision is over the maximum defined for this asset.")
+	case err.Filter == common.FilterTypeMinNotional:
+		return newError(ErrorCodeMinNotional, "Order's notional must be no smaller than %s (unless you choose reduce only).", sym.MinNotional)
+	}
+	return newError(ErrorCodeFilterFailure, "Filter failure: %s", err.Filter)
+}
+
+func (m *Market) futuresOrderJSON(o *order) map[string]interface{} {
+	sym := m.symbols[o.symbol]
+	priceScale := common.MustParseDecimal(sym.TickSize).Trim().Scale()
+	qtyScale := common.MustParseDecimal(sym.StepSize).Trim().Scale()
+	avgPrice := common.Decimal{}.Round(5, common.RoundDown)
+	if o.executedQty.Sign() > 0 {
+		avgPrice = o.cumQuote.Div(o.executedQty, priceScale+5, common.RoundHalfUp)
+	}
+	return map[string]interface{}{
+		"symbol":        o.symbol,
+		"orderId":       o.id,
+		"clientOrderId": o.clientOrderID,
+		"price":         o.price.Round(priceScale, common.RoundDown).String(),
+		"avgPrice":      avgPrice.String(),
+		"origQty":       o.origQty.Round(qtyScale, common.RoundDown).String(),
+		"executedQty":   o.executedQty.Round(qtyScale, common.RoundDown).String(),
+		"cumQty":        o.executedQty.Round(qtyScale, common.RoundDown).String(),
+		"cumQuote":      o.cumQuote.Round(priceScale+qtyScale, common.RoundDown).String(),
+		"status":        o.status,
+		"timeInForce":   o.timeInForce,
+		"type":          o.orderType,
+		"origType":      o.orderType,
+		"side":          o.side,
+		"positionSide":  o.positionSide,
+		"reduceOnly":    o.reduceOnly,
+		"closePosition": o.closePosition,
+		"stopPrice":     o.stopPrice.Round(priceScale, common.RoundDown).String(),
+		"workingType":   "CONTRACT_PRICE",
+		"priceProtect":  false,
+		"time":          o.time,
+		"updateTime":    o.updateTime,
+	}
+}
+
+func (m *Market) futuresGetOrder(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	o, apiErr := m.findOrder(c, sym.Symbol)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if o == nil {
+		return nil, newError(ErrorCodeNoSuchOrder, noSuchOrderMessage)
+	}
+	return m.futuresOrderJSON(o), nil
+}
+
+func (m *Market) futuresCancelOrder(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	o, apiErr := m.findOrder(c, sym.Symbol)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if o == nil || !o.open() {
+		return nil, newError(ErrorCodeCancelRejected, unknownOrderMessage)
+	}
+	m.cancel(o, c.now)
+	return m.futuresOrderJSON(o), nil
+}
+
+func (m *Market) futuresOpenOrders(c *call) (interface{}, *apiError) {
+	symbol := ""
+	sym, apiErr := m.symbol(c, false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if sym != nil {
+		symbol = sym.Symbol
+	}
+	res := []map[string]interface{}{}
+	for _, o := range m.openOrders(c.account, symbol) {
+		res = append(res, m.futuresOrderJSON(o))
+	}
+	return res, nil
+}
+
+func (m *Market) futuresCancelAllOpenOrders(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	for _, o := range m.openOrders(c.account, sym.Symbol) {
+		m.cancel(o, c.now)
+	}
+	return map[string]interface{}{"code": 200, "msg": "The operation of cancel all open order is done."}, nil
+}
+
+// sortedWallets return the assets of the futures wallets of an account by name
+func sortedWallets(a *account) []string {
+	assets := make([]string, 0, len(a.wallets))
+	for asset := range a.wallets {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	return assets
+}
+
+func (m *Market) futuresBalance(c *call) (interface{}, *apiError) {
+	res := []map[string]interface{}{}
+	for _, asset := range sortedWallets(c.account) {
+		wallet, unrealized, used := m.margin(c.account, asset)
+		available := wallet.Add(unrealized).Sub(used)
+		res = append(res, map[string]interface{}{
+			"accountAlias":       "binancetest",
+			"asset":              asset,
+			"balance":            format8(wallet),
+			"crossWalletBalance": format8(wallet),
+			"crossUnPnl":         format8(unrealized),
+			"availableBalance":   format8(available),
+			"maxWithdrawAmount":  format8(available),
+			"marginAvailable":    true,
+			"updateTime":         c.now,
+		})
+	}
+	return res, nil
+}
+
+func (m *Market) futuresAccount(c *call) (interface{}, *apiError) {
+	assets := []map[string]interface{}{}
+	var totalWallet, totalUnrealized, totalUsed common.Decimal
+	for _, asset := range sortedWallets(c.account) {
+		wallet, unrealized, used := m.margin(c.account, asset)
+		totalWallet, totalUnrealized, totalUsed = totalWallet.Add(wallet), totalUnrealized.Add(unrealized), totalUsed.Add(used)
+		available := wallet.Add(unrealized).Sub(used)
+		assets = append(assets, map[string]interface{}{
+			"asset":                  asset,
+			"walletBalance":          format8(wallet),
+			"unrealizedProfit":       format8(unrealized),
+			"marginBalance":          format8(wallet.Add(unrealized)),
+			"maintMargin":            "0.00000000",
+			"initialMargin":          format8(used),
+			"positionInitialMargin":  format8(used),
+			"openOrderInitialMargin": "0.00000000",
+			"crossWalletBalance":     format8(wallet),
+			"crossUnPnl":             format8(unrealized),
+			"availableBalance":       format8(available),
+			"maxWithdrawAmount":      format8(available),
+			"marginAvailable":        true,
+			"updateTime":             c.now,
+		})
+	}
+	positions := []map[string]interface{}{}
+	for _, p := range m.positionRisks(c.account) {
+		positions = append(positions, map[string]interface{}{
+			"symbol":                 p["symbol"],
+			"initialMargin":          p["isolatedMargin"],
+			"maintMargin":            "0",
+			"unrealizedProfit":       p["unRealizedProfit"],
+			"positionInitialMargin":  "0",
+			"openOrderInitialMargin": "0",
+			"leverage":               p["leverage"],
+			"isolated":               false,
+			"entryPrice":             p["entryPrice"],
+			"maxNotional":            p["maxNotionalValue"],
+			"positionSide":           p["positionSide"],
+			"positionAmt":            p["positionAmt"],
+			"notional":               p["notional"],
+			"updateTime":             p["updateTime"],
+		})
+	}
+	available := totalWallet.Add(totalUnrealized).Sub(totalUsed)
+	return map[string]interface{}{
+		"feeTier":                     0,
+		"canTrade":                    true,
+		"canDeposit":                  true,
+		"canWithdraw":                 true,
+		"updateTime":                  0,
+		"multiAssetsMargin":           false,
+		"totalInitialMargin":          format8(totalUsed),
+		"totalMaintMargin":            "0.00000000",
+		"totalWalletBalance":          format8(totalWallet),
+		"totalUnrealizedProfit":       format8(totalUnrealized),
+		"totalMarginBalance":          format8(totalWallet.Add(totalUnrealized)),
+		"totalPositionInitialMargin":  format8(totalUsed),
+		"totalOpenOrderInitialMargin": "0.00000000",
+		"totalCrossWalletBalance":     format8(totalWallet),
+		"totalCrossUnPnl":             format8(totalUnrealized),
+		"availableBalance":            format8(available),
+		"maxWithdrawAmount":           format8(available),
+		"assets":                      assets,
+		"positions":                   positions,
+	}, nil
+}
+
+// positionRisks return the positions of every symbol of the market, like positionRisk
+func (m *Market) positionRisks(a *account) []map[string]interface{} {
+	res := []map[string]interface{}{}
+	for _, sym := range m.sortedSymbols() {
+		p := a.position(sym.Symbol)
+		mark := m.books[sym.Symbol].markPrice()
+		res = append(res, map[string]interface{}{
+			"symbol":           sym.Symbol,
+			"positionAmt":      p.amount.String(),
+			"entryPrice":       p.entryPrice.String(),
+			"markPrice":        mark.String(),
+			"unRealizedProfit": format8(p.amount.Mul(mark.Sub(p.entryPrice))),
+			"liquidationPrice": "0",
+			"leverage":         "20",
+			"maxNotionalValue": "1000000",
+			"marginType":       "cross",
+			"isolatedMargin":   "0.00000000",
+			"isAutoAddMargin":  "false",
+			"positionSide":     "BOTH",
+			"notional":         format8(p.amount.Mul(mark)),
+			"isolatedWallet":   "0",
+			"updateTime":       p.updateTime,
+		})
+	}
+	return res
+}
+
+func (m *Market) futuresPositionRisk(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	res := m.positionRisks(c.account)
+	if sym == nil {
+		return res, nil
+	}
+	for _, p := range res {
+		if p["symbol"] == sym.Symbol {
+			return []map[string]interface{}{p}, nil
+		}
+	}
+	return []map[string]interface{}{}, nil
+}
+
+func (m *Market) futuresUserTrades(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	trades, apiErr := m.userTrades(c, sym.Symbol, 500, 1000)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	res := []map[string]interface{}{}
+	for _, t := range trades {
+		side := sideSell
+		if t.buyer {
+			side = sideBuy
+		}
+		res = append(res, map[string]interface{}{
+			"buyer":           t.buyer,
+			"commission":      t.commission.String(),
+			"commissionAsset": t.commissionAsset,
+			"id":              t.id,
+			"maker":           t.maker,
+			"orderId":         t.orderID,
+			"price":           t.price.String(),
+			"qty":             t.qty.String(),
+			"quoteQty":        t.qty.Mul(t.price).String(),
+			"realizedPnl":     format8(t.realizedPnl),
+			"side":            side,
+			"positionSide":    "BOTH",
+			"symbol":          t.symbol,
+			"time":            t.time,
+		})
+	}
+	return res, nil
+}
+
+// futuresNewListenKey return the active listen key of the account, creating one if needed
+func (m *Market) futuresNewListenKey(c *call) (interface{}, *apiError) {
+	if key := m.listenKey(c.account); key != "" {
+		return map[string]interface{}{"listenKey": key}, nil
+	}
+	return m.newListenKey(c)
+}
+
+// futuresKeepaliveListenKey extend the listen key of the account, the listenKey parameter is optional
+func (m *Market) futuresKeepaliveListenKey(c *call) (interface{}, *apiError) {
+	if c.params.Get("listenKey") != "" {
+		return m.keepaliveListenKey(c)
+	}
+	if m.listenKey(c.account) == "" {
+		return nil, newError(ErrorCodeInvalidListenKey, invalidListenKeyMessage)
+	}
+	return struct{}{}, nil
+}
+
+func (m *Market) futuresCloseListenKey(c *call) (interface{}, *apiError) {
+	if c.params.Get("listenKey") != "" {
+		return m.closeListenKey(c)
+	}
+	if key := m.listenKey(c.account); key != "" {
+		m.s.closeListenKey(c.account, key)
+	}
+	return struct{}{}, nil
+}
+
+// listenKey return the listen key of an account on m, empty if none
+func (m *Market) listenKey(a *account) string {
+	for key, km := range a.listenKeys {
+		if km == m {
+			return key
+		}
+	}
+	return ""
+}
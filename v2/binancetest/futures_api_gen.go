@@ -0,0 +1,1427 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package binancetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// FuturesAPI is an in-memory fake of futures.API. Its services record the arguments of their setters and
+// answer Do with the FakeService of the same name, its user data streams are served by
+// UserDataStream. The zero value is ready to use.
+type FuturesAPI struct {
+	PingService                      FakeService[struct{}]
+	ServerTimeService                FakeService[int64]
+	ExchangeInfoService              FakeService[*futures.ExchangeInfo]
+	DepthService                     FakeService[*futures.DepthResponse]
+	AggTradesService                 FakeService[[]*futures.AggTrade]
+	RecentTradesService              FakeService[[]*futures.Trade]
+	HistoricalTradesService          FakeService[[]*futures.Trade]
+	KlinesService                    FakeService[[]*futures.Kline]
+	ContinuousKlinesService          FakeService[[]*futures.ContinuousKline]
+	IndexPriceKlinesService          FakeService[[]*futures.Kline]
+	MarkPriceKlinesService           FakeService[[]*futures.Kline]
+	ListPriceChangeStatsService      FakeService[[]*futures.PriceChangeStats]
+	ListPricesService                FakeService[[]*futures.SymbolPrice]
+	ListBookTickersService           FakeService[[]*futures.BookTicker]
+	PremiumIndexService              FakeService[[]*futures.PremiumIndex]
+	FundingRateService               FakeService[[]*futures.FundingRate]
+	GetOpenInterestService           FakeService[*futures.OpenInterest]
+	OpenInterestStatisticsService    FakeService[[]*futures.OpenInterestStatistic]
+	LongShortRatioService            FakeService[[]*futures.LongShortRatio]
+	ListLiquidationOrdersService     FakeService[[]*futures.LiquidationOrder]
+	CreateOrderService               FakeService[*futures.CreateOrderResponse]
+	CreateBatchOrdersService         FakeService[*futures.CreateBatchOrdersResponse]
+	GetOrderService                  FakeService[*futures.Order]
+	CancelOrderService               FakeService[*futures.CancelOrderResponse]
+	CancelAllOpenOrdersService       FakeService[struct{}]
+	CancelMultiplesOrdersService     FakeService[[]*futures.CancelOrderResponse]
+	GetOpenOrderService              FakeService[*futures.Order]
+	ListOpenOrdersService            FakeService[[]*futures.Order]
+	ListOrdersService                FakeService[[]*futures.Order]
+	ListAccountTradeService          FakeService[[]*futures.AccountTrade]
+	ListUserLiquidationOrdersService FakeService[[]*futures.UserLiquidationOrder]
+	ChangeLeverageService            FakeService[*futures.SymbolLeverage]
+	ChangeMarginTypeService          FakeService[struct{}]
+	UpdatePositionMarginService      FakeService[struct{}]
+	ChangePositionModeService        FakeService[struct{}]
+	GetPositionModeService           FakeService[*futures.PositionMode]
+	ChangeMultiAssetModeService      FakeService[struct{}]
+	GetMultiAssetModeService         FakeService[*futures.MultiAssetMode]
+	GetAccountService                FakeService[*futures.Account]
+	GetBalanceService                FakeService[[]*futures.Balance]
+	GetPositionRiskService           FakeService[[]*futures.PositionRisk]
+	GetPositionMarginHistoryService  FakeService[[]*futures.PositionMarginHistory]
+	GetIncomeHistoryService          FakeService[[]*futures.IncomeHistory]
+	GetLeverageBracketService        FakeService[[]*futures.LeverageBracket]
+	CommissionRateService            FakeService[*futures.CommissionRate]
+	StartUserStreamService           FakeService[string]
+	KeepaliveUserStreamService       FakeService[struct{}]
+	CloseUserStreamService           FakeService[struct{}]
+	UserDataStream                   FakeUserDataStream[*futures.WsUserDataEvent]
+}
+
+var _ futures.API = (*FuturesAPI)(nil)
+
+// NewPingService implement futures.API
+func (a *FuturesAPI) NewPingService() futures.PingServiceAPI {
+	return &futuresPingService{f: &a.PingService, args: make(Args)}
+}
+
+// NewServerTimeService implement futures.API
+func (a *FuturesAPI) NewServerTimeService() futures.ServerTimeServiceAPI {
+	return &futuresServerTimeService{f: &a.ServerTimeService, args: make(Args)}
+}
+
+// NewExchangeInfoService implement futures.API
+func (a *FuturesAPI) NewExchangeInfoService() futures.ExchangeInfoServiceAPI {
+	return &futuresExchangeInfoService{f: &a.ExchangeInfoService, args: make(Args)}
+}
+
+// NewDepthService implement futures.API
+func (a *FuturesAPI) NewDepthService() futures.DepthServiceAPI {
+	return &futuresDepthService{f: &a.DepthService, args: make(Args)}
+}
+
+// NewAggTradesService implement futures.API
+func (a *FuturesAPI) NewAggTradesService() futures.AggTradesServiceAPI {
+	return &futuresAggTradesService{f: &a.AggTradesService, args: make(Args)}
+}
+
+// NewRecentTradesService implement futures.API
+func (a *FuturesAPI) NewRecentTradesService() futures.RecentTradesServiceAPI {
+	return &futuresRecentTradesService{f: &a.RecentTradesService, args: make(Args)}
+}
+
+// NewHistoricalTradesService implement futures.API
+func (a *FuturesAPI) NewHistoricalTradesService() futures.HistoricalTradesServiceAPI {
+	return &futuresHistoricalTradesService{f: &a.HistoricalTradesService, args: make(Args)}
+}
+
+// NewKlinesService implement futures.API
+func (a *FuturesAPI) NewKlinesService() futures.KlinesServiceAPI {
+	return &futuresKlinesService{f: &a.KlinesService, args: make(Args)}
+}
+
+// NewContinuousKlinesService implement futures.API
+func (a *FuturesAPI) NewContinuousKlinesService() futures.ContinuousKlinesServiceAPI {
+	return &futuresContinuousKlinesService{f: &a.ContinuousKlinesService, args: make(Args)}
+}
+
+// NewIndexPriceKlinesService implement futures.API
+func (a *FuturesAPI) NewIndexPriceKlinesService() futures.IndexPriceKlinesServiceAPI {
+	return &futuresIndexPriceKlinesService{f: &a.IndexPriceKlinesService, args: make(Args)}
+}
+
+// NewMarkPriceKlinesService implement futures.API
+func (a *FuturesAPI) NewMarkPriceKlinesService() futures.MarkPriceKlinesServiceAPI {
+	return &futuresMarkPriceKlinesService{f: &a.MarkPriceKlinesService, args: make(Args)}
+}
+
+// NewListPriceChangeStatsService implement futures.API
+func (a *FuturesAPI) NewListPriceChangeStatsService() futures.ListPriceChangeStatsServiceAPI {
+	return &futuresListPriceChangeStatsService{f: &a.ListPriceChangeStatsService, args: make(Args)}
+}
+
+// NewListPricesService implement futures.API
+func (a *FuturesAPI) NewListPricesService() futures.ListPricesServiceAPI {
+	return &futuresListPricesService{f: &a.ListPricesService, args: make(Args)}
+}
+
+// NewListBookTickersService implement futures.API
+func (a *FuturesAPI) NewListBookTickersService() futures.ListBookTickersServiceAPI {
+	return &futuresListBookTickersService{f: &a.ListBookTickersService, args: make(Args)}
+}
+
+// NewPremiumIndexService implement futures.API
+func (a *FuturesAPI) NewPremiumIndexService() futures.PremiumIndexServiceAPI {
+	return &futuresPremiumIndexService{f: &a.PremiumIndexService, args: make(Args)}
+}
+
+// NewFundingRateService implement futures.API
+func (a *FuturesAPI) NewFundingRateService() futures.FundingRateServiceAPI {
+	return &futuresFundingRateService{f: &a.FundingRateService, args: make(Args)}
+}
+
+// NewGetOpenInterestService implement futures.API
+func (a *FuturesAPI) NewGetOpenInterestService() futures.GetOpenInterestServiceAPI {
+	return &futuresGetOpenInterestService{f: &a.GetOpenInterestService, args: make(Args)}
+}
+
+// NewOpenInterestStatisticsService implement futures.API
+func (a *FuturesAPI) NewOpenInterestStatisticsService() futures.OpenInterestStatisticsServiceAPI {
+	return &futuresOpenInterestStatisticsService{f: &a.OpenInterestStatisticsService, args: make(Args)}
+}
+
+// NewLongShortRatioService implement futures.API
+func (a *FuturesAPI) NewLongShortRatioService() futures.LongShortRatioServiceAPI {
+	return &futuresLongShortRatioService{f: &a.LongShortRatioService, args: make(Args)}
+}
+
+// NewListLiquidationOrdersService implement futures.API
+func (a *FuturesAPI) NewListLiquidationOrdersService() futures.ListLiquidationOrdersServiceAPI {
+	return &futuresListLiquidationOrdersService{f: &a.ListLiquidationOrdersService, args: make(Args)}
+}
+
+// NewCreateOrderService implement futures.API
+func (a *FuturesAPI) NewCreateOrderService() futures.CreateOrderServiceAPI {
+	return &futuresCreateOrderService{f: &a.CreateOrderService, args: make(Args)}
+}
+
+// NewCreateBatchOrdersService implement futures.API
+func (a *FuturesAPI) NewCreateBatchOrdersService() futures.CreateBatchOrdersServiceAPI {
+	return &futuresCreateBatchOrdersService{f: &a.CreateBatchOrdersService, args: make(Args)}
+}
+
+// NewGetOrderService implement futures.API
+func (a *FuturesAPI) NewGetOrderService() futures.GetOrderServiceAPI {
+	return &futuresGetOrderService{f: &a.GetOrderService, args: make(Args)}
+}
+
+// NewCancelOrderService implement futures.API
+func (a *FuturesAPI) NewCancelOrderService() futures.CancelOrderServiceAPI {
+	return &futuresCancelOrderService{f: &a.CancelOrderService, args: make(Args)}
+}
+
+// NewCancelAllOpenOrdersService implement futures.API
+func (a *FuturesAPI) NewCancelAllOpenOrdersService() futures.CancelAllOpenOrdersServiceAPI {
+	return &futuresCancelAllOpenOrdersService{f: &a.CancelAllOpenOrdersService, args: make(Args)}
+}
+
+// NewCancelMultipleOrdersService implement futures.API
+func (a *FuturesAPI) NewCancelMultipleOrdersService() futures.CancelMultiplesOrdersServiceAPI {
+	return &futuresCancelMultiplesOrdersService{f: &a.CancelMultiplesOrdersService, args: make(Args)}
+}
+
+// NewGetOpenOrderService implement futures.API
+func (a *FuturesAPI) NewGetOpenOrderService() futures.GetOpenOrderServiceAPI {
+	return &futuresGetOpenOrderService{f: &a.GetOpenOrderService, args: make(Args)}
+}
+
+// NewListOpenOrdersService implement futures.API
+func (a *FuturesAPI) NewListOpenOrdersService() futures.ListOpenOrdersServiceAPI {
+	return &futuresListOpenOrdersService{f: &a.ListOpenOrdersService, args: make(Args)}
+}
+
+// NewListOrdersService implement futures.API
+func (a *FuturesAPI) NewListOrdersService() futures.ListOrdersServiceAPI {
+	return &futuresListOrdersService{f: &a.ListOrdersService, args: make(Args)}
+}
+
+// NewListAccountTradeService implement futures.API
+func (a *FuturesAPI) NewListAccountTradeService() futures.ListAccountTradeServiceAPI {
+	return &futuresListAccountTradeService{f: &a.ListAccountTradeService, args: make(Args)}
+}
+
+// NewListUserLiquidationOrdersService implement futures.API
+func (a *FuturesAPI) NewListUserLiquidationOrdersService() futures.ListUserLiquidationOrdersServiceAPI {
+	return &futuresListUserLiquidationOrdersService{f: &a.ListUserLiquidationOrdersService, args: make(Args)}
+}
+
+// NewChangeLeverageService implement futures.API
+func (a *FuturesAPI) NewChangeLeverageService() futures.ChangeLeverageServiceAPI {
+	return &futuresChangeLeverageService{f: &a.ChangeLeverageService, args: make(Args)}
+}
+
+// NewChangeMarginTypeService implement futures.API
+func (a *FuturesAPI) NewChangeMarginTypeService() futures.ChangeMarginTypeServiceAPI {
+	return &futuresChangeMarginTypeService{f: &a.ChangeMarginTypeService, args: make(Args)}
+}
+
+// NewUpdatePositionMarginService implement futures.API
+func (a *FuturesAPI) NewUpdatePositionMarginService() futures.UpdatePositionMarginServiceAPI {
+	return &futuresUpdatePositionMarginService{f: &a.UpdatePositionMarginService, args: make(Args)}
+}
+
+// NewChangePositionModeService implement futures.API
+func (a *FuturesAPI) NewChangePositionModeService() futures.ChangePositionModeServiceAPI {
+	return &futuresChangePositionModeService{f: &a.ChangePositionModeService, args: make(Args)}
+}
+
+// NewGetPositionModeService implement futures.API
+func (a *FuturesAPI) NewGetPositionModeService() futures.GetPositionModeServiceAPI {
+	return &futuresGetPositionModeService{f: &a.GetPositionModeService, args: make(Args)}
+}
+
+// NewChangeMultiAssetModeService implement futures.API
+func (a *FuturesAPI) NewChangeMultiAssetModeService() futures.ChangeMultiAssetModeServiceAPI {
+	return &futuresChangeMultiAssetModeService{f: &a.ChangeMultiAssetModeService, args: make(Args)}
+}
+
+// NewGetMultiAssetModeService implement futures.API
+func (a *FuturesAPI) NewGetMultiAssetModeService() futures.GetMultiAssetModeServiceAPI {
+	return &futuresGetMultiAssetModeService{f: &a.GetMultiAssetModeService, args: make(Args)}
+}
+
+// NewGetAccountService implement futures.API
+func (a *FuturesAPI) NewGetAccountService() futures.GetAccountServiceAPI {
+	return &futuresGetAccountService{f: &a.GetAccountService, args: make(Args)}
+}
+
+// NewGetBalanceService implement futures.API
+func (a *FuturesAPI) NewGetBalanceService() futures.GetBalanceServiceAPI {
+	return &futuresGetBalanceService{f: &a.GetBalanceService, args: make(Args)}
+}
+
+// NewGetPositionRiskService implement futures.API
+func (a *FuturesAPI) NewGetPositionRiskService() futures.GetPositionRiskServiceAPI {
+	return &futuresGetPositionRiskService{f: &a.GetPositionRiskService, args: make(Args)}
+}
+
+// NewGetPositionMarginHistoryService implement futures.API
+func (a *FuturesAPI) NewGetPositionMarginHistoryService() futures.GetPositionMarginHistoryServiceAPI {
+	return &futuresGetPositionMarginHistoryService{f: &a.GetPositionMarginHistoryService, args: make(Args)}
+}
+
+// NewGetIncomeHistoryService implement futures.API
+func (a *FuturesAPI) NewGetIncomeHistoryService() futures.GetIncomeHistoryServiceAPI {
+	return &futuresGetIncomeHistoryService{f: &a.GetIncomeHistoryService, args: make(Args)}
+}
+
+// NewGetLeverageBracketService implement futures.API
+func (a *FuturesAPI) NewGetLeverageBracketService() futures.GetLeverageBracketServiceAPI {
+	return &futuresGetLeverageBracketService{f: &a.GetLeverageBracketService, args: make(Args)}
+}
+
+// NewCommissionRateService implement futures.API
+func (a *FuturesAPI) NewCommissionRateService() futures.CommissionRateServiceAPI {
+	return &futuresCommissionRateService{f: &a.CommissionRateService, args: make(Args)}
+}
+
+// NewStartUserStreamService implement futures.API
+func (a *FuturesAPI) NewStartUserStreamService() futures.StartUserStreamServiceAPI {
+	return &futuresStartUserStreamService{f: &a.StartUserStreamService, args: make(Args)}
+}
+
+// NewKeepaliveUserStreamService implement futures.API
+func (a *FuturesAPI) NewKeepaliveUserStreamService() futures.KeepaliveUserStreamServiceAPI {
+	return &futuresKeepaliveUserStreamService{f: &a.KeepaliveUserStreamService, args: make(Args)}
+}
+
+// NewCloseUserStreamService implement futures.API
+func (a *FuturesAPI) NewCloseUserStreamService() futures.CloseUserStreamServiceAPI {
+	return &futuresCloseUserStreamService{f: &a.CloseUserStreamService, args: make(Args)}
+}
+
+// NewUserDataStream implement futures.API
+func (a *FuturesAPI) NewUserDataStream(handler futures.WsUserDataHandler, errHandler futures.ErrHandler) futures.UserDataStreamAPI {
+	return &futuresUserDataStream{s: a.UserDataStream.newStream(handler, errHandler)}
+}
+
+type futuresPingService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *futuresPingService) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type futuresServerTimeService struct {
+	f    *FakeService[int64]
+	args Args
+}
+
+func (s *futuresServerTimeService) Do(ctx context.Context, opts ...futures.RequestOption) (int64, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresExchangeInfoService struct {
+	f    *FakeService[*futures.ExchangeInfo]
+	args Args
+}
+
+func (s *futuresExchangeInfoService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.ExchangeInfo, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresDepthService struct {
+	f    *FakeService[*futures.DepthResponse]
+	args Args
+}
+
+func (s *futuresDepthService) Symbol(symbol string) futures.DepthServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresDepthService) Limit(limit int) futures.DepthServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresDepthService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.DepthResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresAggTradesService struct {
+	f    *FakeService[[]*futures.AggTrade]
+	args Args
+}
+
+func (s *futuresAggTradesService) Symbol(symbol string) futures.AggTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresAggTradesService) FromID(fromID int64) futures.AggTradesServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *futuresAggTradesService) StartTime(startTime int64) futures.AggTradesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresAggTradesService) EndTime(endTime int64) futures.AggTradesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresAggTradesService) Limit(limit int) futures.AggTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresAggTradesService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.AggTrade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresRecentTradesService struct {
+	f    *FakeService[[]*futures.Trade]
+	args Args
+}
+
+func (s *futuresRecentTradesService) Symbol(symbol string) futures.RecentTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresRecentTradesService) Limit(limit int) futures.RecentTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresRecentTradesService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Trade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresHistoricalTradesService struct {
+	f    *FakeService[[]*futures.Trade]
+	args Args
+}
+
+func (s *futuresHistoricalTradesService) Symbol(symbol string) futures.HistoricalTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresHistoricalTradesService) Limit(limit int) futures.HistoricalTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresHistoricalTradesService) FromID(fromID int64) futures.HistoricalTradesServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *futuresHistoricalTradesService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Trade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresKlinesService struct {
+	f    *FakeService[[]*futures.Kline]
+	args Args
+}
+
+func (s *futuresKlinesService) Symbol(symbol string) futures.KlinesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresKlinesService) Interval(interval string) futures.KlinesServiceAPI {
+	s.args["Interval"] = interval
+	return s
+}
+
+func (s *futuresKlinesService) Limit(limit int) futures.KlinesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresKlinesService) StartTime(startTime int64) futures.KlinesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresKlinesService) EndTime(endTime int64) futures.KlinesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresKlinesService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Kline, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresContinuousKlinesService struct {
+	f    *FakeService[[]*futures.ContinuousKline]
+	args Args
+}
+
+func (s *futuresContinuousKlinesService) Pair(pair string) futures.ContinuousKlinesServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *futuresContinuousKlinesService) ContractType(contractType string) futures.ContinuousKlinesServiceAPI {
+	s.args["ContractType"] = contractType
+	return s
+}
+
+func (s *futuresContinuousKlinesService) Interval(interval string) futures.ContinuousKlinesServiceAPI {
+	s.args["Interval"] = interval
+	return s
+}
+
+func (s *futuresContinuousKlinesService) Limit(limit int) futures.ContinuousKlinesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresContinuousKlinesService) StartTime(startTime int64) futures.ContinuousKlinesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresContinuousKlinesService) EndTime(endTime int64) futures.ContinuousKlinesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresContinuousKlinesService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.ContinuousKline, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresIndexPriceKlinesService struct {
+	f    *FakeService[[]*futures.Kline]
+	args Args
+}
+
+func (s *futuresIndexPriceKlinesService) Pair(pair string) futures.IndexPriceKlinesServiceAPI {
+	s.args["Pair"] = pair
+	return s
+}
+
+func (s *futuresIndexPriceKlinesService) Interval(interval string) futures.IndexPriceKlinesServiceAPI {
+	s.args["Interval"] = interval
+	return s
+}
+
+func (s *futuresIndexPriceKlinesService) Limit(limit int) futures.IndexPriceKlinesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresIndexPriceKlinesService) StartTime(startTime int64) futures.IndexPriceKlinesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresIndexPriceKlinesService) EndTime(endTime int64) futures.IndexPriceKlinesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresIndexPriceKlinesService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Kline, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresMarkPriceKlinesService struct {
+	f    *FakeService[[]*futures.Kline]
+	args Args
+}
+
+func (s *futuresMarkPriceKlinesService) Symbol(symbol string) futures.MarkPriceKlinesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresMarkPriceKlinesService) Interval(interval string) futures.MarkPriceKlinesServiceAPI {
+	s.args["Interval"] = interval
+	return s
+}
+
+func (s *futuresMarkPriceKlinesService) Limit(limit int) futures.MarkPriceKlinesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresMarkPriceKlinesService) StartTime(startTime int64) futures.MarkPriceKlinesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresMarkPriceKlinesService) EndTime(endTime int64) futures.MarkPriceKlinesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresMarkPriceKlinesService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Kline, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresListPriceChangeStatsService struct {
+	f    *FakeService[[]*futures.PriceChangeStats]
+	args Args
+}
+
+func (s *futuresListPriceChangeStatsService) Symbol(symbol string) futures.ListPriceChangeStatsServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresListPriceChangeStatsService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.PriceChangeStats, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresListPricesService struct {
+	f    *FakeService[[]*futures.SymbolPrice]
+	args Args
+}
+
+func (s *futuresListPricesService) Symbol(symbol string) futures.ListPricesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresListPricesService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.SymbolPrice, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresListBookTickersService struct {
+	f    *FakeService[[]*futures.BookTicker]
+	args Args
+}
+
+func (s *futuresListBookTickersService) Symbol(symbol string) futures.ListBookTickersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresListBookTickersService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.BookTicker, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresPremiumIndexService struct {
+	f    *FakeService[[]*futures.PremiumIndex]
+	args Args
+}
+
+func (s *futuresPremiumIndexService) Symbol(symbol string) futures.PremiumIndexServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresPremiumIndexService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.PremiumIndex, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresFundingRateService struct {
+	f    *FakeService[[]*futures.FundingRate]
+	args Args
+}
+
+func (s *futuresFundingRateService) Symbol(symbol string) futures.FundingRateServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresFundingRateService) StartTime(startTime int64) futures.FundingRateServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresFundingRateService) EndTime(endTime int64) futures.FundingRateServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresFundingRateService) Limit(limit int) futures.FundingRateServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresFundingRateService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.FundingRate, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresGetOpenInterestService struct {
+	f    *FakeService[*futures.OpenInterest]
+	args Args
+}
+
+func (s *futuresGetOpenInterestService) Symbol(symbol string) futures.GetOpenInterestServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresGetOpenInterestService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.OpenInterest, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresOpenInterestStatisticsService struct {
+	f    *FakeService[[]*futures.OpenInterestStatistic]
+	args Args
+}
+
+func (s *futuresOpenInterestStatisticsService) Symbol(symbol string) futures.OpenInterestStatisticsServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresOpenInterestStatisticsService) Period(period string) futures.OpenInterestStatisticsServiceAPI {
+	s.args["Period"] = period
+	return s
+}
+
+func (s *futuresOpenInterestStatisticsService) Limit(limit int) futures.OpenInterestStatisticsServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresOpenInterestStatisticsService) StartTime(startTime int64) futures.OpenInterestStatisticsServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresOpenInterestStatisticsService) EndTime(endTime int64) futures.OpenInterestStatisticsServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresOpenInterestStatisticsService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.OpenInterestStatistic, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresLongShortRatioService struct {
+	f    *FakeService[[]*futures.LongShortRatio]
+	args Args
+}
+
+func (s *futuresLongShortRatioService) Symbol(symbol string) futures.LongShortRatioServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresLongShortRatioService) Period(period string) futures.LongShortRatioServiceAPI {
+	s.args["Period"] = period
+	return s
+}
+
+func (s *futuresLongShortRatioService) Limit(limit int) futures.LongShortRatioServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresLongShortRatioService) StartTime(startTime int64) futures.LongShortRatioServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresLongShortRatioService) EndTime(endTime int64) futures.LongShortRatioServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresLongShortRatioService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.LongShortRatio, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresListLiquidationOrdersService struct {
+	f    *FakeService[[]*futures.LiquidationOrder]
+	args Args
+}
+
+func (s *futuresListLiquidationOrdersService) Symbol(symbol string) futures.ListLiquidationOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresListLiquidationOrdersService) StartTime(startTime int64) futures.ListLiquidationOrdersServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresListLiquidationOrdersService) EndTime(endTime int64) futures.ListLiquidationOrdersServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresListLiquidationOrdersService) Limit(limit int) futures.ListLiquidationOrdersServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresListLiquidationOrdersService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.LiquidationOrder, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresCreateOrderService struct {
+	f    *FakeService[*futures.CreateOrderResponse]
+	args Args
+}
+
+func (s *futuresCreateOrderService) Symbol(symbol string) futures.CreateOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresCreateOrderService) Side(side futures.SideType) futures.CreateOrderServiceAPI {
+	s.args["Side"] = side
+	return s
+}
+
+func (s *futuresCreateOrderService) PositionSide(positionSide futures.PositionSideType) futures.CreateOrderServiceAPI {
+	s.args["PositionSide"] = positionSide
+	return s
+}
+
+func (s *futuresCreateOrderService) Type(orderType futures.OrderType) futures.CreateOrderServiceAPI {
+	s.args["Type"] = orderType
+	return s
+}
+
+func (s *futuresCreateOrderService) TimeInForce(timeInForce futures.TimeInForceType) futures.CreateOrderServiceAPI {
+	s.args["TimeInForce"] = timeInForce
+	return s
+}
+
+func (s *futuresCreateOrderService) Quantity(quantity string) futures.CreateOrderServiceAPI {
+	s.args["Quantity"] = quantity
+	return s
+}
+
+func (s *futuresCreateOrderService) ReduceOnly(reduceOnly bool) futures.CreateOrderServiceAPI {
+	s.args["ReduceOnly"] = reduceOnly
+	return s
+}
+
+func (s *futuresCreateOrderService) Price(price string) futures.CreateOrderServiceAPI {
+	s.args["Price"] = price
+	return s
+}
+
+func (s *futuresCreateOrderService) NewClientOrderID(newClientOrderID string) futures.CreateOrderServiceAPI {
+	s.args["NewClientOrderID"] = newClientOrderID
+	return s
+}
+
+func (s *futuresCreateOrderService) StopPrice(stopPrice string) futures.CreateOrderServiceAPI {
+	s.args["StopPrice"] = stopPrice
+	return s
+}
+
+func (s *futuresCreateOrderService) WorkingType(workingType futures.WorkingType) futures.CreateOrderServiceAPI {
+	s.args["WorkingType"] = workingType
+	return s
+}
+
+func (s *futuresCreateOrderService) ActivationPrice(activationPrice string) futures.CreateOrderServiceAPI {
+	s.args["ActivationPrice"] = activationPrice
+	return s
+}
+
+func (s *futuresCreateOrderService) CallbackRate(callbackRate string) futures.CreateOrderServiceAPI {
+	s.args["CallbackRate"] = callbackRate
+	return s
+}
+
+func (s *futuresCreateOrderService) PriceProtect(priceProtect bool) futures.CreateOrderServiceAPI {
+	s.args["PriceProtect"] = priceProtect
+	return s
+}
+
+func (s *futuresCreateOrderService) NewOrderResponseType(newOrderResponseType futures.NewOrderRespType) futures.CreateOrderServiceAPI {
+	s.args["NewOrderResponseType"] = newOrderResponseType
+	return s
+}
+
+func (s *futuresCreateOrderService) ClosePosition(closePosition bool) futures.CreateOrderServiceAPI {
+	s.args["ClosePosition"] = closePosition
+	return s
+}
+
+func (s *futuresCreateOrderService) SelfTradePreventionMode(selfTradePreventionMode futures.SelfTradePreventionModeType) futures.CreateOrderServiceAPI {
+	s.args["SelfTradePreventionMode"] = selfTradePreventionMode
+	return s
+}
+
+func (s *futuresCreateOrderService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.CreateOrderResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresCreateBatchOrdersService struct {
+	f    *FakeService[*futures.CreateBatchOrdersResponse]
+	args Args
+}
+
+func (s *futuresCreateBatchOrdersService) OrderList(orders []*futures.CreateOrderService) futures.CreateBatchOrdersServiceAPI {
+	s.args["OrderList"] = orders
+	return s
+}
+
+func (s *futuresCreateBatchOrdersService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.CreateBatchOrdersResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresGetOrderService struct {
+	f    *FakeService[*futures.Order]
+	args Args
+}
+
+func (s *futuresGetOrderService) Symbol(symbol string) futures.GetOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresGetOrderService) OrderID(orderID int64) futures.GetOrderServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *futuresGetOrderService) OrigClientOrderID(origClientOrderID string) futures.GetOrderServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *futuresGetOrderService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresCancelOrderService struct {
+	f    *FakeService[*futures.CancelOrderResponse]
+	args Args
+}
+
+func (s *futuresCancelOrderService) Symbol(symbol string) futures.CancelOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresCancelOrderService) OrderID(orderID int64) futures.CancelOrderServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *futuresCancelOrderService) OrigClientOrderID(origClientOrderID string) futures.CancelOrderServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *futuresCancelOrderService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.CancelOrderResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresCancelAllOpenOrdersService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *futuresCancelAllOpenOrdersService) Symbol(symbol string) futures.CancelAllOpenOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresCancelAllOpenOrdersService) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type futuresCancelMultiplesOrdersService struct {
+	f    *FakeService[[]*futures.CancelOrderResponse]
+	args Args
+}
+
+func (s *futuresCancelMultiplesOrdersService) Symbol(symbol string) futures.CancelMultiplesOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresCancelMultiplesOrdersService) OrderIDList(orderIDList []int64) futures.CancelMultiplesOrdersServiceAPI {
+	s.args["OrderIDList"] = orderIDList
+	return s
+}
+
+func (s *futuresCancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDList []string) futures.CancelMultiplesOrdersServiceAPI {
+	s.args["OrigClientOrderIDList"] = origClientOrderIDList
+	return s
+}
+
+func (s *futuresCancelMultiplesOrdersService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.CancelOrderResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresGetOpenOrderService struct {
+	f    *FakeService[*futures.Order]
+	args Args
+}
+
+func (s *futuresGetOpenOrderService) Symbol(symbol string) futures.GetOpenOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresGetOpenOrderService) OrderID(orderID int64) futures.GetOpenOrderServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *futuresGetOpenOrderService) OrigClientOrderID(origClientOrderID string) futures.GetOpenOrderServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *futuresGetOpenOrderService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresListOpenOrdersService struct {
+	f    *FakeService[[]*futures.Order]
+	args Args
+}
+
+func (s *futuresListOpenOrdersService) Symbol(symbol string) futures.ListOpenOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresListOpenOrdersService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresListOrdersService struct {
+	f    *FakeService[[]*futures.Order]
+	args Args
+}
+
+func (s *futuresListOrdersService) Symbol(symbol string) futures.ListOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresListOrdersService) OrderID(orderID int64) futures.ListOrdersServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *futuresListOrdersService) StartTime(startTime int64) futures.ListOrdersServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresListOrdersService) EndTime(endTime int64) futures.ListOrdersServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresListOrdersService) Limit(limit int) futures.ListOrdersServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresListOrdersService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresListAccountTradeService struct {
+	f    *FakeService[[]*futures.AccountTrade]
+	args Args
+}
+
+func (s *futuresListAccountTradeService) Symbol(symbol string) futures.ListAccountTradeServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresListAccountTradeService) OrderID(orderID int64) futures.ListAccountTradeServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *futuresListAccountTradeService) StartTime(startTime int64) futures.ListAccountTradeServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresListAccountTradeService) EndTime(endTime int64) futures.ListAccountTradeServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresListAccountTradeService) FromID(fromID int64) futures.ListAccountTradeServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *futuresListAccountTradeService) Limit(limit int) futures.ListAccountTradeServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresListAccountTradeService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.AccountTrade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresListUserLiquidationOrdersService struct {
+	f    *FakeService[[]*futures.UserLiquidationOrder]
+	args Args
+}
+
+func (s *futuresListUserLiquidationOrdersService) Symbol(symbol string) futures.ListUserLiquidationOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresListUserLiquidationOrdersService) AutoCloseType(autoCloseType futures.ForceOrderCloseType) futures.ListUserLiquidationOrdersServiceAPI {
+	s.args["AutoCloseType"] = autoCloseType
+	return s
+}
+
+func (s *futuresListUserLiquidationOrdersService) StartTime(startTime int64) futures.ListUserLiquidationOrdersServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresListUserLiquidationOrdersService) EndTime(endTime int64) futures.ListUserLiquidationOrdersServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresListUserLiquidationOrdersService) Limit(limit int) futures.ListUserLiquidationOrdersServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresListUserLiquidationOrdersService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.UserLiquidationOrder, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresChangeLeverageService struct {
+	f    *FakeService[*futures.SymbolLeverage]
+	args Args
+}
+
+func (s *futuresChangeLeverageService) Symbol(symbol string) futures.ChangeLeverageServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresChangeLeverageService) Leverage(leverage int) futures.ChangeLeverageServiceAPI {
+	s.args["Leverage"] = leverage
+	return s
+}
+
+func (s *futuresChangeLeverageService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.SymbolLeverage, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresChangeMarginTypeService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *futuresChangeMarginTypeService) Symbol(symbol string) futures.ChangeMarginTypeServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresChangeMarginTypeService) MarginType(marginType futures.MarginType) futures.ChangeMarginTypeServiceAPI {
+	s.args["MarginType"] = marginType
+	return s
+}
+
+func (s *futuresChangeMarginTypeService) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type futuresUpdatePositionMarginService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *futuresUpdatePositionMarginService) Symbol(symbol string) futures.UpdatePositionMarginServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresUpdatePositionMarginService) PositionSide(positionSide futures.PositionSideType) futures.UpdatePositionMarginServiceAPI {
+	s.args["PositionSide"] = positionSide
+	return s
+}
+
+func (s *futuresUpdatePositionMarginService) Amount(amount string) futures.UpdatePositionMarginServiceAPI {
+	s.args["Amount"] = amount
+	return s
+}
+
+func (s *futuresUpdatePositionMarginService) Type(actionType int) futures.UpdatePositionMarginServiceAPI {
+	s.args["Type"] = actionType
+	return s
+}
+
+func (s *futuresUpdatePositionMarginService) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type futuresChangePositionModeService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *futuresChangePositionModeService) DualSide(dualSide bool) futures.ChangePositionModeServiceAPI {
+	s.args["DualSide"] = dualSide
+	return s
+}
+
+func (s *futuresChangePositionModeService) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type futuresGetPositionModeService struct {
+	f    *FakeService[*futures.PositionMode]
+	args Args
+}
+
+func (s *futuresGetPositionModeService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.PositionMode, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresChangeMultiAssetModeService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *futuresChangeMultiAssetModeService) MultiAssetsMargin(multiAssetsMargin bool) futures.ChangeMultiAssetModeServiceAPI {
+	s.args["MultiAssetsMargin"] = multiAssetsMargin
+	return s
+}
+
+func (s *futuresChangeMultiAssetModeService) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type futuresGetMultiAssetModeService struct {
+	f    *FakeService[*futures.MultiAssetMode]
+	args Args
+}
+
+func (s *futuresGetMultiAssetModeService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.MultiAssetMode, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresGetAccountService struct {
+	f    *FakeService[*futures.Account]
+	args Args
+}
+
+func (s *futuresGetAccountService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.Account, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresGetBalanceService struct {
+	f    *FakeService[[]*futures.Balance]
+	args Args
+}
+
+func (s *futuresGetBalanceService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.Balance, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresGetPositionRiskService struct {
+	f    *FakeService[[]*futures.PositionRisk]
+	args Args
+}
+
+func (s *futuresGetPositionRiskService) Symbol(symbol string) futures.GetPositionRiskServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresGetPositionRiskService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.PositionRisk, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresGetPositionMarginHistoryService struct {
+	f    *FakeService[[]*futures.PositionMarginHistory]
+	args Args
+}
+
+func (s *futuresGetPositionMarginHistoryService) Symbol(symbol string) futures.GetPositionMarginHistoryServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresGetPositionMarginHistoryService) Type(_type int) futures.GetPositionMarginHistoryServiceAPI {
+	s.args["Type"] = _type
+	return s
+}
+
+func (s *futuresGetPositionMarginHistoryService) StartTime(startTime int64) futures.GetPositionMarginHistoryServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresGetPositionMarginHistoryService) EndTime(endTime int64) futures.GetPositionMarginHistoryServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresGetPositionMarginHistoryService) Limit(limit int64) futures.GetPositionMarginHistoryServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresGetPositionMarginHistoryService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.PositionMarginHistory, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresGetIncomeHistoryService struct {
+	f    *FakeService[[]*futures.IncomeHistory]
+	args Args
+}
+
+func (s *futuresGetIncomeHistoryService) Symbol(symbol string) futures.GetIncomeHistoryServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresGetIncomeHistoryService) IncomeType(incomeType string) futures.GetIncomeHistoryServiceAPI {
+	s.args["IncomeType"] = incomeType
+	return s
+}
+
+func (s *futuresGetIncomeHistoryService) StartTime(startTime int64) futures.GetIncomeHistoryServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *futuresGetIncomeHistoryService) EndTime(endTime int64) futures.GetIncomeHistoryServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *futuresGetIncomeHistoryService) Limit(limit int64) futures.GetIncomeHistoryServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *futuresGetIncomeHistoryService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.IncomeHistory, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresGetLeverageBracketService struct {
+	f    *FakeService[[]*futures.LeverageBracket]
+	args Args
+}
+
+func (s *futuresGetLeverageBracketService) Symbol(symbol string) futures.GetLeverageBracketServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresGetLeverageBracketService) Do(ctx context.Context, opts ...futures.RequestOption) ([]*futures.LeverageBracket, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresCommissionRateService struct {
+	f    *FakeService[*futures.CommissionRate]
+	args Args
+}
+
+func (s *futuresCommissionRateService) Symbol(symbol string) futures.CommissionRateServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *futuresCommissionRateService) Do(ctx context.Context, opts ...futures.RequestOption) (*futures.CommissionRate, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresStartUserStreamService struct {
+	f    *FakeService[string]
+	args Args
+}
+
+func (s *futuresStartUserStreamService) Do(ctx context.Context, opts ...futures.RequestOption) (string, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type futuresKeepaliveUserStreamService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *futuresKeepaliveUserStreamService) ListenKey(listenKey string) futures.KeepaliveUserStreamServiceAPI {
+	s.args["ListenKey"] = listenKey
+	return s
+}
+
+func (s *futuresKeepaliveUserStreamService) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type futuresCloseUserStreamService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *futuresCloseUserStreamService) ListenKey(listenKey string) futures.CloseUserStreamServiceAPI {
+	s.args["ListenKey"] = listenKey
+	return s
+}
+
+func (s *futuresCloseUserStreamService) Do(ctx context.Context, opts ...futures.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type futuresUserDataStream struct {
+	s *fakeStream[*futures.WsUserDataEvent]
+}
+
+func (s *futuresUserDataStream) OnResync(f func()) futures.UserDataStreamAPI {
+	s.s.set("OnResync", f)
+	return s
+}
+
+func (s *futuresUserDataStream) KeepaliveInterval(d time.Duration) futures.UserDataStreamAPI {
+	s.s.set("KeepaliveInterval", d)
+	return s
+}
+
+func (s *futuresUserDataStream) Start(ctx context.Context) error {
+	return s.s.Start(ctx)
+}
+
+func (s *futuresUserDataStream) Stop() {
+	s.s.Stop()
+}
+
+func (s *futuresUserDataStream) Done() <-chan struct{} {
+	return s.s.Done()
+}
+
+func (s *futuresUserDataStream) ListenKey() string {
+	return s.s.ListenKey()
+}
@@ -0,0 +1,734 @@
+package binancetest
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Symbol define a symbol listed by a Market. Empty filters get defaults suiting most tests.
+type Symbol struct {
+	Symbol     string
+	BaseAsset  string
+	QuoteAsset string
+	// PRICE_FILTER, TickSize defaults to 0.01
+	MinPrice string
+	MaxPrice string
+	TickSize string
+	// LOT_SIZE, StepSize defaults to 0.00001 and MinQty to StepSize
+	MinQty   string
+	MaxQty   string
+	StepSize string
+	// MIN_NOTIONAL, not checked if empty
+	MinNotional string
+}
+
+func (s *Symbol) setDefaults() {
+	if s.TickSize == "" {
+		s.TickSize = "0.01"
+	}
+	if s.MinPrice == "" {
+		s.MinPrice = s.TickSize
+	}
+	if s.MaxPrice == "" {
+		s.MaxPrice = "1000000"
+	}
+	if s.StepSize == "" {
+		s.StepSize = "0.00001"
+	}
+	if s.MinQty == "" {
+		s.MinQty = s.StepSize
+	}
+	if s.MaxQty == "" {
+		s.MaxQty = "9000"
+	}
+}
+
+func (s *Symbol) filters() *common.SymbolFilters {
+	return &common.SymbolFilters{
+		MinPrice:                 s.MinPrice,
+		MaxPrice:                 s.MaxPrice,
+		TickSize:                 s.TickSize,
+		MinQuantity:              s.MinQty,
+		MaxQuantity:              s.MaxQty,
+		StepSize:                 s.StepSize,
+		NotionalFilter:           common.FilterTypeMinNotional,
+		MinNotional:              s.MinNotional,
+		ApplyMinNotionalToMarket: true,
+	}
+}
+
+// level is a price level of the depth
+type level struct {
+	price common.Decimal
+	qty   common.Decimal
+}
+
+// book is the depth of a symbol, which taker orders consume
+type book struct {
+	bids         []level // best first
+	asks         []level // best first
+	lastUpdateID int64
+	lastPrice    common.Decimal
+}
+
+// fill is an execution of an order
+type fill struct {
+	price common.Decimal
+	qty   common.Decimal
+}
+
+// opposite return the side of the book a taker order consumes
+func (b *book) opposite(buy bool) *[]level {
+	if buy {
+		return &b.asks
+	}
+	return &b.bids
+}
+
+// take match a taker order against the book without changing it. A zero limit matches any price,
+// and a positive quoteQty bounds the quote spent instead of qty.
+func (b *book) take(buy bool, limit, qty, quoteQty, step common.Decimal) []fill {
+	var fills []fill
+	for _, l := range *b.opposite(buy) {
+		if limit.Sign() > 0 && (buy && l.price.GreaterThan(limit) || !buy && l.price.LessThan(limit)) {
+			break
+		}
+		q := l.qty
+		if quoteQty.Sign() > 0 {
+			max := quoteQty.Div(l.price, step.Trim().Scale(), common.RoundDown).RoundToStep(step, common.RoundDown)
+			if q.GreaterThan(max) {
+				q = max
+			}
+		} else if q.GreaterThan(qty) {
+			q = qty
+		}
+		if q.Sign() <= 0 {
+			break
+		}
+		fills = append(fills, fill{price: l.price, qty: q})
+		if quoteQty.Sign() > 0 {
+			quoteQty = quoteQty.Sub(q.Mul(l.price))
+		} else {
+			qty = qty.Sub(q)
+			if qty.Sign() <= 0 {
+				break
+			}
+		}
+	}
+	return fills
+}
+
+// consume remove the liquidity taken by fills from the book
+func (b *book) consume(buy bool, fills []fill) {
+	levels := b.opposite(buy)
+	for _, f := range fills {
+		(*levels)[0].qty = (*levels)[0].qty.Sub(f.qty)
+		if (*levels)[0].qty.Sign() <= 0 {
+			*levels = (*levels)[1:]
+		}
+		b.lastPrice = f.price
+	}
+	if len(fills) > 0 {
+		b.lastUpdateID++
+	}
+}
+
+// order is an order of an account
+type order struct {
+	account       *account
+	id            int64
+	clientOrderID string
+	symbol        string
+	side          string
+	orderType     string
+	timeInForce   string
+	price         common.Decimal
+	stopPrice     common.Decimal
+	origQty       common.Decimal
+	origQuoteQty  common.Decimal
+	icebergQty    common.Decimal
+	executedQty   common.Decimal
+	cumQuote      common.Decimal
+	status        string
+	reduceOnly    bool
+	closePosition bool
+	positionSide  string
+	time          int64
+	updateTime    int64
+	// stop orders are working once triggered
+	triggered bool
+	// the other order of an OCO, whose stop order locks no balance
+	listID            int64
+	listClientOrderID string
+	leg               *order
+	noLock            bool
+}
+
+func (o *order) buy() bool {
+	return o.side == sideBuy
+}
+
+func (o *order) open() bool {
+	return o.status == statusNew || o.status == statusPartiallyFilled
+}
+
+func (o *order) remaining() common.Decimal {
+	return o.origQty.Sub(o.executedQty)
+}
+
+// stop return whether o waits for its stop price before working
+func (o *order) stop() bool {
+	return spotStopOrderTypes[o.orderType] || futuresStopOrderTypes[o.orderType]
+}
+
+// working return whether o is in the order book
+func (o *order) working() bool {
+	return o.open() && (!o.stop() || o.triggered)
+}
+
+// orderListID return the orderListId of o, -1 if it is not part of a list
+func (o *order) orderListID() int64 {
+	if o.listID == 0 {
+		return -1
+	}
+	return o.listID
+}
+
+// triggeredBy return whether a trade at price reaches the stop price of o. Stop loss orders trigger
+// when the price moves against the position they protect, take profit orders when it moves in favor.
+func (o *order) triggeredBy(price common.Decimal) bool {
+	if !o.stop() || o.triggered || o.stopPrice.Sign() == 0 {
+		return false
+	}
+	takeProfit := o.orderType == "TAKE_PROFIT" || o.orderType == "TAKE_PROFIT_LIMIT" || o.orderType == "TAKE_PROFIT_MARKET"
+	if o.buy() != takeProfit {
+		return !price.LessThan(o.stopPrice)
+	}
+	return !price.GreaterThan(o.stopPrice)
+}
+
+// detach unlink o from the other order of its OCO and return it
+func (o *order) detach() *order {
+	leg := o.leg
+	if leg != nil {
+		o.leg, leg.leg = nil, nil
+	}
+	return leg
+}
+
+// trade is an execution of an order of an account
+type trade struct {
+	account     *account
+	id          int64
+	orderID     int64
+	symbol      string
+	buyer       bool
+	maker       bool
+	price       common.Decimal
+	qty         common.Decimal
+	realizedPnl common.Decimal
+	// commission is charged in the received asset on spot and in the quote asset on futures
+	commission      common.Decimal
+	commissionAsset string
+	time            int64
+}
+
+// Order statuses, sides and types
+const (
+	statusNew             = "NEW"
+	statusPartiallyFilled = "PARTIALLY_FILLED"
+	statusFilled          = "FILLED"
+	statusCanceled        = "CANCELED"
+	statusExpired         = "EXPIRED"
+
+	sideBuy  = "BUY"
+	sideSell = "SELL"
+
+	orderTypeLimit      = "LIMIT"
+	orderTypeMarket     = "MARKET"
+	orderTypeLimitMaker = "LIMIT_MAKER"
+
+	timeInForceGTC = "GTC"
+	timeInForceIOC = "IOC"
+	timeInForceFOK = "FOK"
+	timeInForceGTX = "GTX"
+)
+
+// Market hold the symbols, depth, orders and trades of the spot or futures API.
+// Taker orders are matched against the depth set with SetDepth, consuming it, while
+// resting orders are only filled, and stop orders triggered, by Trade.
+type Market struct {
+	s           *Server
+	futures     bool
+	symbols     map[string]*Symbol
+	books       map[string]*book
+	orders      []*order
+	trades      []*trade
+	nextOrderID int64
+	nextTradeID int64
+	nextListID  int64
+	makerRate   common.Decimal
+	takerRate   common.Decimal
+}
+
+func newMarket(s *Server, futures bool) *Market {
+	return &Market{
+		s:       s,
+		futures: futures,
+		symbols: make(map[string]*Symbol),
+		books:   make(map[string]*book),
+	}
+}
+
+// AddSymbol list a symbol
+func (m *Market) AddSymbol(symbol Symbol) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	symbol.setDefaults()
+	m.symbols[symbol.Symbol] = &symbol
+	m.books[symbol.Symbol] = &book{lastUpdateID: 1}
+}
+
+// SetDepth replace the depth of a symbol with [price, quantity] levels, best first
+func (m *Market) SetDepth(symbol string, bids, asks [][2]string) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	b := m.book(symbol)
+	b.bids, b.asks = toLevels(bids), toLevels(asks)
+	b.lastUpdateID++
+}
+
+func toLevels(levels [][2]string) []level {
+	res := make([]level, len(levels))
+	for i, l := range levels {
+		res[i] = level{price: common.MustParseDecimal(l[0]), qty: common.MustParseDecimal(l[1])}
+	}
+	return res
+}
+
+func (m *Market) book(symbol string) *book {
+	b, ok := m.books[symbol]
+	if !ok {
+		panic(fmt.Sprintf("binancetest: unknown symbol %q", symbol))
+	}
+	return b
+}
+
+// SetBalance set the free spot balance, or the futures wallet balance, of an asset of an account
+func (m *Market) SetBalance(apiKey, asset, amount string) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	a := m.s.account(apiKey)
+	if m.futures {
+		a.wallets[asset] = common.MustParseDecimal(amount)
+		return
+	}
+	a.balance(asset).free = common.MustParseDecimal(amount)
+}
+
+// SetCommission set the commission rates of maker and taker trades, such as "0.001" for 0.1%.
+// Trades are free by default.
+func (m *Market) SetCommission(maker, taker string) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	m.makerRate, m.takerRate = common.MustParseDecimal(maker), common.MustParseDecimal(taker)
+}
+
+// Balance return the free and locked spot balance, or the futures wallet balance and zero,
+// of an asset of an account
+func (m *Market) Balance(apiKey, asset string) (free, locked string) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	a := m.s.account(apiKey)
+	if m.futures {
+		return a.wallets[asset].Trim().String(), "0"
+	}
+	b := a.balance(asset)
+	return b.free.Trim().String(), b.locked.Trim().String()
+}
+
+// Position return the futures position amount, negative for shorts, and entry price of a symbol
+func (m *Market) Position(apiKey, symbol string) (amount, entryPrice string) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	p := m.s.account(apiKey).position(symbol)
+	return p.amount.Trim().String(), p.entryPrice.Trim().String()
+}
+
+// Trade simulate a trade of the market at price. The stop orders reached by price are triggered,
+// then the resting orders crossing it are filled at their own price, best price first, up to quantity.
+func (m *Market) Trade(symbol, price, quantity string) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	p, qty := common.MustParseDecimal(price), common.MustParseDecimal(quantity)
+	b := m.book(symbol)
+	b.lastPrice = p
+	now := m.s.now().UnixNano() / 1e6
+	var touched []*account
+	for i := 0; i < len(m.orders); i++ {
+		if o := m.orders[i]; o.symbol == symbol && o.open() && o.triggeredBy(p) {
+			m.trigger(o, now)
+			touched = append(touched, o.account)
+		}
+	}
+	var crossing []*order
+	for _, o := range m.orders {
+		if o.symbol == symbol && o.working() && o.orderType != orderTypeMarket && o.price.Sign() > 0 &&
+			(o.buy() && !o.price.LessThan(p) || !o.buy() && !o.price.GreaterThan(p)) {
+			crossing = append(crossing, o)
+		}
+	}
+	sort.SliceStable(crossing, func(i, j int) bool {
+		if crossing[i].buy() {
+			return crossing[i].price.GreaterThan(crossing[j].price)
+		}
+		return crossing[i].price.LessThan(crossing[j].price)
+	})
+	for _, o := range crossing {
+		if qty.Sign() <= 0 {
+			break
+		}
+		if !o.working() {
+			// canceled as the other order of a filled OCO
+			continue
+		}
+		q := o.remaining()
+		if q.GreaterThan(qty) {
+			q = qty
+		}
+		qty = qty.Sub(q)
+		m.execute(o, []fill{{price: o.price, qty: q}}, true, now)
+		touched = append(touched, o.account)
+	}
+	reported := make(map[*account]bool)
+	for _, a := range touched {
+		if !reported[a] {
+			reported[a] = true
+			m.reportAccount(a, symbol, now)
+		}
+	}
+}
+
+// trigger make a stop order work once its stop price is reached. Market stop orders and the
+// crossing part of limit ones take the depth, the rest of limit ones rests unless IOC or FOK.
+func (m *Market) trigger(o *order, now int64) {
+	if leg := o.detach(); leg != nil && leg.open() {
+		m.cancel(leg, now)
+	}
+	m.lock(o, -1)
+	o.triggered, o.noLock = true, false
+	o.updateTime = now
+	sym, b := m.symbols[o.symbol], m.books[o.symbol]
+	if m.futures && (o.reduceOnly || o.closePosition) {
+		pos := o.account.position(o.symbol)
+		reduces := pos.amount.Sign() != 0 && (pos.amount.Sign() > 0) != o.buy()
+		switch {
+		case !reduces:
+			o.origQty = o.executedQty
+		case o.closePosition || o.remaining().GreaterThan(pos.amount.Abs()):
+			o.origQty = o.executedQty.Add(pos.amount.Abs())
+		}
+	}
+	fills := b.take(o.buy(), o.price, o.remaining(), common.Decimal{}, common.MustParseDecimal(sym.StepSize))
+	if !m.futures && o.buy() {
+		// a spot buy without locked balance takes what the free quote balance pays for
+		_, quote := sumFills(fills)
+		if o.account.balance(sym.QuoteAsset).free.LessThan(quote) {
+			fills = nil
+		}
+	}
+	if o.timeInForce == timeInForceFOK {
+		if filled, _ := sumFills(fills); filled.LessThan(o.remaining()) {
+			fills = nil
+		}
+	}
+	b.consume(o.buy(), fills)
+	m.execute(o, fills, false, now)
+	switch {
+	case o.status == statusFilled:
+	case o.price.Sign() > 0 && o.timeInForce != timeInForceIOC && o.timeInForce != timeInForceFOK && m.canLock(o):
+		m.lock(o, 1)
+	default:
+		o.status = statusExpired
+		m.reportOrder(o, executionTypeExpired, nil, now)
+	}
+}
+
+// execute apply fills to an order and its account, recording and reporting the trades
+func (m *Market) execute(o *order, fills []fill, maker bool, now int64) {
+	a := o.account
+	sym := m.symbols[o.symbol]
+	rate := m.takerRate
+	if maker {
+		rate = m.makerRate
+	}
+	if len(fills) > 0 {
+		// a fill of an OCO order cancels the other one
+		if leg := o.detach(); leg != nil && leg.open() {
+			m.cancel(leg, now)
+		}
+	}
+	for _, f := range fills {
+		quote := f.qty.Mul(f.price)
+		var pnl, commission common.Decimal
+		commissionAsset := sym.QuoteAsset
+		if m.futures {
+			commission = quote.Mul(rate).Round(8, common.RoundUp)
+			pnl = a.position(o.symbol).update(o.buy(), f.qty, f.price, now)
+			a.wallets[sym.QuoteAsset] = a.wallets[sym.QuoteAsset].Add(pnl).Sub(commission)
+		} else {
+			base, quoteBalance := a.balance(sym.BaseAsset), a.balance(sym.QuoteAsset)
+			if o.buy() {
+				commission, commissionAsset = f.qty.Mul(rate).Round(8, common.RoundUp), sym.BaseAsset
+			} else {
+				commission = quote.Mul(rate).Round(8, common.RoundUp)
+			}
+			switch {
+			case o.buy() && maker:
+				quoteBalance.locked = quoteBalance.locked.Sub(f.qty.Mul(o.price))
+				quoteBalance.free = quoteBalance.free.Add(f.qty.Mul(o.price).Sub(quote))
+				base.free = base.free.Add(f.qty).Sub(commission)
+			case o.buy():
+				quoteBalance.free = quoteBalance.free.Sub(quote)
+				base.free = base.free.Add(f.qty).Sub(commission)
+			case maker:
+				base.locked = base.locked.Sub(f.qty)
+				quoteBalance.free = quoteBalance.free.Add(quote).Sub(commission)
+			default:
+				base.free = base.free.Sub(f.qty)
+				quoteBalance.free = quoteBalance.free.Add(quote).Sub(commission)
+			}
+		}
+		m.nextTradeID++
+		t := &trade{
+			account:         a,
+			id:              m.nextTradeID,
+			orderID:         o.id,
+			symbol:          o.symbol,
+			buyer:           o.buy(),
+			maker:           maker,
+			price:           f.price,
+			qty:             f.qty,
+			realizedPnl:     pnl,
+			commission:      commission,
+			commissionAsset: commissionAsset,
+			time:            now,
+		}
+		m.trades = append(m.trades, t)
+		o.executedQty = o.executedQty.Add(f.qty)
+		o.cumQuote = o.cumQuote.Add(quote)
+		o.updateTime = now
+		if o.remaining().Sign() <= 0 {
+			o.status = statusFilled
+		} else {
+			o.status = statusPartiallyFilled
+		}
+		m.reportOrder(o, executionTypeTrade, t, now)
+	}
+}
+
+// lock reserve the spot balance of the remaining quantity of a resting order
+func (m *Market) lock(o *order, sign int) {
+	b, amount := m.locked(o)
+	if b == nil {
+		return
+	}
+	if sign < 0 {
+		amount = amount.Neg()
+	}
+	b.free = b.free.Sub(amount)
+	b.locked = b.locked.Add(amount)
+}
+
+// canLock return whether the free balance covers the remaining quantity of a resting order
+func (m *Market) canLock(o *order) bool {
+	b, amount := m.locked(o)
+	return b == nil || !b.free.LessThan(amount)
+}
+
+// locked return the spot balance locked by a resting order and the amount, nil if it locks none
+func (m *Market) locked(o *order) (*balance, common.Decimal) {
+	if m.futures || o.noLock || o.buy() && o.price.Sign() == 0 {
+		return nil, common.Decimal{}
+	}
+	sym := m.symbols[o.symbol]
+	if o.buy() {
+		return o.account.balance(sym.QuoteAsset), o.remaining().Mul(o.price)
+	}
+	return o.account.balance(sym.BaseAsset), o.remaining()
+}
+
+// cancel cancel an open order and the other order of its OCO, releasing their locked balance
+func (m *Market) cancel(o *order, now int64) {
+	m.lock(o, -1)
+	o.status = statusCanceled
+	o.updateTime = now
+	m.reportOrder(o, executionTypeCanceled, nil, now)
+	if leg := o.detach(); leg != nil && leg.open() {
+		m.cancel(leg, now)
+	}
+}
+
+// findOrder return an order of an account by orderId or origClientOrderId
+func (m *Market) findOrder(c *call, symbol string) (*order, *apiError) {
+	id, apiErr := intParam(c.params, "orderId", false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	clientOrderID := c.params.Get("origClientOrderId")
+	if id == 0 && clientOrderID == "" {
+		return nil, newError(ErrorCodeMandatoryParam, "Param 'origClientOrderId' or 'orderId' must be sent, but both were empty/null!")
+	}
+	for i := len(m.orders) - 1; i >= 0; i-- {
+		o := m.orders[i]
+		if o.account == c.account && o.symbol == symbol && (id != 0 && o.id == id || id == 0 && o.clientOrderID == clientOrderID) {
+			return o, nil
+		}
+	}
+	return nil, nil
+}
+
+// openOrders return the open orders of an account, of a symbol if not empty
+func (m *Market) openOrders(a *account, symbol string) []*order {
+	var res []*order
+	for _, o := range m.orders {
+		if o.account == a && o.open() && (symbol == "" || o.symbol == symbol) {
+			res = append(res, o)
+		}
+	}
+	return res
+}
+
+// symbol return the symbol parameter of a call
+func (m *Market) symbol(c *call, required bool) (*Symbol, *apiError) {
+	name, apiErr := stringParam(c.params, "symbol", required)
+	if apiErr != nil || name == "" {
+		return nil, apiErr
+	}
+	sym, ok := m.symbols[name]
+	if !ok {
+		return nil, newError(ErrorCodeBadSymbol, "Invalid symbol.")
+	}
+	return sym, nil
+}
+
+// newClientOrderID return the client order id given to an order sent without one
+func newClientOrderID(id int64) string {
+	return fmt.Sprintf("binancetest%011d", id)
+}
+
+// referencePrice return the best price a market order of the given side would match first
+func (b *book) referencePrice(buy bool) string {
+	levels := *b.opposite(buy)
+	if len(levels) == 0 {
+		return b.lastPrice.String()
+	}
+	return levels[0].price.String()
+}
+
+// sumFills return the quantity and quote of fills
+func sumFills(fills []fill) (qty, quote common.Decimal) {
+	for _, f := range fills {
+		qty = qty.Add(f.qty)
+		quote = quote.Add(f.qty.Mul(f.price))
+	}
+	return qty, quote
+}
+
+// userTrades return the trades of an account on a symbol, filtered like myTrades and userTrades
+func (m *Market) userTrades(c *call, symbol string, defaultLimit, maxLimit int64) ([]*trade, *apiError) {
+	orderID, apiErr := intParam(c.params, "orderId", false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	fromID, apiErr := intParam(c.params, "fromId", false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	startTime, apiErr := intParam(c.params, "startTime", false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	endTime, apiErr := intParam(c.params, "endTime", false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	limit, apiErr := intParam(c.params, "limit", false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	var res []*trade
+	for _, t := range m.trades {
+		if t.account != c.account || t.symbol != symbol ||
+			orderID != 0 && t.orderID != orderID ||
+			t.id < fromID ||
+			startTime != 0 && t.time < startTime ||
+			endTime != 0 && t.time > endTime {
+			continue
+		}
+		res = append(res, t)
+		if int64(len(res)) == limit {
+			break
+		}
+	}
+	return res, nil
+}
+
+func (a *account) balance(asset string) *balance {
+	b, ok := a.balances[asset]
+	if !ok {
+		b = &balance{}
+		a.balances[asset] = b
+	}
+	return b
+}
+
+func (a *account) position(symbol string) *position {
+	p, ok := a.positions[symbol]
+	if !ok {
+		p = &position{}
+		a.positions[symbol] = p
+	}
+	return p
+}
+
+// update apply a fill to the position and return the realized profit
+func (p *position) update(buy bool, qty, price common.Decimal, now int64) (pnl common.Decimal) {
+	signed := qty
+	if !buy {
+		signed = qty.Neg()
+	}
+	p.updateTime = now
+	amount := p.amount
+	if amount.Sign() == 0 || amount.Sign() == signed.Sign() {
+		// increase the position at the average entry price
+		total := amount.Add(signed).Abs()
+		p.entryPrice = p.entryPrice.Mul(amount.Abs()).Add(price.Mul(qty)).Div(total, 8, common.RoundHalfUp).Trim()
+		p.amount = amount.Add(signed)
+		return pnl
+	}
+	closed := qty
+	if closed.GreaterThan(amount.Abs()) {
+		closed = amount.Abs()
+	}
+	pnl = closed.Mul(price.Sub(p.entryPrice))
+	if amount.Sign() < 0 {
+		pnl = pnl.Neg()
+	}
+	p.amount = amount.Add(signed)
+	switch {
+	case p.amount.Sign() == 0:
+		p.entryPrice = common.Decimal{}
+	case p.amount.Sign() != amount.Sign():
+		p.entryPrice = price
+	}
+	return pnl
+}
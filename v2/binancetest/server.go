@@ -0,0 +1,413 @@
+// Package binancetest provides an in-process fake of the core spot and futures REST APIs
+// for offline integration tests. Point Client.BaseURL at Server.URL and it will check
+// API keys, signatures and timestamps, match orders against the depth set by the test
+// and keep balances, orders and trades like the exchange does. The events of user data
+// streams are served at Server.WsURL.
+package binancetest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Error codes returned by the server
+const (
+	ErrorCodeUnknown                int64 = -1000
+	ErrorCodeFilterFailure          int64 = -1013
+	ErrorCodeInvalidTimestamp       int64 = -1021
+	ErrorCodeInvalidSignature       int64 = -1022
+	ErrorCodeIllegalChars           int64 = -1100
+	ErrorCodeMandatoryParam         int64 = -1102
+	ErrorCodeBadPrecision           int64 = -1111
+	ErrorCodeInvalidOrderType       int64 = -1116
+	ErrorCodeInvalidSide            int64 = -1117
+	ErrorCodeBadSymbol              int64 = -1121
+	ErrorCodeInvalidListenKey       int64 = -1125
+	ErrorCodeBadRecvWindow          int64 = -1131
+	ErrorCodeNewOrderRejected       int64 = -2010
+	ErrorCodeCancelRejected         int64 = -2011
+	ErrorCodeNoSuchOrder            int64 = -2013
+	ErrorCodeBadAPIKeyFormat        int64 = -2014
+	ErrorCodeRejectedAPIKey         int64 = -2015
+	ErrorCodeMarginInsufficient     int64 = -2019
+	ErrorCodeImmediateTrigger       int64 = -2021
+	ErrorCodeReduceOnlyReject       int64 = -2022
+	ErrorCodeInvalidTickSize        int64 = -4014
+	ErrorCodePositionSideMismatch   int64 = -4061
+	ErrorCodeDuplicateClientOrderID int64 = -4116
+	ErrorCodeMinNotional            int64 = -4164
+)
+
+const (
+	defaultRecvWindow       = 5000
+	maxRecvWindow           = 60000
+	timestampAheadTolerance = 1000
+	usedWeightHeader        = "X-MBX-USED-WEIGHT-1M"
+	apiKeyHeader            = "X-MBX-APIKEY"
+	signatureParam          = "signature"
+
+	mandatoryParamMessage      = "Mandatory parameter '%s' was not sent, was empty/null, or malformed."
+	illegalCharsMessage        = "Illegal characters found in parameter '%s'; legal range is '^([0-9]{1,20})(\\.[0-9]{1,20})?$'."
+	noSuchOrderMessage         = "Order does not exist."
+	unknownOrderMessage        = "Unknown order sent."
+	insufficientBalanceMessage = "Account has insufficient balance for requested action."
+	invalidListenKeyMessage    = "This listenKey does not exist."
+)
+
+// secType define the security type of an endpoint
+type secType int
+
+const (
+	secTypeNone secType = iota
+	secTypeAPIKey
+	secTypeSigned
+)
+
+// apiError is written as the JSON error body of Binance
+type apiError struct {
+	status int
+	code   int64
+	msg    string
+}
+
+func newError(code int64, format string, args ...interface{}) *apiError {
+	status := http.StatusBadRequest
+	if code == ErrorCodeBadAPIKeyFormat || code == ErrorCodeRejectedAPIKey {
+		status = http.StatusUnauthorized
+	}
+	return &apiError{status: status, code: code, msg: fmt.Sprintf(format, args...)}
+}
+
+// call hold a request being handled
+type call struct {
+	account *account
+	params  url.Values
+	now     int64
+}
+
+// handler serve an endpoint, returning the value written as JSON
+type handler func(c *call) (interface{}, *apiError)
+
+type route struct {
+	sec     secType
+	handler handler
+}
+
+// account hold the keys and state of an API key
+type account struct {
+	apiKey    string
+	secretKey string
+	// spot balances
+	balances map[string]*balance
+	// futures wallet balances and one-way mode positions
+	wallets   map[string]common.Decimal
+	positions map[string]*position
+	// listen keys of user data streams and their market
+	listenKeys map[string]*Market
+}
+
+type balance struct {
+	free   common.Decimal
+	locked common.Decimal
+}
+
+type position struct {
+	amount     common.Decimal // negative for shorts
+	entryPrice common.Decimal
+	updateTime int64
+}
+
+// Server is a fake Binance REST API. Its methods may be called while requests are served.
+type Server struct {
+	// URL is the base URL to set as Client.BaseURL
+	URL string
+	// WsURL is the websocket endpoint serving user data streams at WsURL + "/" + listenKey
+	WsURL string
+	// Spot hold the symbols, depth and orders of the spot API under /api
+	Spot *Market
+	// Futures hold the symbols, depth and orders of the USDⓈ-M futures API under /fapi
+	Futures *Market
+
+	srv            *httptest.Server
+	mu             sync.Mutex
+	now            func() time.Time
+	skipTimestamps bool
+	fallback       http.Handler
+	accounts       map[string]*account
+	routes         map[string]route
+	streams        map[string]map[*stream]bool
+	closed         bool
+	weight         int64
+	weightMinute   int64
+	nextKey        int64
+}
+
+// NewServer start a server, call Close when done
+func NewServer() *Server {
+	s := &Server{
+		now:      time.Now,
+		accounts: make(map[string]*account),
+		routes:   make(map[string]route),
+		streams:  make(map[string]map[*stream]bool),
+	}
+	s.Spot = newMarket(s, false)
+	s.Futures = newMarket(s, true)
+	s.registerSpot()
+	s.registerFutures()
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	s.WsURL = "ws" + strings.TrimPrefix(s.srv.URL, "http") + "/ws"
+	return s
+}
+
+// Close shut the server down
+func (s *Server) Close() {
+	s.mu.Lock()
+	s.closed = true
+	for key, streams := range s.streams {
+		for st := range streams {
+			s.unsubscribe(key, st)
+		}
+	}
+	s.mu.Unlock()
+	s.srv.Close()
+}
+
+// SetClock set the function returning the server time, such as to test timestamp errors
+func (s *Server) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// SkipTimestampCheck accept signed requests whatever their timestamp, such as when the clock
+// replays recorded market data
+func (s *Server) SkipTimestampCheck(skip bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.skipTimestamps = skip
+}
+
+// SetFallback set the handler of requests to unknown endpoints and websocket streams, such as
+// a proxy to the exchange. They get 404 Not Found by default.
+func (s *Server) SetFallback(h http.Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fallback = h
+}
+
+// AddAccount register an API key and the secret key signing its requests with HMAC SHA256
+func (s *Server) AddAccount(apiKey, secretKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts[apiKey] = &account{
+		apiKey:     apiKey,
+		secretKey:  secretKey,
+		balances:   make(map[string]*balance),
+		wallets:    make(map[string]common.Decimal),
+		positions:  make(map[string]*position),
+		listenKeys: make(map[string]*Market),
+	}
+}
+
+func (s *Server) account(apiKey string) *account {
+	a, ok := s.accounts[apiKey]
+	if !ok {
+		panic(fmt.Sprintf("binancetest: unknown account %q", apiKey))
+	}
+	return a
+}
+
+func (s *Server) handle(method, endpoint string, sec secType, h handler) {
+	s.routes[method+" "+endpoint] = route{sec: sec, handler: h}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	if key, ok := s.streamKey(r.URL.Path); ok {
+		s.serveStream(w, r, key)
+		return
+	}
+	rt, ok := s.routes[r.Method+" "+r.URL.Path]
+	if !ok {
+		fallback := s.fallback
+		s.mu.Unlock()
+		if fallback == nil {
+			http.NotFound(w, r)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		fallback.ServeHTTP(w, r)
+		return
+	}
+	defer s.mu.Unlock()
+
+	now := s.now()
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(usedWeightHeader, strconv.FormatInt(s.useWeight(now), 10))
+
+	c := &call{now: now.UnixNano() / int64(time.Millisecond)}
+	var res interface{}
+	apiErr := s.authenticate(c, rt.sec, r, string(body))
+	if apiErr == nil {
+		res, apiErr = rt.handler(c)
+	}
+	if apiErr != nil {
+		w.WriteHeader(apiErr.status)
+		res = map[string]interface{}{"code": apiErr.code, "msg": apiErr.msg}
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+// useWeight count a request against the weight of the current minute
+func (s *Server) useWeight(now time.Time) int64 {
+	minute := now.Unix() / 60
+	if minute != s.weightMinute {
+		s.weightMinute, s.weight = minute, 0
+	}
+	s.weight++
+	return s.weight
+}
+
+// authenticate parse the parameters of r and check its API key, signature and timestamp
+func (s *Server) authenticate(c *call, sec secType, r *http.Request, body string) *apiError {
+	params, err := url.ParseQuery(r.URL.RawQuery)
+	if err != nil {
+		return newError(ErrorCodeUnknown, "Invalid query string.")
+	}
+	form, err := url.ParseQuery(body)
+	if err != nil {
+		return newError(ErrorCodeUnknown, "Invalid request body.")
+	}
+	for k, v := range form {
+		params[k] = append(params[k], v...)
+	}
+	c.params = params
+	if sec == secTypeNone {
+		return nil
+	}
+
+	apiKey := r.Header.Get(apiKeyHeader)
+	if apiKey == "" {
+		return newError(ErrorCodeBadAPIKeyFormat, "API-key format invalid.")
+	}
+	a, ok := s.accounts[apiKey]
+	if !ok {
+		return newError(ErrorCodeRejectedAPIKey, "Invalid API-key, IP, or permissions for action.")
+	}
+	c.account = a
+	if sec == secTypeAPIKey {
+		return nil
+	}
+
+	signature := params.Get(signatureParam)
+	if signature == "" {
+		return newError(ErrorCodeMandatoryParam, mandatoryParamMessage, signatureParam)
+	}
+	// the signature is the HMAC of the query string followed by the body, without the signature
+	payload := withoutSignature(r.URL.RawQuery) + withoutSignature(body)
+	mac := hmac.New(sha256.New, []byte(a.secretKey))
+	mac.Write([]byte(payload))
+	if !hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(strings.ToLower(signature))) {
+		return newError(ErrorCodeInvalidSignature, "Signature for this request is not valid.")
+	}
+
+	timestamp, apiErr := intParam(params, "timestamp", true)
+	if apiErr != nil || s.skipTimestamps {
+		return apiErr
+	}
+	recvWindow, apiErr := intParam(params, "recvWindow", false)
+	if apiErr != nil {
+		return apiErr
+	}
+	if recvWindow == 0 {
+		recvWindow = defaultRecvWindow
+	}
+	if recvWindow > maxRecvWindow {
+		return newError(ErrorCodeBadRecvWindow, "recvWindow must be less than %d.", maxRecvWindow)
+	}
+	if timestamp >= c.now+timestampAheadTolerance {
+		return newError(ErrorCodeInvalidTimestamp, "Timestamp for this request was 1000ms ahead of the server's time.")
+	}
+	if c.now-timestamp > recvWindow {
+		return newError(ErrorCodeInvalidTimestamp, "Timestamp for this request is outside of the recvWindow.")
+	}
+	return nil
+}
+
+// withoutSignature remove the signature parameter from an encoded query string
+func withoutSignature(query string) string {
+	if query == "" {
+		return ""
+	}
+	parts := strings.Split(query, "&")
+	kept := parts[:0]
+	for _, p := range parts {
+		if !strings.HasPrefix(p, signatureParam+"=") {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, "&")
+}
+
+func stringParam(params url.Values, name string, required bool) (string, *apiError) {
+	v := params.Get(name)
+	if v == "" && required {
+		return "", newError(ErrorCodeMandatoryParam, mandatoryParamMessage, name)
+	}
+	return v, nil
+}
+
+func intParam(params url.Values, name string, required bool) (int64, *apiError) {
+	v, apiErr := stringParam(params, name, required)
+	if apiErr != nil || v == "" {
+		return 0, apiErr
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, newError(ErrorCodeIllegalChars, illegalCharsMessage, name)
+	}
+	return i, nil
+}
+
+func decimalParam(params url.Values, name string, required bool) (common.Decimal, *apiError) {
+	v, apiErr := stringParam(params, name, required)
+	if apiErr != nil || v == "" {
+		return common.Decimal{}, apiErr
+	}
+	d, err := common.ParseDecimal(v)
+	if err != nil || d.Sign() < 0 {
+		return common.Decimal{}, newError(ErrorCodeIllegalChars, illegalCharsMessage, name)
+	}
+	return d, nil
+}
+
+// newListenKey return a random looking listen key of the user data stream of an account on m
+func (s *Server) newListenKey(a *account, m *Market) string {
+	s.nextKey++
+	mac := hmac.New(sha256.New, []byte(a.apiKey))
+	fmt.Fprintf(mac, "%d", s.nextKey)
+	key := hex.EncodeToString(mac.Sum(nil))
+	a.listenKeys[key] = m
+	return key
+}
@@ -0,0 +1,435 @@
+package binancetest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/binancetest"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+const (
+	apiKey    = "testKey"
+	secretKey = "testSecret"
+)
+
+type serverTestSuite struct {
+	suite.Suite
+	srv *binancetest.Server
+	ctx context.Context
+}
+
+func TestServer(t *testing.T) {
+	suite.Run(t, new(serverTestSuite))
+}
+
+func (s *serverTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.srv = binancetest.NewServer()
+	s.srv.AddAccount(apiKey, secretKey)
+	s.srv.Spot.AddSymbol(binancetest.Symbol{
+		Symbol:      "BTCUSDT",
+		BaseAsset:   "BTC",
+		QuoteAsset:  "USDT",
+		TickSize:    "0.01",
+		StepSize:    "0.001",
+		MinNotional: "10",
+	})
+	s.srv.Spot.SetDepth("BTCUSDT",
+		[][2]string{{"29990.00", "1"}, {"29980.00", "2"}},
+		[][2]string{{"30000.00", "0.5"}, {"30010.00", "2"}})
+	s.srv.Spot.SetBalance(apiKey, "USDT", "100000")
+	s.srv.Futures.AddSymbol(binancetest.Symbol{
+		Symbol:      "BTCUSDT",
+		BaseAsset:   "BTC",
+		QuoteAsset:  "USDT",
+		TickSize:    "0.10",
+		StepSize:    "0.001",
+		MinNotional: "5",
+	})
+	s.srv.Futures.SetDepth("BTCUSDT",
+		[][2]string{{"29990.0", "10"}},
+		[][2]string{{"30000.0", "10"}})
+	s.srv.Futures.SetBalance(apiKey, "USDT", "1000")
+}
+
+func (s *serverTestSuite) TearDownTest() {
+	s.srv.Close()
+}
+
+func (s *serverTestSuite) spotClient(secret string) *binance.Client {
+	return binance.NewClient(apiKey, secret, binance.WithBaseURL(s.srv.URL))
+}
+
+func (s *serverTestSuite) futuresClient() *futures.Client {
+	return futures.NewClient(apiKey, secretKey, futures.WithBaseURL(s.srv.URL))
+}
+
+func (s *serverTestSuite) assertAPIError(err error, code int64) {
+	s.Require().Error(err)
+	s.True(common.IsAPIErrorCode(err, code), "expected code %d, got %v", code, err)
+}
+
+func (s *serverTestSuite) TestSpotMarketData() {
+	c := s.spotClient(secretKey)
+	info, err := c.NewExchangeInfoService().Do(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(info.Symbols, 1)
+	s.Equal("0.01", info.Symbols[0].PriceFilter().TickSize)
+	s.Equal("0.001", info.Symbols[0].LotSizeFilter().StepSize)
+	s.Equal("10", info.Symbols[0].MinNotionalFilter().MinNotional)
+
+	depth, err := c.NewDepthService().Symbol("BTCUSDT").Limit(1).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Len(depth.Bids, 1)
+	s.Equal("30000.00", depth.Asks[0].Price)
+
+	_, err = c.NewDepthService().Symbol("ETHUSDT").Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeBadSymbol)
+}
+
+func (s *serverTestSuite) TestSpotMarketOrder() {
+	c := s.spotClient(secretKey)
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("1").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeFilled, res.Status)
+	s.Equal("1.00000000", res.ExecutedQuantity)
+	s.Equal("30005.00000000", res.CummulativeQuoteQuantity) // 0.5 * 30000 + 0.5 * 30010
+	s.Require().Len(res.Fills, 2)
+	s.Equal("30010.00000000", res.Fills[1].Price)
+
+	free, locked := s.srv.Spot.Balance(apiKey, "BTC")
+	s.Equal("1", free)
+	s.Equal("0", locked)
+	free, _ = s.srv.Spot.Balance(apiKey, "USDT")
+	s.Equal("69995", free)
+
+	// the depth was consumed
+	depth, err := c.NewDepthService().Symbol("BTCUSDT").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal("30010.00", depth.Asks[0].Price)
+	s.Equal("1.5", depth.Asks[0].Quantity)
+
+	trades, err := c.NewListTradesService().Symbol("BTCUSDT").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Len(trades, 2)
+	s.True(trades[0].IsBuyer)
+	s.Equal(res.OrderID, trades[0].OrderID)
+
+	account, err := c.NewGetAccountService().Do(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(account.Balances, 2)
+	s.Equal("BTC", account.Balances[0].Asset)
+	s.Equal("1.00000000", account.Balances[0].Free)
+}
+
+func (s *serverTestSuite) TestSpotLimitOrder() {
+	c := s.spotClient(secretKey)
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("2").Price("29000").NewClientOrderID("my-order").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeNew, res.Status)
+	_, locked := s.srv.Spot.Balance(apiKey, "USDT")
+	s.Equal("58000", locked)
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("2").Price("29000").NewClientOrderID("my-order").Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeNewOrderRejected)
+
+	open, err := c.NewListOpenOrdersService().Symbol("BTCUSDT").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Len(open, 1)
+
+	s.srv.Spot.Trade("BTCUSDT", "28900", "0.5")
+	order, err := c.NewGetOrderService().Symbol("BTCUSDT").OrigClientOrderID("my-order").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypePartiallyFilled, order.Status)
+	s.Equal("0.50000000", order.ExecutedQuantity)
+
+	cancel, err := c.NewCancelOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeCanceled, cancel.Status)
+	s.Equal("my-order", cancel.OrigClientOrderID)
+	free, locked := s.srv.Spot.Balance(apiKey, "USDT")
+	s.Equal("85500", free)
+	s.Equal("0", locked)
+
+	_, err = c.NewCancelOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeCancelRejected)
+	_, err = c.NewGetOrderService().Symbol("BTCUSDT").OrderID(42).Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeNoSuchOrder)
+}
+
+func (s *serverTestSuite) TestSpotOrderRejected() {
+	c := s.spotClient(secretKey)
+	_, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeMarket).Quantity("1").Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeNewOrderRejected)
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("1").Price("29000.001").Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeFilterFailure)
+	s.Contains(err.Error(), "PRICE_FILTER")
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).Quantity("1").Price("29000").Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeMandatoryParam)
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimitMaker).Quantity("1").Price("30000").Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeNewOrderRejected)
+}
+
+func (s *serverTestSuite) TestAuthentication() {
+	_, err := s.spotClient("wrongSecret").NewGetAccountService().Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeInvalidSignature)
+
+	_, err = binance.NewClient("unknownKey", secretKey, binance.WithBaseURL(s.srv.URL)).NewGetAccountService().Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeRejectedAPIKey)
+
+	s.srv.SetClock(func() time.Time { return time.Now().Add(-time.Minute) })
+	_, err = s.spotClient(secretKey).NewGetAccountService().Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeInvalidTimestamp)
+
+	s.srv.SetClock(func() time.Time { return time.Now().Add(time.Minute) })
+	_, err = s.spotClient(secretKey).NewGetAccountService().Do(s.ctx, binance.WithRecvWindow(10000))
+	s.assertAPIError(err, binancetest.ErrorCodeInvalidTimestamp)
+
+	// syncing the server time fixes the timestamp
+	c := s.spotClient(secretKey)
+	_, err = c.NewSetServerTimeService().Do(s.ctx)
+	s.Require().NoError(err)
+	_, err = c.NewGetAccountService().Do(s.ctx)
+	s.NoError(err)
+}
+
+func (s *serverTestSuite) TestListenKey() {
+	c := s.spotClient(secretKey)
+	key, err := c.NewStartUserStreamService().Do(s.ctx)
+	s.Require().NoError(err)
+	s.NotEmpty(key)
+	s.NoError(c.NewKeepaliveUserStreamService().ListenKey(key).Do(s.ctx))
+	s.NoError(c.NewCloseUserStreamService().ListenKey(key).Do(s.ctx))
+	err = c.NewKeepaliveUserStreamService().ListenKey(key).Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeInvalidListenKey)
+
+	f := s.futuresClient()
+	fkey, err := f.NewStartUserStreamService().Do(s.ctx)
+	s.Require().NoError(err)
+	again, err := f.NewStartUserStreamService().Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(fkey, again)
+}
+
+func (s *serverTestSuite) TestFuturesPosition() {
+	c := s.futuresClient()
+	info, err := c.NewExchangeInfoService().Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal("5", info.Symbols[0].MinNotionalFilter().Notional)
+
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("0.1").NewOrderResponseType(futures.NewOrderRespTypeRESULT).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeFilled, res.Status)
+	s.Equal("0.100", res.ExecutedQuantity)
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("1").ReduceOnly(true).Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeReduceOnlyReject)
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("5").Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeMarginInsufficient)
+
+	risks, err := c.NewGetPositionRiskService().Symbol("BTCUSDT").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(risks, 1)
+	s.Equal("0.1", risks[0].PositionAmt)
+	s.Equal("30000", risks[0].EntryPrice)
+
+	s.srv.Futures.SetDepth("BTCUSDT", [][2]string{{"31000.0", "10"}}, [][2]string{{"31010.0", "10"}})
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeMarket).Quantity("1").ReduceOnly(true).Do(s.ctx)
+	s.Require().NoError(err)
+
+	amount, _ := s.srv.Futures.Position(apiKey, "BTCUSDT")
+	s.Equal("0", amount)
+	balances, err := c.NewGetBalanceService().Do(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(balances, 1)
+	s.Equal("1100.00000000", balances[0].Balance)
+
+	trades, err := c.NewListAccountTradeService().Symbol("BTCUSDT").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(trades, 2)
+	s.Equal("100.00000000", trades[1].RealizedPnl)
+}
+
+func (s *serverTestSuite) TestFuturesLimitOrder() {
+	c := s.futuresClient()
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTC).
+		Quantity("0.1").Price("30500").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeNew, res.Status)
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTC).
+		Quantity("0.1").Price("30500.05").Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeInvalidTickSize)
+
+	s.srv.Futures.Trade("BTCUSDT", "30600", "1")
+	order, err := c.NewGetOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeFilled, order.Status)
+	s.Equal("30500.000000", order.AvgPrice)
+	amount, entry := s.srv.Futures.Position(apiKey, "BTCUSDT")
+	s.Equal("-0.1", amount)
+	s.Equal("30500", entry)
+}
+
+func (s *serverTestSuite) TestSpotCommissionAndOCO() {
+	s.srv.Spot.SetCommission("0.001", "0.001")
+	c := s.spotClient(secretKey)
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("0.5").Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal("0.00050000", res.Fills[0].Commission)
+	s.Equal("BTC", res.Fills[0].CommissionAsset)
+	free, _ := s.srv.Spot.Balance(apiKey, "BTC")
+	s.Equal("0.4995", free)
+
+	oco, err := c.NewCreateOCOService().Symbol("BTCUSDT").Side(binance.SideTypeSell).Quantity("0.4").
+		Price("31000").StopPrice("29000").StopLimitPrice("28900").
+		StopLimitTimeInForce(binance.TimeInForceTypeGTC).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal("EXEC_STARTED", oco.ListStatusType)
+	s.Require().Len(oco.OrderReports, 2)
+	_, locked := s.srv.Spot.Balance(apiKey, "BTC")
+	s.Equal("0.4", locked)
+
+	// the stop order triggers and sells to the bids, canceling the limit maker order
+	s.srv.Spot.Trade("BTCUSDT", "29000", "1")
+	stop, err := c.NewGetOrderService().Symbol("BTCUSDT").OrderID(oco.Orders[0].OrderID).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeFilled, stop.Status)
+	s.Equal("11996.00000000", stop.CummulativeQuoteQuantity)
+	limit, err := c.NewGetOrderService().Symbol("BTCUSDT").OrderID(oco.Orders[1].OrderID).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeCanceled, limit.Status)
+	free, locked = s.srv.Spot.Balance(apiKey, "BTC")
+	s.Equal("0.0995", free)
+	s.Equal("0", locked)
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeStopLossLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("0.01").Price("29900").StopPrice("30000").Do(s.ctx)
+	s.assertAPIError(err, binancetest.ErrorCodeNewOrderRejected)
+
+	oco, err = c.NewCreateOCOService().Symbol("BTCUSDT").Side(binance.SideTypeSell).Quantity("0.05").
+		Price("31000").StopPrice("28000").Do(s.ctx)
+	s.Require().NoError(err)
+	cancel, err := c.NewCancelOCOService().Symbol("BTCUSDT").OrderListID(oco.OrderListID).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal("ALL_DONE", cancel.ListOrderStatus)
+	_, locked = s.srv.Spot.Balance(apiKey, "BTC")
+	s.Equal("0", locked)
+}
+
+func (s *serverTestSuite) TestSpotUserDataStream() {
+	c := binance.NewClient(apiKey, secretKey, binance.WithBaseURL(s.srv.URL), binance.WithWsURL(s.srv.WsURL))
+	key, err := c.NewStartUserStreamService().Do(s.ctx)
+	s.Require().NoError(err)
+	events := make(chan *binance.WsUserDataEvent, 10)
+	doneC, stopC, err := c.WsClient().WsUserDataServe(key, func(event *binance.WsUserDataEvent) {
+		events <- event
+	}, func(err error) {})
+	s.Require().NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+
+	_, err = c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("0.1").Price("29000").Do(s.ctx)
+	s.Require().NoError(err)
+	event := <-events
+	s.Equal(binance.UserDataEventTypeExecutionReport, event.Event)
+	s.Equal("NEW", event.OrderUpdate.ExecutionType)
+	<-events // outboundAccountPosition
+
+	s.srv.Spot.Trade("BTCUSDT", "29000", "1")
+	event = <-events
+	s.Equal(binance.UserDataEventTypeExecutionReport, event.Event)
+	s.Equal("TRADE", event.OrderUpdate.ExecutionType)
+	s.Equal("FILLED", event.OrderUpdate.Status)
+	s.Equal("0.10000000", event.OrderUpdate.LatestVolume)
+	s.True(event.OrderUpdate.IsMaker)
+	event = <-events
+	s.Equal(binance.UserDataEventTypeOutboundAccountPosition, event.Event)
+	s.Require().Len(event.AccountUpdate.WsAccountUpdates, 2)
+	s.Equal("BTC", event.AccountUpdate.WsAccountUpdates[0].Asset)
+}
+
+func (s *serverTestSuite) TestFuturesBatchAndStopOrders() {
+	s.srv.Futures.SetCommission("0.0002", "0.0004")
+	c := futures.NewClient(apiKey, secretKey, futures.WithBaseURL(s.srv.URL), futures.WithWsURL(s.srv.WsURL))
+	key, err := c.NewStartUserStreamService().Do(s.ctx)
+	s.Require().NoError(err)
+	events := make(chan *futures.WsUserDataEvent, 20)
+	doneC, stopC, err := c.WsClient().WsUserDataServe(key, func(event *futures.WsUserDataEvent) {
+		events <- event
+	}, func(err error) {})
+	s.Require().NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+
+	res, err := c.NewCreateBatchOrdersService().OrderList([]*futures.CreateOrderService{
+		c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).Type(futures.OrderTypeMarket).Quantity("0.1"),
+		c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).Type(futures.OrderTypeLimit).
+			TimeInForce(futures.TimeInForceTypeGTC).Quantity("0.1").Price("29000.05"),
+	}).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(res.Orders, 2)
+	s.Equal(futures.OrderStatusTypeFilled, res.Orders[0].Status)
+	// the price of the second order is not a multiple of the tick size
+	s.Nil(res.Orders[1])
+	s.ErrorIs(res.Errors[1], common.ErrFilterFailure)
+	balances, err := c.NewGetBalanceService().Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal("998.80000000", balances[0].Balance) // 3000 * 0.0004 commission
+
+	stop, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeStopMarket).StopPrice("29500").ClosePosition(true).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeNew, stop.Status)
+	s.srv.Futures.Trade("BTCUSDT", "29500", "1")
+	order, err := c.NewGetOrderService().Symbol("BTCUSDT").OrderID(stop.OrderID).Do(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeFilled, order.Status)
+	s.Equal("0.100", order.ExecutedQuantity)
+	amount, _ := s.srv.Futures.Position(apiKey, "BTCUSDT")
+	s.Equal("0", amount)
+
+	var last *futures.WsUserDataEvent
+	s.Eventually(func() bool {
+		select {
+		case last = <-events:
+			return last.Event == futures.UserDataEventTypeAccountUpdate && last.AccountUpdate.Positions[0].Amount == "0"
+		default:
+			return false
+		}
+	}, 5*time.Second, time.Millisecond)
+	s.Equal("USDT", last.AccountUpdate.Balances[0].Asset)
+}
@@ -0,0 +1,682 @@
+package binancetest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// spot stop order types, resting until a trade reaches their stop price
+var spotStopOrderTypes = map[string]bool{
+	"STOP_LOSS":         true,
+	"STOP_LOSS_LIMIT":   true,
+	"TAKE_PROFIT":       true,
+	"TAKE_PROFIT_LIMIT": true,
+}
+
+func (s *Server) registerSpot() {
+	m := s.Spot
+	s.handle(http.MethodGet, "/api/v3/ping", secTypeNone, func(c *call) (interface{}, *apiError) {
+		return struct{}{}, nil
+	})
+	s.handle(http.MethodGet, "/api/v3/time", secTypeNone, func(c *call) (interface{}, *apiError) {
+		return map[string]interface{}{"serverTime": c.now}, nil
+	})
+	s.handle(http.MethodGet, "/api/v3/exchangeInfo", secTypeNone, m.spotExchangeInfo)
+	s.handle(http.MethodGet, "/api/v3/depth", secTypeNone, m.depth)
+	s.handle(http.MethodPost, "/api/v3/order", secTypeSigned, m.spotNewOrder)
+	s.handle(http.MethodPost, "/api/v3/order/test", secTypeSigned, m.spotTestOrder)
+	s.handle(http.MethodGet, "/api/v3/order", secTypeSigned, m.spotGetOrder)
+	s.handle(http.MethodDelete, "/api/v3/order", secTypeSigned, m.spotCancelOrder)
+	s.handle(http.MethodPost, "/api/v3/order/oco", secTypeSigned, m.spotNewOCO)
+	s.handle(http.MethodDelete, "/api/v3/orderList", secTypeSigned, m.spotCancelOrderList)
+	s.handle(http.MethodGet, "/api/v3/openOrders", secTypeSigned, m.spotOpenOrders)
+	s.handle(http.MethodDelete, "/api/v3/openOrders", secTypeSigned, m.spotCancelOpenOrders)
+	s.handle(http.MethodGet, "/api/v3/account", secTypeSigned, m.spotAccount)
+	s.handle(http.MethodGet, "/api/v3/myTrades", secTypeSigned, m.spotMyTrades)
+	s.handle(http.MethodPost, "/api/v3/userDataStream", secTypeAPIKey, m.newListenKey)
+	s.handle(http.MethodPut, "/api/v3/userDataStream", secTypeAPIKey, m.keepaliveListenKey)
+	s.handle(http.MethodDelete, "/api/v3/userDataStream", secTypeAPIKey, m.closeListenKey)
+}
+
+// format8 format a spot amount with 8 decimal places, like the exchange does
+func format8(d common.Decimal) string {
+	return d.Round(8, common.RoundHalfUp).String()
+}
+
+// basisPoints return a commission rate in basis points, as in the commissions of the spot account
+func basisPoints(rate common.Decimal) int64 {
+	return int64(rate.Mul(common.NewDecimal(10000, 0)).Float64())
+}
+
+func rateLimits(weight, orders int) []map[string]interface{} {
+	return []map[string]interface{}{
+		{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": weight},
+		{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": orders},
+	}
+}
+
+// sortedSymbols return the symbols of the market by name
+func (m *Market) sortedSymbols() []*Symbol {
+	res := make([]*Symbol, 0, len(m.symbols))
+	for _, sym := range m.symbols {
+		res = append(res, sym)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Symbol < res[j].Symbol })
+	return res
+}
+
+func (m *Market) spotExchangeInfo(c *call) (interface{}, *apiError) {
+	var symbols []map[string]interface{}
+	for _, sym := range m.sortedSymbols() {
+		filters := []map[string]interface{}{
+			{"filterType": "PRICE_FILTER", "minPrice": sym.MinPrice, "maxPrice": sym.MaxPrice, "tickSize": sym.TickSize},
+			{"filterType": "LOT_SIZE", "minQty": sym.MinQty, "maxQty": sym.MaxQty, "stepSize": sym.StepSize},
+		}
+		if sym.MinNotional != "" {
+			filters = append(filters, map[string]interface{}{
+				"filterType": "MIN_NOTIONAL", "minNotional": sym.MinNotional, "applyToMarket": true, "avgPriceMins": 5,
+			})
+		}
+		symbols = append(symbols, map[string]interface{}{
+			"symbol":                     sym.Symbol,
+			"status":                     "TRADING",
+			"baseAsset":                  sym.BaseAsset,
+			"baseAssetPrecision":         8,
+			"quoteAsset":                 sym.QuoteAsset,
+			"quotePrecision":             8,
+			"quoteAssetPrecision":        8,
+			"baseCommissionPrecision":    8,
+			"quoteCommissionPrecision":   8,
+			"orderTypes":                 []string{"LIMIT", "LIMIT_MAKER", "MARKET", "STOP_LOSS", "STOP_LOSS_LIMIT", "TAKE_PROFIT", "TAKE_PROFIT_LIMIT"},
+			"icebergAllowed":             true,
+			"ocoAllowed":                 false,
+			"quoteOrderQtyMarketAllowed": true,
+			"isSpotTradingAllowed":       true,
+			"isMarginTradingAllowed":     false,
+			"filters":                    filters,
+			"permissions":                []string{"SPOT"},
+		})
+	}
+	return map[string]interface{}{
+		"timezone":        "UTC",
+		"serverTime":      c.now,
+		"rateLimits":      rateLimits(6000, 100),
+		"exchangeFilters": []interface{}{},
+		"symbols":         symbols,
+	}, nil
+}
+
+func (m *Market) depth(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	limit, apiErr := intParam(c.params, "limit", false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+	b := m.books[sym.Symbol]
+	levels := func(l []level) [][2]string {
+		res := make([][2]string, 0, len(l))
+		for i := 0; i < len(l) && int64(i) < limit; i++ {
+			res = append(res, [2]string{l[i].price.String(), l[i].qty.String()})
+		}
+		return res
+	}
+	res := map[string]interface{}{
+		"lastUpdateId": b.lastUpdateID,
+		"bids":         levels(b.bids),
+		"asks":         levels(b.asks),
+	}
+	if m.futures {
+		res["E"], res["T"] = c.now, c.now
+	}
+	return res, nil
+}
+
+// parseSpotOrder check the parameters and filters of a new spot order
+func (m *Market) parseSpotOrder(c *call) (*order, *Symbol, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+	o := &order{account: c.account, symbol: sym.Symbol, time: c.now, updateTime: c.now}
+	if o.side, apiErr = stringParam(c.params, "side", true); apiErr != nil {
+		return nil, nil, apiErr
+	}
+	if o.side != sideBuy && o.side != sideSell {
+		return nil, nil, newError(ErrorCodeInvalidSide, "Invalid side.")
+	}
+	if o.orderType, apiErr = stringParam(c.params, "type", true); apiErr != nil {
+		return nil, nil, apiErr
+	}
+	limit := o.orderType == orderTypeLimit || o.orderType == "STOP_LOSS_LIMIT" || o.orderType == "TAKE_PROFIT_LIMIT"
+	if !limit && o.orderType != orderTypeMarket && o.orderType != orderTypeLimitMaker && !spotStopOrderTypes[o.orderType] {
+		return nil, nil, newError(ErrorCodeInvalidOrderType, "Invalid orderType.")
+	}
+	if o.timeInForce, apiErr = stringParam(c.params, "timeInForce", limit); apiErr != nil {
+		return nil, nil, apiErr
+	}
+	market := o.orderType == orderTypeMarket
+	if o.origQuoteQty, apiErr = decimalParam(c.params, "quoteOrderQty", false); apiErr != nil {
+		return nil, nil, apiErr
+	}
+	if market && o.origQuoteQty.Sign() > 0 && c.params.Get("quantity") != "" {
+		return nil, nil, newError(ErrorCodeMandatoryParam, "Parameter 'quantity' and 'quoteOrderQty' cannot be sent together.")
+	}
+	if market && o.origQuoteQty.Sign() == 0 && c.params.Get("quantity") == "" {
+		return nil, nil, newError(ErrorCodeMandatoryParam, "Param 'quantity' or 'quoteOrderQty' must be sent, but both were empty/null!")
+	}
+	if o.origQty, apiErr = decimalParam(c.params, "quantity", !market); apiErr != nil {
+		return nil, nil, apiErr
+	}
+	if o.price, apiErr = decimalParam(c.params, "price", limit || o.orderType == orderTypeLimitMaker); apiErr != nil {
+		return nil, nil, apiErr
+	}
+	if o.stopPrice, apiErr = decimalParam(c.params, "stopPrice", spotStopOrderTypes[o.orderType]); apiErr != nil {
+		return nil, nil, apiErr
+	}
+	if o.icebergQty, apiErr = decimalParam(c.params, "icebergQty", false); apiErr != nil {
+		return nil, nil, apiErr
+	}
+	o.clientOrderID = c.params.Get("newClientOrderId")
+	for _, open := range m.openOrders(c.account, sym.Symbol) {
+		if o.clientOrderID != "" && open.clientOrderID == o.clientOrderID {
+			return nil, nil, newError(ErrorCodeNewOrderRejected, "Duplicate order sent.")
+		}
+	}
+
+	params := &common.OrderParams{
+		Symbol:         sym.Symbol,
+		Buy:            o.buy(),
+		Market:         market || o.orderType == "STOP_LOSS" || o.orderType == "TAKE_PROFIT",
+		Quantity:       c.params.Get("quantity"),
+		Price:          c.params.Get("price"),
+		StopPrice:      c.params.Get("stopPrice"),
+		ReferencePrice: m.books[sym.Symbol].referencePrice(o.buy()),
+	}
+	if o.origQuoteQty.Sign() > 0 {
+		params.QuoteOrderQuantity = o.origQuoteQty.String()
+	}
+	if err := common.ValidateOrder(sym.filters(), params, false); err != nil {
+		return nil, nil, newError(ErrorCodeFilterFailure, "Filter failure: %s", err.(*common.OrderValidationError).Errors[0].Filter)
+	}
+	if o.triggeredBy(m.books[sym.Symbol].lastPrice) {
+		return nil, nil, newError(ErrorCodeNewOrderRejected, "Stop price would trigger immediately.")
+	}
+	return o, sym, nil
+}
+
+func (m *Market) spotTestOrder(c *call) (interface{}, *apiError) {
+	if _, _, apiErr := m.parseSpotOrder(c); apiErr != nil {
+		return nil, apiErr
+	}
+	return struct{}{}, nil
+}
+
+func (m *Market) spotNewOrder(c *call) (interface{}, *apiError) {
+	o, sym, apiErr := m.parseSpotOrder(c)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	b := m.books[sym.Symbol]
+	step := common.MustParseDecimal(sym.StepSize)
+
+	var fills []fill
+	switch {
+	case spotStopOrderTypes[o.orderType]:
+	case o.orderType == orderTypeMarket:
+		fills = b.take(o.buy(), common.Decimal{}, o.origQty, o.origQuoteQty, step)
+	default:
+		fills = b.take(o.buy(), o.price, o.origQty, common.Decimal{}, step)
+	}
+	filledQty, filledQuote := sumFills(fills)
+	if o.orderType == orderTypeLimitMaker && len(fills) > 0 {
+		return nil, newError(ErrorCodeNewOrderRejected, "Order would immediately match and take.")
+	}
+	if o.timeInForce == timeInForceFOK && filledQty.LessThan(o.origQty) {
+		fills, filledQty, filledQuote = nil, common.Decimal{}, common.Decimal{}
+	}
+	if o.origQuoteQty.Sign() > 0 {
+		o.origQty = filledQty
+	}
+
+	// check the balance spent by the fills and locked by the rest of the order
+	rests := o.orderType != orderTypeMarket && o.timeInForce != timeInForceIOC && o.timeInForce != timeInForceFOK
+	rest := o.origQty.Sub(filledQty)
+	needed, asset := filledQty, sym.BaseAsset
+	if rests {
+		needed = needed.Add(rest)
+	}
+	if o.buy() {
+		needed, asset = filledQuote, sym.QuoteAsset
+		if rests {
+			needed = needed.Add(rest.Mul(o.price))
+		}
+	}
+	if c.account.balance(asset).free.LessThan(needed) {
+		return nil, newError(ErrorCodeNewOrderRejected, insufficientBalanceMessage)
+	}
+
+	m.nextOrderID++
+	o.id = m.nextOrderID
+	if o.clientOrderID == "" {
+		o.clientOrderID = newClientOrderID(o.id)
+	}
+	o.status = statusNew
+	m.orders = append(m.orders, o)
+	m.reportOrder(o, executionTypeNew, nil, c.now)
+	b.consume(o.buy(), fills)
+	firstTrade := len(m.trades)
+	m.execute(o, fills, false, c.now)
+	switch {
+	case o.status == statusFilled:
+	case !rests:
+		o.status = statusExpired
+		m.reportOrder(o, executionTypeExpired, nil, c.now)
+	default:
+		m.lock(o, 1)
+	}
+	m.reportAccount(c.account, sym.Symbol, c.now)
+
+	respType := c.params.Get("newOrderRespType")
+	if respType == "" {
+		respType = "ACK"
+		if o.orderType == orderTypeMarket || o.orderType == orderTypeLimit {
+			respType = "FULL"
+		}
+	}
+	res := map[string]interface{}{
+		"symbol":        o.symbol,
+		"orderId":       o.id,
+		"orderListId":   -1,
+		"clientOrderId": o.clientOrderID,
+		"transactTime":  c.now,
+	}
+	if respType == "ACK" {
+		return res, nil
+	}
+	for k, v := range spotOrderJSON(o) {
+		if k != "time" && k != "updateTime" && k != "isWorking" && k != "stopPrice" && k != "icebergQty" {
+			res[k] = v
+		}
+	}
+	if respType == "FULL" {
+		resFills := []map[string]interface{}{}
+		for _, t := range m.trades[firstTrade:] {
+			resFills = append(resFills, map[string]interface{}{
+				"price":           format8(t.price),
+				"qty":             format8(t.qty),
+				"commission":      format8(t.commission),
+				"commissionAsset": t.commissionAsset,
+				"tradeId":         t.id,
+			})
+		}
+		res["fills"] = resFills
+	}
+	return res, nil
+}
+
+func spotOrderJSON(o *order) map[string]interface{} {
+	return map[string]interface{}{
+		"symbol":              o.symbol,
+		"orderId":             o.id,
+		"orderListId":         o.orderListID(),
+		"clientOrderId":       o.clientOrderID,
+		"price":               format8(o.price),
+		"origQty":             format8(o.origQty),
+		"executedQty":         format8(o.executedQty),
+		"cummulativeQuoteQty": format8(o.cumQuote),
+		"status":              o.status,
+		"timeInForce":         o.timeInForce,
+		"type":                o.orderType,
+		"side":                o.side,
+		"stopPrice":           format8(o.stopPrice),
+		"icebergQty":          format8(o.icebergQty),
+		"time":                o.time,
+		"updateTime":          o.updateTime,
+		"isWorking":           o.working(),
+		"origQuoteOrderQty":   format8(o.origQuoteQty),
+	}
+}
+
+func (m *Market) spotGetOrder(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	o, apiErr := m.findOrder(c, sym.Symbol)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if o == nil {
+		return nil, newError(ErrorCodeNoSuchOrder, noSuchOrderMessage)
+	}
+	return spotOrderJSON(o), nil
+}
+
+func (m *Market) spotCancelOrder(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	o, apiErr := m.findOrder(c, sym.Symbol)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if o == nil || !o.open() {
+		return nil, newError(ErrorCodeCancelRejected, unknownOrderMessage)
+	}
+	m.cancel(o, c.now)
+	m.reportAccount(c.account, sym.Symbol, c.now)
+	return spotCancelJSON(o, c.params.Get("newClientOrderId")), nil
+}
+
+func spotCancelJSON(o *order, clientOrderID string) map[string]interface{} {
+	res := spotOrderJSON(o)
+	for _, k := range []string{"time", "updateTime", "isWorking", "origQuoteOrderQty"} {
+		delete(res, k)
+	}
+	if clientOrderID == "" {
+		clientOrderID = newClientOrderID(-o.id)
+	}
+	res["origClientOrderId"] = o.clientOrderID
+	res["clientOrderId"] = clientOrderID
+	return res
+}
+
+func (m *Market) spotOpenOrders(c *call) (interface{}, *apiError) {
+	symbol := ""
+	sym, apiErr := m.symbol(c, false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if sym != nil {
+		symbol = sym.Symbol
+	}
+	res := []map[string]interface{}{}
+	for _, o := range m.openOrders(c.account, symbol) {
+		res = append(res, spotOrderJSON(o))
+	}
+	return res, nil
+}
+
+func (m *Market) spotCancelOpenOrders(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	res := []map[string]interface{}{}
+	for _, o := range m.openOrders(c.account, sym.Symbol) {
+		if !o.open() {
+			// canceled with the other order of its OCO
+			continue
+		}
+		if o.leg != nil {
+			legs := []*order{o, o.leg}
+			m.cancel(o, c.now)
+			res = append(res, m.spotListJSON(legs, "ALL_DONE", c.now))
+			continue
+		}
+		m.cancel(o, c.now)
+		res = append(res, spotCancelJSON(o, ""))
+	}
+	m.reportAccount(c.account, sym.Symbol, c.now)
+	return res, nil
+}
+
+func (m *Market) spotAccount(c *call) (interface{}, *apiError) {
+	assets := make([]string, 0, len(c.account.balances))
+	for asset := range c.account.balances {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	balances := []map[string]interface{}{}
+	for _, asset := range assets {
+		b := c.account.balances[asset]
+		balances = append(balances, map[string]interface{}{
+			"asset":  asset,
+			"free":   format8(b.free),
+			"locked": format8(b.locked),
+		})
+	}
+	return map[string]interface{}{
+		"makerCommission":  basisPoints(m.makerRate),
+		"takerCommission":  basisPoints(m.takerRate),
+		"buyerCommission":  0,
+		"sellerCommission": 0,
+		"canTrade":         true,
+		"canWithdraw":      true,
+		"canDeposit":       true,
+		"updateTime":       c.now,
+		"accountType":      "SPOT",
+		"balances":         balances,
+		"permissions":      []string{"SPOT"},
+	}, nil
+}
+
+func (m *Market) spotMyTrades(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	trades, apiErr := m.userTrades(c, sym.Symbol, 500, 1000)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	res := []map[string]interface{}{}
+	for _, t := range trades {
+		res = append(res, map[string]interface{}{
+			"symbol":          t.symbol,
+			"id":              t.id,
+			"orderId":         t.orderID,
+			"orderListId":     -1,
+			"price":           format8(t.price),
+			"qty":             format8(t.qty),
+			"quoteQty":        format8(t.qty.Mul(t.price)),
+			"commission":      format8(t.commission),
+			"commissionAsset": t.commissionAsset,
+			"time":            t.time,
+			"isBuyer":         t.buyer,
+			"isMaker":         t.maker,
+			"isBestMatch":     true,
+		})
+	}
+	return res, nil
+}
+
+// spotNewOCO place a LIMIT_MAKER order and a STOP_LOSS or STOP_LOSS_LIMIT order on the same
+// quantity. The stop order locks no balance, and the first to fill or trigger cancels the other.
+func (m *Market) spotNewOCO(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	side, apiErr := stringParam(c.params, "side", true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if side != sideBuy && side != sideSell {
+		return nil, newError(ErrorCodeInvalidSide, "Invalid side.")
+	}
+	qty, apiErr := decimalParam(c.params, "quantity", true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	price, apiErr := decimalParam(c.params, "price", true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	stopPrice, apiErr := decimalParam(c.params, "stopPrice", true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	stopLimitPrice, apiErr := decimalParam(c.params, "stopLimitPrice", false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	stopTimeInForce, apiErr := stringParam(c.params, "stopLimitTimeInForce", stopLimitPrice.Sign() > 0)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	limit := &order{
+		account: c.account, symbol: sym.Symbol, side: side, orderType: orderTypeLimitMaker,
+		price: price, origQty: qty, clientOrderID: c.params.Get("limitClientOrderId"), time: c.now, updateTime: c.now,
+	}
+	stop := &order{
+		account: c.account, symbol: sym.Symbol, side: side, orderType: "STOP_LOSS", stopPrice: stopPrice,
+		origQty: qty, clientOrderID: c.params.Get("stopClientOrderId"), time: c.now, updateTime: c.now, noLock: true,
+	}
+	if stopLimitPrice.Sign() > 0 {
+		stop.orderType, stop.price, stop.timeInForce = "STOP_LOSS_LIMIT", stopLimitPrice, stopTimeInForce
+	}
+	b := m.books[sym.Symbol]
+	for _, o := range []*order{limit, stop} {
+		params := &common.OrderParams{
+			Symbol:         sym.Symbol,
+			Buy:            o.buy(),
+			Market:         o.price.Sign() == 0,
+			Quantity:       o.origQty.String(),
+			ReferencePrice: b.referencePrice(o.buy()),
+		}
+		if o.price.Sign() > 0 {
+			params.Price = o.price.String()
+		}
+		if o.stopPrice.Sign() > 0 {
+			params.StopPrice = o.stopPrice.String()
+		}
+		if err := common.ValidateOrder(sym.filters(), params, false); err != nil {
+			return nil, newError(ErrorCodeFilterFailure, "Filter failure: %s", err.(*common.OrderValidationError).Errors[0].Filter)
+		}
+	}
+	// a sell OCO has its limit price above the market and its stop price below, a buy one the opposite
+	ref := b.lastPrice
+	if levels := *b.opposite(limit.buy()); len(levels) > 0 {
+		ref = levels[0].price
+	}
+	if limit.buy() && (!price.LessThan(ref) || !stopPrice.GreaterThan(ref)) ||
+		!limit.buy() && (!price.GreaterThan(ref) || !stopPrice.LessThan(ref)) {
+		return nil, newError(ErrorCodeNewOrderRejected, "The relationship of the prices for the orders is not correct.")
+	}
+	if !m.canLock(limit) {
+		return nil, newError(ErrorCodeNewOrderRejected, insufficientBalanceMessage)
+	}
+
+	m.nextListID++
+	listClientOrderID := c.params.Get("listClientOrderId")
+	if listClientOrderID == "" {
+		listClientOrderID = newClientOrderID(-m.nextListID)
+	}
+	legs := []*order{stop, limit}
+	for _, o := range legs {
+		m.nextOrderID++
+		o.id, o.status, o.listID, o.listClientOrderID = m.nextOrderID, statusNew, m.nextListID, listClientOrderID
+		if o.clientOrderID == "" {
+			o.clientOrderID = newClientOrderID(o.id)
+		}
+		m.orders = append(m.orders, o)
+	}
+	stop.leg, limit.leg = limit, stop
+	m.lock(limit, 1)
+	for _, o := range legs {
+		m.reportOrder(o, executionTypeNew, nil, c.now)
+	}
+	m.reportAccount(c.account, sym.Symbol, c.now)
+	return m.spotListJSON(legs, "EXEC_STARTED", c.now), nil
+}
+
+// spotListJSON return an order list of OCO orders
+func (m *Market) spotListJSON(legs []*order, status string, now int64) map[string]interface{} {
+	listOrderStatus := "EXECUTING"
+	if status == "ALL_DONE" {
+		listOrderStatus = "ALL_DONE"
+	}
+	orders := []map[string]interface{}{}
+	reports := []map[string]interface{}{}
+	for _, o := range legs {
+		orders = append(orders, map[string]interface{}{"symbol": o.symbol, "orderId": o.id, "clientOrderId": o.clientOrderID})
+		report := spotOrderJSON(o)
+		for _, k := range []string{"time", "updateTime", "isWorking", "origQuoteOrderQty"} {
+			delete(report, k)
+		}
+		report["transactTime"] = now
+		reports = append(reports, report)
+	}
+	return map[string]interface{}{
+		"orderListId":       legs[0].listID,
+		"contingencyType":   "OCO",
+		"listStatusType":    status,
+		"listOrderStatus":   listOrderStatus,
+		"listClientOrderId": legs[0].listClientOrderID,
+		"transactionTime":   now,
+		"symbol":            legs[0].symbol,
+		"orders":            orders,
+		"orderReports":      reports,
+	}
+}
+
+// spotCancelOrderList cancel the open orders of an OCO by orderListId or listClientOrderId
+func (m *Market) spotCancelOrderList(c *call) (interface{}, *apiError) {
+	sym, apiErr := m.symbol(c, true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	id, apiErr := intParam(c.params, "orderListId", false)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	clientID := c.params.Get("listClientOrderId")
+	if id == 0 && clientID == "" {
+		return nil, newError(ErrorCodeMandatoryParam, "Param 'listClientOrderId' or 'orderListId' must be sent, but both were empty/null!")
+	}
+	var legs []*order
+	for _, o := range m.orders {
+		if o.account == c.account && o.symbol == sym.Symbol && o.listID != 0 &&
+			(id != 0 && o.listID == id || id == 0 && o.listClientOrderID == clientID) {
+			legs = append(legs, o)
+		}
+	}
+	if len(legs) == 0 || !legs[0].open() && !legs[1].open() {
+		return nil, newError(ErrorCodeCancelRejected, "Unknown order list sent.")
+	}
+	for _, o := range legs {
+		if o.open() {
+			m.cancel(o, c.now)
+		}
+	}
+	m.reportAccount(c.account, sym.Symbol, c.now)
+	return m.spotListJSON(legs, "ALL_DONE", c.now), nil
+}
+
+func (m *Market) newListenKey(c *call) (interface{}, *apiError) {
+	return map[string]interface{}{"listenKey": m.s.newListenKey(c.account, m)}, nil
+}
+
+func (m *Market) keepaliveListenKey(c *call) (interface{}, *apiError) {
+	key, apiErr := stringParam(c.params, "listenKey", true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if c.account.listenKeys[key] != m {
+		return nil, newError(ErrorCodeInvalidListenKey, invalidListenKeyMessage)
+	}
+	return struct{}{}, nil
+}
+
+func (m *Market) closeListenKey(c *call) (interface{}, *apiError) {
+	key, apiErr := stringParam(c.params, "listenKey", true)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if c.account.listenKeys[key] != m {
+		return nil, newError(ErrorCodeInvalidListenKey, invalidListenKeyMessage)
+	}
+	m.s.closeListenKey(c.account, key)
+	return struct{}{}, nil
+}
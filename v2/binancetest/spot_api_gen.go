@@ -0,0 +1,1912 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package binancetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+)
+
+// SpotAPI is an in-memory fake of binance.API. Its services record the arguments of their setters and
+// answer Do with the FakeService of the same name, its user data streams are served by
+// UserDataStream. The zero value is ready to use.
+type SpotAPI struct {
+	PingService                        FakeService[struct{}]
+	ServerTimeService                  FakeService[int64]
+	ExchangeInfoService                FakeService[*binance.ExchangeInfo]
+	DepthService                       FakeService[*binance.DepthResponse]
+	AggTradesService                   FakeService[[]*binance.AggTrade]
+	RecentTradesService                FakeService[[]*binance.Trade]
+	HistoricalTradesService            FakeService[[]*binance.Trade]
+	KlinesService                      FakeService[[]*binance.Kline]
+	ListPriceChangeStatsService        FakeService[[]*binance.PriceChangeStats]
+	ListPricesService                  FakeService[[]*binance.SymbolPrice]
+	ListBookTickersService             FakeService[[]*binance.BookTicker]
+	ListSymbolTickerService            FakeService[[]*binance.SymbolTicker]
+	AveragePriceService                FakeService[*binance.AvgPrice]
+	CreateOrderService                 FakeService[*binance.CreateOrderResponse]
+	CreateOCOService                   FakeService[*binance.CreateOCOResponse]
+	CreateOrderListOCOService          FakeService[*binance.CreateOrderListResponse]
+	CreateOrderListOTOService          FakeService[*binance.CreateOrderListResponse]
+	CreateOrderListOTOCOService        FakeService[*binance.CreateOrderListResponse]
+	GetOrderListService                FakeService[*binance.OrderList]
+	ListAllOrderListsService           FakeService[[]*binance.OrderList]
+	CancelOCOService                   FakeService[*binance.CancelOCOResponse]
+	GetOrderService                    FakeService[*binance.Order]
+	CancelOrderService                 FakeService[*binance.CancelOrderResponse]
+	CancelReplaceOrderService          FakeService[*binance.CancelReplaceOrderResponse]
+	AmendOrderKeepPriorityService      FakeService[*binance.AmendOrderKeepPriorityResponse]
+	CancelOpenOrdersService            FakeService[*binance.CancelOpenOrdersResponse]
+	ListOpenOrdersService              FakeService[[]*binance.Order]
+	ListOpenOcoService                 FakeService[[]*binance.Oco]
+	ListOrdersService                  FakeService[[]*binance.Order]
+	ListTradesService                  FakeService[[]*binance.TradeV3]
+	ListPreventedMatchesService        FakeService[[]*binance.PreventedMatch]
+	GetAccountService                  FakeService[*binance.Account]
+	GetAccountSnapshotService          FakeService[*binance.Snapshot]
+	ListDepositsService                FakeService[[]*binance.Deposit]
+	GetDepositsAddressService          FakeService[*binance.GetDepositAddressResponse]
+	CreateWithdrawService              FakeService[*binance.CreateWithdrawResponse]
+	ListWithdrawsService               FakeService[[]*binance.Withdraw]
+	GetAssetDetailService              FakeService[map[string]binance.AssetDetail]
+	GetAllCoinsInfoService             FakeService[[]*binance.CoinInfo]
+	TradeFeeService                    FakeService[[]*binance.TradeFeeDetails]
+	CreateUserUniversalTransferService FakeService[*binance.CreateUserUniversalTransferResponse]
+	ListDustLogService                 FakeService[*binance.DustResult]
+	ListDustService                    FakeService[*binance.ListDustResponse]
+	DustTransferService                FakeService[*binance.DustTransferResponse]
+	AssetDividendService               FakeService[*binance.DividendResponseWrapper]
+	StartUserStreamService             FakeService[string]
+	KeepaliveUserStreamService         FakeService[struct{}]
+	CloseUserStreamService             FakeService[struct{}]
+	UserDataStream                     FakeUserDataStream[*binance.WsUserDataEvent]
+}
+
+var _ binance.API = (*SpotAPI)(nil)
+
+// NewPingService implement binance.API
+func (a *SpotAPI) NewPingService() binance.PingServiceAPI {
+	return &spotPingService{f: &a.PingService, args: make(Args)}
+}
+
+// NewServerTimeService implement binance.API
+func (a *SpotAPI) NewServerTimeService() binance.ServerTimeServiceAPI {
+	return &spotServerTimeService{f: &a.ServerTimeService, args: make(Args)}
+}
+
+// NewExchangeInfoService implement binance.API
+func (a *SpotAPI) NewExchangeInfoService() binance.ExchangeInfoServiceAPI {
+	return &spotExchangeInfoService{f: &a.ExchangeInfoService, args: make(Args)}
+}
+
+// NewDepthService implement binance.API
+func (a *SpotAPI) NewDepthService() binance.DepthServiceAPI {
+	return &spotDepthService{f: &a.DepthService, args: make(Args)}
+}
+
+// NewAggTradesService implement binance.API
+func (a *SpotAPI) NewAggTradesService() binance.AggTradesServiceAPI {
+	return &spotAggTradesService{f: &a.AggTradesService, args: make(Args)}
+}
+
+// NewRecentTradesService implement binance.API
+func (a *SpotAPI) NewRecentTradesService() binance.RecentTradesServiceAPI {
+	return &spotRecentTradesService{f: &a.RecentTradesService, args: make(Args)}
+}
+
+// NewHistoricalTradesService implement binance.API
+func (a *SpotAPI) NewHistoricalTradesService() binance.HistoricalTradesServiceAPI {
+	return &spotHistoricalTradesService{f: &a.HistoricalTradesService, args: make(Args)}
+}
+
+// NewKlinesService implement binance.API
+func (a *SpotAPI) NewKlinesService() binance.KlinesServiceAPI {
+	return &spotKlinesService{f: &a.KlinesService, args: make(Args)}
+}
+
+// NewListPriceChangeStatsService implement binance.API
+func (a *SpotAPI) NewListPriceChangeStatsService() binance.ListPriceChangeStatsServiceAPI {
+	return &spotListPriceChangeStatsService{f: &a.ListPriceChangeStatsService, args: make(Args)}
+}
+
+// NewListPricesService implement binance.API
+func (a *SpotAPI) NewListPricesService() binance.ListPricesServiceAPI {
+	return &spotListPricesService{f: &a.ListPricesService, args: make(Args)}
+}
+
+// NewListBookTickersService implement binance.API
+func (a *SpotAPI) NewListBookTickersService() binance.ListBookTickersServiceAPI {
+	return &spotListBookTickersService{f: &a.ListBookTickersService, args: make(Args)}
+}
+
+// NewListSymbolTickerService implement binance.API
+func (a *SpotAPI) NewListSymbolTickerService() binance.ListSymbolTickerServiceAPI {
+	return &spotListSymbolTickerService{f: &a.ListSymbolTickerService, args: make(Args)}
+}
+
+// NewAveragePriceService implement binance.API
+func (a *SpotAPI) NewAveragePriceService() binance.AveragePriceServiceAPI {
+	return &spotAveragePriceService{f: &a.AveragePriceService, args: make(Args)}
+}
+
+// NewCreateOrderService implement binance.API
+func (a *SpotAPI) NewCreateOrderService() binance.CreateOrderServiceAPI {
+	return &spotCreateOrderService{f: &a.CreateOrderService, args: make(Args)}
+}
+
+// NewCreateOCOService implement binance.API
+func (a *SpotAPI) NewCreateOCOService() binance.CreateOCOServiceAPI {
+	return &spotCreateOCOService{f: &a.CreateOCOService, args: make(Args)}
+}
+
+// NewCreateOrderListOCOService implement binance.API
+func (a *SpotAPI) NewCreateOrderListOCOService() binance.CreateOrderListOCOServiceAPI {
+	return &spotCreateOrderListOCOService{f: &a.CreateOrderListOCOService, args: make(Args)}
+}
+
+// NewCreateOrderListOTOService implement binance.API
+func (a *SpotAPI) NewCreateOrderListOTOService() binance.CreateOrderListOTOServiceAPI {
+	return &spotCreateOrderListOTOService{f: &a.CreateOrderListOTOService, args: make(Args)}
+}
+
+// NewCreateOrderListOTOCOService implement binance.API
+func (a *SpotAPI) NewCreateOrderListOTOCOService() binance.CreateOrderListOTOCOServiceAPI {
+	return &spotCreateOrderListOTOCOService{f: &a.CreateOrderListOTOCOService, args: make(Args)}
+}
+
+// NewGetOrderListService implement binance.API
+func (a *SpotAPI) NewGetOrderListService() binance.GetOrderListServiceAPI {
+	return &spotGetOrderListService{f: &a.GetOrderListService, args: make(Args)}
+}
+
+// NewListAllOrderListsService implement binance.API
+func (a *SpotAPI) NewListAllOrderListsService() binance.ListAllOrderListsServiceAPI {
+	return &spotListAllOrderListsService{f: &a.ListAllOrderListsService, args: make(Args)}
+}
+
+// NewCancelOCOService implement binance.API
+func (a *SpotAPI) NewCancelOCOService() binance.CancelOCOServiceAPI {
+	return &spotCancelOCOService{f: &a.CancelOCOService, args: make(Args)}
+}
+
+// NewGetOrderService implement binance.API
+func (a *SpotAPI) NewGetOrderService() binance.GetOrderServiceAPI {
+	return &spotGetOrderService{f: &a.GetOrderService, args: make(Args)}
+}
+
+// NewCancelOrderService implement binance.API
+func (a *SpotAPI) NewCancelOrderService() binance.CancelOrderServiceAPI {
+	return &spotCancelOrderService{f: &a.CancelOrderService, args: make(Args)}
+}
+
+// NewCancelReplaceOrderService implement binance.API
+func (a *SpotAPI) NewCancelReplaceOrderService() binance.CancelReplaceOrderServiceAPI {
+	return &spotCancelReplaceOrderService{f: &a.CancelReplaceOrderService, args: make(Args)}
+}
+
+// NewAmendOrderKeepPriorityService implement binance.API
+func (a *SpotAPI) NewAmendOrderKeepPriorityService() binance.AmendOrderKeepPriorityServiceAPI {
+	return &spotAmendOrderKeepPriorityService{f: &a.AmendOrderKeepPriorityService, args: make(Args)}
+}
+
+// NewCancelOpenOrdersService implement binance.API
+func (a *SpotAPI) NewCancelOpenOrdersService() binance.CancelOpenOrdersServiceAPI {
+	return &spotCancelOpenOrdersService{f: &a.CancelOpenOrdersService, args: make(Args)}
+}
+
+// NewListOpenOrdersService implement binance.API
+func (a *SpotAPI) NewListOpenOrdersService() binance.ListOpenOrdersServiceAPI {
+	return &spotListOpenOrdersService{f: &a.ListOpenOrdersService, args: make(Args)}
+}
+
+// NewListOpenOcoService implement binance.API
+func (a *SpotAPI) NewListOpenOcoService() binance.ListOpenOcoServiceAPI {
+	return &spotListOpenOcoService{f: &a.ListOpenOcoService, args: make(Args)}
+}
+
+// NewListOrdersService implement binance.API
+func (a *SpotAPI) NewListOrdersService() binance.ListOrdersServiceAPI {
+	return &spotListOrdersService{f: &a.ListOrdersService, args: make(Args)}
+}
+
+// NewListTradesService implement binance.API
+func (a *SpotAPI) NewListTradesService() binance.ListTradesServiceAPI {
+	return &spotListTradesService{f: &a.ListTradesService, args: make(Args)}
+}
+
+// NewListPreventedMatchesService implement binance.API
+func (a *SpotAPI) NewListPreventedMatchesService() binance.ListPreventedMatchesServiceAPI {
+	return &spotListPreventedMatchesService{f: &a.ListPreventedMatchesService, args: make(Args)}
+}
+
+// NewGetAccountService implement binance.API
+func (a *SpotAPI) NewGetAccountService() binance.GetAccountServiceAPI {
+	return &spotGetAccountService{f: &a.GetAccountService, args: make(Args)}
+}
+
+// NewGetAccountSnapshotService implement binance.API
+func (a *SpotAPI) NewGetAccountSnapshotService() binance.GetAccountSnapshotServiceAPI {
+	return &spotGetAccountSnapshotService{f: &a.GetAccountSnapshotService, args: make(Args)}
+}
+
+// NewListDepositsService implement binance.API
+func (a *SpotAPI) NewListDepositsService() binance.ListDepositsServiceAPI {
+	return &spotListDepositsService{f: &a.ListDepositsService, args: make(Args)}
+}
+
+// NewGetDepositAddressService implement binance.API
+func (a *SpotAPI) NewGetDepositAddressService() binance.GetDepositsAddressServiceAPI {
+	return &spotGetDepositsAddressService{f: &a.GetDepositsAddressService, args: make(Args)}
+}
+
+// NewCreateWithdrawService implement binance.API
+func (a *SpotAPI) NewCreateWithdrawService() binance.CreateWithdrawServiceAPI {
+	return &spotCreateWithdrawService{f: &a.CreateWithdrawService, args: make(Args)}
+}
+
+// NewListWithdrawsService implement binance.API
+func (a *SpotAPI) NewListWithdrawsService() binance.ListWithdrawsServiceAPI {
+	return &spotListWithdrawsService{f: &a.ListWithdrawsService, args: make(Args)}
+}
+
+// NewGetAssetDetailService implement binance.API
+func (a *SpotAPI) NewGetAssetDetailService() binance.GetAssetDetailServiceAPI {
+	return &spotGetAssetDetailService{f: &a.GetAssetDetailService, args: make(Args)}
+}
+
+// NewGetAllCoinsInfoService implement binance.API
+func (a *SpotAPI) NewGetAllCoinsInfoService() binance.GetAllCoinsInfoServiceAPI {
+	return &spotGetAllCoinsInfoService{f: &a.GetAllCoinsInfoService, args: make(Args)}
+}
+
+// NewTradeFeeService implement binance.API
+func (a *SpotAPI) NewTradeFeeService() binance.TradeFeeServiceAPI {
+	return &spotTradeFeeService{f: &a.TradeFeeService, args: make(Args)}
+}
+
+// NewUserUniversalTransferService implement binance.API
+func (a *SpotAPI) NewUserUniversalTransferService() binance.CreateUserUniversalTransferServiceAPI {
+	return &spotCreateUserUniversalTransferService{f: &a.CreateUserUniversalTransferService, args: make(Args)}
+}
+
+// NewListDustLogService implement binance.API
+func (a *SpotAPI) NewListDustLogService() binance.ListDustLogServiceAPI {
+	return &spotListDustLogService{f: &a.ListDustLogService, args: make(Args)}
+}
+
+// NewListDustService implement binance.API
+func (a *SpotAPI) NewListDustService() binance.ListDustServiceAPI {
+	return &spotListDustService{f: &a.ListDustService, args: make(Args)}
+}
+
+// NewDustTransferService implement binance.API
+func (a *SpotAPI) NewDustTransferService() binance.DustTransferServiceAPI {
+	return &spotDustTransferService{f: &a.DustTransferService, args: make(Args)}
+}
+
+// NewAssetDividendService implement binance.API
+func (a *SpotAPI) NewAssetDividendService() binance.AssetDividendServiceAPI {
+	return &spotAssetDividendService{f: &a.AssetDividendService, args: make(Args)}
+}
+
+// NewStartUserStreamService implement binance.API
+func (a *SpotAPI) NewStartUserStreamService() binance.StartUserStreamServiceAPI {
+	return &spotStartUserStreamService{f: &a.StartUserStreamService, args: make(Args)}
+}
+
+// NewKeepaliveUserStreamService implement binance.API
+func (a *SpotAPI) NewKeepaliveUserStreamService() binance.KeepaliveUserStreamServiceAPI {
+	return &spotKeepaliveUserStreamService{f: &a.KeepaliveUserStreamService, args: make(Args)}
+}
+
+// NewCloseUserStreamService implement binance.API
+func (a *SpotAPI) NewCloseUserStreamService() binance.CloseUserStreamServiceAPI {
+	return &spotCloseUserStreamService{f: &a.CloseUserStreamService, args: make(Args)}
+}
+
+// NewUserDataStream implement binance.API
+func (a *SpotAPI) NewUserDataStream(handler binance.WsUserDataHandler, errHandler binance.ErrHandler) binance.UserDataStreamAPI {
+	return &spotUserDataStream{s: a.UserDataStream.newStream(handler, errHandler)}
+}
+
+type spotPingService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *spotPingService) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type spotServerTimeService struct {
+	f    *FakeService[int64]
+	args Args
+}
+
+func (s *spotServerTimeService) Do(ctx context.Context, opts ...binance.RequestOption) (int64, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotExchangeInfoService struct {
+	f    *FakeService[*binance.ExchangeInfo]
+	args Args
+}
+
+func (s *spotExchangeInfoService) Symbol(symbol string) binance.ExchangeInfoServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotExchangeInfoService) Symbols(symbols ...string) binance.ExchangeInfoServiceAPI {
+	s.args["Symbols"] = symbols
+	return s
+}
+
+func (s *spotExchangeInfoService) Permissions(permissions ...string) binance.ExchangeInfoServiceAPI {
+	s.args["Permissions"] = permissions
+	return s
+}
+
+func (s *spotExchangeInfoService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.ExchangeInfo, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotDepthService struct {
+	f    *FakeService[*binance.DepthResponse]
+	args Args
+}
+
+func (s *spotDepthService) Symbol(symbol string) binance.DepthServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotDepthService) Limit(limit int) binance.DepthServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotDepthService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.DepthResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotAggTradesService struct {
+	f    *FakeService[[]*binance.AggTrade]
+	args Args
+}
+
+func (s *spotAggTradesService) Symbol(symbol string) binance.AggTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotAggTradesService) FromID(fromID int64) binance.AggTradesServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *spotAggTradesService) StartTime(startTime int64) binance.AggTradesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotAggTradesService) EndTime(endTime int64) binance.AggTradesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotAggTradesService) Limit(limit int) binance.AggTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotAggTradesService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.AggTrade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotRecentTradesService struct {
+	f    *FakeService[[]*binance.Trade]
+	args Args
+}
+
+func (s *spotRecentTradesService) Symbol(symbol string) binance.RecentTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotRecentTradesService) Limit(limit int) binance.RecentTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotRecentTradesService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Trade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotHistoricalTradesService struct {
+	f    *FakeService[[]*binance.Trade]
+	args Args
+}
+
+func (s *spotHistoricalTradesService) Symbol(symbol string) binance.HistoricalTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotHistoricalTradesService) Limit(limit int) binance.HistoricalTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotHistoricalTradesService) FromID(fromID int64) binance.HistoricalTradesServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *spotHistoricalTradesService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Trade, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotKlinesService struct {
+	f    *FakeService[[]*binance.Kline]
+	args Args
+}
+
+func (s *spotKlinesService) Symbol(symbol string) binance.KlinesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotKlinesService) Interval(interval string) binance.KlinesServiceAPI {
+	s.args["Interval"] = interval
+	return s
+}
+
+func (s *spotKlinesService) Limit(limit int) binance.KlinesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotKlinesService) StartTime(startTime int64) binance.KlinesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotKlinesService) EndTime(endTime int64) binance.KlinesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotKlinesService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Kline, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListPriceChangeStatsService struct {
+	f    *FakeService[[]*binance.PriceChangeStats]
+	args Args
+}
+
+func (s *spotListPriceChangeStatsService) Symbol(symbol string) binance.ListPriceChangeStatsServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotListPriceChangeStatsService) Symbols(symbols []string) binance.ListPriceChangeStatsServiceAPI {
+	s.args["Symbols"] = symbols
+	return s
+}
+
+func (s *spotListPriceChangeStatsService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.PriceChangeStats, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListPricesService struct {
+	f    *FakeService[[]*binance.SymbolPrice]
+	args Args
+}
+
+func (s *spotListPricesService) Symbol(symbol string) binance.ListPricesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotListPricesService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SymbolPrice, error) {
+	return s.f.do(ctx, s.args)
+}
+
+func (s *spotListPricesService) Symbols(symbols []string) binance.ListPricesServiceAPI {
+	s.args["Symbols"] = symbols
+	return s
+}
+
+type spotListBookTickersService struct {
+	f    *FakeService[[]*binance.BookTicker]
+	args Args
+}
+
+func (s *spotListBookTickersService) Symbol(symbol string) binance.ListBookTickersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotListBookTickersService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.BookTicker, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListSymbolTickerService struct {
+	f    *FakeService[[]*binance.SymbolTicker]
+	args Args
+}
+
+func (s *spotListSymbolTickerService) Symbol(symbol string) binance.ListSymbolTickerServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotListSymbolTickerService) Symbols(symbols []string) binance.ListSymbolTickerServiceAPI {
+	s.args["Symbols"] = symbols
+	return s
+}
+
+func (s *spotListSymbolTickerService) WindowSize(windowSize string) binance.ListSymbolTickerServiceAPI {
+	s.args["WindowSize"] = windowSize
+	return s
+}
+
+func (s *spotListSymbolTickerService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.SymbolTicker, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotAveragePriceService struct {
+	f    *FakeService[*binance.AvgPrice]
+	args Args
+}
+
+func (s *spotAveragePriceService) Symbol(symbol string) binance.AveragePriceServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotAveragePriceService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.AvgPrice, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCreateOrderService struct {
+	f    *FakeService[*binance.CreateOrderResponse]
+	args Args
+}
+
+func (s *spotCreateOrderService) Symbol(symbol string) binance.CreateOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotCreateOrderService) Side(side binance.SideType) binance.CreateOrderServiceAPI {
+	s.args["Side"] = side
+	return s
+}
+
+func (s *spotCreateOrderService) Type(orderType binance.OrderType) binance.CreateOrderServiceAPI {
+	s.args["Type"] = orderType
+	return s
+}
+
+func (s *spotCreateOrderService) TimeInForce(timeInForce binance.TimeInForceType) binance.CreateOrderServiceAPI {
+	s.args["TimeInForce"] = timeInForce
+	return s
+}
+
+func (s *spotCreateOrderService) Quantity(quantity string) binance.CreateOrderServiceAPI {
+	s.args["Quantity"] = quantity
+	return s
+}
+
+func (s *spotCreateOrderService) QuoteOrderQty(quoteOrderQty string) binance.CreateOrderServiceAPI {
+	s.args["QuoteOrderQty"] = quoteOrderQty
+	return s
+}
+
+func (s *spotCreateOrderService) Price(price string) binance.CreateOrderServiceAPI {
+	s.args["Price"] = price
+	return s
+}
+
+func (s *spotCreateOrderService) NewClientOrderID(newClientOrderID string) binance.CreateOrderServiceAPI {
+	s.args["NewClientOrderID"] = newClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderService) StopPrice(stopPrice string) binance.CreateOrderServiceAPI {
+	s.args["StopPrice"] = stopPrice
+	return s
+}
+
+func (s *spotCreateOrderService) TrailingDelta(trailingDelta string) binance.CreateOrderServiceAPI {
+	s.args["TrailingDelta"] = trailingDelta
+	return s
+}
+
+func (s *spotCreateOrderService) IcebergQuantity(icebergQuantity string) binance.CreateOrderServiceAPI {
+	s.args["IcebergQuantity"] = icebergQuantity
+	return s
+}
+
+func (s *spotCreateOrderService) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CreateOrderServiceAPI {
+	s.args["NewOrderRespType"] = newOrderRespType
+	return s
+}
+
+func (s *spotCreateOrderService) SelfTradePreventionMode(selfTradePreventionMode binance.SelfTradePreventionModeType) binance.CreateOrderServiceAPI {
+	s.args["SelfTradePreventionMode"] = selfTradePreventionMode
+	return s
+}
+
+func (s *spotCreateOrderService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateOrderResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCreateOCOService struct {
+	f    *FakeService[*binance.CreateOCOResponse]
+	args Args
+}
+
+func (s *spotCreateOCOService) Symbol(symbol string) binance.CreateOCOServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotCreateOCOService) Side(side binance.SideType) binance.CreateOCOServiceAPI {
+	s.args["Side"] = side
+	return s
+}
+
+func (s *spotCreateOCOService) Quantity(quantity string) binance.CreateOCOServiceAPI {
+	s.args["Quantity"] = quantity
+	return s
+}
+
+func (s *spotCreateOCOService) ListClientOrderID(listClientOrderID string) binance.CreateOCOServiceAPI {
+	s.args["ListClientOrderID"] = listClientOrderID
+	return s
+}
+
+func (s *spotCreateOCOService) LimitClientOrderID(limitClientOrderID string) binance.CreateOCOServiceAPI {
+	s.args["LimitClientOrderID"] = limitClientOrderID
+	return s
+}
+
+func (s *spotCreateOCOService) Price(price string) binance.CreateOCOServiceAPI {
+	s.args["Price"] = price
+	return s
+}
+
+func (s *spotCreateOCOService) LimitIcebergQuantity(limitIcebergQty string) binance.CreateOCOServiceAPI {
+	s.args["LimitIcebergQuantity"] = limitIcebergQty
+	return s
+}
+
+func (s *spotCreateOCOService) StopClientOrderID(stopClientOrderID string) binance.CreateOCOServiceAPI {
+	s.args["StopClientOrderID"] = stopClientOrderID
+	return s
+}
+
+func (s *spotCreateOCOService) StopPrice(stopPrice string) binance.CreateOCOServiceAPI {
+	s.args["StopPrice"] = stopPrice
+	return s
+}
+
+func (s *spotCreateOCOService) StopLimitPrice(stopLimitPrice string) binance.CreateOCOServiceAPI {
+	s.args["StopLimitPrice"] = stopLimitPrice
+	return s
+}
+
+func (s *spotCreateOCOService) StopIcebergQty(stopIcebergQty string) binance.CreateOCOServiceAPI {
+	s.args["StopIcebergQty"] = stopIcebergQty
+	return s
+}
+
+func (s *spotCreateOCOService) StopLimitTimeInForce(stopLimitTimeInForce binance.TimeInForceType) binance.CreateOCOServiceAPI {
+	s.args["StopLimitTimeInForce"] = stopLimitTimeInForce
+	return s
+}
+
+func (s *spotCreateOCOService) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CreateOCOServiceAPI {
+	s.args["NewOrderRespType"] = newOrderRespType
+	return s
+}
+
+func (s *spotCreateOCOService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateOCOResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCreateOrderListOCOService struct {
+	f    *FakeService[*binance.CreateOrderListResponse]
+	args Args
+}
+
+func (s *spotCreateOrderListOCOService) Symbol(symbol string) binance.CreateOrderListOCOServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) ListClientOrderID(listClientOrderID string) binance.CreateOrderListOCOServiceAPI {
+	s.args["ListClientOrderID"] = listClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) Side(side binance.SideType) binance.CreateOrderListOCOServiceAPI {
+	s.args["Side"] = side
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) Quantity(quantity string) binance.CreateOrderListOCOServiceAPI {
+	s.args["Quantity"] = quantity
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) AboveType(aboveType binance.OrderType) binance.CreateOrderListOCOServiceAPI {
+	s.args["AboveType"] = aboveType
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) AboveClientOrderID(aboveClientOrderID string) binance.CreateOrderListOCOServiceAPI {
+	s.args["AboveClientOrderID"] = aboveClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) AbovePrice(abovePrice string) binance.CreateOrderListOCOServiceAPI {
+	s.args["AbovePrice"] = abovePrice
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) AboveStopPrice(aboveStopPrice string) binance.CreateOrderListOCOServiceAPI {
+	s.args["AboveStopPrice"] = aboveStopPrice
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) AboveTrailingDelta(aboveTrailingDelta string) binance.CreateOrderListOCOServiceAPI {
+	s.args["AboveTrailingDelta"] = aboveTrailingDelta
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) AboveIcebergQuantity(aboveIcebergQty string) binance.CreateOrderListOCOServiceAPI {
+	s.args["AboveIcebergQuantity"] = aboveIcebergQty
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) AboveTimeInForce(aboveTimeInForce binance.TimeInForceType) binance.CreateOrderListOCOServiceAPI {
+	s.args["AboveTimeInForce"] = aboveTimeInForce
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) BelowType(belowType binance.OrderType) binance.CreateOrderListOCOServiceAPI {
+	s.args["BelowType"] = belowType
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) BelowClientOrderID(belowClientOrderID string) binance.CreateOrderListOCOServiceAPI {
+	s.args["BelowClientOrderID"] = belowClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) BelowPrice(belowPrice string) binance.CreateOrderListOCOServiceAPI {
+	s.args["BelowPrice"] = belowPrice
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) BelowStopPrice(belowStopPrice string) binance.CreateOrderListOCOServiceAPI {
+	s.args["BelowStopPrice"] = belowStopPrice
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) BelowTrailingDelta(belowTrailingDelta string) binance.CreateOrderListOCOServiceAPI {
+	s.args["BelowTrailingDelta"] = belowTrailingDelta
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) BelowIcebergQuantity(belowIcebergQty string) binance.CreateOrderListOCOServiceAPI {
+	s.args["BelowIcebergQuantity"] = belowIcebergQty
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) BelowTimeInForce(belowTimeInForce binance.TimeInForceType) binance.CreateOrderListOCOServiceAPI {
+	s.args["BelowTimeInForce"] = belowTimeInForce
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CreateOrderListOCOServiceAPI {
+	s.args["NewOrderRespType"] = newOrderRespType
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) SelfTradePreventionMode(selfTradePreventionMode binance.SelfTradePreventionModeType) binance.CreateOrderListOCOServiceAPI {
+	s.args["SelfTradePreventionMode"] = selfTradePreventionMode
+	return s
+}
+
+func (s *spotCreateOrderListOCOService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateOrderListResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCreateOrderListOTOService struct {
+	f    *FakeService[*binance.CreateOrderListResponse]
+	args Args
+}
+
+func (s *spotCreateOrderListOTOService) Symbol(symbol string) binance.CreateOrderListOTOServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) ListClientOrderID(listClientOrderID string) binance.CreateOrderListOTOServiceAPI {
+	s.args["ListClientOrderID"] = listClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) WorkingType(workingType binance.OrderType) binance.CreateOrderListOTOServiceAPI {
+	s.args["WorkingType"] = workingType
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) WorkingSide(workingSide binance.SideType) binance.CreateOrderListOTOServiceAPI {
+	s.args["WorkingSide"] = workingSide
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) WorkingClientOrderID(workingClientOrderID string) binance.CreateOrderListOTOServiceAPI {
+	s.args["WorkingClientOrderID"] = workingClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) WorkingPrice(workingPrice string) binance.CreateOrderListOTOServiceAPI {
+	s.args["WorkingPrice"] = workingPrice
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) WorkingQuantity(workingQuantity string) binance.CreateOrderListOTOServiceAPI {
+	s.args["WorkingQuantity"] = workingQuantity
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) WorkingIcebergQuantity(workingIcebergQty string) binance.CreateOrderListOTOServiceAPI {
+	s.args["WorkingIcebergQuantity"] = workingIcebergQty
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) WorkingTimeInForce(workingTimeInForce binance.TimeInForceType) binance.CreateOrderListOTOServiceAPI {
+	s.args["WorkingTimeInForce"] = workingTimeInForce
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) PendingType(pendingType binance.OrderType) binance.CreateOrderListOTOServiceAPI {
+	s.args["PendingType"] = pendingType
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) PendingSide(pendingSide binance.SideType) binance.CreateOrderListOTOServiceAPI {
+	s.args["PendingSide"] = pendingSide
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) PendingClientOrderID(pendingClientOrderID string) binance.CreateOrderListOTOServiceAPI {
+	s.args["PendingClientOrderID"] = pendingClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) PendingPrice(pendingPrice string) binance.CreateOrderListOTOServiceAPI {
+	s.args["PendingPrice"] = pendingPrice
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) PendingStopPrice(pendingStopPrice string) binance.CreateOrderListOTOServiceAPI {
+	s.args["PendingStopPrice"] = pendingStopPrice
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) PendingTrailingDelta(pendingTrailingDelta string) binance.CreateOrderListOTOServiceAPI {
+	s.args["PendingTrailingDelta"] = pendingTrailingDelta
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) PendingQuantity(pendingQuantity string) binance.CreateOrderListOTOServiceAPI {
+	s.args["PendingQuantity"] = pendingQuantity
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) PendingIcebergQuantity(pendingIcebergQty string) binance.CreateOrderListOTOServiceAPI {
+	s.args["PendingIcebergQuantity"] = pendingIcebergQty
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) PendingTimeInForce(pendingTimeInForce binance.TimeInForceType) binance.CreateOrderListOTOServiceAPI {
+	s.args["PendingTimeInForce"] = pendingTimeInForce
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CreateOrderListOTOServiceAPI {
+	s.args["NewOrderRespType"] = newOrderRespType
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) SelfTradePreventionMode(selfTradePreventionMode binance.SelfTradePreventionModeType) binance.CreateOrderListOTOServiceAPI {
+	s.args["SelfTradePreventionMode"] = selfTradePreventionMode
+	return s
+}
+
+func (s *spotCreateOrderListOTOService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateOrderListResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCreateOrderListOTOCOService struct {
+	f    *FakeService[*binance.CreateOrderListResponse]
+	args Args
+}
+
+func (s *spotCreateOrderListOTOCOService) Symbol(symbol string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) ListClientOrderID(listClientOrderID string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["ListClientOrderID"] = listClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) WorkingType(workingType binance.OrderType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["WorkingType"] = workingType
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) WorkingSide(workingSide binance.SideType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["WorkingSide"] = workingSide
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) WorkingClientOrderID(workingClientOrderID string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["WorkingClientOrderID"] = workingClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) WorkingPrice(workingPrice string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["WorkingPrice"] = workingPrice
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) WorkingQuantity(workingQuantity string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["WorkingQuantity"] = workingQuantity
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) WorkingIcebergQuantity(workingIcebergQty string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["WorkingIcebergQuantity"] = workingIcebergQty
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) WorkingTimeInForce(workingTimeInForce binance.TimeInForceType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["WorkingTimeInForce"] = workingTimeInForce
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingSide(pendingSide binance.SideType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingSide"] = pendingSide
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingQuantity(pendingQuantity string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingQuantity"] = pendingQuantity
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingAboveType(pendingAboveType binance.OrderType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingAboveType"] = pendingAboveType
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingAboveClientOrderID(pendingAboveClientOrderID string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingAboveClientOrderID"] = pendingAboveClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingAbovePrice(pendingAbovePrice string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingAbovePrice"] = pendingAbovePrice
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingAboveStopPrice(pendingAboveStopPrice string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingAboveStopPrice"] = pendingAboveStopPrice
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingAboveTrailingDelta(pendingAboveTrailingDelta string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingAboveTrailingDelta"] = pendingAboveTrailingDelta
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingAboveIcebergQuantity(pendingAboveIcebergQty string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingAboveIcebergQuantity"] = pendingAboveIcebergQty
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingAboveTimeInForce(pendingAboveTimeInForce binance.TimeInForceType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingAboveTimeInForce"] = pendingAboveTimeInForce
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingBelowType(pendingBelowType binance.OrderType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingBelowType"] = pendingBelowType
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingBelowClientOrderID(pendingBelowClientOrderID string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingBelowClientOrderID"] = pendingBelowClientOrderID
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingBelowPrice(pendingBelowPrice string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingBelowPrice"] = pendingBelowPrice
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingBelowStopPrice(pendingBelowStopPrice string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingBelowStopPrice"] = pendingBelowStopPrice
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingBelowTrailingDelta(pendingBelowTrailingDelta string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingBelowTrailingDelta"] = pendingBelowTrailingDelta
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingBelowIcebergQuantity(pendingBelowIcebergQty string) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingBelowIcebergQuantity"] = pendingBelowIcebergQty
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) PendingBelowTimeInForce(pendingBelowTimeInForce binance.TimeInForceType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["PendingBelowTimeInForce"] = pendingBelowTimeInForce
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["NewOrderRespType"] = newOrderRespType
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) SelfTradePreventionMode(selfTradePreventionMode binance.SelfTradePreventionModeType) binance.CreateOrderListOTOCOServiceAPI {
+	s.args["SelfTradePreventionMode"] = selfTradePreventionMode
+	return s
+}
+
+func (s *spotCreateOrderListOTOCOService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CreateOrderListResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotGetOrderListService struct {
+	f    *FakeService[*binance.OrderList]
+	args Args
+}
+
+func (s *spotGetOrderListService) OrderListID(orderListID int64) binance.GetOrderListServiceAPI {
+	s.args["OrderListID"] = orderListID
+	return s
+}
+
+func (s *spotGetOrderListService) OrigClientOrderID(origClientOrderID string) binance.GetOrderListServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *spotGetOrderListService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.OrderList, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListAllOrderListsService struct {
+	f    *FakeService[[]*binance.OrderList]
+	args Args
+}
+
+func (s *spotListAllOrderListsService) FromID(fromID int64) binance.ListAllOrderListsServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *spotListAllOrderListsService) StartTime(startTime int64) binance.ListAllOrderListsServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotListAllOrderListsService) EndTime(endTime int64) binance.ListAllOrderListsServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotListAllOrderListsService) Limit(limit int) binance.ListAllOrderListsServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotListAllOrderListsService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.OrderList, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCancelOCOService struct {
+	f    *FakeService[*binance.CancelOCOResponse]
+	args Args
+}
+
+func (s *spotCancelOCOService) Symbol(symbol string) binance.CancelOCOServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotCancelOCOService) ListClientOrderID(listClientOrderID string) binance.CancelOCOServiceAPI {
+	s.args["ListClientOrderID"] = listClientOrderID
+	return s
+}
+
+func (s *spotCancelOCOService) OrderListID(orderListID int64) binance.CancelOCOServiceAPI {
+	s.args["OrderListID"] = orderListID
+	return s
+}
+
+func (s *spotCancelOCOService) NewClientOrderID(newClientOrderID string) binance.CancelOCOServiceAPI {
+	s.args["NewClientOrderID"] = newClientOrderID
+	return s
+}
+
+func (s *spotCancelOCOService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelOCOResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotGetOrderService struct {
+	f    *FakeService[*binance.Order]
+	args Args
+}
+
+func (s *spotGetOrderService) Symbol(symbol string) binance.GetOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotGetOrderService) OrderID(orderID int64) binance.GetOrderServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *spotGetOrderService) OrigClientOrderID(origClientOrderID string) binance.GetOrderServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *spotGetOrderService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCancelOrderService struct {
+	f    *FakeService[*binance.CancelOrderResponse]
+	args Args
+}
+
+func (s *spotCancelOrderService) Symbol(symbol string) binance.CancelOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotCancelOrderService) OrderID(orderID int64) binance.CancelOrderServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *spotCancelOrderService) OrigClientOrderID(origClientOrderID string) binance.CancelOrderServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *spotCancelOrderService) NewClientOrderID(newClientOrderID string) binance.CancelOrderServiceAPI {
+	s.args["NewClientOrderID"] = newClientOrderID
+	return s
+}
+
+func (s *spotCancelOrderService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelOrderResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCancelReplaceOrderService struct {
+	f    *FakeService[*binance.CancelReplaceOrderResponse]
+	args Args
+}
+
+func (s *spotCancelReplaceOrderService) Symbol(symbol string) binance.CancelReplaceOrderServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) Side(side binance.SideType) binance.CancelReplaceOrderServiceAPI {
+	s.args["Side"] = side
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) Type(orderType binance.OrderType) binance.CancelReplaceOrderServiceAPI {
+	s.args["Type"] = orderType
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) CancelReplaceMode(mode binance.CancelReplaceModeType) binance.CancelReplaceOrderServiceAPI {
+	s.args["CancelReplaceMode"] = mode
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) TimeInForce(timeInForce binance.TimeInForceType) binance.CancelReplaceOrderServiceAPI {
+	s.args["TimeInForce"] = timeInForce
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) Quantity(quantity string) binance.CancelReplaceOrderServiceAPI {
+	s.args["Quantity"] = quantity
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) QuoteOrderQty(quoteOrderQty string) binance.CancelReplaceOrderServiceAPI {
+	s.args["QuoteOrderQty"] = quoteOrderQty
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) Price(price string) binance.CancelReplaceOrderServiceAPI {
+	s.args["Price"] = price
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) CancelNewClientOrderID(cancelNewClientOrderID string) binance.CancelReplaceOrderServiceAPI {
+	s.args["CancelNewClientOrderID"] = cancelNewClientOrderID
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) CancelOrigClientOrderID(cancelOrigClientOrderID string) binance.CancelReplaceOrderServiceAPI {
+	s.args["CancelOrigClientOrderID"] = cancelOrigClientOrderID
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) CancelOrderID(cancelOrderID int64) binance.CancelReplaceOrderServiceAPI {
+	s.args["CancelOrderID"] = cancelOrderID
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) NewClientOrderID(newClientOrderID string) binance.CancelReplaceOrderServiceAPI {
+	s.args["NewClientOrderID"] = newClientOrderID
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) StopPrice(stopPrice string) binance.CancelReplaceOrderServiceAPI {
+	s.args["StopPrice"] = stopPrice
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) TrailingDelta(trailingDelta string) binance.CancelReplaceOrderServiceAPI {
+	s.args["TrailingDelta"] = trailingDelta
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) IcebergQuantity(icebergQuantity string) binance.CancelReplaceOrderServiceAPI {
+	s.args["IcebergQuantity"] = icebergQuantity
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) NewOrderRespType(newOrderRespType binance.NewOrderRespType) binance.CancelReplaceOrderServiceAPI {
+	s.args["NewOrderRespType"] = newOrderRespType
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) CancelRestrictions(cancelRestrictions binance.CancelRestrictionsType) binance.CancelReplaceOrderServiceAPI {
+	s.args["CancelRestrictions"] = cancelRestrictions
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) OrderRateLimitExceededMode(mode binance.OrderRateLimitExceededModeType) binance.CancelReplaceOrderServiceAPI {
+	s.args["OrderRateLimitExceededMode"] = mode
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) SelfTradePreventionMode(selfTradePreventionMode binance.SelfTradePreventionModeType) binance.CancelReplaceOrderServiceAPI {
+	s.args["SelfTradePreventionMode"] = selfTradePreventionMode
+	return s
+}
+
+func (s *spotCancelReplaceOrderService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelReplaceOrderResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotAmendOrderKeepPriorityService struct {
+	f    *FakeService[*binance.AmendOrderKeepPriorityResponse]
+	args Args
+}
+
+func (s *spotAmendOrderKeepPriorityService) Symbol(symbol string) binance.AmendOrderKeepPriorityServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotAmendOrderKeepPriorityService) OrderID(orderID int64) binance.AmendOrderKeepPriorityServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *spotAmendOrderKeepPriorityService) OrigClientOrderID(origClientOrderID string) binance.AmendOrderKeepPriorityServiceAPI {
+	s.args["OrigClientOrderID"] = origClientOrderID
+	return s
+}
+
+func (s *spotAmendOrderKeepPriorityService) NewClientOrderID(newClientOrderID string) binance.AmendOrderKeepPriorityServiceAPI {
+	s.args["NewClientOrderID"] = newClientOrderID
+	return s
+}
+
+func (s *spotAmendOrderKeepPriorityService) NewQuantity(newQuantity string) binance.AmendOrderKeepPriorityServiceAPI {
+	s.args["NewQuantity"] = newQuantity
+	return s
+}
+
+func (s *spotAmendOrderKeepPriorityService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.AmendOrderKeepPriorityResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCancelOpenOrdersService struct {
+	f    *FakeService[*binance.CancelOpenOrdersResponse]
+	args Args
+}
+
+func (s *spotCancelOpenOrdersService) Symbol(symbol string) binance.CancelOpenOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotCancelOpenOrdersService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.CancelOpenOrdersResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListOpenOrdersService struct {
+	f    *FakeService[[]*binance.Order]
+	args Args
+}
+
+func (s *spotListOpenOrdersService) Symbol(symbol string) binance.ListOpenOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotListOpenOrdersService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListOpenOcoService struct {
+	f    *FakeService[[]*binance.Oco]
+	args Args
+}
+
+func (s *spotListOpenOcoService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Oco, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListOrdersService struct {
+	f    *FakeService[[]*binance.Order]
+	args Args
+}
+
+func (s *spotListOrdersService) Symbol(symbol string) binance.ListOrdersServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotListOrdersService) OrderID(orderID int64) binance.ListOrdersServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *spotListOrdersService) StartTime(startTime int64) binance.ListOrdersServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotListOrdersService) EndTime(endTime int64) binance.ListOrdersServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotListOrdersService) Limit(limit int) binance.ListOrdersServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotListOrdersService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.Order, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListTradesService struct {
+	f    *FakeService[[]*binance.TradeV3]
+	args Args
+}
+
+func (s *spotListTradesService) Symbol(symbol string) binance.ListTradesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotListTradesService) StartTime(startTime int64) binance.ListTradesServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotListTradesService) EndTime(endTime int64) binance.ListTradesServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotListTradesService) Limit(limit int) binance.ListTradesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotListTradesService) FromID(fromID int64) binance.ListTradesServiceAPI {
+	s.args["FromID"] = fromID
+	return s
+}
+
+func (s *spotListTradesService) OrderId(OrderId int64) binance.ListTradesServiceAPI {
+	s.args["OrderId"] = OrderId
+	return s
+}
+
+func (s *spotListTradesService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.TradeV3, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListPreventedMatchesService struct {
+	f    *FakeService[[]*binance.PreventedMatch]
+	args Args
+}
+
+func (s *spotListPreventedMatchesService) Symbol(symbol string) binance.ListPreventedMatchesServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotListPreventedMatchesService) PreventedMatchID(preventedMatchID int64) binance.ListPreventedMatchesServiceAPI {
+	s.args["PreventedMatchID"] = preventedMatchID
+	return s
+}
+
+func (s *spotListPreventedMatchesService) OrderID(orderID int64) binance.ListPreventedMatchesServiceAPI {
+	s.args["OrderID"] = orderID
+	return s
+}
+
+func (s *spotListPreventedMatchesService) FromPreventedMatchID(fromPreventedMatchID int64) binance.ListPreventedMatchesServiceAPI {
+	s.args["FromPreventedMatchID"] = fromPreventedMatchID
+	return s
+}
+
+func (s *spotListPreventedMatchesService) Limit(limit int) binance.ListPreventedMatchesServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotListPreventedMatchesService) Do(ctx context.Context, opts ...binance.RequestOption) ([]*binance.PreventedMatch, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotGetAccountService struct {
+	f    *FakeService[*binance.Account]
+	args Args
+}
+
+func (s *spotGetAccountService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.Account, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotGetAccountSnapshotService struct {
+	f    *FakeService[*binance.Snapshot]
+	args Args
+}
+
+func (s *spotGetAccountSnapshotService) Type(accountType string) binance.GetAccountSnapshotServiceAPI {
+	s.args["Type"] = accountType
+	return s
+}
+
+func (s *spotGetAccountSnapshotService) StartTime(startTime int64) binance.GetAccountSnapshotServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotGetAccountSnapshotService) EndTime(endTime int64) binance.GetAccountSnapshotServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotGetAccountSnapshotService) Limit(limit int) binance.GetAccountSnapshotServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotGetAccountSnapshotService) Do(ctx context.Context, opts ...binance.RequestOption) (*binance.Snapshot, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListDepositsService struct {
+	f    *FakeService[[]*binance.Deposit]
+	args Args
+}
+
+func (s *spotListDepositsService) Coin(coin string) binance.ListDepositsServiceAPI {
+	s.args["Coin"] = coin
+	return s
+}
+
+func (s *spotListDepositsService) Status(status int) binance.ListDepositsServiceAPI {
+	s.args["Status"] = status
+	return s
+}
+
+func (s *spotListDepositsService) StartTime(startTime int64) binance.ListDepositsServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotListDepositsService) EndTime(endTime int64) binance.ListDepositsServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotListDepositsService) Offset(offset int) binance.ListDepositsServiceAPI {
+	s.args["Offset"] = offset
+	return s
+}
+
+func (s *spotListDepositsService) Limit(limit int) binance.ListDepositsServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotListDepositsService) TxID(id string) binance.ListDepositsServiceAPI {
+	s.args["TxID"] = id
+	return s
+}
+
+func (s *spotListDepositsService) Do(ctx context.Context) ([]*binance.Deposit, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotGetDepositsAddressService struct {
+	f    *FakeService[*binance.GetDepositAddressResponse]
+	args Args
+}
+
+func (s *spotGetDepositsAddressService) Coin(coin string) binance.GetDepositsAddressServiceAPI {
+	s.args["Coin"] = coin
+	return s
+}
+
+func (s *spotGetDepositsAddressService) Network(network string) binance.GetDepositsAddressServiceAPI {
+	s.args["Network"] = network
+	return s
+}
+
+func (s *spotGetDepositsAddressService) Do(ctx context.Context) (*binance.GetDepositAddressResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCreateWithdrawService struct {
+	f    *FakeService[*binance.CreateWithdrawResponse]
+	args Args
+}
+
+func (s *spotCreateWithdrawService) Coin(v string) binance.CreateWithdrawServiceAPI {
+	s.args["Coin"] = v
+	return s
+}
+
+func (s *spotCreateWithdrawService) WithdrawOrderID(v string) binance.CreateWithdrawServiceAPI {
+	s.args["WithdrawOrderID"] = v
+	return s
+}
+
+func (s *spotCreateWithdrawService) Network(v string) binance.CreateWithdrawServiceAPI {
+	s.args["Network"] = v
+	return s
+}
+
+func (s *spotCreateWithdrawService) Address(v string) binance.CreateWithdrawServiceAPI {
+	s.args["Address"] = v
+	return s
+}
+
+func (s *spotCreateWithdrawService) AddressTag(v string) binance.CreateWithdrawServiceAPI {
+	s.args["AddressTag"] = v
+	return s
+}
+
+func (s *spotCreateWithdrawService) Amount(v string) binance.CreateWithdrawServiceAPI {
+	s.args["Amount"] = v
+	return s
+}
+
+func (s *spotCreateWithdrawService) TransactionFeeFlag(v bool) binance.CreateWithdrawServiceAPI {
+	s.args["TransactionFeeFlag"] = v
+	return s
+}
+
+func (s *spotCreateWithdrawService) Name(v string) binance.CreateWithdrawServiceAPI {
+	s.args["Name"] = v
+	return s
+}
+
+func (s *spotCreateWithdrawService) Do(ctx context.Context) (*binance.CreateWithdrawResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListWithdrawsService struct {
+	f    *FakeService[[]*binance.Withdraw]
+	args Args
+}
+
+func (s *spotListWithdrawsService) Coin(coin string) binance.ListWithdrawsServiceAPI {
+	s.args["Coin"] = coin
+	return s
+}
+
+func (s *spotListWithdrawsService) WithdrawOrderId(withdrawOrderId string) binance.ListWithdrawsServiceAPI {
+	s.args["WithdrawOrderId"] = withdrawOrderId
+	return s
+}
+
+func (s *spotListWithdrawsService) Status(status int) binance.ListWithdrawsServiceAPI {
+	s.args["Status"] = status
+	return s
+}
+
+func (s *spotListWithdrawsService) StartTime(startTime int64) binance.ListWithdrawsServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotListWithdrawsService) EndTime(endTime int64) binance.ListWithdrawsServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotListWithdrawsService) Offset(offset int) binance.ListWithdrawsServiceAPI {
+	s.args["Offset"] = offset
+	return s
+}
+
+func (s *spotListWithdrawsService) Limit(limit int) binance.ListWithdrawsServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotListWithdrawsService) Do(ctx context.Context) ([]*binance.Withdraw, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotGetAssetDetailService struct {
+	f    *FakeService[map[string]binance.AssetDetail]
+	args Args
+}
+
+func (s *spotGetAssetDetailService) Asset(asset string) binance.GetAssetDetailServiceAPI {
+	s.args["Asset"] = asset
+	return s
+}
+
+func (s *spotGetAssetDetailService) Do(ctx context.Context) (map[string]binance.AssetDetail, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotGetAllCoinsInfoService struct {
+	f    *FakeService[[]*binance.CoinInfo]
+	args Args
+}
+
+func (s *spotGetAllCoinsInfoService) Do(ctx context.Context) ([]*binance.CoinInfo, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotTradeFeeService struct {
+	f    *FakeService[[]*binance.TradeFeeDetails]
+	args Args
+}
+
+func (s *spotTradeFeeService) Symbol(symbol string) binance.TradeFeeServiceAPI {
+	s.args["Symbol"] = symbol
+	return s
+}
+
+func (s *spotTradeFeeService) Do(ctx context.Context) ([]*binance.TradeFeeDetails, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotCreateUserUniversalTransferService struct {
+	f    *FakeService[*binance.CreateUserUniversalTransferResponse]
+	args Args
+}
+
+func (s *spotCreateUserUniversalTransferService) Type(v string) binance.CreateUserUniversalTransferServiceAPI {
+	s.args["Type"] = v
+	return s
+}
+
+func (s *spotCreateUserUniversalTransferService) Asset(v string) binance.CreateUserUniversalTransferServiceAPI {
+	s.args["Asset"] = v
+	return s
+}
+
+func (s *spotCreateUserUniversalTransferService) Amount(v float64) binance.CreateUserUniversalTransferServiceAPI {
+	s.args["Amount"] = v
+	return s
+}
+
+func (s *spotCreateUserUniversalTransferService) FromSymbol(v string) binance.CreateUserUniversalTransferServiceAPI {
+	s.args["FromSymbol"] = v
+	return s
+}
+
+func (s *spotCreateUserUniversalTransferService) ToSymbol(v string) binance.CreateUserUniversalTransferServiceAPI {
+	s.args["ToSymbol"] = v
+	return s
+}
+
+func (s *spotCreateUserUniversalTransferService) Do(ctx context.Context) (*binance.CreateUserUniversalTransferResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListDustLogService struct {
+	f    *FakeService[*binance.DustResult]
+	args Args
+}
+
+func (s *spotListDustLogService) StartTime(startTime int64) binance.ListDustLogServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotListDustLogService) EndTime(endTime int64) binance.ListDustLogServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotListDustLogService) Do(ctx context.Context) (*binance.DustResult, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotListDustService struct {
+	f    *FakeService[*binance.ListDustResponse]
+	args Args
+}
+
+func (s *spotListDustService) Do(ctx context.Context) (*binance.ListDustResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotDustTransferService struct {
+	f    *FakeService[*binance.DustTransferResponse]
+	args Args
+}
+
+func (s *spotDustTransferService) Asset(asset []string) binance.DustTransferServiceAPI {
+	s.args["Asset"] = asset
+	return s
+}
+
+func (s *spotDustTransferService) Do(ctx context.Context) (*binance.DustTransferResponse, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotAssetDividendService struct {
+	f    *FakeService[*binance.DividendResponseWrapper]
+	args Args
+}
+
+func (s *spotAssetDividendService) Asset(asset string) binance.AssetDividendServiceAPI {
+	s.args["Asset"] = asset
+	return s
+}
+
+func (s *spotAssetDividendService) Limit(limit int) binance.AssetDividendServiceAPI {
+	s.args["Limit"] = limit
+	return s
+}
+
+func (s *spotAssetDividendService) StartTime(startTime int64) binance.AssetDividendServiceAPI {
+	s.args["StartTime"] = startTime
+	return s
+}
+
+func (s *spotAssetDividendService) EndTime(endTime int64) binance.AssetDividendServiceAPI {
+	s.args["EndTime"] = endTime
+	return s
+}
+
+func (s *spotAssetDividendService) Do(ctx context.Context) (*binance.DividendResponseWrapper, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotStartUserStreamService struct {
+	f    *FakeService[string]
+	args Args
+}
+
+func (s *spotStartUserStreamService) Do(ctx context.Context, opts ...binance.RequestOption) (string, error) {
+	return s.f.do(ctx, s.args)
+}
+
+type spotKeepaliveUserStreamService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *spotKeepaliveUserStreamService) ListenKey(listenKey string) binance.KeepaliveUserStreamServiceAPI {
+	s.args["ListenKey"] = listenKey
+	return s
+}
+
+func (s *spotKeepaliveUserStreamService) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type spotCloseUserStreamService struct {
+	f    *FakeService[struct{}]
+	args Args
+}
+
+func (s *spotCloseUserStreamService) ListenKey(listenKey string) binance.CloseUserStreamServiceAPI {
+	s.args["ListenKey"] = listenKey
+	return s
+}
+
+func (s *spotCloseUserStreamService) Do(ctx context.Context, opts ...binance.RequestOption) error {
+	_, err := s.f.do(ctx, s.args)
+	return err
+}
+
+type spotUserDataStream struct {
+	s *fakeStream[*binance.WsUserDataEvent]
+}
+
+func (s *spotUserDataStream) Margin() binance.UserDataStreamAPI {
+	s.s.set("Margin", true)
+	return s
+}
+
+func (s *spotUserDataStream) IsolatedMargin(symbol string) binance.UserDataStreamAPI {
+	s.s.set("IsolatedMargin", symbol)
+	return s
+}
+
+func (s *spotUserDataStream) OnResync(f func()) binance.UserDataStreamAPI {
+	s.s.set("OnResync", f)
+	return s
+}
+
+func (s *spotUserDataStream) KeepaliveInterval(d time.Duration) binance.UserDataStreamAPI {
+	s.s.set("KeepaliveInterval", d)
+	return s
+}
+
+func (s *spotUserDataStream) Start(ctx context.Context) error {
+	return s.s.Start(ctx)
+}
+
+func (s *spotUserDataStream) Stop() {
+	s.s.Stop()
+}
+
+func (s *spotUserDataStream) Done() <-chan struct{} {
+	return s.s.Done()
+}
+
+func (s *spotUserDataStream) ListenKey() string {
+	return s.s.ListenKey()
+}
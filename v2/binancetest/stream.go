@@ -0,0 +1,262 @@
+package binancetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// streamBuffer is the number of events a user data stream may lag behind before it is closed
+const streamBuffer = 1024
+
+// Execution types of order updates
+const (
+	executionTypeNew      = "NEW"
+	executionTypeTrade    = "TRADE"
+	executionTypeCanceled = "CANCELED"
+	executionTypeExpired  = "EXPIRED"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// stream is a connection to a user data stream
+type stream struct {
+	c chan []byte
+}
+
+// streamKey return the listen key of a user data stream path, which ends with /ws/<listenKey>
+func (s *Server) streamKey(p string) (string, bool) {
+	dir, key := path.Split(p)
+	if !strings.HasSuffix(dir, "/ws/") {
+		return "", false
+	}
+	for _, a := range s.accounts {
+		if a.listenKeys[key] != nil {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// serveStream send the events of a listen key to a websocket connection until it is closed.
+// It is called with s.mu held and subscribes before the handshake, so that the client gets
+// every event once connected.
+func (s *Server) serveStream(w http.ResponseWriter, r *http.Request, key string) {
+	st := &stream{c: make(chan []byte, streamBuffer)}
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	if s.streams[key] == nil {
+		s.streams[key] = make(map[*stream]bool)
+	}
+	s.streams[key][st] = true
+	s.mu.Unlock()
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.mu.Lock()
+		s.unsubscribe(key, st)
+		s.mu.Unlock()
+		return
+	}
+	defer conn.Close()
+
+	go func() {
+		// read to answer pings and notice the client closing the connection
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				s.mu.Lock()
+				s.unsubscribe(key, st)
+				s.mu.Unlock()
+				return
+			}
+		}
+	}()
+	for msg := range st.c {
+		if err := conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+			return
+		}
+	}
+}
+
+// unsubscribe close a stream of a listen key
+func (s *Server) unsubscribe(key string, st *stream) {
+	if s.streams[key][st] {
+		delete(s.streams[key], st)
+		close(st.c)
+	}
+}
+
+// closeListenKey delete a listen key of an account, closing its streams
+func (s *Server) closeListenKey(a *account, key string) {
+	delete(a.listenKeys, key)
+	for st := range s.streams[key] {
+		s.unsubscribe(key, st)
+	}
+	delete(s.streams, key)
+}
+
+// publish send an event to the user data streams of an account on m, closing the ones lagging behind
+func (s *Server) publish(a *account, m *Market, event interface{}) {
+	var msg []byte
+	for key, km := range a.listenKeys {
+		if km != m {
+			continue
+		}
+		for st := range s.streams[key] {
+			if msg == nil {
+				msg, _ = json.Marshal(event)
+			}
+			select {
+			case st.c <- msg:
+			default:
+				s.unsubscribe(key, st)
+			}
+		}
+	}
+}
+
+// reportOrder send an executionReport or ORDER_TRADE_UPDATE event of an order, t is the trade of
+// a TRADE execution
+func (m *Market) reportOrder(o *order, executionType string, t *trade, now int64) {
+	var lastQty, lastPrice, commission common.Decimal
+	var commissionAsset interface{}
+	tradeID, maker := int64(-1), false
+	if t != nil {
+		lastQty, lastPrice, commission = t.qty, t.price, t.commission
+		commissionAsset, tradeID, maker = t.commissionAsset, t.id, t.maker
+	}
+	if m.futures {
+		j := m.futuresOrderJSON(o)
+		realizedPnl := "0"
+		if t != nil {
+			realizedPnl = format8(t.realizedPnl)
+		}
+		m.s.publish(o.account, m, map[string]interface{}{
+			"e": "ORDER_TRADE_UPDATE",
+			"E": now,
+			"T": now,
+			"o": map[string]interface{}{
+				"s":  o.symbol,
+				"c":  o.clientOrderID,
+				"S":  o.side,
+				"o":  o.orderType,
+				"f":  o.timeInForce,
+				"q":  j["origQty"],
+				"p":  j["price"],
+				"ap": j["avgPrice"],
+				"sp": j["stopPrice"],
+				"x":  executionType,
+				"X":  o.status,
+				"i":  o.id,
+				"l":  lastQty.String(),
+				"z":  j["executedQty"],
+				"L":  lastPrice.String(),
+				"N":  commissionAsset,
+				"n":  commission.String(),
+				"T":  now,
+				"t":  tradeID,
+				"b":  "0",
+				"a":  "0",
+				"m":  maker,
+				"R":  o.reduceOnly,
+				"wt": "CONTRACT_PRICE",
+				"ot": o.orderType,
+				"ps": o.positionSide,
+				"cp": o.closePosition,
+				"rp": realizedPnl,
+			},
+		})
+		return
+	}
+	origClientOrderID := ""
+	if executionType == executionTypeCanceled {
+		origClientOrderID = o.clientOrderID
+	}
+	m.s.publish(o.account, m, map[string]interface{}{
+		"e": "executionReport",
+		"E": now,
+		"s": o.symbol,
+		"c": o.clientOrderID,
+		"S": o.side,
+		"o": o.orderType,
+		"f": o.timeInForce,
+		"q": format8(o.origQty),
+		"p": format8(o.price),
+		"P": format8(o.stopPrice),
+		"F": format8(o.icebergQty),
+		"g": o.orderListID(),
+		"C": origClientOrderID,
+		"x": executionType,
+		"X": o.status,
+		"r": "NONE",
+		"i": o.id,
+		"l": format8(lastQty),
+		"z": format8(o.executedQty),
+		"L": format8(lastPrice),
+		"n": format8(commission),
+		"N": commissionAsset,
+		"T": now,
+		"t": tradeID,
+		"w": o.working(),
+		"m": maker,
+		"O": o.time,
+		"Z": format8(o.cumQuote),
+		"Y": format8(lastQty.Mul(lastPrice)),
+		"Q": format8(o.origQuoteQty),
+	})
+}
+
+// reportAccount send an outboundAccountPosition or ACCOUNT_UPDATE event with the balances, and
+// futures position, of a symbol
+func (m *Market) reportAccount(a *account, symbol string, now int64) {
+	sym := m.symbols[symbol]
+	if m.futures {
+		p := a.position(symbol)
+		mark := m.books[symbol].markPrice()
+		m.s.publish(a, m, map[string]interface{}{
+			"e": "ACCOUNT_UPDATE",
+			"E": now,
+			"T": now,
+			"a": map[string]interface{}{
+				"m": "ORDER",
+				"B": []map[string]interface{}{{
+					"a":  sym.QuoteAsset,
+					"wb": format8(a.wallets[sym.QuoteAsset]),
+					"cw": format8(a.wallets[sym.QuoteAsset]),
+					"bc": "0",
+				}},
+				"P": []map[string]interface{}{{
+					"s":  symbol,
+					"pa": p.amount.Trim().String(),
+					"ep": p.entryPrice.Trim().String(),
+					"cr": "0",
+					"up": format8(p.amount.Mul(mark.Sub(p.entryPrice))),
+					"mt": "cross",
+					"iw": "0",
+					"ps": "BOTH",
+				}},
+			},
+		})
+		return
+	}
+	var balances []map[string]interface{}
+	for _, asset := range []string{sym.BaseAsset, sym.QuoteAsset} {
+		b := a.balance(asset)
+		balances = append(balances, map[string]interface{}{"a": asset, "f": format8(b.free), "l": format8(b.locked)})
+	}
+	m.s.publish(a, m, map[string]interface{}{
+		"e": "outboundAccountPosition",
+		"E": now,
+		"u": now,
+		"B": balances,
+	})
+}
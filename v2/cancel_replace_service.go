@@ -0,0 +1,272 @@
+package binance
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// CancelReplaceOrderService cancel an order and place a new one in a single request
+type CancelReplaceOrderService struct {
+	c                          *Client
+	symbol                     string
+	side                       SideType
+	orderType                  OrderType
+	cancelReplaceMode          CancelReplaceModeType
+	timeInForce                *TimeInForceType
+	quantity                   *string
+	quoteOrderQty              *string
+	price                      *string
+	cancelNewClientOrderID     *string
+	cancelOrigClientOrderID    *string
+	cancelOrderID              *int64
+	newClientOrderID           *string
+	stopPrice                  *string
+	trailingDelta              *string
+	icebergQuantity            *string
+	newOrderRespType           *NewOrderRespType
+	cancelRestrictions         *CancelRestrictionsType
+	orderRateLimitExceededMode *OrderRateLimitExceededModeType
+	selfTradePreventionMode    *SelfTradePreventionModeType
+}
+
+// Symbol set symbol
+func (s *CancelReplaceOrderService) Symbol(symbol string) *CancelReplaceOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side of the new order
+func (s *CancelReplaceOrderService) Side(side SideType) *CancelReplaceOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type of the new order
+func (s *CancelReplaceOrderService) Type(orderType OrderType) *CancelReplaceOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode
+func (s *CancelReplaceOrderService) CancelReplaceMode(mode CancelReplaceModeType) *CancelReplaceOrderService {
+	s.cancelReplaceMode = mode
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CancelReplaceOrderService) TimeInForce(timeInForce TimeInForceType) *CancelReplaceOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CancelReplaceOrderService) Quantity(quantity string) *CancelReplaceOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *CancelReplaceOrderService) QuoteOrderQty(quoteOrderQty string) *CancelReplaceOrderService {
+	s.quoteOrderQty = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *CancelReplaceOrderService) Price(price string) *CancelReplaceOrderService {
+	s.price = &price
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderId, the client order id of the cancellation
+func (s *CancelReplaceOrderService) CancelNewClientOrderID(cancelNewClientOrderID string) *CancelReplaceOrderService {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderId, the client order id of the order to cancel
+func (s *CancelReplaceOrderService) CancelOrigClientOrderID(cancelOrigClientOrderID string) *CancelReplaceOrderService {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelOrderID set cancelOrderId, the id of the order to cancel
+func (s *CancelReplaceOrderService) CancelOrderID(cancelOrderID int64) *CancelReplaceOrderService {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderId, the client order id of the new order
+func (s *CancelReplaceOrderService) NewClientOrderID(newClientOrderID string) *CancelReplaceOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CancelReplaceOrderService) StopPrice(stopPrice string) *CancelReplaceOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// TrailingDelta set trailingDelta
+func (s *CancelReplaceOrderService) TrailingDelta(trailingDelta string) *CancelReplaceOrderService {
+	s.trailingDelta = &trailingDelta
+	return s
+}
+
+// IcebergQuantity set icebergQty
+func (s *CancelReplaceOrderService) IcebergQuantity(icebergQuantity string) *CancelReplaceOrderService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CancelReplaceOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CancelReplaceOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// CancelRestrictions set cancelRestrictions, the status the order must have to be canceled
+func (s *CancelReplaceOrderService) CancelRestrictions(cancelRestrictions CancelRestrictionsType) *CancelReplaceOrderService {
+	s.cancelRestrictions = &cancelRestrictions
+	return s
+}
+
+// OrderRateLimitExceededMode set orderRateLimitExceededMode
+func (s *CancelReplaceOrderService) OrderRateLimitExceededMode(mode OrderRateLimitExceededModeType) *CancelReplaceOrderService {
+	s.orderRateLimitExceededMode = &mode
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode of the new order, the symbol default applies
+// when it is not set
+func (s *CancelReplaceOrderService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) *CancelReplaceOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// Do send request. When the cancellation or the new order fails, the response reporting the
+// result of both is returned along with the *common.APIError of the request.
+func (s *CancelReplaceOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelReplaceOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v3/order/cancelReplace",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":            s.symbol,
+		"side":              s.side,
+		"type":              s.orderType,
+		"cancelReplaceMode": s.cancelReplaceMode,
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQty != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQty
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.trailingDelta != nil {
+		m["trailingDelta"] = *s.trailingDelta
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.cancelRestrictions != nil {
+		m["cancelRestrictions"] = *s.cancelRestrictions
+	}
+	if s.orderRateLimitExceededMode != nil {
+		m["orderRateLimitExceededMode"] = *s.orderRateLimitExceededMode
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		// the errors of a failed cancel-replace report the result of both steps in their data
+		apiErr, ok := common.AsAPIError(err)
+		if !ok || len(apiErr.Data) == 0 || string(apiErr.Data) == "null" {
+			return nil, err
+		}
+		data = apiErr.Data
+	}
+	res = new(CancelReplaceOrderResponse)
+	if e := json.Unmarshal(data, res); e != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, e
+	}
+	return res, err
+}
+
+// CancelReplaceOrderResponse define cancel-replace order response. The response of each step is
+// set when it succeeded, and its error when it failed.
+type CancelReplaceOrderResponse struct {
+	CancelResult     CancelReplaceResultType
+	NewOrderResult   CancelReplaceResultType
+	CancelResponse   *CancelOrderResponse
+	CancelError      *common.APIError
+	NewOrderResponse *CreateOrderResponse
+	NewOrderError    *common.APIError
+}
+
+// UnmarshalJSON unmarshal the response of each step, or its error
+func (r *CancelReplaceOrderResponse) UnmarshalJSON(data []byte) (err error) {
+	var raw struct {
+		CancelResult     CancelReplaceResultType `json:"cancelResult"`
+		NewOrderResult   CancelReplaceResultType `json:"newOrderResult"`
+		CancelResponse   stdjson.RawMessage      `json:"cancelResponse"`
+		NewOrderResponse stdjson.RawMessage      `json:"newOrderResponse"`
+	}
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.CancelResult, r.NewOrderResult = raw.CancelResult, raw.NewOrderResult
+	if r.CancelError, err = unmarshalCancelReplaceStep(raw.CancelResponse, &r.CancelResponse); err != nil {
+		return err
+	}
+	r.NewOrderError, err = unmarshalCancelReplaceStep(raw.NewOrderResponse, &r.NewOrderResponse)
+	return err
+}
+
+// unmarshalCancelReplaceStep unmarshal the response of a step into v, or return its error
+func unmarshalCancelReplaceStep(data []byte, v interface{}) (*common.APIError, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	apiErr := new(common.APIError)
+	if err := json.Unmarshal(data, apiErr); err != nil {
+		return nil, err
+	}
+	if apiErr.Code != 0 {
+		return apiErr, nil
+	}
+	return nil, json.Unmarshal(data, v)
+}
@@ -0,0 +1,191 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type cancelReplaceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCancelReplaceService(t *testing.T) {
+	suite.Run(t, new(cancelReplaceServiceTestSuite))
+}
+
+func (s *cancelReplaceServiceTestSuite) TestCancelReplaceOrder() {
+	data := []byte(`{
+		"cancelResult": "SUCCESS",
+		"newOrderResult": "SUCCESS",
+		"cancelResponse": {
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "DnLo3vTAQcjha43lAZhZ0y",
+			"orderId": 9,
+			"orderListId": -1,
+			"clientOrderId": "osxN3JXAtJvKvCqGeMWMVR",
+			"price": "0.01000000",
+			"origQty": "0.000100",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL"
+		},
+		"newOrderResponse": {
+			"symbol": "BTCUSDT",
+			"orderId": 10,
+			"orderListId": -1,
+			"clientOrderId": "wOceeeOzNORyLiQfw7jd8S",
+			"transactTime": 1652928801803,
+			"price": "0.02000000",
+			"origQty": "0.040000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY"
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                     "BTCUSDT",
+			"side":                       SideTypeBuy,
+			"type":                       OrderTypeLimit,
+			"cancelReplaceMode":          CancelReplaceModeTypeStopOnFailure,
+			"timeInForce":                TimeInForceTypeGTC,
+			"quantity":                   "0.04",
+			"price":                      "0.02",
+			"cancelOrderId":              9,
+			"cancelNewClientOrderId":     "osxN3JXAtJvKvCqGeMWMVR",
+			"newClientOrderId":           "wOceeeOzNORyLiQfw7jd8S",
+			"newOrderRespType":           NewOrderRespTypeRESULT,
+			"cancelRestrictions":         CancelRestrictionsTypeOnlyNew,
+			"orderRateLimitExceededMode": OrderRateLimitExceededModeTypeCancelOnly,
+			"selfTradePreventionMode":    SelfTradePreventionModeTypeExpireMaker,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).CancelReplaceMode(CancelReplaceModeTypeStopOnFailure).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.04").Price("0.02").CancelOrderID(9).
+		CancelNewClientOrderID("osxN3JXAtJvKvCqGeMWMVR").NewClientOrderID("wOceeeOzNORyLiQfw7jd8S").
+		NewOrderRespType(NewOrderRespTypeRESULT).CancelRestrictions(CancelRestrictionsTypeOnlyNew).
+		OrderRateLimitExceededMode(OrderRateLimitExceededModeTypeCancelOnly).
+		SelfTradePreventionMode(SelfTradePreventionModeTypeExpireMaker).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(CancelReplaceResultTypeSuccess, res.CancelResult)
+	r.Equal(CancelReplaceResultTypeSuccess, res.NewOrderResult)
+	r.Nil(res.CancelError)
+	r.Nil(res.NewOrderError)
+	r.Equal(&CancelOrderResponse{
+		Symbol:                   "BTCUSDT",
+		OrigClientOrderID:        "DnLo3vTAQcjha43lAZhZ0y",
+		OrderID:                  9,
+		OrderListID:              -1,
+		ClientOrderID:            "osxN3JXAtJvKvCqGeMWMVR",
+		Price:                    "0.01000000",
+		OrigQuantity:             "0.000100",
+		ExecutedQuantity:         "0.00000000",
+		CummulativeQuoteQuantity: "0.00000000",
+		Status:                   OrderStatusTypeCanceled,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeSell,
+	}, res.CancelResponse)
+	r.Equal(int64(10), res.NewOrderResponse.OrderID)
+	r.Equal(OrderStatusTypeNew, res.NewOrderResponse.Status)
+	r.Equal("0.040000", res.NewOrderResponse.OrigQuantity)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestPartialFailure() {
+	data := []byte(`{
+		"code": -2021,
+		"msg": "Order cancel-replace partially failed.",
+		"data": {
+			"cancelResult": "SUCCESS",
+			"newOrderResult": "FAILURE",
+			"cancelResponse": {
+				"symbol": "BTCUSDT",
+				"origClientOrderId": "86M8erehfExV8z2RC8Zo8k",
+				"orderId": 3,
+				"orderListId": -1,
+				"clientOrderId": "G1kLo6aDv2KGNTFcjfTSFq",
+				"status": "CANCELED"
+			},
+			"newOrderResponse": {
+				"code": -2010,
+				"msg": "Order would immediately match and take."
+			}
+		}
+	}`)
+	s.mockDo(data, nil, http.StatusConflict)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimitMaker).CancelReplaceMode(CancelReplaceModeTypeAllowFailure).
+		Quantity("0.1").Price("30000").CancelOrderID(3).Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.True(common.IsAPIErrorCode(err, -2021))
+	apiErr, ok := common.AsAPIError(err)
+	r.True(ok)
+	r.Equal(http.StatusConflict, apiErr.StatusCode)
+	r.NotNil(res)
+	r.Equal(CancelReplaceResultTypeSuccess, res.CancelResult)
+	r.Equal(CancelReplaceResultTypeFailure, res.NewOrderResult)
+	r.Nil(res.CancelError)
+	r.Equal(OrderStatusTypeCanceled, res.CancelResponse.Status)
+	r.Nil(res.NewOrderResponse)
+	r.Equal(int64(-2010), res.NewOrderError.Code)
+	r.Equal("Order would immediately match and take.", res.NewOrderError.Message)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestFailure() {
+	data := []byte(`{
+		"code": -2022,
+		"msg": "Order cancel-replace failed.",
+		"data": {
+			"cancelResult": "FAILURE",
+			"newOrderResult": "NOT_ATTEMPTED",
+			"cancelResponse": {
+				"code": -2011,
+				"msg": "Unknown order sent."
+			},
+			"newOrderResponse": null
+		}
+	}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimit).CancelReplaceMode(CancelReplaceModeTypeStopOnFailure).
+		Quantity("0.1").Price("30000").CancelOrigClientOrderID("gone").Do(newContext())
+	r := s.r()
+	r.True(common.IsAPIErrorCode(err, -2022))
+	r.NotNil(res)
+	r.Equal(CancelReplaceResultTypeFailure, res.CancelResult)
+	r.Equal(CancelReplaceResultTypeNotAttempted, res.NewOrderResult)
+	r.Nil(res.CancelResponse)
+	r.Equal(int64(-2011), res.CancelError.Code)
+	r.True(common.IsAPIErrorCode(res.CancelError, common.ErrorCodeCancelRejected))
+	r.Nil(res.NewOrderResponse)
+	r.Nil(res.NewOrderError)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestOtherError() {
+	s.mockDo([]byte(`{"code":-1102,"msg":"Mandatory parameter 'cancelReplaceMode' was not sent."}`), nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Do(newContext())
+	s.r().True(common.IsAPIErrorCode(err, -1102))
+	s.r().Nil(res)
+}
@@ -1,13 +1,8 @@
 package binance
 
 import (
-	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
 	"crypto/tls"
-	"fmt"
-	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
@@ -20,6 +15,7 @@ import (
 	"github.com/adshao/go-binance/v2/common"
 	"github.com/adshao/go-binance/v2/delivery"
 	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/internal/transport"
 )
 
 // SideType define side type of order
@@ -37,6 +33,30 @@ type NewOrderRespType string
 // OrderStatusType define order status type
 type OrderStatusType string
 
+// SelfTradePreventionModeType define the self-trade prevention mode of an order
+type SelfTradePreventionModeType string
+
+// CancelReplaceModeType define the behavior of a cancel-replace order when the cancellation fails
+type CancelReplaceModeType string
+
+// CancelReplaceResultType define the result of the cancellation or placement of a cancel-replace order
+type CancelReplaceResultType string
+
+// CancelRestrictionsType define the status an order must have to be canceled
+type CancelRestrictionsType string
+
+// OrderRateLimitExceededModeType define the behavior of a cancel-replace order exceeding the order rate limit
+type OrderRateLimitExceededModeType string
+
+// ContingencyType define the contingency type of an order list
+type ContingencyType string
+
+// ListStatusType define the status type of an order list
+type ListStatusType string
+
+// ListOrderStatusType define the order status of an order list
+type ListOrderStatusType string
+
 // SymbolType define symbol type
 type SymbolType string
 
@@ -134,13 +154,46 @@ const (
 	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
 	NewOrderRespTypeFULL   NewOrderRespType = "FULL"
 
+	CancelReplaceModeTypeStopOnFailure CancelReplaceModeType = "STOP_ON_FAILURE"
+	CancelReplaceModeTypeAllowFailure  CancelReplaceModeType = "ALLOW_FAILURE"
+
+	CancelReplaceResultTypeSuccess      CancelReplaceResultType = "SUCCESS"
+	CancelReplaceResultTypeFailure      CancelReplaceResultType = "FAILURE"
+	CancelReplaceResultTypeNotAttempted CancelReplaceResultType = "NOT_ATTEMPTED"
+
+	CancelRestrictionsTypeOnlyNew             CancelRestrictionsType = "ONLY_NEW"
+	CancelRestrictionsTypeOnlyPartiallyFilled CancelRestrictionsType = "ONLY_PARTIALLY_FILLED"
+
+	OrderRateLimitExceededModeTypeDoNothing  OrderRateLimitExceededModeType = "DO_NOTHING"
+	OrderRateLimitExceededModeTypeCancelOnly OrderRateLimitExceededModeType = "CANCEL_ONLY"
+
+	ContingencyTypeOCO ContingencyType = "OCO"
+	ContingencyTypeOTO ContingencyType = "OTO"
+
+	ListStatusTypeResponse    ListStatusType = "RESPONSE"
+	ListStatusTypeExecStarted ListStatusType = "EXEC_STARTED"
+	ListStatusTypeUpdated     ListStatusType = "UPDATED"
+	ListStatusTypeAllDone     ListStatusType = "ALL_DONE"
+
+	ListOrderStatusTypeExecuting ListOrderStatusType = "EXECUTING"
+	ListOrderStatusTypeAllDone   ListOrderStatusType = "ALL_DONE"
+	ListOrderStatusTypeReject    ListOrderStatusType = "REJECT"
+
 	OrderStatusTypeNew             OrderStatusType = "NEW"
+	OrderStatusTypePendingNew      OrderStatusType = "PENDING_NEW"
 	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
 	OrderStatusTypeFilled          OrderStatusType = "FILLED"
 	OrderStatusTypeCanceled        OrderStatusType = "CANCELED"
 	OrderStatusTypePendingCancel   OrderStatusType = "PENDING_CANCEL"
 	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
 	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
+	OrderStatusTypeExpiredInMatch  OrderStatusType = "EXPIRED_IN_MATCH"
+
+	SelfTradePreventionModeTypeNone        SelfTradePreventionModeType = "NONE"
+	SelfTradePreventionModeTypeExpireTaker SelfTradePreventionModeType = "EXPIRE_TAKER"
+	SelfTradePreventionModeTypeExpireMaker SelfTradePreventionModeType = "EXPIRE_MAKER"
+	SelfTradePreventionModeTypeExpireBoth  SelfTradePreventionModeType = "EXPIRE_BOTH"
+	SelfTradePreventionModeTypeDecrement   SelfTradePreventionModeType = "DECREMENT"
 
 	SymbolTypeSpot SymbolType = "SPOT"
 
@@ -160,12 +213,14 @@ const (
 	SymbolFilterTypeNotional         SymbolFilterType = "NOTIONAL"
 	SymbolFilterTypeIcebergParts     SymbolFilterType = "ICEBERG_PARTS"
 	SymbolFilterTypeMarketLotSize    SymbolFilterType = "MARKET_LOT_SIZE"
+	SymbolFilterTypeMaxNumOrders     SymbolFilterType = "MAX_NUM_ORDERS"
 	SymbolFilterTypeMaxNumAlgoOrders SymbolFilterType = "MAX_NUM_ALGO_ORDERS"
 
 	UserDataEventTypeOutboundAccountPosition UserDataEventType = "outboundAccountPosition"
 	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
 	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
 	UserDataEventTypeListStatus              UserDataEventType = "ListStatus"
+	UserDataEventTypeListenKeyExpired        UserDataEventType = "listenKeyExpired"
 
 	MarginTransferTypeToMargin MarginTransferType = 1
 	MarginTransferTypeToMain   MarginTransferType = 2
@@ -266,15 +321,24 @@ func getAPIEndpoint() string {
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
-func NewClient(apiKey, secretKey string) *Client {
-	return &Client{
+// Without options, the client is configured with the package variables.
+func NewClient(apiKey, secretKey string, opts ...ClientOption) *Client {
+	o := newClientOptions(opts)
+	c := &Client{
 		APIKey:     apiKey,
 		SecretKey:  secretKey,
-		BaseURL:    getAPIEndpoint(),
+		BaseURL:    o.apiURL(),
 		UserAgent:  "Binance/golang",
-		HTTPClient: http.DefaultClient,
+		HTTPClient: o.client(),
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
+	if o.logger != nil {
+		c.Logger = o.logger
+	}
+	if len(opts) > 0 {
+		c.ws = o.wsClient()
+	}
+	return c
 }
 
 // NewProxiedClient passing a proxy url
@@ -300,13 +364,13 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 }
 
 // NewFuturesClient initialize client for futures API
-func NewFuturesClient(apiKey, secretKey string) *futures.Client {
-	return futures.NewClient(apiKey, secretKey)
+func NewFuturesClient(apiKey, secretKey string, opts ...futures.ClientOption) *futures.Client {
+	return futures.NewClient(apiKey, secretKey, opts...)
 }
 
 // NewDeliveryClient initialize client for coin-M futures API
-func NewDeliveryClient(apiKey, secretKey string) *delivery.Client {
-	return delivery.NewClient(apiKey, secretKey)
+func NewDeliveryClient(apiKey, secretKey string, opts ...delivery.ClientOption) *delivery.Client {
+	return delivery.NewClient(apiKey, secretKey, opts...)
 }
 
 type doFunc func(req *http.Request) (*http.Response, error)
@@ -315,13 +379,38 @@ type doFunc func(req *http.Request) (*http.Response, error)
 type Client struct {
 	APIKey     string
 	SecretKey  string
+	Signer     common.Signer // signs SIGNED requests instead of the HMAC of SecretKey if set
 	BaseURL    string
 	UserAgent  string
 	HTTPClient *http.Client
 	Debug      bool
 	Logger     *log.Logger
 	TimeOffset int64
+	// RateLimiter delays requests that would exceed the rate limits if set
+	RateLimiter *common.RateLimiter
+	// RetryPolicy retries requests failing with a transient error if set
+	RetryPolicy *common.RetryPolicy
+	// OnResponse is called with the status code and headers of every response if set
+	OnResponse common.ResponseHook
 	do         doFunc
+	ws         *WsClient
+}
+
+// WsClient return the websocket client with the endpoints and settings given to NewClient,
+// or with those of the package variables
+func (c *Client) WsClient() *WsClient {
+	if c.ws != nil {
+		return c.ws
+	}
+	return defaultWsClient()
+}
+
+// signer return the signer of SIGNED requests
+func (c *Client) signer() common.Signer {
+	if c.Signer != nil {
+		return c.Signer
+	}
+	return common.NewHMACSigner(c.SecretKey)
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -330,6 +419,39 @@ func (c *Client) debug(format string, v ...interface{}) {
 	}
 }
 
+// newTransport return the transport sending requests with the settings of c
+func (c *Client) newTransport() *transport.Client {
+	f := c.do
+	if f == nil {
+		f = c.HTTPClient.Do
+	}
+	return &transport.Client{
+		APIKey:        c.APIKey,
+		Signer:        c.signer(),
+		BaseURL:       c.BaseURL,
+		TimeOffset:    c.TimeOffset,
+		RateLimiter:   c.RateLimiter,
+		RetryPolicy:   c.RetryPolicy,
+		Do:            f,
+		Debug:         c.debug,
+		OnResponse:    c.OnResponse,
+		OrderEndpoint: "/api/v3/order",
+		LookupOrder:   c.lookupOrder,
+		SyncTime: func(ctx context.Context) (int64, error) {
+			return c.NewSetServerTimeService().Do(ctx)
+		},
+	}
+}
+
+// lookupOrder return the JSON of an order by client order id, to check if a failed placement went through
+func (c *Client) lookupOrder(ctx context.Context, symbol, clientOrderID string) ([]byte, error) {
+	order, err := c.NewGetOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(order)
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -339,100 +461,26 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if err != nil {
 		return err
 	}
-
-	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.endpoint)
-	if r.recvWindow > 0 {
-		r.setParam(recvWindowKey, r.recvWindow)
-	}
-	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
-	}
-	queryString := r.query.Encode()
-	body := &bytes.Buffer{}
-	bodyString := r.form.Encode()
-	header := http.Header{}
-	if r.header != nil {
-		header = r.header.Clone()
-	}
-	if bodyString != "" {
-		header.Set("Content-Type", "application/x-www-form-urlencoded")
-		body = bytes.NewBufferString(bodyString)
-	}
-	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
-		header.Set("X-MBX-APIKEY", c.APIKey)
-	}
-
-	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		mac := hmac.New(sha256.New, []byte(c.SecretKey))
-		_, err = mac.Write([]byte(raw))
-		if err != nil {
-			return err
-		}
-		v := url.Values{}
-		v.Set(signatureKey, fmt.Sprintf("%x", (mac.Sum(nil))))
-		if queryString == "" {
-			queryString = v.Encode()
-		} else {
-			queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
-		}
-	}
-	if queryString != "" {
-		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
+	tr := r.toTransport()
+	err = c.newTransport().Parse(tr)
+	if err != nil {
+		return err
 	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
-
-	r.fullURL = fullURL
-	r.header = header
-	r.body = body
+	r.fullURL, r.header, r.body = tr.FullURL, tr.Header, tr.Body
 	return nil
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
-	err = c.parseRequest(r, opts...)
-	if err != nil {
-		return []byte{}, err
-	}
-	req, err := http.NewRequest(r.method, r.fullURL, r.body)
-	if err != nil {
-		return []byte{}, err
-	}
-	req = req.WithContext(ctx)
-	req.Header = r.header
-	c.debug("request: %#v", req)
-	f := c.do
-	if f == nil {
-		f = c.HTTPClient.Do
-	}
-	res, err := f(req)
-	if err != nil {
-		return []byte{}, err
+	// set request options from user
+	for _, opt := range opts {
+		opt(r)
 	}
-	data, err = ioutil.ReadAll(res.Body)
+	err = r.validate()
 	if err != nil {
 		return []byte{}, err
 	}
-	defer func() {
-		cerr := res.Body.Close()
-		// Only overwrite the retured error if the original error was nil and an
-		// error occurred while closing the body.
-		if err == nil && cerr != nil {
-			err = cerr
-		}
-	}()
-	c.debug("response: %#v", res)
-	c.debug("response body: %s", string(data))
-	c.debug("response status code: %d", res.StatusCode)
-
-	if res.StatusCode >= http.StatusBadRequest {
-		apiErr := new(common.APIError)
-		e := json.Unmarshal(data, apiErr)
-		if e != nil {
-			c.debug("failed to unmarshal json: %s", e)
-		}
-		return nil, apiErr
-	}
-	return data, nil
+	data, _, err = c.newTransport().Call(ctx, r.toTransport())
+	return data, err
 }
 
 // SetApiEndpoint set api Endpoint
@@ -506,6 +554,31 @@ func (c *Client) NewCreateOCOService() *CreateOCOService {
 	return &CreateOCOService{c: c}
 }
 
+// NewCreateOrderListOCOService init creating order list OCO service
+func (c *Client) NewCreateOrderListOCOService() *CreateOrderListOCOService {
+	return &CreateOrderListOCOService{c: c}
+}
+
+// NewCreateOrderListOTOService init creating order list OTO service
+func (c *Client) NewCreateOrderListOTOService() *CreateOrderListOTOService {
+	return &CreateOrderListOTOService{c: c}
+}
+
+// NewCreateOrderListOTOCOService init creating order list OTOCO service
+func (c *Client) NewCreateOrderListOTOCOService() *CreateOrderListOTOCOService {
+	return &CreateOrderListOTOCOService{c: c}
+}
+
+// NewGetOrderListService init get order list service
+func (c *Client) NewGetOrderListService() *GetOrderListService {
+	return &GetOrderListService{c: c}
+}
+
+// NewListAllOrderListsService init listing all order lists service
+func (c *Client) NewListAllOrderListsService() *ListAllOrderListsService {
+	return &ListAllOrderListsService{c: c}
+}
+
 // NewCancelOCOService init cancel OCO service
 func (c *Client) NewCancelOCOService() *CancelOCOService {
 	return &CancelOCOService{c: c}
@@ -521,6 +594,16 @@ func (c *Client) NewCancelOrderService() *CancelOrderService {
 	return &CancelOrderService{c: c}
 }
 
+// NewCancelReplaceOrderService init cancel-replace order service
+func (c *Client) NewCancelReplaceOrderService() *CancelReplaceOrderService {
+	return &CancelReplaceOrderService{c: c}
+}
+
+// NewAmendOrderKeepPriorityService init amend order keep priority service
+func (c *Client) NewAmendOrderKeepPriorityService() *AmendOrderKeepPriorityService {
+	return &AmendOrderKeepPriorityService{c: c}
+}
+
 // NewCancelOpenOrdersService init cancel open orders service
 func (c *Client) NewCancelOpenOrdersService() *CancelOpenOrdersService {
 	return &CancelOpenOrdersService{c: c}
@@ -541,6 +624,11 @@ func (c *Client) NewListOrdersService() *ListOrdersService {
 	return &ListOrdersService{c: c}
 }
 
+// NewListPreventedMatchesService init listing prevented matches service
+func (c *Client) NewListPreventedMatchesService() *ListPreventedMatchesService {
+	return &ListPreventedMatchesService{c: c}
+}
+
 // NewGetAccountService init getting account service
 func (c *Client) NewGetAccountService() *GetAccountService {
 	return &GetAccountService{c: c}
@@ -631,6 +719,11 @@ func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
 	return &CloseUserStreamService{c: c}
 }
 
+// NewUserDataStream init a user data stream passing its events to handler, opened by Start
+func (c *Client) NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) *UserDataStream {
+	return &UserDataStream{c: c, handler: handler, errHandler: errHandler}
+}
+
 // NewExchangeInfoService init exchange info service
 func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
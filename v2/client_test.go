@@ -3,6 +3,7 @@ package binance
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -167,3 +168,33 @@ func TestFormatTimestamp(t *testing.T) {
 	tm, _ := time.Parse("2006-01-02 15:04:05", "2018-06-01 01:01:01")
 	assert.Equal(t, int64(1527814861000), FormatTimestamp(tm))
 }
+
+type fakeSigner struct {
+	payloads []string
+}
+
+func (s *fakeSigner) Sign(payload []byte) (string, error) {
+	s.payloads = append(s.payloads, string(payload))
+	return "a/b+c=", nil
+}
+
+func TestParseRequestWithSigner(t *testing.T) {
+	signer := new(fakeSigner)
+	c := NewClient("dummyAPIKey", "")
+	c.Signer = signer
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v3/order",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", "LTCBTC")
+	r.setFormParam("side", "BUY")
+	require.NoError(t, c.parseRequest(r))
+
+	u, err := url.Parse(r.fullURL)
+	require.NoError(t, err)
+	assert.Contains(t, u.RawQuery, "signature=a%2Fb%2Bc%3D")
+	assert.Equal(t, "a/b+c=", u.Query().Get(signatureKey))
+	require.Len(t, signer.payloads, 1)
+	assert.Equal(t, fmt.Sprintf("symbol=LTCBTC&timestamp=%s", u.Query().Get(timestampKey))+"side=BUY", signer.payloads[0])
+}
@@ -0,0 +1,195 @@
+// Command binance-klines downloads the klines of a symbol over a date range and writes them as
+// CSV, JSON Lines or Parquet.
+//
+//	binance-klines -symbol BTCUSDT -interval 1m -start 2023-01-01 -end 2023-02-01 -out btcusdt.parquet
+//
+// Klines are spooled to <out>.part while downloading, so that an interrupted run resumes where it
+// stopped when started again with the same flags. Missing klines are requested again before the
+// output is written, and gaps the exchange can't fill, such as maintenance windows, are reported.
+//
+// The spot klines are downloaded by default. Set -market futures for USDⓈ-M futures klines, with
+// -type continuous, mark or index for continuous contract, mark price or index price klines. The
+// output schema is the same for every type:
+//
+//	open_time, open, high, low, close, volume, close_time, quote_volume, trades,
+//	taker_buy_base_volume, taker_buy_quote_volume
+//
+// Times are in milliseconds since the epoch, prices and volumes are decimal strings as sent by the
+// exchange. Mark price and index price klines have no volumes, which are left empty, nor trades.
+// Parquet files have uncompressed PLAIN encoded columns, of INT64 and UTF8 BYTE_ARRAY values with
+// the times as TIMESTAMP_MILLIS.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Markets and kline types
+const (
+	marketSpot    = "spot"
+	marketFutures = "futures"
+
+	typeKlines     = "klines"
+	typeContinuous = "continuous"
+	typeMark       = "mark"
+	typeIndex      = "index"
+)
+
+// config hold the flags of a run
+type config struct {
+	market       string
+	klineType    string
+	symbol       string
+	contractType string
+	interval     string
+	start        int64
+	end          int64
+	format       string
+	out          string
+	baseURL      string
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	cfg, err := parseFlags(os.Args[1:], time.Now(), os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err == nil {
+		err = run(ctx, cfg, os.Stderr)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "binance-klines:", err)
+		os.Exit(1)
+	}
+}
+
+// parseFlags parse the command line, now being the default end
+func parseFlags(args []string, now time.Time, output io.Writer) (*config, error) {
+	cfg := new(config)
+	fs := flag.NewFlagSet("binance-klines", flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.StringVar(&cfg.market, "market", marketSpot, "market: spot or futures")
+	fs.StringVar(&cfg.klineType, "type", typeKlines, "futures kline type: klines, continuous, mark or index")
+	fs.StringVar(&cfg.symbol, "symbol", "", "symbol, or pair for continuous and index klines")
+	fs.StringVar(&cfg.contractType, "contract-type", "PERPETUAL", "contract type of continuous klines")
+	fs.StringVar(&cfg.interval, "interval", "1m", "kline interval, such as 1m, 4h or 1d")
+	start := fs.String("start", "", "start date or time: 2006-01-02, RFC 3339 or milliseconds")
+	end := fs.String("end", "", "end date or time, excluded, now by default")
+	fs.StringVar(&cfg.format, "format", "", "output format: csv, jsonl or parquet, from the -out extension by default")
+	fs.StringVar(&cfg.out, "out", "", "output file")
+	fs.StringVar(&cfg.baseURL, "base-url", "", "REST API base URL, such as a local stand-in of the exchange")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if cfg.symbol == "" || cfg.out == "" || *start == "" {
+		fs.Usage()
+		return nil, errors.New("-symbol, -start and -out are required")
+	}
+	cfg.symbol = strings.ToUpper(cfg.symbol)
+	if cfg.start, err = parseTime(*start); err != nil {
+		return nil, fmt.Errorf("invalid -start: %w", err)
+	}
+	cfg.end = now.UnixNano() / int64(time.Millisecond)
+	if *end != "" {
+		if cfg.end, err = parseTime(*end); err != nil {
+			return nil, fmt.Errorf("invalid -end: %w", err)
+		}
+	}
+	if cfg.end <= cfg.start {
+		return nil, errors.New("-end must be after -start")
+	}
+	if _, err = parseInterval(cfg.interval); err != nil {
+		return nil, err
+	}
+	switch {
+	case cfg.market != marketSpot && cfg.market != marketFutures:
+		return nil, fmt.Errorf("unknown market %q", cfg.market)
+	case cfg.klineType != typeKlines && cfg.klineType != typeContinuous && cfg.klineType != typeMark && cfg.klineType != typeIndex:
+		return nil, fmt.Errorf("unknown kline type %q", cfg.klineType)
+	case cfg.market == marketSpot && cfg.klineType != typeKlines:
+		return nil, fmt.Errorf("%s klines are only listed for futures", cfg.klineType)
+	}
+	if cfg.format == "" {
+		cfg.format = strings.TrimPrefix(filepath.Ext(cfg.out), ".")
+	}
+	if _, ok := writers[cfg.format]; !ok {
+		return nil, fmt.Errorf("unknown format %q, set -format to csv, jsonl or parquet", cfg.format)
+	}
+	return cfg, nil
+}
+
+// parseTime parse a date, an RFC 3339 time or milliseconds
+func parseTime(s string) (int64, error) {
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return ms, nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t, err = time.Parse(time.RFC3339, s)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano() / int64(time.Millisecond), nil
+}
+
+// run download the klines of cfg, fill the gaps and write the output
+func run(ctx context.Context, cfg *config, log io.Writer) error {
+	src, err := newSource(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	sp := &spool{path: cfg.out + ".part"}
+	records, err := sp.load()
+	if err != nil {
+		return err
+	}
+	from := cfg.start
+	if n := len(records); n > 0 && records[n-1].OpenTime >= from {
+		from = records[n-1].OpenTime + 1
+		fmt.Fprintf(log, "resuming after the kline opening at %s\n", formatTime(records[n-1].OpenTime))
+	}
+	if err := download(ctx, src, sp, from, cfg.end); err != nil {
+		return err
+	}
+
+	step, _ := parseInterval(cfg.interval)
+	if records, err = sp.load(); err != nil {
+		return err
+	}
+	for _, g := range findGaps(records, step) {
+		if err := download(ctx, src, sp, g.from, g.to); err != nil {
+			return err
+		}
+	}
+	if records, err = sp.load(); err != nil {
+		return err
+	}
+	for _, g := range findGaps(records, step) {
+		fmt.Fprintf(log, "gap from %s to %s\n", formatTime(g.from), formatTime(g.to))
+	}
+
+	if err := writeFile(cfg.out, cfg.format, records); err != nil {
+		return err
+	}
+	fmt.Fprintf(log, "wrote %d klines to %s\n", len(records), cfg.out)
+	return sp.remove()
+}
+
+func formatTime(ms int64) string {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	minute = int64(60 * 1000)
+	t0     = int64(1672531200000) // 2023-01-01
+)
+
+// standIn is a local stand-in of the exchange serving 1m klines from t0
+type standIn struct {
+	mu       sync.Mutex
+	klines   int              // number of klines listed
+	holes    map[int64]bool   // klines missing from the first response including them
+	missing  map[int64]bool   // klines never listed
+	failFrom int              // number of kline requests served before failing, if positive
+	starts   []int64          // start times of the kline requests
+	served   map[string]int64 // kline requests by path
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.URL.Path {
+	case "/api/v3/exchangeInfo", "/fapi/v1/exchangeInfo":
+		w.Write([]byte(`{"rateLimits":[{"rateLimitType":"REQUEST_WEIGHT","interval":"MINUTE","intervalNum":1,"limit":6000}],"symbols":[]}`))
+		return
+	case "/api/v3/klines", "/fapi/v1/markPriceKlines":
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	s.served[r.URL.Path]++
+	if s.failFrom > 0 && len(s.starts) >= s.failFrom {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":-1121,"msg":"Invalid symbol."}`))
+		return
+	}
+	q := r.URL.Query()
+	start, _ := strconv.ParseInt(q.Get("startTime"), 10, 64)
+	end, _ := strconv.ParseInt(q.Get("endTime"), 10, 64)
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	s.starts = append(s.starts, start)
+	rows := []string{}
+	for i := 0; i < s.klines && len(rows) < limit; i++ {
+		open := t0 + int64(i)*minute
+		if open < start || open > end || s.missing[open] {
+			continue
+		}
+		if s.holes[open] {
+			delete(s.holes, open)
+			continue
+		}
+		rows = append(rows, fmt.Sprintf(`[%d,"%d.10","%d.50","%d.00","%d.20","1.5",%d,"15000.5",%d,"0.5","5000"]`,
+			open, 10000+i, 10000+i, 10000+i, 10000+i, open+minute-1, i))
+	}
+	w.Write([]byte("[" + strings.Join(rows, ",") + "]"))
+}
+
+type klinesTestSuite struct {
+	suite.Suite
+	standIn *standIn
+	srv     *httptest.Server
+	dir     string
+	log     bytes.Buffer
+}
+
+func TestKlines(t *testing.T) {
+	suite.Run(t, new(klinesTestSuite))
+}
+
+func (s *klinesTestSuite) SetupTest() {
+	s.standIn = &standIn{klines: 2500, holes: map[int64]bool{}, missing: map[int64]bool{}, served: map[string]int64{}}
+	s.srv = httptest.NewServer(s.standIn)
+	s.dir = s.T().TempDir()
+	s.log.Reset()
+}
+
+func (s *klinesTestSuite) TearDownTest() {
+	s.srv.Close()
+}
+
+// run the command with args and the flags pointing at the stand-in
+func (s *klinesTestSuite) run(args ...string) error {
+	args = append(args, "-base-url", s.srv.URL, "-start", strconv.FormatInt(t0, 10))
+	cfg, err := parseFlags(args, time.Unix(0, (t0+3000*minute)*int64(time.Millisecond)), &s.log)
+	if err != nil {
+		return err
+	}
+	return run(context.Background(), cfg, &s.log)
+}
+
+func (s *klinesTestSuite) TestCSVWithGaps() {
+	s.standIn.holes[t0+1500*minute] = true
+	s.standIn.missing[t0+2000*minute] = true
+	s.standIn.missing[t0+2001*minute] = true
+	out := filepath.Join(s.dir, "btcusdt.csv")
+
+	s.Require().NoError(s.run("-symbol", "btcusdt", "-out", out))
+	data, err := os.ReadFile(out)
+	s.Require().NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	s.Require().Len(lines, 1+2500-2)
+	s.Equal("open_time,open,high,low,close,volume,close_time,quote_volume,trades,taker_buy_base_volume,taker_buy_quote_volume", lines[0])
+	s.Equal(fmt.Sprintf("%d,10000.10,10000.50,10000.00,10000.20,1.5,%d,15000.5,0,0.5,5000", t0, t0+minute-1), lines[1])
+	s.Contains(lines[1501], fmt.Sprintf("%d,11500.10", t0+1500*minute))
+	s.Contains(s.log.String(), "gap from 2023-01-02T09:20:00Z to 2023-01-02T09:22:00Z")
+	s.NotContains(s.log.String(), "01:00:00Z")
+	s.NoFileExists(out + ".part")
+}
+
+func (s *klinesTestSuite) TestResume() {
+	s.standIn.failFrom = 1
+	out := filepath.Join(s.dir, "btcusdt.jsonl")
+	s.Require().Error(s.run("-symbol", "BTCUSDT", "-out", out))
+	s.FileExists(out + ".part")
+	s.NoFileExists(out)
+	// a line cut short by the interruption
+	f, err := os.OpenFile(out+".part", os.O_APPEND|os.O_WRONLY, 0)
+	s.Require().NoError(err)
+	f.WriteString(`{"open_time":16725`)
+	f.Close()
+
+	s.standIn.failFrom = 0
+	s.standIn.starts = nil
+	s.Require().NoError(s.run("-symbol", "BTCUSDT", "-out", out))
+	s.Contains(s.log.String(), "resuming after the kline opening at 2023-01-01T16:39:00Z")
+	s.Equal(t0+999*minute+1, s.standIn.starts[0])
+
+	data, err := os.ReadFile(out)
+	s.Require().NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	s.Require().Len(lines, 2500)
+	r := new(record)
+	s.Require().NoError(json.Unmarshal([]byte(lines[2499]), r))
+	s.Equal(t0+2499*minute, r.OpenTime)
+	s.Equal("12499.20", r.Close)
+	s.Equal(int64(2499), r.Trades)
+}
+
+func (s *klinesTestSuite) TestParquet() {
+	out := filepath.Join(s.dir, "mark.parquet")
+	s.Require().NoError(s.run("-market", "futures", "-type", "mark", "-symbol", "BTCUSDT", "-interval", "1m", "-out", out))
+	s.Equal(int64(2), s.standIn.served["/fapi/v1/markPriceKlines"])
+
+	data, err := os.ReadFile(out)
+	s.Require().NoError(err)
+	file, err := readParquet(data)
+	s.Require().NoError(err)
+	s.Equal(2500, file.rows)
+	var names []string
+	for _, c := range columns {
+		names = append(names, c.name)
+	}
+	s.Equal(names, file.names)
+	s.Equal(t0, file.values["open_time"][0])
+	s.Equal(t0+2499*minute, file.values["open_time"][2499])
+	s.Equal("10000.50", file.values["high"][0])
+	s.Equal("12499.20", file.values["close"][2499])
+	s.Equal(int64(0), file.values["trades"][2499])
+	s.Equal("", file.values["volume"][2499])
+}
+
+func (s *klinesTestSuite) TestFlags() {
+	now := time.Now()
+	_, err := parseFlags([]string{"-symbol", "BTCUSDT", "-start", "2023-01-01"}, now, &s.log)
+	s.EqualError(err, "-symbol, -start and -out are required")
+	_, err = parseFlags([]string{"-symbol", "BTCUSDT", "-start", "2023-01-01", "-out", "k.txt"}, now, &s.log)
+	s.EqualError(err, `unknown format "txt", set -format to csv, jsonl or parquet`)
+	_, err = parseFlags([]string{"-type", "mark", "-symbol", "BTCUSDT", "-start", "2023-01-01", "-out", "k.csv"}, now, &s.log)
+	s.EqualError(err, "mark klines are only listed for futures")
+	_, err = parseFlags([]string{"-symbol", "BTCUSDT", "-interval", "1x", "-start", "2023-01-01", "-out", "k.csv"}, now, &s.log)
+	s.EqualError(err, `invalid interval "1x"`)
+
+	cfg, err := parseFlags([]string{"-symbol", "ethusdt", "-start", "2023-01-01", "-end", "2023-01-02T12:00:00Z",
+		"-out", "k.data", "-format", "parquet"}, now, &s.log)
+	s.Require().NoError(err)
+	s.Equal("ETHUSDT", cfg.symbol)
+	s.Equal(t0, cfg.start)
+	s.Equal(t0+36*60*minute, cfg.end)
+	s.Equal("parquet", cfg.format)
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// Parquet files are written without a dependency: a row group per parquetRowGroupSize records,
+// holding a single uncompressed, PLAIN encoded data page per column. Every column is required, so
+// pages have no repetition or definition levels. The output is compared with the golden file
+// testdata/klines.parquet, see TestParquetGolden before changing the layout.
+const (
+	parquetMagic        = "PAR1"
+	parquetRowGroupSize = 1 << 16
+	parquetCreatedBy    = "go-binance binance-klines"
+
+	// Parquet enums
+	parquetTypeInt64           = 2
+	parquetTypeByteArray       = 6
+	parquetRequired            = 0
+	parquetConvertedUTF8       = 0
+	parquetConvertedTimestamp  = 9 // TIMESTAMP_MILLIS
+	parquetEncodingPlain       = 0
+	parquetEncodingRLE         = 3
+	parquetCodecUncompressed   = 0
+	parquetPageTypeData        = 0
+	parquetFileMetadataVersion = 1
+)
+
+// Thrift compact protocol types
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thrift write structs in the Thrift compact protocol, which encodes Parquet metadata
+type thrift struct {
+	buf    bytes.Buffer
+	fields []int16 // id of the last field written in each open struct
+}
+
+func newThrift() *thrift {
+	return &thrift{fields: []int16{0}}
+}
+
+func (t *thrift) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	t.buf.Write(b[:binary.PutUvarint(b[:], v)])
+}
+
+func (t *thrift) varint(v int64) {
+	t.uvarint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (t *thrift) field(id int16, typ byte) {
+	last := &t.fields[len(t.fields)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		t.buf.WriteByte(typ)
+		t.varint(int64(id))
+	}
+	*last = id
+}
+
+func (t *thrift) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.varint(int64(v))
+}
+
+func (t *thrift) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.varint(v)
+}
+
+func (t *thrift) binary(b string) {
+	t.uvarint(uint64(len(b)))
+	t.buf.WriteString(b)
+}
+
+func (t *thrift) string(id int16, s string) {
+	t.field(id, thriftBinary)
+	t.binary(s)
+}
+
+func (t *thrift) list(id int16, elemType byte, n int) {
+	t.field(id, thriftList)
+	if n < 15 {
+		t.buf.WriteByte(byte(n)<<4 | elemType)
+		return
+	}
+	t.buf.WriteByte(0xf0 | elemType)
+	t.uvarint(uint64(n))
+}
+
+// structure write a struct, as a field if id is positive or as a list element otherwise
+func (t *thrift) structure(id int16, fields func()) {
+	if id > 0 {
+		t.field(id, thriftStruct)
+	}
+	t.fields = append(t.fields, 0)
+	fields()
+	t.buf.WriteByte(0) // stop
+	t.fields = t.fields[:len(t.fields)-1]
+}
+
+// columnChunk is the position of a column of a row group in the file
+type columnChunk struct {
+	offset int64
+	size   int64
+}
+
+// rowGroup is the position and size of a row group in the file
+type rowGroup struct {
+	rows    int
+	size    int64
+	columns []columnChunk
+}
+
+// writeParquet write the records as a Parquet file
+func writeParquet(w io.Writer, records []*record) error {
+	cw := &countingWriter{w: w}
+	if _, err := io.WriteString(cw, parquetMagic); err != nil {
+		return err
+	}
+	var groups []rowGroup
+	for start := 0; start < len(records); start += parquetRowGroupSize {
+		end := start + parquetRowGroupSize
+		if end > len(records) {
+			end = len(records)
+		}
+		g := rowGroup{rows: end - start}
+		for _, c := range columns {
+			page := parquetPage(c, records[start:end])
+			header := newThrift()
+			header.structure(0, func() {
+				header.i32(1, parquetPageTypeData)
+				header.i32(2, int32(len(page)))
+				header.i32(3, int32(len(page)))
+				header.structure(5, func() {
+					header.i32(1, int32(g.rows))
+					header.i32(2, parquetEncodingPlain)
+					header.i32(3, parquetEncodingRLE)
+					header.i32(4, parquetEncodingRLE)
+				})
+			})
+			chunk := columnChunk{offset: cw.n, size: int64(header.buf.Len() + len(page))}
+			if _, err := cw.Write(header.buf.Bytes()); err != nil {
+				return err
+			}
+			if _, err := cw.Write(page); err != nil {
+				return err
+			}
+			g.columns = append(g.columns, chunk)
+			g.size += chunk.size
+		}
+		groups = append(groups, g)
+	}
+
+	meta := parquetMetadata(len(records), groups)
+	if _, err := cw.Write(meta); err != nil {
+		return err
+	}
+	var footer [4]byte
+	binary.LittleEndian.PutUint32(footer[:], uint32(len(meta)))
+	if _, err := cw.Write(footer[:]); err != nil {
+		return err
+	}
+	_, err := io.WriteString(cw, parquetMagic)
+	return err
+}
+
+// parquetPage encode the values of a column, PLAIN encoding integers as 8 bytes little endian and
+// strings as their length as 4 bytes little endian followed by their bytes
+func parquetPage(c column, records []*record) []byte {
+	var page bytes.Buffer
+	var b [8]byte
+	for _, r := range records {
+		if c.int64 != nil {
+			binary.LittleEndian.PutUint64(b[:], uint64(c.int64(r)))
+			page.Write(b[:8])
+			continue
+		}
+		s := c.string(r)
+		binary.LittleEndian.PutUint32(b[:4], uint32(len(s)))
+		page.Write(b[:4])
+		page.WriteString(s)
+	}
+	return page.Bytes()
+}
+
+// parquetMetadata encode the FileMetaData of the file
+func parquetMetadata(rows int, groups []rowGroup) []byte {
+	t := newThrift()
+	t.structure(0, func() {
+		t.i32(1, parquetFileMetadataVersion)
+		t.list(2, thriftStruct, len(columns)+1)
+		t.structure(0, func() {
+			t.string(4, "schema")
+			t.i32(5, int32(len(columns)))
+		})
+		for _, c := range columns {
+			c := c
+			t.structure(0, func() {
+				switch {
+				case c.int64 == nil:
+					t.i32(1, parquetTypeByteArray)
+					t.i32(3, parquetRequired)
+					t.string(4, c.name)
+					t.i32(6, parquetConvertedUTF8)
+				case c.timestamp:
+					t.i32(1, parquetTypeInt64)
+					t.i32(3, parquetRequired)
+					t.string(4, c.name)
+					t.i32(6, parquetConvertedTimestamp)
+				default:
+					t.i32(1, parquetTypeInt64)
+					t.i32(3, parquetRequired)
+					t.string(4, c.name)
+				}
+			})
+		}
+		t.i64(3, int64(rows))
+		t.list(4, thriftStruct, len(groups))
+		for _, g := range groups {
+			g := g
+			t.structure(0, func() {
+				t.list(1, thriftStruct, len(g.columns))
+				for i, chunk := range g.columns {
+					c, chunk := columns[i], chunk
+					t.structure(0, func() {
+						t.i64(2, chunk.offset)
+						t.structure(3, func() {
+							if c.int64 != nil {
+								t.i32(1, parquetTypeInt64)
+							} else {
+								t.i32(1, parquetTypeByteArray)
+							}
+							t.list(2, thriftI32, 1)
+							t.varint(parquetEncodingPlain)
+							t.list(3, thriftBinary, 1)
+							t.binary(c.name)
+							t.i32(4, parquetCodecUncompressed)
+							t.i64(5, int64(g.rows))
+							t.i64(6, chunk.size)
+							t.i64(7, chunk.size)
+							t.i64(9, chunk.offset)
+						})
+					})
+				}
+				t.i64(2, g.size)
+				t.i64(3, int64(g.rows))
+			})
+		}
+		t.string(6, parquetCreatedBy)
+	})
+	return t.buf.Bytes()
+}
+
+// countingWriter count the bytes written, which are the offsets of the column chunks
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
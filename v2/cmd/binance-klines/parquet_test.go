@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of testdata")
+
+// goldenRecords are the klines of testdata/klines.parquet: a spot kline and a mark price kline
+var goldenRecords = []*record{
+	{
+		OpenTime: 1672531200000, Open: "16541.77", High: "16545.70", Low: "16508.39", Close: "16529.67",
+		Volume: "4364.83570", CloseTime: 1672531259999, QuoteVolume: "72146794.28153510", Trades: 9,
+		TakerBuyBaseVolume: "2179.62570", TakerBuyQuoteVolume: "36026338.51373200",
+	},
+	{
+		OpenTime: 1672531260000, Open: "16529.67", High: "16530.00", Low: "16528.10", Close: "16529.50",
+		CloseTime: 1672531319999,
+	},
+}
+
+// TestParquetGolden compare the output of the writer with testdata/klines.parquet, which was
+// checked against the Parquet format specification. Check it with a reference reader, such as
+// pyarrow.parquet.read_table, before updating it with go test -run TestParquetGolden -update.
+func TestParquetGolden(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeParquet(&buf, goldenRecords))
+	golden := filepath.Join("testdata", "klines.parquet")
+	if *update {
+		require.NoError(t, os.WriteFile(golden, buf.Bytes(), 0o644))
+	}
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, want, buf.Bytes())
+
+	file, err := readParquet(want)
+	require.NoError(t, err)
+	require.Equal(t, 2, file.rows)
+	require.Equal(t, []interface{}{int64(9), int64(0)}, file.values["trades"])
+	require.Equal(t, []interface{}{"4364.83570", ""}, file.values["volume"])
+}
+
+// thriftReader decode the Thrift compact protocol into values: int64, []byte, []interface{} and
+// map[int16]interface{} for structs
+type thriftReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *thriftReader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.b[r.pos:])
+	if n <= 0 {
+		return 0, errors.New("invalid varint")
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) varint() (int64, error) {
+	v, err := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (r *thriftReader) value(typ byte) (interface{}, error) {
+	switch typ {
+	case thriftI32, thriftI64:
+		return r.varint()
+	case thriftBinary:
+		n, err := r.uvarint()
+		if err != nil || r.pos+int(n) > len(r.b) {
+			return nil, errors.New("invalid binary")
+		}
+		r.pos += int(n)
+		return r.b[r.pos-int(n) : r.pos], nil
+	case thriftList:
+		if r.pos >= len(r.b) {
+			return nil, errors.New("invalid list")
+		}
+		header := r.b[r.pos]
+		r.pos++
+		n := uint64(header >> 4)
+		if n == 15 {
+			var err error
+			if n, err = r.uvarint(); err != nil {
+				return nil, err
+			}
+		}
+		list := make([]interface{}, n)
+		for i := range list {
+			v, err := r.value(header & 0x0f)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = v
+		}
+		return list, nil
+	case thriftStruct:
+		fields := make(map[int16]interface{})
+		var id int16
+		for r.pos < len(r.b) {
+			header := r.b[r.pos]
+			r.pos++
+			if header == 0 {
+				return fields, nil
+			}
+			if delta := int16(header >> 4); delta != 0 {
+				id += delta
+			} else {
+				v, err := r.varint()
+				if err != nil {
+					return nil, err
+				}
+				id = int16(v)
+			}
+			v, err := r.value(header & 0x0f)
+			if err != nil {
+				return nil, err
+			}
+			fields[id] = v
+		}
+		return nil, errors.New("invalid struct")
+	}
+	return nil, fmt.Errorf("unexpected type %d", typ)
+}
+
+// parquetFile is the content of a Parquet file written by writeParquet
+type parquetFile struct {
+	rows   int
+	names  []string
+	values map[string][]interface{}
+}
+
+// readParquet read the footer of a Parquet file, then the values of every data page
+func readParquet(data []byte) (*parquetFile, error) {
+	if len(data) < 12 || string(data[:4]) != parquetMagic || string(data[len(data)-4:]) != parquetMagic {
+		return nil, errors.New("missing magic")
+	}
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	r := &thriftReader{b: data[len(data)-8-size : len(data)-8]}
+	v, err := r.value(thriftStruct)
+	if err != nil {
+		return nil, err
+	}
+	meta := v.(map[int16]interface{})
+	file := &parquetFile{rows: int(meta[3].(int64)), values: make(map[string][]interface{})}
+	schema := meta[2].([]interface{})
+	if n := schema[0].(map[int16]interface{})[5].(int64); int(n) != len(schema)-1 {
+		return nil, fmt.Errorf("root has %d children", n)
+	}
+	types := make(map[string]int64)
+	for _, e := range schema[1:] {
+		e := e.(map[int16]interface{})
+		name := string(e[4].([]byte))
+		file.names = append(file.names, name)
+		types[name] = e[1].(int64)
+	}
+
+	rows := 0
+	for _, g := range meta[4].([]interface{}) {
+		g := g.(map[int16]interface{})
+		rows += int(g[3].(int64))
+		for _, c := range g[1].([]interface{}) {
+			cm := c.(map[int16]interface{})[3].(map[int16]interface{})
+			name := string(cm[3].([]interface{})[0].([]byte))
+			r := &thriftReader{b: data, pos: int(cm[9].(int64))}
+			v, err := r.value(thriftStruct)
+			if err != nil {
+				return nil, err
+			}
+			header := v.(map[int16]interface{})
+			n := int(header[5].(map[int16]interface{})[1].(int64))
+			page := data[r.pos : r.pos+int(header[3].(int64))]
+			if int64(r.pos-int(cm[9].(int64))+len(page)) != cm[6].(int64) {
+				return nil, fmt.Errorf("column %s has an invalid size", name)
+			}
+			for i := 0; i < n; i++ {
+				if types[name] == parquetTypeInt64 {
+					file.values[name] = append(file.values[name], int64(binary.LittleEndian.Uint64(page)))
+					page = page[8:]
+					continue
+				}
+				l := binary.LittleEndian.Uint32(page)
+				file.values[name] = append(file.values[name], string(page[4:4+l]))
+				page = page[4+l:]
+			}
+			if len(page) != 0 {
+				return nil, fmt.Errorf("column %s has %d bytes left", name, len(page))
+			}
+		}
+	}
+	if rows != file.rows {
+		return nil, fmt.Errorf("row groups have %d rows, expected %d", rows, file.rows)
+	}
+	return file, nil
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// record is a kline as written to the output
+type record struct {
+	OpenTime            int64  `json:"open_time"`
+	Open                string `json:"open"`
+	High                string `json:"high"`
+	Low                 string `json:"low"`
+	Close               string `json:"close"`
+	Volume              string `json:"volume"`
+	CloseTime           int64  `json:"close_time"`
+	QuoteVolume         string `json:"quote_volume"`
+	Trades              int64  `json:"trades"`
+	TakerBuyBaseVolume  string `json:"taker_buy_base_volume"`
+	TakerBuyQuoteVolume string `json:"taker_buy_quote_volume"`
+}
+
+// column is a column of the output schema, holding either integers or decimal strings
+type column struct {
+	name      string
+	timestamp bool
+	int64     func(r *record) int64
+	string    func(r *record) string
+}
+
+// columns is the output schema, in order
+var columns = []column{
+	{name: "open_time", timestamp: true, int64: func(r *record) int64 { return r.OpenTime }},
+	{name: "open", string: func(r *record) string { return r.Open }},
+	{name: "high", string: func(r *record) string { return r.High }},
+	{name: "low", string: func(r *record) string { return r.Low }},
+	{name: "close", string: func(r *record) string { return r.Close }},
+	{name: "volume", string: func(r *record) string { return r.Volume }},
+	{name: "close_time", timestamp: true, int64: func(r *record) int64 { return r.CloseTime }},
+	{name: "quote_volume", string: func(r *record) string { return r.QuoteVolume }},
+	{name: "trades", int64: func(r *record) int64 { return r.Trades }},
+	{name: "taker_buy_base_volume", string: func(r *record) string { return r.TakerBuyBaseVolume }},
+	{name: "taker_buy_quote_volume", string: func(r *record) string { return r.TakerBuyQuoteVolume }},
+}
+
+// writers write sorted records in an output format
+var writers = map[string]func(w io.Writer, records []*record) error{
+	"csv":     writeCSV,
+	"jsonl":   writeJSONL,
+	"parquet": writeParquet,
+}
+
+func writeCSV(w io.Writer, records []*record) error {
+	cw := csv.NewWriter(w)
+	row := make([]string, len(columns))
+	for i, c := range columns {
+		row[i] = c.name
+	}
+	if err := cw.Write(row); err != nil {
+		return err
+	}
+	for _, r := range records {
+		for i, c := range columns {
+			if c.int64 != nil {
+				row[i] = strconv.FormatInt(c.int64(r), 10)
+			} else {
+				row[i] = c.string(r)
+			}
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeJSONL(w io.Writer, records []*record) error {
+	enc := json.NewEncoder(w)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFile write the records to path through a temporary file, so that path is either complete
+// or left as it was
+func writeFile(path, format string, records []*record) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	w := bufio.NewWriter(f)
+	if err := writers[format](w, records); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// spool is the JSON Lines file the klines are appended to while downloading
+type spool struct {
+	path string
+	f    *os.File
+	w    *bufio.Writer
+}
+
+// load return the spooled records sorted by open time, without duplicates. A line cut short by an
+// interrupted run is skipped.
+func (s *spool) load() ([]*record, error) {
+	f, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	byOpenTime := make(map[int64]*record)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		r := new(record)
+		if json.Unmarshal(scanner.Bytes(), r) == nil {
+			byOpenTime[r.OpenTime] = r
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	records := make([]*record, 0, len(byOpenTime))
+	for _, r := range byOpenTime {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].OpenTime < records[j].OpenTime })
+	return records, nil
+}
+
+// append add a record to the spool, which is written by flush
+func (s *spool) append(r *record) error {
+	if s.f == nil {
+		f, err := os.OpenFile(s.path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		s.f, s.w = f, bufio.NewWriter(f)
+		// start a new line after a line cut short
+		if info, err := f.Stat(); err == nil && info.Size() > 0 {
+			last := make([]byte, 1)
+			if _, err := f.ReadAt(last, info.Size()-1); err == nil && last[0] != '\n' {
+				s.w.WriteByte('\n')
+			}
+		}
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	s.w.Write(data)
+	return s.w.WriteByte('\n')
+}
+
+// flush write the appended records
+func (s *spool) flush() error {
+	if s.w == nil {
+		return nil
+	}
+	return s.w.Flush()
+}
+
+// remove close and delete the spool once the output is written
+func (s *spool) remove() error {
+	if s.f != nil {
+		s.f.Close()
+		s.f, s.w = nil, nil
+	}
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// source pass the klines opening between from and to, in milliseconds, to emit
+type source func(ctx context.Context, from, to int64, emit func(*record) error) error
+
+// newSource create a client for the market of cfg, limited by the rate limits of the exchange,
+// and return the source of its klines
+func newSource(ctx context.Context, cfg *config) (source, error) {
+	if cfg.market == marketSpot {
+		var opts []binance.ClientOption
+		if cfg.baseURL != "" {
+			opts = append(opts, binance.WithBaseURL(cfg.baseURL))
+		}
+		c := binance.NewClient("", "", opts...)
+		c.RetryPolicy = common.NewRetryPolicy()
+		info, err := c.NewExchangeInfoService().Symbol(cfg.symbol).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.RateLimiter = binance.NewRateLimiter(info.RateLimits)
+		return func(ctx context.Context, from, to int64, emit func(*record) error) error {
+			it := c.NewKlinesService().Symbol(cfg.symbol).Interval(cfg.interval).Range(ctx, from, to)
+			return each(it, spotRecord, emit)
+		}, nil
+	}
+
+	var opts []futures.ClientOption
+	if cfg.baseURL != "" {
+		opts = append(opts, futures.WithBaseURL(cfg.baseURL))
+	}
+	c := futures.NewClient("", "", opts...)
+	c.RetryPolicy = common.NewRetryPolicy()
+	info, err := c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.RateLimiter = futures.NewRateLimiter(info.RateLimits)
+	return func(ctx context.Context, from, to int64, emit func(*record) error) error {
+		switch cfg.klineType {
+		case typeContinuous:
+			it := c.NewContinuousKlinesService().Pair(cfg.symbol).ContractType(cfg.contractType).
+				Interval(cfg.interval).Range(ctx, from, to)
+			return each(it, continuousRecord, emit)
+		case typeMark:
+			it := c.NewMarkPriceKlinesService().Symbol(cfg.symbol).Interval(cfg.interval).Range(ctx, from, to)
+			return each(it, priceRecord, emit)
+		case typeIndex:
+			it := c.NewIndexPriceKlinesService().Pair(cfg.symbol).Interval(cfg.interval).Range(ctx, from, to)
+			return each(it, priceRecord, emit)
+		}
+		it := c.NewKlinesService().Symbol(cfg.symbol).Interval(cfg.interval).Range(ctx, from, to)
+		return each(it, futuresRecord, emit)
+	}, nil
+}
+
+// each pass the records of the klines of it to emit
+func each[T any](it *common.Iterator[T], toRecord func(T) *record, emit func(*record) error) error {
+	for it.Next() {
+		if err := emit(toRecord(it.Value())); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+func spotRecord(k *binance.Kline) *record {
+	return &record{k.OpenTime, k.Open, k.High, k.Low, k.Close, k.Volume, k.CloseTime, k.QuoteAssetVolume,
+		k.TradeNum, k.TakerBuyBaseAssetVolume, k.TakerBuyQuoteAssetVolume}
+}
+
+func futuresRecord(k *futures.Kline) *record {
+	return &record{k.OpenTime, k.Open, k.High, k.Low, k.Close, k.Volume, k.CloseTime, k.QuoteAssetVolume,
+		k.TradeNum, k.TakerBuyBaseAssetVolume, k.TakerBuyQuoteAssetVolume}
+}
+
+// priceRecord return the record of a mark price or index price kline, leaving the volumes empty and
+// the trades at 0 since the exchange sends placeholders for them
+func priceRecord(k *futures.Kline) *record {
+	return &record{OpenTime: k.OpenTime, Open: k.Open, High: k.High, Low: k.Low, Close: k.Close, CloseTime: k.CloseTime}
+}
+
+func continuousRecord(k *futures.ContinuousKline) *record {
+	return &record{k.OpenTime, k.Open, k.High, k.Low, k.Close, k.Volume, k.CloseTime, k.QuoteAssetVolume,
+		k.TradeNum, k.TakerBuyBaseAssetVolume, k.TakerBuyQuoteAssetVolume}
+}
+
+// download append the closed klines opening between from and to, excluded, to the spool
+func download(ctx context.Context, src source, sp *spool, from, to int64) error {
+	err := src(ctx, from, to-1, func(r *record) error {
+		if r.CloseTime >= to {
+			return nil
+		}
+		return sp.append(r)
+	})
+	if ferr := sp.flush(); err == nil {
+		err = ferr
+	}
+	return err
+}
+
+// parseInterval return the duration of an interval, or 0 for months
+func parseInterval(interval string) (time.Duration, error) {
+	if len(interval) < 2 {
+		return 0, fmt.Errorf("invalid interval %q", interval)
+	}
+	n, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid interval %q", interval)
+	}
+	units := map[byte]time.Duration{
+		's': time.Second,
+		'm': time.Minute,
+		'h': time.Hour,
+		'd': 24 * time.Hour,
+		'w': 7 * 24 * time.Hour,
+		'M': 0,
+	}
+	unit, ok := units[interval[len(interval)-1]]
+	if !ok {
+		return 0, fmt.Errorf("invalid interval %q", interval)
+	}
+	return time.Duration(n) * unit, nil
+}
+
+// gap is a range of missing klines, in milliseconds
+type gap struct {
+	from int64
+	to   int64 // excluded
+}
+
+// findGaps return the missing klines between sorted records. The klines before the first one are
+// not checked, as the symbol may not be listed yet, nor monthly klines, whose length varies.
+func findGaps(records []*record, step time.Duration) []gap {
+	if step == 0 {
+		return nil
+	}
+	ms := int64(step / time.Millisecond)
+	var gaps []gap
+	for i := 1; i < len(records); i++ {
+		if expected := records[i-1].OpenTime + ms; records[i].OpenTime > expected {
+			gaps = append(gaps, gap{expected, records[i].OpenTime})
+		}
+	}
+	return gaps
+}
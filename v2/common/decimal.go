@@ -0,0 +1,333 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode define how a decimal is rounded
+type RoundingMode int
+
+// Rounding modes
+const (
+	RoundDown   RoundingMode = iota // toward negative infinity
+	RoundUp                         // toward positive infinity
+	RoundHalfUp                     // to the nearest value, halves toward positive infinity
+)
+
+// Decimal is an exact decimal number, such as a price or quantity.
+// It keeps the number of decimal places it was parsed with, so that it is formatted and
+// marshaled to JSON in the exact string form of the exchange. The zero value is 0.
+type Decimal struct {
+	value *big.Int // unscaled value, nil means 0
+	scale int32    // number of decimal places
+}
+
+// maxDecimalScale bound the decimal places and the exponent of parsed decimals, so that a malformed
+// field such as "1e2000000000" is rejected instead of exhausting the memory
+const maxDecimalScale = 1000
+
+// NewDecimal return value * 10^-scale. A negative scale is applied to value, leaving no decimal
+// places.
+func NewDecimal(value int64, scale int32) Decimal {
+	v := big.NewInt(value)
+	if scale < 0 {
+		v.Mul(v, pow10(-int64(scale)))
+		scale = 0
+	}
+	return Decimal{value: v, scale: scale}
+}
+
+// ParseDecimal parse a decimal such as "0.01000000", "-12" or "1e-8", with at most 1000 decimal
+// places or trailing zeros
+func ParseDecimal(s string) (Decimal, error) {
+	str := s
+	var exp int64
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		var err error
+		exp, err = strconv.ParseInt(str[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+		str = str[:i]
+	}
+	var scale int64
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		scale = int64(len(str) - i - 1)
+		str = str[:i] + str[i+1:]
+	}
+	if str == "" || str == "-" || str == "+" || strings.ContainsAny(str[1:], "+-") {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	value, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	scale -= exp
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, fmt.Errorf("decimal %q out of range", s)
+	}
+	if scale < 0 {
+		value.Mul(value, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{value: value, scale: int32(scale)}, nil
+}
+
+// MustParseDecimal parse a decimal and panic if it is invalid
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// ToDecimal parse a decimal field of a response, returning 0 if it is empty or invalid
+func ToDecimal(s string) Decimal {
+	d, _ := ParseDecimal(s)
+	return d
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+func (d Decimal) unscaled() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+	return d.value
+}
+
+// rescale return the unscaled value of d with scale decimal places, scale must not be lower than d.scale
+func (d Decimal) rescale(scale int32) *big.Int {
+	v := new(big.Int).Set(d.unscaled())
+	if scale > d.scale {
+		v.Mul(v, pow10(int64(scale-d.scale)))
+	}
+	return v
+}
+
+func maxScale(a, b Decimal) int32 {
+	if a.scale > b.scale {
+		return a.scale
+	}
+	return b.scale
+}
+
+// Scale return the number of decimal places of d
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// String return d with all its decimal places
+func (d Decimal) String() string {
+	if d.scale < 0 {
+		return new(big.Int).Mul(d.unscaled(), pow10(-int64(d.scale))).String()
+	}
+	s := d.unscaled().String()
+	if d.scale == 0 {
+		return s
+	}
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	if len(s) <= int(d.scale) {
+		s = strings.Repeat("0", int(d.scale)-len(s)+1) + s
+	}
+	s = s[:len(s)-int(d.scale)] + "." + s[len(s)-int(d.scale):]
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+// Float64 return the nearest float64 to d
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Add return d + x
+func (d Decimal) Add(x Decimal) Decimal {
+	scale := maxScale(d, x)
+	return Decimal{value: new(big.Int).Add(d.rescale(scale), x.rescale(scale)), scale: scale}
+}
+
+// Sub return d - x
+func (d Decimal) Sub(x Decimal) Decimal {
+	scale := maxScale(d, x)
+	return Decimal{value: new(big.Int).Sub(d.rescale(scale), x.rescale(scale)), scale: scale}
+}
+
+// Mul return d * x, with the decimal places of both
+func (d Decimal) Mul(x Decimal) Decimal {
+	return Decimal{value: new(big.Int).Mul(d.unscaled(), x.unscaled()), scale: d.scale + x.scale}
+}
+
+// Div return d / x rounded to places decimal places, it panics if x is 0
+func (d Decimal) Div(x Decimal, places int32, mode RoundingMode) Decimal {
+	if x.Sign() == 0 {
+		panic("decimal division by zero")
+	}
+	// d / x = (dv * 10^(places+x.scale-d.scale) / xv) * 10^-places
+	num := new(big.Int).Set(d.unscaled())
+	den := new(big.Int).Set(x.unscaled())
+	if shift := int64(places) + int64(x.scale) - int64(d.scale); shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	return Decimal{value: divRound(num, den, mode), scale: places}
+}
+
+// divRound return num / den rounded with mode
+func divRound(num, den *big.Int, mode RoundingMode) *big.Int {
+	if den.Sign() < 0 {
+		num = new(big.Int).Neg(num)
+		den = new(big.Int).Neg(den)
+	}
+	// Div rounds toward negative infinity for a positive divisor
+	q, m := new(big.Int).DivMod(num, den, new(big.Int))
+	if m.Sign() == 0 {
+		return q
+	}
+	switch mode {
+	case RoundUp:
+		q.Add(q, big.NewInt(1))
+	case RoundHalfUp:
+		if new(big.Int).Lsh(m, 1).Cmp(den) >= 0 {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// Round return d rounded to places decimal places
+func (d Decimal) Round(places int32, mode RoundingMode) Decimal {
+	if places >= d.scale {
+		return Decimal{value: d.rescale(places), scale: places}
+	}
+	return Decimal{value: divRound(d.unscaled(), pow10(int64(d.scale-places)), mode), scale: places}
+}
+
+// RoundToStep return d rounded to a multiple of step, such as a price to the tick size of
+// PRICE_FILTER, with the significant decimal places of step. d is returned as is if step is not positive.
+func (d Decimal) RoundToStep(step Decimal, mode RoundingMode) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+	scale := maxScale(d, step)
+	n := divRound(d.rescale(scale), step.rescale(scale), mode)
+	return step.Mul(Decimal{value: n}).Round(step.Trim().scale, RoundDown)
+}
+
+// Trim return d without trailing zero decimal places
+func (d Decimal) Trim() Decimal {
+	v := new(big.Int).Set(d.unscaled())
+	scale := d.scale
+	ten := big.NewInt(10)
+	q, m := new(big.Int), new(big.Int)
+	for scale > 0 && v.Sign() != 0 {
+		q.QuoRem(v, ten, m)
+		if m.Sign() != 0 {
+			break
+		}
+		v.Set(q)
+		scale--
+	}
+	if v.Sign() == 0 {
+		scale = 0
+	}
+	return Decimal{value: v, scale: scale}
+}
+
+// Neg return -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{value: new(big.Int).Neg(d.unscaled()), scale: d.scale}
+}
+
+// Abs return the absolute value of d
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: new(big.Int).Abs(d.unscaled()), scale: d.scale}
+}
+
+// Sign return -1, 0 or 1 if d is negative, zero or positive
+func (d Decimal) Sign() int {
+	return d.unscaled().Sign()
+}
+
+// IsZero check if d is 0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp return -1, 0 or 1 if d is lower than, equal to or greater than x
+func (d Decimal) Cmp(x Decimal) int {
+	scale := maxScale(d, x)
+	return d.rescale(scale).Cmp(x.rescale(scale))
+}
+
+// Equal check if d and x are the same number, whatever their decimal places
+func (d Decimal) Equal(x Decimal) bool {
+	return d.Cmp(x) == 0
+}
+
+// LessThan check if d < x
+func (d Decimal) LessThan(x Decimal) bool {
+	return d.Cmp(x) < 0
+}
+
+// GreaterThan check if d > x
+func (d Decimal) GreaterThan(x Decimal) bool {
+	return d.Cmp(x) > 0
+}
+
+// MarshalJSON marshal d as a JSON string, like the exchange does
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON unmarshal a JSON string or number, an empty string or null is 0
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		var err error
+		s, err = strconv.Unquote(s)
+		if err != nil {
+			return err
+		}
+		if s == "" {
+			*d = Decimal{}
+			return nil
+		}
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalText marshal d as text, such as a request parameter
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parse text as a decimal
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
@@ -0,0 +1,137 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"0.01000000", "0.01000000"},
+		{"-12", "-12"},
+		{"+1.5", "1.5"},
+		{".5", "0.5"},
+		{"-0.000123", "-0.000123"},
+		{"1e-8", "0.00000001"},
+		{"1.5E3", "1500"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789"},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, d.String(), tt.in)
+	}
+	for _, in := range []string{"", "-", "abc", "1.2.3", "1-2", "1e", "0x10", "1e2000000000", "1e-2147483648", "1e1001"} {
+		_, err := ParseDecimal(in)
+		assert.Error(t, err, in)
+	}
+	assert.Equal(t, "0", ToDecimal("invalid").String())
+	assert.Panics(t, func() { MustParseDecimal("invalid") })
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := MustParseDecimal("0.1")
+	b := MustParseDecimal("0.20")
+	assert.Equal(t, "0.30", a.Add(b).String())
+	assert.True(t, a.Add(b).Equal(MustParseDecimal("0.3")))
+	assert.Equal(t, "-0.10", a.Sub(b).String())
+	assert.Equal(t, "0.020", a.Mul(b).String())
+	assert.Equal(t, "0.5000", a.Div(b, 4, RoundDown).String())
+	assert.Equal(t, "0.33", MustParseDecimal("1").Div(MustParseDecimal("3"), 2, RoundDown).String())
+	assert.Equal(t, "0.34", MustParseDecimal("1").Div(MustParseDecimal("3"), 2, RoundUp).String())
+	assert.Equal(t, "0.67", MustParseDecimal("2").Div(MustParseDecimal("3"), 2, RoundHalfUp).String())
+	assert.Equal(t, "-0.34", MustParseDecimal("-1").Div(MustParseDecimal("3"), 2, RoundDown).String())
+	assert.Equal(t, "-0.33", MustParseDecimal("1").Div(MustParseDecimal("-3"), 2, RoundUp).String())
+	assert.Equal(t, "1000", MustParseDecimal("10").Div(MustParseDecimal("0.01"), 0, RoundDown).String())
+	assert.Panics(t, func() { a.Div(Decimal{}, 2, RoundDown) })
+	assert.Equal(t, "0.1", a.Neg().Abs().String())
+	assert.Equal(t, -1, a.Neg().Sign())
+	assert.True(t, Decimal{}.IsZero())
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, 0.1, a.Float64())
+	assert.Equal(t, NewDecimal(125, 3).String(), "0.125")
+	assert.Equal(t, "500", NewDecimal(5, -2).String())
+	assert.Equal(t, int32(0), NewDecimal(5, -2).Scale())
+	assert.Equal(t, "1200", MustParseDecimal("1234").Round(-2, RoundDown).String())
+}
+
+func TestDecimalCompare(t *testing.T) {
+	a := MustParseDecimal("1.50")
+	b := MustParseDecimal("1.5")
+	c := MustParseDecimal("1.51")
+	assert.True(t, a.Equal(b))
+	assert.Equal(t, 0, a.Cmp(b))
+	assert.True(t, a.LessThan(c))
+	assert.True(t, c.GreaterThan(b))
+	assert.False(t, a.GreaterThan(b))
+}
+
+func TestDecimalRound(t *testing.T) {
+	d := MustParseDecimal("1.2345")
+	assert.Equal(t, "1.23", d.Round(2, RoundDown).String())
+	assert.Equal(t, "1.24", d.Round(2, RoundUp).String())
+	assert.Equal(t, "1.235", d.Round(3, RoundHalfUp).String())
+	assert.Equal(t, "1.234500", d.Round(6, RoundDown).String())
+	assert.Equal(t, "-1.24", d.Neg().Round(2, RoundDown).String())
+	assert.Equal(t, "1.5", MustParseDecimal("1.50000").Trim().String())
+	assert.Equal(t, "0", MustParseDecimal("0.000").Trim().String())
+}
+
+func TestDecimalRoundToStep(t *testing.T) {
+	tests := []struct {
+		value, step string
+		mode        RoundingMode
+		want        string
+	}{
+		{"0.3", "0.1", RoundDown, "0.3"},
+		{"20000.019", "0.01000000", RoundDown, "20000.01"},
+		{"20000.011", "0.01000000", RoundUp, "20000.02"},
+		{"19999.995", "0.01", RoundHalfUp, "20000.00"},
+		{"7", "5", RoundDown, "5"},
+		{"7", "5", RoundUp, "10"},
+		{"0.0012345", "0.00001000", RoundDown, "0.00123"},
+		{"1.5", "0", RoundDown, "1.5"},
+	}
+	for _, tt := range tests {
+		got := MustParseDecimal(tt.value).RoundToStep(MustParseDecimal(tt.step), tt.mode)
+		assert.Equal(t, tt.want, got.String(), tt.value)
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var v struct {
+		Price    Decimal  `json:"price"`
+		Quantity Decimal  `json:"qty"`
+		Empty    Decimal  `json:"empty"`
+		Null     *Decimal `json:"null"`
+	}
+	err := json.Unmarshal([]byte(`{"price":"0.01000000","qty":1.5,"empty":"","null":null}`), &v)
+	require.NoError(t, err)
+	assert.Equal(t, "0.01000000", v.Price.String())
+	assert.Equal(t, "1.5", v.Quantity.String())
+	assert.True(t, v.Empty.IsZero())
+	assert.Nil(t, v.Null)
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"price":"0.01000000","qty":"1.5","empty":"0","null":null}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"price":"abc"}`), &v))
+}
+
+func TestPriceLevelParseDecimal(t *testing.T) {
+	p := &PriceLevel{Price: "20000.01000000", Quantity: "0.00100000"}
+	price, quantity, err := p.ParseDecimal()
+	require.NoError(t, err)
+	assert.Equal(t, "20000.01000000", price.String())
+	assert.Equal(t, "0.00100000", quantity.String())
+
+	p.Quantity = "x"
+	_, _, err = p.ParseDecimal()
+	assert.Error(t, err)
+}
@@ -1,22 +1,154 @@
 package common
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// Error codes of the error categories
+const (
+	ErrorCodeTimeout             int64 = -1007 // timeout waiting for the backend, execution status unknown
+	ErrorCodeFilterFailure       int64 = -1013 // the request failed a symbol filter
+	ErrorCodeInvalidSignature    int64 = -1022 // signature for this request is not valid
+	ErrorCodeNewOrderRejected    int64 = -2010 // order placement rejected
+	ErrorCodeCancelRejected      int64 = -2011 // order cancellation rejected
+	ErrorCodeBalanceInsufficient int64 = -2018 // futures balance is insufficient
+	ErrorCodeMarginInsufficient  int64 = -2019 // futures margin is insufficient
+	ErrorCodePriceTickSize       int64 = -4014 // futures price not increased by tick size
+	ErrorCodeQuantityStepSize    int64 = -4023 // futures quantity not increased by step size
+	ErrorCodeNotionalTooSmall    int64 = -4164 // futures order notional below the minimum
+)
+
+const (
+	filterFailurePrefix            = "Filter failure: "
+	insufficientBalanceMessagePart = "insufficient balance"
+	unknownOrderMessagePart        = "Unknown order"
+)
+
+// Error categories, to be checked with errors.Is
+var (
+	// ErrRateLimited is a 429 response or a request held back by the rate limiter
+	ErrRateLimited = errors.New("rate limited")
+	// ErrIPBanned is a 418 response, the IP is banned for repeatedly exceeding rate limits
+	ErrIPBanned = errors.New("IP banned")
+	// ErrInvalidTimestamp is a request with a timestamp outside of recvWindow
+	ErrInvalidTimestamp = errors.New("timestamp outside of recvWindow")
+	// ErrInvalidSignature is a request with an invalid signature
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrInsufficientBalance is an order rejected for lack of balance or margin
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	// ErrUnknownOrder is a query or cancellation of an order which does not exist
+	ErrUnknownOrder = errors.New("unknown order")
+	// ErrFilterFailure is an order failing a symbol filter, see APIError.Filter
+	ErrFilterFailure = errors.New("filter failure")
+	// ErrUnknownExecutionStatus is a 5xx response or backend timeout: the request may have been
+	// executed, so an order must be looked up before it is sent again
+	ErrUnknownExecutionStatus = errors.New("order status unknown, execution may have happened")
+)
+
+// futuresFilters map the futures error codes to the filter they failed
+var futuresFilters = map[int64]string{
+	ErrorCodePriceTickSize:    "PRICE_FILTER",
+	ErrorCodeQuantityStepSize: "LOT_SIZE",
+	ErrorCodeNotionalTooSmall: "MIN_NOTIONAL",
+}
+
 // APIError define API error when response status is 4xx or 5xx
 type APIError struct {
 	Code    int64  `json:"code"`
 	Message string `json:"msg"`
+	// Data hold the details of the errors returning some, such as a failed cancel-replace
+	Data json.RawMessage `json:"data,omitempty"`
+
+	// StatusCode is the HTTP status of the response
+	StatusCode int `json:"-"`
+	// Header hold the headers of the response, such as Retry-After and the used weight
+	Header http.Header `json:"-"`
+	// Body is the raw response, kept when it is not the JSON of an error
+	Body []byte `json:"-"`
 }
 
 // Error return error code and message
 func (e APIError) Error() string {
+	if e.Code == 0 && e.Message == "" && e.StatusCode != 0 {
+		return fmt.Sprintf("<APIError> status=%d, body=%s", e.StatusCode, e.Body)
+	}
 	return fmt.Sprintf("<APIError> code=%d, msg=%s", e.Code, e.Message)
 }
 
-// IsAPIError check if e is an API error
+// Is check if e belongs to an error category such as ErrRateLimited
+func (e APIError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrIPBanned:
+		return e.StatusCode == http.StatusTeapot
+	case ErrInvalidTimestamp:
+		return e.Code == ErrorCodeInvalidTimestamp
+	case ErrInvalidSignature:
+		return e.Code == ErrorCodeInvalidSignature
+	case ErrInsufficientBalance:
+		return e.Code == ErrorCodeBalanceInsufficient || e.Code == ErrorCodeMarginInsufficient ||
+			strings.Contains(e.Message, insufficientBalanceMessagePart)
+	case ErrUnknownOrder:
+		return e.Code == ErrorCodeNoSuchOrder ||
+			(e.Code == ErrorCodeCancelRejected && strings.Contains(e.Message, unknownOrderMessagePart))
+	case ErrFilterFailure:
+		return e.Filter() != ""
+	case ErrUnknownExecutionStatus:
+		return e.StatusCode >= http.StatusInternalServerError || e.Code == ErrorCodeTimeout
+	}
+	return false
+}
+
+// Filter return the name of the symbol filter failed by an order, such as LOT_SIZE, or an empty string
+func (e APIError) Filter() string {
+	if e.Code == ErrorCodeFilterFailure {
+		if i := strings.Index(e.Message, filterFailurePrefix); i >= 0 {
+			return strings.TrimSpace(e.Message[i+len(filterFailurePrefix):])
+		}
+	}
+	return futuresFilters[e.Code]
+}
+
+// RetryAfter return the delay the Retry-After header asks to wait for, or 0
+func (e APIError) RetryAfter() time.Duration {
+	seconds, err := strconv.ParseInt(e.Header.Get("Retry-After"), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// UsedWeight return the request weight used in the current minute as reported by the response, or -1
+func (e APIError) UsedWeight() int64 {
+	used, err := strconv.ParseInt(e.Header.Get("X-MBX-USED-WEIGHT-1M"), 10, 64)
+	if err != nil {
+		return -1
+	}
+	return used
+}
+
+// AsAPIError return the API error e is or wraps, whether it was returned as a pointer or a value
+func AsAPIError(e error) (*APIError, bool) {
+	var ptr *APIError
+	if errors.As(e, &ptr) && ptr != nil {
+		return ptr, true
+	}
+	var value APIError
+	if errors.As(e, &value) {
+		return &value, true
+	}
+	return nil, false
+}
+
+// IsAPIError check if e is an API error, or wraps one
 func IsAPIError(e error) bool {
-	_, ok := e.(*APIError)
+	_, ok := AsAPIError(e)
 	return ok
 }
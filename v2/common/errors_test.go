@@ -0,0 +1,72 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		category error
+	}{
+		{"rate limited", &APIError{Code: -1003, StatusCode: http.StatusTooManyRequests}, ErrRateLimited},
+		{"banned", &APIError{Code: -1003, StatusCode: http.StatusTeapot}, ErrIPBanned},
+		{"rate limiter", &RateLimitError{RetryAfter: time.Second}, ErrRateLimited},
+		{"rate limiter banned", &RateLimitError{Banned: true}, ErrIPBanned},
+		{"timestamp", &APIError{Code: ErrorCodeInvalidTimestamp}, ErrInvalidTimestamp},
+		{"signature", &APIError{Code: ErrorCodeInvalidSignature}, ErrInvalidSignature},
+		{"spot balance", &APIError{Code: ErrorCodeNewOrderRejected, Message: "Account has insufficient balance for requested action."}, ErrInsufficientBalance},
+		{"futures margin", &APIError{Code: ErrorCodeMarginInsufficient, Message: "Margin is insufficient."}, ErrInsufficientBalance},
+		{"no such order", &APIError{Code: ErrorCodeNoSuchOrder, Message: "Order does not exist."}, ErrUnknownOrder},
+		{"unknown order", &APIError{Code: ErrorCodeCancelRejected, Message: "Unknown order sent."}, ErrUnknownOrder},
+		{"filter", &APIError{Code: ErrorCodeFilterFailure, Message: "Filter failure: LOT_SIZE"}, ErrFilterFailure},
+		{"futures filter", &APIError{Code: ErrorCodeNotionalTooSmall}, ErrFilterFailure},
+		{"server error", &APIError{StatusCode: http.StatusServiceUnavailable}, ErrUnknownExecutionStatus},
+		{"timeout", &APIError{Code: ErrorCodeTimeout}, ErrUnknownExecutionStatus},
+		{"value", APIError{Code: ErrorCodeInvalidSignature}, ErrInvalidSignature},
+		{"wrapped", fmt.Errorf("place order: %w", &APIError{Code: ErrorCodeInvalidTimestamp}), ErrInvalidTimestamp},
+	}
+	categories := []error{ErrRateLimited, ErrIPBanned, ErrInvalidTimestamp, ErrInvalidSignature,
+		ErrInsufficientBalance, ErrUnknownOrder, ErrFilterFailure, ErrUnknownExecutionStatus}
+	for _, tt := range tests {
+		for _, category := range categories {
+			assert.Equal(t, category == tt.category, errors.Is(tt.err, category), "%s is %s", tt.name, category)
+		}
+	}
+}
+
+func TestAPIErrorDetails(t *testing.T) {
+	err := &APIError{Code: ErrorCodeFilterFailure, Message: "Filter failure: PRICE_FILTER"}
+	assert.Equal(t, "PRICE_FILTER", err.Filter())
+	assert.Equal(t, "MIN_NOTIONAL", APIError{Code: ErrorCodeNotionalTooSmall}.Filter())
+	assert.Equal(t, "", APIError{Code: ErrorCodeNoSuchOrder}.Filter())
+
+	err = &APIError{Header: http.Header{"Retry-After": {"120"}, "X-Mbx-Used-Weight-1m": {"1200"}}}
+	assert.Equal(t, 2*time.Minute, err.RetryAfter())
+	assert.Equal(t, int64(1200), err.UsedWeight())
+	assert.Equal(t, time.Duration(0), APIError{}.RetryAfter())
+	assert.Equal(t, int64(-1), APIError{}.UsedWeight())
+
+	assert.Equal(t, "<APIError> code=-1021, msg=late", APIError{Code: -1021, Message: "late"}.Error())
+	assert.Equal(t, "<APIError> status=502, body=bad gateway", APIError{StatusCode: 502, Body: []byte("bad gateway")}.Error())
+}
+
+func TestIsAPIError(t *testing.T) {
+	assert.True(t, IsAPIError(&APIError{Code: -1121}))
+	assert.True(t, IsAPIError(APIError{Code: -1121}))
+	assert.True(t, IsAPIError(fmt.Errorf("wrapped: %w", &APIError{})))
+	assert.False(t, IsAPIError(errors.New("connection reset")))
+	assert.False(t, IsAPIError(nil))
+	assert.True(t, IsAPIErrorCode(APIError{Code: -1121}, -1121))
+
+	apiErr, ok := AsAPIError(fmt.Errorf("wrapped: %w", APIError{Code: -2013}))
+	assert.True(t, ok)
+	assert.Equal(t, int64(-2013), apiErr.Code)
+}
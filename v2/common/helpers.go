@@ -1,11 +1,22 @@
 package common
 
-import "math"
-import "bytes"
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
 
-// AmountToLotSize converts an amount to a lot sized amount
+// AmountToLotSize converts an amount to a lot sized amount, using the shortest decimal
+// form of lot and amount so that exact multiples of lot are kept
 func AmountToLotSize(lot float64, precision int, amount float64) float64 {
-	return math.Trunc(math.Floor(amount/lot)*lot*math.Pow10(precision)) / math.Pow10(precision)
+	sized := roundToStep(strconv.FormatFloat(amount, 'f', -1, 64), "", strconv.FormatFloat(lot, 'f', -1, 64), RoundDown)
+	if precision > 0 {
+		sized = roundToStep(sized, "", "0."+strings.Repeat("0", precision-1)+"1", RoundDown)
+	} else {
+		sized = roundToStep(sized, "", "1", RoundDown)
+	}
+	f, _ := strconv.ParseFloat(sized, 64)
+	return f
 }
 
 // ToJSONList convert v to json list if v is a map
@@ -34,7 +34,25 @@ func TestAmountToLotSize(t *testing.T) {
 				precision: 3,
 				amount:    1.39,
 			},
-			want: 1.389,
+			want: 1.39,
+		},
+		{
+			name: "test with amount multiple of lot",
+			args: args{
+				lot:       0.1,
+				precision: 1,
+				amount:    0.3,
+			},
+			want: 0.3,
+		},
+		{
+			name: "test with precision below lot",
+			args: args{
+				lot:       0.00100000,
+				precision: 2,
+				amount:    1.239,
+			},
+			want: 1.23,
 		},
 		{
 			name: "test with big decimal",
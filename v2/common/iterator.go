@@ -0,0 +1,149 @@
+package common
+
+import "context"
+
+// PageFunc fetch the page of items at cursor, returning the cursor following the page and whether
+// there may be more pages
+type PageFunc[T any] func(ctx context.Context, cursor int64) (page []T, next int64, more bool, err error)
+
+// CursorFunc return the cursor resuming an iteration right after item
+type CursorFunc[T any] func(item T) int64
+
+// Iterator iterate over the items of a paginated endpoint, fetching each page once the previous
+// one is consumed. Pages are requested through the client, so they wait for its rate limiter and
+// follow its retry policy.
+//
+//	it := client.NewKlinesService().Symbol("BTCUSDT").Interval("1m").Range(ctx, start, end)
+//	for it.Next() {
+//		kline := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// resume later from it.Checkpoint()
+//	}
+type Iterator[T any] struct {
+	ctx    context.Context
+	fetch  PageFunc[T]
+	after  CursorFunc[T]
+	cursor int64 // cursor of the current page
+	next   int64 // cursor of the next page
+	more   bool
+	page   []T
+	i      int
+	err    error
+}
+
+// NewIterator create an iterator starting at cursor. The checkpoint after an item is given by
+// after, or is the cursor of its page if after is nil, and the cursor following its page once
+// the page is consumed.
+func NewIterator[T any](ctx context.Context, cursor int64, fetch PageFunc[T], after CursorFunc[T]) *Iterator[T] {
+	return &Iterator[T]{ctx: ctx, fetch: fetch, after: after, cursor: cursor, next: cursor, more: true}
+}
+
+// Next advance to the next item, fetching a page if needed. It returns false at the end of the
+// iteration or on error, see Err.
+func (it *Iterator[T]) Next() bool {
+	for it.i >= len(it.page) {
+		if !it.more || it.err != nil {
+			return false
+		}
+		if it.err = it.ctx.Err(); it.err != nil {
+			return false
+		}
+		page, next, more, err := it.fetch(it.ctx, it.next)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.cursor, it.next, it.more, it.page, it.i = it.next, next, more && len(page) > 0, page, 0
+	}
+	it.i++
+	return true
+}
+
+// Value return the current item
+func (it *Iterator[T]) Value() T {
+	return it.page[it.i-1]
+}
+
+// Err return the error which stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Checkpoint return the cursor resuming the iteration after the current item, to be passed as
+// the starting cursor of a new iteration
+func (it *Iterator[T]) Checkpoint() int64 {
+	switch {
+	case it.i == 0:
+		return it.cursor
+	case it.i == len(it.page):
+		return it.next
+	case it.after != nil:
+		return it.after(it.page[it.i-1])
+	}
+	return it.cursor
+}
+
+// PageLimit return the limit set on a service if positive, or max, the largest page of its endpoint
+func PageLimit(limit *int, max int) int {
+	if limit != nil && *limit > 0 {
+		return *limit
+	}
+	return max
+}
+
+// NewTimeIterator create an iterator over an endpoint listing items by start time, such as klines,
+// from startTime to endTime in milliseconds. fetch request the page of at most limit items from a
+// start time, up to endTime, and at return the time of an item. The checkpoint is the time
+// following the last item returned.
+func NewTimeIterator[T any](ctx context.Context, startTime, endTime int64, limit int, fetch func(ctx context.Context, startTime int64) ([]T, error), at func(T) int64) *Iterator[T] {
+	return NewIterator(ctx, startTime, func(ctx context.Context, cursor int64) ([]T, int64, bool, error) {
+		items, err := fetch(ctx, cursor)
+		if err != nil || len(items) == 0 {
+			return nil, cursor, false, err
+		}
+		next := at(items[len(items)-1]) + 1
+		return items, next, len(items) == limit && next <= endTime, nil
+	}, func(item T) int64 {
+		return at(item) + 1
+	})
+}
+
+// NewIDIterator create an iterator over an endpoint listing items by id, such as trades and
+// orders, from the one with id fromID up to endTime in milliseconds if not nil. fetch request the
+// page of at most limit items from an id, and key return the id and time of an item. The
+// checkpoint is the id following the last item returned.
+func NewIDIterator[T any](ctx context.Context, fromID int64, endTime *int64, limit int, fetch func(ctx context.Context, fromID int64) ([]T, error), key func(T) (id, time int64)) *Iterator[T] {
+	return NewIterator(ctx, fromID, func(ctx context.Context, cursor int64) ([]T, int64, bool, error) {
+		items, err := fetch(ctx, cursor)
+		if err != nil || len(items) == 0 {
+			return nil, cursor, false, err
+		}
+		full, ended := len(items) == limit, false
+		if endTime != nil {
+			for i, item := range items {
+				if _, time := key(item); time > *endTime {
+					items, ended = items[:i], true
+					break
+				}
+			}
+		}
+		if len(items) == 0 {
+			return nil, cursor, false, nil
+		}
+		id, _ := key(items[len(items)-1])
+		return items, id + 1, full && !ended, nil
+	}, func(item T) int64 {
+		id, _ := key(item)
+		return id + 1
+	})
+}
+
+// All consume the remaining items
+func (it *Iterator[T]) All() ([]T, error) {
+	var items []T
+	for it.Next() {
+		items = append(items, it.Value())
+	}
+	return items, it.Err()
+}
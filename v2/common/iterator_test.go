@@ -0,0 +1,125 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pages serve the pages of [from, to) with 3 items each, failing once at failAt
+func pages(to int64, failAt int64) PageFunc[int64] {
+	return func(ctx context.Context, cursor int64) ([]int64, int64, bool, error) {
+		if cursor == failAt {
+			failAt = -1
+			return nil, cursor, false, errors.New("connection reset")
+		}
+		var page []int64
+		for i := cursor; i < to && len(page) < 3; i++ {
+			page = append(page, i)
+		}
+		next := cursor + int64(len(page))
+		return page, next, next < to, nil
+	}
+}
+
+func TestIterator(t *testing.T) {
+	it := NewIterator(context.Background(), 0, pages(8, -1), func(i int64) int64 { return i + 1 })
+	items, err := it.All()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6, 7}, items)
+	assert.Equal(t, int64(8), it.Checkpoint())
+	assert.False(t, it.Next())
+}
+
+func TestIteratorResume(t *testing.T) {
+	after := func(i int64) int64 { return i + 1 }
+	it := NewIterator(context.Background(), 0, pages(8, 6), after)
+	var items []int64
+	for it.Next() {
+		items = append(items, it.Value())
+	}
+	assert.EqualError(t, it.Err(), "connection reset")
+	assert.Equal(t, []int64{0, 1, 2, 3, 4, 5}, items)
+
+	it = NewIterator(context.Background(), it.Checkpoint(), pages(8, -1), after)
+	require.True(t, it.Next())
+	assert.Equal(t, int64(6), it.Value())
+	assert.Equal(t, int64(7), it.Checkpoint())
+
+	// without a cursor per item, the checkpoint is the page of the next item
+	it = NewIterator(context.Background(), 0, pages(8, -1), nil)
+	require.True(t, it.Next())
+	require.True(t, it.Next())
+	assert.Equal(t, int64(0), it.Checkpoint())
+	require.True(t, it.Next())
+	assert.Equal(t, int64(3), it.Checkpoint())
+}
+
+func TestIteratorCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it := NewIterator(ctx, 0, pages(8, -1), nil)
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+}
+
+func TestTimeIterator(t *testing.T) {
+	// items are listed 3 at a time from a start time, one every 10ms
+	var starts []int64
+	end := int64(75)
+	fetch := func(ctx context.Context, startTime int64) ([]int64, error) {
+		starts = append(starts, startTime)
+		var page []int64
+		for at := (startTime + 9) / 10 * 10; at <= end && len(page) < 3; at += 10 {
+			page = append(page, at)
+		}
+		return page, nil
+	}
+	at := func(i int64) int64 { return i }
+	it := NewTimeIterator(context.Background(), 5, 75, 3, fetch, at)
+	items, err := it.All()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{10, 20, 30, 40, 50, 60, 70}, items)
+	assert.Equal(t, []int64{5, 31, 61}, starts)
+	assert.Equal(t, int64(71), it.Checkpoint())
+
+	// a full page reaching the end time is the last one
+	starts, end = nil, 60
+	items, err = NewTimeIterator(context.Background(), 5, end, 3, fetch, at).All()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{10, 20, 30, 40, 50, 60}, items)
+	assert.Equal(t, []int64{5, 31}, starts)
+}
+
+func TestIDIterator(t *testing.T) {
+	// items are listed 3 at a time from an id, item i being at time 100*i
+	var froms []int64
+	fetch := func(ctx context.Context, fromID int64) ([]int64, error) {
+		froms = append(froms, fromID)
+		var page []int64
+		for i := fromID; i < 8 && len(page) < 3; i++ {
+			page = append(page, i)
+		}
+		return page, nil
+	}
+	key := func(i int64) (int64, int64) { return i, 100 * i }
+	it := NewIDIterator(context.Background(), 1, nil, 3, fetch, key)
+	items, err := it.All()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7}, items)
+	assert.Equal(t, []int64{1, 4, 7}, froms)
+	assert.Equal(t, int64(8), it.Checkpoint())
+
+	// the items after the end time end the iteration
+	froms = nil
+	endTime := int64(450)
+	it = NewIDIterator(context.Background(), 1, &endTime, 3, fetch, key)
+	items, err = it.All()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4}, items)
+	assert.Equal(t, []int64{1, 4}, froms)
+	assert.Equal(t, int64(5), it.Checkpoint())
+}
@@ -0,0 +1,301 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Filter types reported by FilterError
+const (
+	FilterTypePrice            = "PRICE_FILTER"
+	FilterTypePercentPrice     = "PERCENT_PRICE"
+	FilterTypeLotSize          = "LOT_SIZE"
+	FilterTypeMarketLotSize    = "MARKET_LOT_SIZE"
+	FilterTypeMinNotional      = "MIN_NOTIONAL"
+	FilterTypeNotional         = "NOTIONAL"
+	FilterTypeIcebergParts     = "ICEBERG_PARTS"
+	FilterTypeMaxNumOrders     = "MAX_NUM_ORDERS"
+	FilterTypeMaxNumAlgoOrders = "MAX_NUM_ALGO_ORDERS"
+)
+
+// ErrUnknownSymbol is returned when validating an order of a symbol missing from exchange info
+var ErrUnknownSymbol = errors.New("symbol not in exchange info")
+
+// FilterViolation define how a value breaks a filter
+type FilterViolation string
+
+// Filter violations
+const (
+	FilterViolationBelowMin FilterViolation = "BELOW_MIN"
+	FilterViolationAboveMax FilterViolation = "ABOVE_MAX"
+	FilterViolationTickSize FilterViolation = "TICK_SIZE"
+	FilterViolationStepSize FilterViolation = "STEP_SIZE"
+)
+
+// FilterError describe an order field breaking a symbol filter
+type FilterError struct {
+	Filter    string // filter type, such as PRICE_FILTER
+	Field     string // order field, such as price or notional
+	Violation FilterViolation
+	Value     string // value of the field
+	Bound     string // min, max, tick or step broken
+}
+
+// Error return the filter, field and bound broken
+func (e *FilterError) Error() string {
+	var what string
+	switch e.Violation {
+	case FilterViolationBelowMin:
+		what = "is below min"
+	case FilterViolationAboveMax:
+		what = "is above max"
+	case FilterViolationTickSize:
+		what = "is not a multiple of tick size"
+	case FilterViolationStepSize:
+		what = "is not a multiple of step size"
+	}
+	return fmt.Sprintf("<FilterError> %s: %s %s %s %s", e.Filter, e.Field, e.Value, what, e.Bound)
+}
+
+// OrderValidationError list every filter an order breaks
+type OrderValidationError struct {
+	Symbol string
+	Errors []*FilterError
+}
+
+// Error return the filter errors
+func (e *OrderValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("<OrderValidationError> symbol=%s: %s", e.Symbol, strings.Join(msgs, "; "))
+}
+
+// Has check if the order breaks the given filter type
+func (e *OrderValidationError) Has(filter string) bool {
+	for _, err := range e.Errors {
+		if err.Filter == filter {
+			return true
+		}
+	}
+	return false
+}
+
+// SymbolFilters hold the filters of a symbol orders are checked against, empty or zero values are not checked
+type SymbolFilters struct {
+	MinPrice string
+	MaxPrice string
+	TickSize string
+
+	MultiplierUp   string
+	MultiplierDown string
+
+	MinQuantity string
+	MaxQuantity string
+	StepSize    string
+
+	MarketMinQuantity string
+	MarketMaxQuantity string
+	MarketStepSize    string
+
+	NotionalFilter           string // MIN_NOTIONAL or NOTIONAL
+	MinNotional              string
+	MaxNotional              string
+	ApplyMinNotionalToMarket bool
+	ApplyMaxNotionalToMarket bool
+
+	IcebergParts     int
+	MaxNumOrders     int
+	MaxNumAlgoOrders int
+}
+
+// OrderParams define the fields of an order checked against SymbolFilters
+type OrderParams struct {
+	Symbol             string
+	Buy                bool
+	Market             bool
+	Algo               bool // a conditional order counting against MAX_NUM_ALGO_ORDERS
+	Price              string
+	StopPrice          string
+	Quantity           string
+	QuoteOrderQuantity string
+	IcebergQuantity    string
+
+	ReferencePrice string // average or mark price for PERCENT_PRICE and market notional
+	OpenOrders     int    // open orders of the symbol before this one
+	OpenAlgoOrders int    // open conditional orders of the symbol before this one
+}
+
+// ValidateOrder check o against f and return an *OrderValidationError listing every violation.
+// If round is set, price and quantities are first rounded to the tick and step size in o:
+// quantities down, and prices away from the market, down when buying and up when selling.
+func ValidateOrder(f *SymbolFilters, o *OrderParams, round bool) error {
+	v := &orderValidation{err: &OrderValidationError{Symbol: o.Symbol}}
+	if round {
+		priceMode := RoundUp
+		if o.Buy {
+			priceMode = RoundDown
+		}
+		o.Price = roundToStep(o.Price, f.MinPrice, f.TickSize, priceMode)
+		o.StopPrice = roundToStep(o.StopPrice, f.MinPrice, f.TickSize, RoundHalfUp)
+		if o.Market && ToDecimal(f.MarketStepSize).Sign() > 0 {
+			o.Quantity = roundToStep(o.Quantity, f.MarketMinQuantity, f.MarketStepSize, RoundDown)
+		} else {
+			o.Quantity = roundToStep(o.Quantity, f.MinQuantity, f.StepSize, RoundDown)
+		}
+		o.IcebergQuantity = roundToStep(o.IcebergQuantity, f.MinQuantity, f.StepSize, RoundDown)
+	}
+
+	for _, p := range []struct{ field, value string }{{"price", o.Price}, {"stopPrice", o.StopPrice}} {
+		v.checkRange(FilterTypePrice, p.field, p.value, f.MinPrice, f.MaxPrice)
+		v.checkStep(FilterTypePrice, p.field, p.value, f.MinPrice, f.TickSize, FilterViolationTickSize)
+	}
+	if !o.Market && o.ReferencePrice != "" {
+		v.checkRange(FilterTypePercentPrice, "price", o.Price,
+			mul(o.ReferencePrice, f.MultiplierDown), mul(o.ReferencePrice, f.MultiplierUp))
+	}
+
+	v.checkRange(FilterTypeLotSize, "quantity", o.Quantity, f.MinQuantity, f.MaxQuantity)
+	v.checkStep(FilterTypeLotSize, "quantity", o.Quantity, f.MinQuantity, f.StepSize, FilterViolationStepSize)
+	if o.Market {
+		v.checkRange(FilterTypeMarketLotSize, "quantity", o.Quantity, f.MarketMinQuantity, f.MarketMaxQuantity)
+		v.checkStep(FilterTypeMarketLotSize, "quantity", o.Quantity, f.MarketMinQuantity, f.MarketStepSize, FilterViolationStepSize)
+	}
+	if o.IcebergQuantity != "" {
+		v.checkRange(FilterTypeLotSize, "icebergQuantity", o.IcebergQuantity, f.MinQuantity, f.MaxQuantity)
+		v.checkStep(FilterTypeLotSize, "icebergQuantity", o.IcebergQuantity, f.MinQuantity, f.StepSize, FilterViolationStepSize)
+		if parts := icebergParts(o.Quantity, o.IcebergQuantity); f.IcebergParts > 0 && parts > f.IcebergParts {
+			v.add(FilterTypeIcebergParts, "icebergParts", FilterViolationAboveMax, fmt.Sprint(parts), fmt.Sprint(f.IcebergParts))
+		}
+	}
+
+	notional := o.QuoteOrderQuantity
+	if notional == "" {
+		price := o.Price
+		if o.Market {
+			price = o.ReferencePrice
+		}
+		notional = mul(price, o.Quantity)
+	}
+	minNotional, maxNotional := f.MinNotional, f.MaxNotional
+	if o.Market && !f.ApplyMinNotionalToMarket {
+		minNotional = ""
+	}
+	if o.Market && !f.ApplyMaxNotionalToMarket {
+		maxNotional = ""
+	}
+	v.checkRange(f.NotionalFilter, "notional", notional, minNotional, maxNotional)
+
+	if f.MaxNumOrders > 0 && o.OpenOrders+1 > f.MaxNumOrders {
+		v.add(FilterTypeMaxNumOrders, "openOrders", FilterViolationAboveMax, fmt.Sprint(o.OpenOrders+1), fmt.Sprint(f.MaxNumOrders))
+	}
+	if o.Algo && f.MaxNumAlgoOrders > 0 && o.OpenAlgoOrders+1 > f.MaxNumAlgoOrders {
+		v.add(FilterTypeMaxNumAlgoOrders, "openAlgoOrders", FilterViolationAboveMax, fmt.Sprint(o.OpenAlgoOrders+1), fmt.Sprint(f.MaxNumAlgoOrders))
+	}
+
+	if len(v.err.Errors) > 0 {
+		return v.err
+	}
+	return nil
+}
+
+type orderValidation struct {
+	err *OrderValidationError
+}
+
+func (v *orderValidation) add(filter, field string, violation FilterViolation, value, bound string) {
+	v.err.Errors = append(v.err.Errors, &FilterError{
+		Filter:    filter,
+		Field:     field,
+		Violation: violation,
+		Value:     value,
+		Bound:     bound,
+	})
+}
+
+// checkRange report value below min or above max, a zero bound is not checked
+func (v *orderValidation) checkRange(filter, field, value, min, max string) {
+	x, err := ParseDecimal(value)
+	if err != nil {
+		return
+	}
+	if m := ToDecimal(min); m.Sign() > 0 && x.LessThan(m) {
+		v.add(filter, field, FilterViolationBelowMin, value, min)
+	}
+	if m := ToDecimal(max); m.Sign() > 0 && x.GreaterThan(m) {
+		v.add(filter, field, FilterViolationAboveMax, value, max)
+	}
+}
+
+// checkStep report value whose distance to min is not a multiple of step
+func (v *orderValidation) checkStep(filter, field, value, min, step string, violation FilterViolation) {
+	x, err := ParseDecimal(value)
+	if err != nil {
+		return
+	}
+	s := ToDecimal(step)
+	if s.Sign() <= 0 {
+		return
+	}
+	x = x.Sub(ToDecimal(min))
+	if !x.RoundToStep(s, RoundDown).Equal(x) {
+		v.add(filter, field, violation, value, step)
+	}
+}
+
+// roundToStep round value to min plus a multiple of step, value is returned as is if it is empty
+// or step is not set
+func roundToStep(value, min, step string, mode RoundingMode) string {
+	x, err := ParseDecimal(value)
+	if err != nil {
+		return value
+	}
+	s := ToDecimal(step)
+	if s.Sign() <= 0 {
+		return value
+	}
+	base := ToDecimal(min)
+	return x.Sub(base).RoundToStep(s, mode).Add(base.Trim()).String()
+}
+
+// mul return the exact product of two decimals, or "" if either is not set
+func mul(a, b string) string {
+	x, err := ParseDecimal(a)
+	if err != nil {
+		return ""
+	}
+	y, err := ParseDecimal(b)
+	if err != nil {
+		return ""
+	}
+	return x.Mul(y).String()
+}
+
+// icebergParts return the number of parts quantity is split into
+func icebergParts(quantity, icebergQuantity string) int {
+	q, err := ParseDecimal(quantity)
+	if err != nil {
+		return 0
+	}
+	iq := ToDecimal(icebergQuantity)
+	if iq.Sign() <= 0 {
+		return 0
+	}
+	return int(q.Div(iq, 0, RoundUp).unscaled().Int64())
+}
+
+// RoundToStep round value down to a multiple of step with exact decimal maths,
+// such as a quantity to the step size of LOT_SIZE
+func RoundToStep(value, step string) (string, error) {
+	x, err := ParseDecimal(value)
+	if err != nil {
+		return "", err
+	}
+	s, err := ParseDecimal(step)
+	if err != nil {
+		return "", err
+	}
+	return x.RoundToStep(s, RoundDown).String(), nil
+}
@@ -0,0 +1,136 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSymbolFilters() *SymbolFilters {
+	return &SymbolFilters{
+		MinPrice:                 "0.01000000",
+		MaxPrice:                 "1000000.00000000",
+		TickSize:                 "0.01000000",
+		MultiplierUp:             "5",
+		MultiplierDown:           "0.2",
+		MinQuantity:              "0.00001000",
+		MaxQuantity:              "9000.00000000",
+		StepSize:                 "0.00001000",
+		MarketMinQuantity:        "0.00000000",
+		MarketMaxQuantity:        "100.00000000",
+		MarketStepSize:           "0.00000000",
+		NotionalFilter:           FilterTypeNotional,
+		MinNotional:              "10.00000000",
+		ApplyMinNotionalToMarket: true,
+		MaxNotional:              "9000000.00000000",
+		IcebergParts:             10,
+		MaxNumOrders:             200,
+		MaxNumAlgoOrders:         5,
+	}
+}
+
+func TestValidateOrder(t *testing.T) {
+	f := testSymbolFilters()
+	assert.NoError(t, ValidateOrder(f, &OrderParams{Symbol: "BTCUSDT", Price: "20000.01", Quantity: "0.001"}, false))
+
+	err := ValidateOrder(f, &OrderParams{
+		Symbol:          "BTCUSDT",
+		Price:           "0.005",
+		Quantity:        "0.0000123",
+		IcebergQuantity: "0.000001",
+		OpenOrders:      200,
+	}, false)
+	var validationErr *OrderValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "BTCUSDT", validationErr.Symbol)
+	assert.Equal(t, []*FilterError{
+		{Filter: FilterTypePrice, Field: "price", Violation: FilterViolationBelowMin, Value: "0.005", Bound: "0.01000000"},
+		{Filter: FilterTypePrice, Field: "price", Violation: FilterViolationTickSize, Value: "0.005", Bound: "0.01000000"},
+		{Filter: FilterTypeLotSize, Field: "quantity", Violation: FilterViolationStepSize, Value: "0.0000123", Bound: "0.00001000"},
+		{Filter: FilterTypeLotSize, Field: "icebergQuantity", Violation: FilterViolationBelowMin, Value: "0.000001", Bound: "0.00001000"},
+		{Filter: FilterTypeLotSize, Field: "icebergQuantity", Violation: FilterViolationStepSize, Value: "0.000001", Bound: "0.00001000"},
+		{Filter: FilterTypeIcebergParts, Field: "icebergParts", Violation: FilterViolationAboveMax, Value: "13", Bound: "10"},
+		{Filter: FilterTypeNotional, Field: "notional", Violation: FilterViolationBelowMin, Value: "0.0000000615", Bound: "10.00000000"},
+		{Filter: FilterTypeMaxNumOrders, Field: "openOrders", Violation: FilterViolationAboveMax, Value: "201", Bound: "200"},
+	}, validationErr.Errors)
+	assert.True(t, validationErr.Has(FilterTypeIcebergParts))
+	assert.False(t, validationErr.Has(FilterTypePercentPrice))
+}
+
+func TestValidateMarketOrder(t *testing.T) {
+	f := testSymbolFilters()
+	// the notional of market orders is only checked against a reference price
+	assert.NoError(t, ValidateOrder(f, &OrderParams{Symbol: "BTCUSDT", Market: true, Quantity: "0.0001"}, false))
+
+	err := ValidateOrder(f, &OrderParams{
+		Symbol:         "BTCUSDT",
+		Market:         true,
+		Algo:           true,
+		StopPrice:      "19000",
+		Quantity:       "200",
+		ReferencePrice: "0.04",
+		OpenAlgoOrders: 5,
+	}, false)
+	var validationErr *OrderValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, []*FilterError{
+		{Filter: FilterTypeMarketLotSize, Field: "quantity", Violation: FilterViolationAboveMax, Value: "200", Bound: "100.00000000"},
+		{Filter: FilterTypeNotional, Field: "notional", Violation: FilterViolationBelowMin, Value: "8.00", Bound: "10.00000000"},
+		{Filter: FilterTypeMaxNumAlgoOrders, Field: "openAlgoOrders", Violation: FilterViolationAboveMax, Value: "6", Bound: "5"},
+	}, validationErr.Errors)
+}
+
+func TestValidateOrderPercentPrice(t *testing.T) {
+	f := testSymbolFilters()
+	o := &OrderParams{Symbol: "BTCUSDT", Price: "100001", Quantity: "0.01", ReferencePrice: "20000"}
+	err := ValidateOrder(f, o, false)
+	var validationErr *OrderValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, []*FilterError{
+		{Filter: FilterTypePercentPrice, Field: "price", Violation: FilterViolationAboveMax, Value: "100001", Bound: "100000"},
+	}, validationErr.Errors)
+}
+
+func TestValidateOrderAutoRound(t *testing.T) {
+	f := testSymbolFilters()
+	buy := &OrderParams{Symbol: "BTCUSDT", Buy: true, Price: "20000.019", StopPrice: "19999.995", Quantity: "0.0012345"}
+	require.NoError(t, ValidateOrder(f, buy, true))
+	assert.Equal(t, "20000.01", buy.Price)
+	assert.Equal(t, "20000.00", buy.StopPrice)
+	assert.Equal(t, "0.00123", buy.Quantity)
+
+	sell := &OrderParams{Symbol: "BTCUSDT", Price: "20000.011", Quantity: "0.0012345"}
+	require.NoError(t, ValidateOrder(f, sell, true))
+	assert.Equal(t, "20000.02", sell.Price)
+	assert.Equal(t, "0.00123", sell.Quantity)
+
+	// rounding does not hide other violations
+	small := &OrderParams{Symbol: "BTCUSDT", Buy: true, Price: "1", Quantity: "0.000019"}
+	err := ValidateOrder(f, small, true)
+	assert.Equal(t, "0.00001", small.Quantity)
+	var validationErr *OrderValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.True(t, validationErr.Has(FilterTypeNotional))
+}
+
+func TestRoundToStep(t *testing.T) {
+	tests := []struct {
+		value, step, want string
+	}{
+		{"0.3", "0.1", "0.3"},
+		{"1.39", "0.00100000", "1.390"},
+		{"123.456789", "0.01", "123.45"},
+		{"123.456789", "1", "123"},
+		{"0.00000999", "0.00001", "0.00000"},
+		{"7", "5", "5"},
+	}
+	for _, tt := range tests {
+		got, err := RoundToStep(tt.value, tt.step)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, tt.value)
+	}
+	_, err := RoundToStep("abc", "0.1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,301 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rate limit types and intervals, as reported by exchangeInfo
+const (
+	RateLimitTypeRequestWeight = "REQUEST_WEIGHT"
+	RateLimitTypeOrders        = "ORDERS"
+	RateLimitTypeRawRequests   = "RAW_REQUESTS"
+
+	RateLimitIntervalSecond = "SECOND"
+	RateLimitIntervalMinute = "MINUTE"
+	RateLimitIntervalHour   = "HOUR"
+	RateLimitIntervalDay    = "DAY"
+)
+
+// RateLimit define a limit on requests, weight or orders over an interval
+type RateLimit struct {
+	RateLimitType string
+	Interval      string
+	IntervalNum   int64
+	Limit         int64
+}
+
+// duration return the length of the limit window
+func (l RateLimit) duration() time.Duration {
+	var unit time.Duration
+	switch l.Interval {
+	case RateLimitIntervalSecond:
+		unit = time.Second
+	case RateLimitIntervalMinute:
+		unit = time.Minute
+	case RateLimitIntervalHour:
+		unit = time.Hour
+	case RateLimitIntervalDay:
+		unit = 24 * time.Hour
+	}
+	return time.Duration(l.IntervalNum) * unit
+}
+
+// RequestCostFunc return the weight of a request and the number of orders it places, from its
+// query and form parameters
+type RequestCostFunc func(method, endpoint string, query url.Values) (weight, orders int64)
+
+// BatchOrderCount return the number of orders placed by a request, which is the length of the
+// batchOrders parameter of batch requests and 1 otherwise
+func BatchOrderCount(query url.Values) int64 {
+	var orders []json.RawMessage
+	if err := json.Unmarshal([]byte(query.Get("batchOrders")), &orders); err != nil || len(orders) == 0 {
+		return 1
+	}
+	return int64(len(orders))
+}
+
+// RateLimitError is returned when a request would exceed a limit in fail fast mode,
+// or while the exchange asked to back off after a 429 or 418 response
+type RateLimitError struct {
+	RateLimit  RateLimit
+	Banned     bool // the IP is banned (418) rather than rate limited (429)
+	RetryAfter time.Duration
+}
+
+// Error return the limit exceeded and the delay before retrying
+func (e *RateLimitError) Error() string {
+	if e.RateLimit.RateLimitType == "" {
+		if e.Banned {
+			return fmt.Sprintf("<RateLimitError> IP banned, retry after %s", e.RetryAfter)
+		}
+		return fmt.Sprintf("<RateLimitError> rate limited, retry after %s", e.RetryAfter)
+	}
+	return fmt.Sprintf("<RateLimitError> %s limit of %d per %d %s reached, retry after %s",
+		e.RateLimit.RateLimitType, e.RateLimit.Limit, e.RateLimit.IntervalNum, e.RateLimit.Interval, e.RetryAfter)
+}
+
+// Is check if e is ErrIPBanned or, otherwise, ErrRateLimited
+func (e *RateLimitError) Is(target error) bool {
+	return (target == ErrIPBanned && e.Banned) || (target == ErrRateLimited && !e.Banned)
+}
+
+type rateLimitCounter struct {
+	RateLimit
+	windowEnd time.Time
+	used      int64
+}
+
+// reset start a new window if the current one is over
+func (c *rateLimitCounter) reset(now time.Time) {
+	if now.Before(c.windowEnd) {
+		return
+	}
+	d := c.duration()
+	if d <= 0 {
+		return
+	}
+	// binance windows are aligned on the clock
+	c.windowEnd = now.Truncate(d).Add(d)
+	c.used = 0
+}
+
+// RateLimiter keep track of the request weight and order count used by a client,
+// and delays or fails requests that would exceed the limits.
+// Usage is counted locally and corrected with the X-MBX-USED-WEIGHT-* and
+// X-MBX-ORDER-COUNT-* response headers.
+type RateLimiter struct {
+	// Cost return the weight of each request, 1 if nil
+	Cost RequestCostFunc
+	// FailFast return a *RateLimitError instead of waiting for the limit to reset
+	FailFast bool
+
+	mu          sync.Mutex
+	counters    []*rateLimitCounter
+	backoffTill time.Time
+	banned      bool
+	now         func() time.Time
+}
+
+// NewRateLimiter init a rate limiter with limits, usually the RateLimits of exchangeInfo
+func NewRateLimiter(limits []RateLimit, cost RequestCostFunc) *RateLimiter {
+	l := &RateLimiter{
+		Cost: cost,
+		now:  time.Now,
+	}
+	for _, limit := range limits {
+		l.counters = append(l.counters, &rateLimitCounter{RateLimit: limit})
+	}
+	return l
+}
+
+// Wait reserve the weight of a request, blocking until the limits allow it
+// unless FailFast is set
+func (l *RateLimiter) Wait(ctx context.Context, method, endpoint string, query url.Values) error {
+	weight, orders := int64(1), int64(0)
+	if l.Cost != nil {
+		weight, orders = l.Cost(method, endpoint, query)
+	}
+	for {
+		delay, err := l.reserve(weight, orders)
+		if err != nil || delay == 0 {
+			return err
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve add the request to the counters if it fits, or return how long to wait
+func (l *RateLimiter) reserve(weight, orders int64) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if now.Before(l.backoffTill) {
+		delay := l.backoffTill.Sub(now)
+		if l.FailFast {
+			return 0, &RateLimitError{Banned: l.banned, RetryAfter: delay}
+		}
+		return delay, nil
+	}
+	for _, c := range l.counters {
+		c.reset(now)
+		cost := c.cost(weight, orders)
+		if cost == 0 || c.Limit <= 0 || c.used+cost <= c.Limit {
+			continue
+		}
+		delay := c.windowEnd.Sub(now)
+		if l.FailFast {
+			return 0, &RateLimitError{RateLimit: c.RateLimit, RetryAfter: delay}
+		}
+		return delay, nil
+	}
+	for _, c := range l.counters {
+		c.used += c.cost(weight, orders)
+	}
+	return 0, nil
+}
+
+func (c *rateLimitCounter) cost(weight, orders int64) int64 {
+	switch c.RateLimitType {
+	case RateLimitTypeRequestWeight:
+		return weight
+	case RateLimitTypeOrders:
+		return orders
+	case RateLimitTypeRawRequests:
+		return 1
+	}
+	return 0
+}
+
+// ResponseHook is called with the status code and headers of every REST response,
+// such as to capture the X-MBX-USED-WEIGHT and X-MBX-ORDER-COUNT headers
+type ResponseHook func(method, endpoint string, statusCode int, header http.Header)
+
+// Update sync the counters with the usage reported by a response, and back off
+// for the Retry-After delay on 429 and 418 responses
+func (l *RateLimiter) Update(statusCode int, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for key := range header {
+		var rateLimitType string
+		var suffix string
+		upper := strings.ToUpper(key)
+		switch {
+		case strings.HasPrefix(upper, "X-MBX-USED-WEIGHT-"):
+			rateLimitType, suffix = RateLimitTypeRequestWeight, upper[len("X-MBX-USED-WEIGHT-"):]
+		case strings.HasPrefix(upper, "X-MBX-ORDER-COUNT-"):
+			rateLimitType, suffix = RateLimitTypeOrders, upper[len("X-MBX-ORDER-COUNT-"):]
+		default:
+			continue
+		}
+		used, err := strconv.ParseInt(header.Get(key), 10, 64)
+		if err != nil {
+			continue
+		}
+		limit, ok := parseRateLimitInterval(suffix)
+		if !ok {
+			continue
+		}
+		limit.RateLimitType = rateLimitType
+		c := l.counter(limit)
+		c.reset(now)
+		// requests still in flight are counted locally but not yet by the exchange
+		if used > c.used {
+			c.used = used
+		}
+	}
+	if statusCode == http.StatusTooManyRequests || statusCode == http.StatusTeapot {
+		retryAfter := time.Second
+		if seconds, err := strconv.ParseInt(header.Get("Retry-After"), 10, 64); err == nil {
+			retryAfter = time.Duration(seconds) * time.Second
+		}
+		if till := now.Add(retryAfter); till.After(l.backoffTill) {
+			l.backoffTill = till
+		}
+		l.banned = statusCode == http.StatusTeapot
+	}
+}
+
+// counter return the counter of limit, adding an unlimited one if it is not known
+func (l *RateLimiter) counter(limit RateLimit) *rateLimitCounter {
+	for _, c := range l.counters {
+		if c.RateLimitType == limit.RateLimitType && c.duration() == limit.duration() {
+			return c
+		}
+	}
+	c := &rateLimitCounter{RateLimit: limit}
+	l.counters = append(l.counters, c)
+	return c
+}
+
+// Used return the usage of each limit in its current window
+func (l *RateLimiter) Used() map[RateLimit]int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	used := make(map[RateLimit]int64, len(l.counters))
+	for _, c := range l.counters {
+		c.reset(now)
+		used[c.RateLimit] = c.used
+	}
+	return used
+}
+
+// parseRateLimitInterval parse header suffixes such as 1M or 10S
+func parseRateLimitInterval(s string) (RateLimit, bool) {
+	if len(s) < 2 {
+		return RateLimit{}, false
+	}
+	num, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if err != nil {
+		return RateLimit{}, false
+	}
+	limit := RateLimit{IntervalNum: num}
+	switch s[len(s)-1] {
+	case 'S':
+		limit.Interval = RateLimitIntervalSecond
+	case 'M':
+		limit.Interval = RateLimitIntervalMinute
+	case 'H':
+		limit.Interval = RateLimitIntervalHour
+	case 'D':
+		limit.Interval = RateLimitIntervalDay
+	default:
+		return RateLimit{}, false
+	}
+	return limit, true
+}
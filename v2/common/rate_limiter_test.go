@@ -0,0 +1,155 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRateLimiter(now *time.Time, limits ...RateLimit) *RateLimiter {
+	l := NewRateLimiter(limits, func(method, endpoint string, query url.Values) (int64, int64) {
+		if endpoint == "/order" {
+			return 1, BatchOrderCount(query)
+		}
+		return 10, 0
+	})
+	l.now = func() time.Time { return *now }
+	return l
+}
+
+var (
+	testWeightLimit = RateLimit{RateLimitType: RateLimitTypeRequestWeight, Interval: RateLimitIntervalMinute, IntervalNum: 1, Limit: 25}
+	testOrderLimit  = RateLimit{RateLimitType: RateLimitTypeOrders, Interval: RateLimitIntervalSecond, IntervalNum: 10, Limit: 2}
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(&now, testWeightLimit, testOrderLimit)
+
+	for i := 0; i < 2; i++ {
+		delay, err := l.reserve(l.Cost(http.MethodGet, "/depth", nil))
+		require.NoError(t, err)
+		assert.Zero(t, delay)
+	}
+	// 20 of 25 used, a third request waits for the next minute
+	delay, err := l.reserve(l.Cost(http.MethodGet, "/depth", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, delay)
+
+	// orders have their own limit
+	for i := 0; i < 2; i++ {
+		delay, err = l.reserve(l.Cost(http.MethodPost, "/order", nil))
+		require.NoError(t, err)
+		assert.Zero(t, delay)
+	}
+	delay, err = l.reserve(l.Cost(http.MethodPost, "/order", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, delay)
+	assert.Equal(t, map[RateLimit]int64{testWeightLimit: 22, testOrderLimit: 2}, l.Used())
+
+	now = now.Add(30 * time.Second)
+	delay, err = l.reserve(l.Cost(http.MethodGet, "/depth", nil))
+	require.NoError(t, err)
+	assert.Zero(t, delay)
+	assert.Equal(t, map[RateLimit]int64{testWeightLimit: 10, testOrderLimit: 0}, l.Used())
+}
+
+func TestRateLimiterBatchOrders(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestRateLimiter(&now, testOrderLimit)
+
+	// a batch counts each of its orders
+	batch := url.Values{"batchOrders": {`[{"symbol":"BTCUSDT"},{"symbol":"ETHUSDT"}]`}}
+	delay, err := l.reserve(l.Cost(http.MethodPost, "/order", batch))
+	require.NoError(t, err)
+	assert.Zero(t, delay)
+	assert.Equal(t, map[RateLimit]int64{testOrderLimit: 2}, l.Used())
+	delay, err = l.reserve(l.Cost(http.MethodPost, "/order", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, delay)
+}
+
+func TestRateLimiterFailFast(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 45, 0, time.UTC)
+	l := newTestRateLimiter(&now, testWeightLimit)
+	l.FailFast = true
+
+	require.NoError(t, l.Wait(context.Background(), http.MethodGet, "/depth", nil))
+	require.NoError(t, l.Wait(context.Background(), http.MethodGet, "/depth", nil))
+	err := l.Wait(context.Background(), http.MethodGet, "/depth", nil)
+	var rateLimitErr *RateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, testWeightLimit, rateLimitErr.RateLimit)
+	assert.Equal(t, 15*time.Second, rateLimitErr.RetryAfter)
+	assert.False(t, rateLimitErr.Banned)
+}
+
+func TestRateLimiterWaitContext(t *testing.T) {
+	l := NewRateLimiter([]RateLimit{{
+		RateLimitType: RateLimitTypeRawRequests,
+		Interval:      RateLimitIntervalDay,
+		IntervalNum:   1,
+		Limit:         1,
+	}}, nil)
+	require.NoError(t, l.Wait(context.Background(), http.MethodGet, "/time", nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := l.Wait(ctx, http.MethodGet, "/time", nil)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(&now, testWeightLimit, testOrderLimit)
+	l.FailFast = true
+
+	header := http.Header{}
+	header.Set("X-MBX-USED-WEIGHT-1M", "20")
+	header.Set("X-MBX-ORDER-COUNT-10S", "1")
+	header.Set("X-MBX-ORDER-COUNT-1D", "100")
+	l.Update(http.StatusOK, header)
+	assert.Equal(t, map[RateLimit]int64{
+		testWeightLimit: 20,
+		testOrderLimit:  1,
+		{RateLimitType: RateLimitTypeOrders, Interval: RateLimitIntervalDay, IntervalNum: 1}: 100,
+	}, l.Used())
+
+	// the exchange usage never lowers requests counted locally
+	header.Set("X-MBX-USED-WEIGHT-1M", "5")
+	l.Update(http.StatusOK, header)
+	assert.Equal(t, int64(20), l.Used()[testWeightLimit])
+
+	err := l.Wait(context.Background(), http.MethodGet, "/depth", nil)
+	assert.Error(t, err)
+}
+
+func TestRateLimiterRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(&now)
+	l.FailFast = true
+
+	header := http.Header{}
+	header.Set("Retry-After", "120")
+	l.Update(http.StatusTeapot, header)
+	err := l.Wait(context.Background(), http.MethodGet, "/depth", nil)
+	var rateLimitErr *RateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.True(t, rateLimitErr.Banned)
+	assert.Equal(t, 120*time.Second, rateLimitErr.RetryAfter)
+
+	now = now.Add(100 * time.Second)
+	l.FailFast = false
+	delay, err := l.reserve(10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 20*time.Second, delay)
+
+	now = now.Add(20 * time.Second)
+	assert.NoError(t, l.Wait(context.Background(), http.MethodGet, "/depth", nil))
+}
@@ -0,0 +1,116 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// Error codes the retry policy acts on
+const (
+	ErrorCodeInternal         int64 = -1001 // internal error, unable to process the request
+	ErrorCodeInvalidTimestamp int64 = -1021 // timestamp outside of recvWindow
+	ErrorCodeNoSuchOrder      int64 = -2013 // order does not exist
+)
+
+// RetryPolicy define how REST requests failing with a transient error are sent again.
+// Only reads are retried, except order placements with a client order id that a lookup
+// shows were not placed, and requests rejected for their timestamp which are retried
+// after syncing the server time.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts including the first one
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after each retry
+	Multiplier float64
+	// Jitter randomizes each delay by up to this fraction of it, between 0 and 1
+	Jitter float64
+}
+
+// NewRetryPolicy returns a policy making up to 3 attempts with exponential backoff
+// from 200ms up to 5s
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// Backoff return the delay before the given retry, starting at 1
+func (p *RetryPolicy) Backoff(retry int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	d := float64(p.InitialBackoff) * math.Pow(multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d += d * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(d)
+}
+
+// Wait sleep for the backoff of the given retry, or until ctx is done
+func (p *RetryPolicy) Wait(ctx context.Context, retry int) error {
+	timer := time.NewTimer(p.Backoff(retry))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsTransientError check if a request failed in a way that may succeed if sent again:
+// a transport error before any response (statusCode 0), a 5xx response or an internal error.
+// Rate limiter refusals, canceled contexts and errors building or signing the request are
+// not transient.
+func IsTransientError(statusCode int, err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return false
+	}
+	if statusCode == 0 {
+		return isTransportError(err)
+	}
+	if statusCode >= http.StatusInternalServerError {
+		return true
+	}
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.Code == ErrorCodeInternal
+}
+
+// isTransportError check if err is a network timeout, or a connection closed or reset
+// before the response was read
+func isTransportError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE)
+}
+
+// IsAPIErrorCode check if err is an API error with the given code
+func IsAPIErrorCode(err error, code int64) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.Code == code
+}
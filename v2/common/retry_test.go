@@ -0,0 +1,73 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     3,
+	}
+	assert.Equal(t, 100*time.Millisecond, p.Backoff(1))
+	assert.Equal(t, 300*time.Millisecond, p.Backoff(2))
+	assert.Equal(t, 900*time.Millisecond, p.Backoff(3))
+	assert.Equal(t, time.Second, p.Backoff(4))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.Backoff(1)
+		assert.True(t, d >= 50*time.Millisecond && d <= 150*time.Millisecond, d)
+	}
+}
+
+func TestRetryPolicyWait(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: time.Hour}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, p.Wait(ctx, 1))
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		err        error
+		transient  bool
+	}{
+		{"connection reset", 0, &url.Error{Op: "Get", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}, true},
+		{"timeout", 0, &url.Error{Op: "Get", Err: timeoutError{}}, true},
+		{"unexpected EOF", 0, &url.Error{Op: "Get", Err: io.ErrUnexpectedEOF}, true},
+		{"canceled", 0, fmt.Errorf("request: %w", context.Canceled), false},
+		{"deadline", 0, &url.Error{Op: "Get", Err: context.DeadlineExceeded}, false},
+		{"rate limiter", 0, &RateLimitError{}, false},
+		{"signer", 0, errors.New("sign: invalid key"), false},
+		{"server error", http.StatusBadGateway, &APIError{}, true},
+		{"internal error", http.StatusBadRequest, &APIError{Code: ErrorCodeInternal}, true},
+		{"rejected", http.StatusBadRequest, &APIError{Code: -1121}, false},
+		{"rate limited", http.StatusTooManyRequests, &APIError{Code: -1003}, false},
+		{"no error", http.StatusOK, nil, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.transient, IsTransientError(tt.statusCode, tt.err), tt.name)
+	}
+}
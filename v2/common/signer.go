@@ -0,0 +1,110 @@
+package common
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// Signer signs the payload of SIGNED requests. The returned signature is set as
+// the signature parameter as is, clients take care of URL encoding it.
+type Signer interface {
+	Sign(payload []byte) (string, error)
+}
+
+// HMACSigner signs with HMAC SHA256 of the secret key, hex encoded
+type HMACSigner struct {
+	SecretKey string
+}
+
+// NewHMACSigner init an HMAC signer with a secret key
+func NewHMACSigner(secretKey string) *HMACSigner {
+	return &HMACSigner{SecretKey: secretKey}
+}
+
+// Sign return the hex HMAC SHA256 of payload
+func (s *HMACSigner) Sign(payload []byte) (string, error) {
+	mac := hmac.New(sha256.New, []byte(s.SecretKey))
+	_, err := mac.Write(payload)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", mac.Sum(nil)), nil
+}
+
+// RSASigner signs with RSASSA-PKCS1-v1_5 over SHA256, base64 encoded.
+// Key can be an *rsa.PrivateKey or any crypto.Signer holding an RSA key, such as an HSM handle.
+type RSASigner struct {
+	Key crypto.Signer
+}
+
+// NewRSASigner init an RSA signer with a private key
+func NewRSASigner(key crypto.Signer) *RSASigner {
+	return &RSASigner{Key: key}
+}
+
+// Sign return the base64 RSA signature of payload
+func (s *RSASigner) Sign(payload []byte) (string, error) {
+	digest := sha256.Sum256(payload)
+	signature, err := s.Key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// Ed25519Signer signs with Ed25519, base64 encoded.
+// Key can be an ed25519.PrivateKey or any crypto.Signer holding an Ed25519 key.
+type Ed25519Signer struct {
+	Key crypto.Signer
+}
+
+// NewEd25519Signer init an Ed25519 signer with a private key
+func NewEd25519Signer(key crypto.Signer) *Ed25519Signer {
+	return &Ed25519Signer{Key: key}
+}
+
+// Sign return the base64 Ed25519 signature of payload
+func (s *Ed25519Signer) Sign(payload []byte) (string, error) {
+	signature, err := s.Key.Sign(rand.Reader, payload, crypto.Hash(0))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// NewSignerFromPEM init an RSA or Ed25519 signer from a PEM encoded private key,
+// in PKCS#8 or, for RSA, PKCS#1 form
+func NewSignerFromPEM(data []byte) (Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("signer: no PEM block found")
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return NewRSASigner(key), nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return NewRSASigner(key), nil
+	case ed25519.PrivateKey:
+		return NewEd25519Signer(key), nil
+	default:
+		return nil, fmt.Errorf("signer: unsupported private key type %T", key)
+	}
+}
@@ -0,0 +1,102 @@
+package common
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const signerTestPayload = "symbol=LTCBTC&side=BUY&type=LIMIT&timeInForce=GTC&quantity=1&price=0.1&recvWindow=5000&timestamp=1499827319559"
+
+func TestHMACSigner(t *testing.T) {
+	// example from the binance API documentation
+	signer := NewHMACSigner("NhqPtmdSJYdKjVHjA7PZj4Mge3R5YNiP1e3UZjInClVN65XAbvqqM6A7H5fATj0j")
+	signature, err := signer.Sign([]byte(signerTestPayload))
+	require.NoError(t, err)
+	assert.Equal(t, "c8db56825ae71d6d79447849e617115f4a920fa2acdcab2b053c4b2838bd6b71", signature)
+}
+
+func TestRSASigner(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signature, err := NewRSASigner(key).Sign([]byte(signerTestPayload))
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(signerTestPayload))
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], raw))
+}
+
+func TestEd25519Signer(t *testing.T) {
+	public, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signature, err := NewEd25519Signer(key).Sign([]byte(signerTestPayload))
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(public, []byte(signerTestPayload), raw))
+}
+
+func TestNewSignerFromPEM(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaPKCS8, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ed25519PKCS8, err := x509.MarshalPKCS8PrivateKey(ed25519Key)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		block *pem.Block
+		want  crypto.Signer
+	}{
+		{
+			name:  "rsa pkcs1",
+			block: &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)},
+			want:  rsaKey,
+		},
+		{
+			name:  "rsa pkcs8",
+			block: &pem.Block{Type: "PRIVATE KEY", Bytes: rsaPKCS8},
+			want:  rsaKey,
+		},
+		{
+			name:  "ed25519 pkcs8",
+			block: &pem.Block{Type: "PRIVATE KEY", Bytes: ed25519PKCS8},
+			want:  ed25519Key,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := NewSignerFromPEM(pem.EncodeToMemory(tt.block))
+			require.NoError(t, err)
+			// compare the public keys, parsed private keys may differ in their precomputed values
+			var key crypto.Signer
+			switch signer := signer.(type) {
+			case *RSASigner:
+				key = signer.Key
+			case *Ed25519Signer:
+				key = signer.Key
+			}
+			require.NotNil(t, key)
+			assert.IsType(t, tt.want, key)
+			assert.Equal(t, tt.want.Public(), key.Public())
+		})
+	}
+
+	_, err = NewSignerFromPEM([]byte("not a key"))
+	assert.Error(t, err)
+	_, err = NewSignerFromPEM(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("garbage")}))
+	assert.Error(t, err)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // GetBalanceService get account balance
@@ -42,6 +44,26 @@ type Balance struct {
 	UpdateTime         int64  `json:"updateTime"`
 }
 
+// BalanceDecimal return Balance as a decimal
+func (b *Balance) BalanceDecimal() common.Decimal {
+	return common.ToDecimal(b.Balance)
+}
+
+// CrossWalletBalanceDecimal return CrossWalletBalance as a decimal
+func (b *Balance) CrossWalletBalanceDecimal() common.Decimal {
+	return common.ToDecimal(b.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal return CrossUnPnl as a decimal
+func (b *Balance) CrossUnPnlDecimal() common.Decimal {
+	return common.ToDecimal(b.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal return AvailableBalance as a decimal
+func (b *Balance) AvailableBalanceDecimal() common.Decimal {
+	return common.ToDecimal(b.AvailableBalance)
+}
+
 // GetAccountService get account info
 type GetAccountService struct {
 	c *Client
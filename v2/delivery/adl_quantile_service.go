@@ -0,0 +1,57 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetADLQuantileService get the auto-deleveraging quantile of the positions
+type GetADLQuantileService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetADLQuantileService) Symbol(symbol string) *GetADLQuantileService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetADLQuantileService) Do(ctx context.Context, opts ...RequestOption) (res []*ADLQuantile, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/adlQuantile",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ADLQuantile{}, err
+	}
+	res = make([]*ADLQuantile, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ADLQuantile{}, err
+	}
+	return res, nil
+}
+
+// ADLQuantile define the ADL quantile of the positions of a symbol
+type ADLQuantile struct {
+	Symbol      string           `json:"symbol"`
+	ADLQuantile ADLQuantileValue `json:"adlQuantile"`
+}
+
+// ADLQuantileValue define the ADL quantile, from 0 to 4, of every position side. In hedge mode
+// Hedge is only a sign, the quantiles of both sides being in Long and Short, and in one-way mode
+// the position is in Both.
+type ADLQuantileValue struct {
+	Long  int `json:"LONG"`
+	Short int `json:"SHORT"`
+	Both  int `json:"BOTH"`
+	Hedge int `json:"HEDGE"`
+}
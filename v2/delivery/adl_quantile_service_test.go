@@ -0,0 +1,54 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type adlQuantileServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestADLQuantileService(t *testing.T) {
+	suite.Run(t, new(adlQuantileServiceTestSuite))
+}
+
+func (s *adlQuantileServiceTestSuite) TestGetADLQuantile() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_200925",
+			"adlQuantile": {
+				"LONG": 3,
+				"SHORT": 3,
+				"HEDGE": 0
+			}
+		},
+		{
+			"symbol": "BTCUSD_201225",
+			"adlQuantile": {
+				"BOTH": 1
+			}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetADLQuantileService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*ADLQuantile{
+		{
+			Symbol:      "BTCUSD_200925",
+			ADLQuantile: ADLQuantileValue{Long: 3, Short: 3},
+		},
+		{
+			Symbol:      "BTCUSD_201225",
+			ADLQuantile: ADLQuantileValue{Both: 1},
+		},
+	}, res)
+}
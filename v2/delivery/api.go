@@ -0,0 +1,78 @@
+package delivery
+
+// MarketDataAPI define the market data services of Client
+type MarketDataAPI interface {
+	NewPingService() PingServiceAPI
+	NewServerTimeService() ServerTimeServiceAPI
+	NewExchangeInfoService() ExchangeInfoServiceAPI
+	NewKlinesService() KlinesServiceAPI
+	NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI
+	NewListPricesService() ListPricesServiceAPI
+	NewListBookTickersService() ListBookTickersServiceAPI
+	NewListLiquidationOrdersService() ListLiquidationOrdersServiceAPI
+	NewDepthService() DepthServiceAPI
+	NewRecentTradesService() RecentTradesServiceAPI
+	NewHistoricalTradesService() HistoricalTradesServiceAPI
+	NewAggTradesService() AggTradesServiceAPI
+	NewPremiumIndexService() PremiumIndexServiceAPI
+	NewFundingRateService() FundingRateServiceAPI
+	NewGetOpenInterestService() GetOpenInterestServiceAPI
+	NewOpenInterestStatisticsService() OpenInterestStatisticsServiceAPI
+	NewBasisService() BasisServiceAPI
+	NewTakerBuySellVolumeService() TakerBuySellVolumeServiceAPI
+	NewLongShortRatioService() LongShortRatioServiceAPI
+}
+
+// TradingAPI define the order and position services of Client
+type TradingAPI interface {
+	NewCreateOrderService() CreateOrderServiceAPI
+	NewGetOrderService() GetOrderServiceAPI
+	NewCancelOrderService() CancelOrderServiceAPI
+	NewCancelAllOpenOrdersService() CancelAllOpenOrdersServiceAPI
+	NewListOpenOrdersService() ListOpenOrdersServiceAPI
+	NewListOrdersService() ListOrdersServiceAPI
+	NewChangeLeverageService() ChangeLeverageServiceAPI
+	NewChangeMarginTypeService() ChangeMarginTypeServiceAPI
+	NewUpdatePositionMarginService() UpdatePositionMarginServiceAPI
+	NewChangePositionModeService() ChangePositionModeServiceAPI
+	NewGetPositionModeService() GetPositionModeServiceAPI
+	NewCreateBatchOrdersService() CreateBatchOrdersServiceAPI
+	NewCancelMultipleOrdersService() CancelMultiplesOrdersServiceAPI
+	NewModifyOrderService() ModifyOrderServiceAPI
+	NewListUserLiquidationOrdersService() ListUserLiquidationOrdersServiceAPI
+	NewGetADLQuantileService() GetADLQuantileServiceAPI
+}
+
+// AccountAPI define the account services of Client
+type AccountAPI interface {
+	NewGetAccountService() GetAccountServiceAPI
+	NewGetBalanceService() GetBalanceServiceAPI
+	NewGetPositionRiskService() GetPositionRiskServiceAPI
+	NewListAccountTradeService() ListAccountTradeServiceAPI
+	NewGetIncomeHistoryService() GetIncomeHistoryServiceAPI
+	NewCommissionRateService() CommissionRateServiceAPI
+	NewGetLeverageBracketService() GetLeverageBracketServiceAPI
+}
+
+// UserStreamAPI define the user data stream services of Client
+type UserStreamAPI interface {
+	NewStartUserStreamService() StartUserStreamServiceAPI
+	NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI
+	NewCloseUserStreamService() CloseUserStreamServiceAPI
+	NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) UserDataStreamAPI
+}
+
+// API define the services of Client covered by the interfaces above, returned by Client.API and
+// implemented by the fakes of binancetest
+type API interface {
+	MarketDataAPI
+	TradingAPI
+	AccountAPI
+	UserStreamAPI
+}
+
+// API return the services of c behind the interfaces above, so that code depending on them can be
+// tested with a fake of binancetest
+func (c *Client) API() API {
+	return clientAPI{c}
+}
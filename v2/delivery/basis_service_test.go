@@ -0,0 +1,59 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type basisServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestBasisService(t *testing.T) {
+	suite.Run(t, new(basisServiceTestSuite))
+}
+
+func (s *basisServiceTestSuite) TestBasis() {
+	data := []byte(`[
+		{
+			"indexPrice": "29269.93972727",
+			"contractType": "CURRENT_QUARTER",
+			"basisRate": "0.0024",
+			"futuresPrice": "29341.3",
+			"annualizedBasisRate": "0.0283",
+			"basis": "71.36027273",
+			"pair": "BTCUSD",
+			"timestamp": 1653381600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "1d"
+	limit := 30
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         pair,
+			"contractType": ContractTypeCurrentQuarter,
+			"period":       period,
+			"limit":        limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewBasisService().Pair(pair).ContractType(ContractTypeCurrentQuarter).
+		Period(period).Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*Basis{{
+		Pair:                "BTCUSD",
+		ContractType:        ContractTypeCurrentQuarter,
+		IndexPrice:          "29269.93972727",
+		FuturesPrice:        "29341.3",
+		Basis:               "71.36027273",
+		BasisRate:           "0.0024",
+		AnnualizedBasisRate: "0.0283",
+		Timestamp:           1653381600000,
+	}}, res)
+}
@@ -1,20 +1,15 @@
 package delivery
 
 import (
-	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
 	"encoding/json"
-	"fmt"
-	"io/ioutil"
 	"log"
 	"net/http"
-	"net/url"
 	"os"
 	"time"
 
 	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/internal/transport"
 	"github.com/bitly/go-simplejson"
 )
 
@@ -63,6 +58,12 @@ type UserDataEventType string
 // UserDataEventReasonType define reason type for user data event
 type UserDataEventReasonType string
 
+// ContractType define contract type of a symbol
+type ContractType string
+
+// ForceOrderCloseType define reason type for force order
+type ForceOrderCloseType string
+
 // Endpoints
 const (
 	baseApiMainUrl    = "https://dapi.binance.com"
@@ -136,6 +137,14 @@ const (
 	MarginTypeIsolated MarginType = "ISOLATED"
 	MarginTypeCrossed  MarginType = "CROSSED"
 
+	ContractTypeAll            ContractType = "ALL"
+	ContractTypePerpetual      ContractType = "PERPETUAL"
+	ContractTypeCurrentQuarter ContractType = "CURRENT_QUARTER"
+	ContractTypeNextQuarter    ContractType = "NEXT_QUARTER"
+
+	ForceOrderCloseTypeLiquidation ForceOrderCloseType = "LIQUIDATION"
+	ForceOrderCloseTypeADL         ForceOrderCloseType = "ADL"
+
 	UserDataEventTypeListenKeyExpired    UserDataEventType = "listenKeyExpired"
 	UserDataEventTypeMarginCall          UserDataEventType = "MARGIN_CALL"
 	UserDataEventTypeAccountUpdate       UserDataEventType = "ACCOUNT_UPDATE"
@@ -185,15 +194,24 @@ func getApiEndpoint() string {
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
-func NewClient(apiKey, secretKey string) *Client {
-	return &Client{
+// Without options, the client is configured with the package variables.
+func NewClient(apiKey, secretKey string, opts ...ClientOption) *Client {
+	o := newClientOptions(opts)
+	c := &Client{
 		APIKey:     apiKey,
 		SecretKey:  secretKey,
-		BaseURL:    getApiEndpoint(),
+		BaseURL:    o.apiURL(),
 		UserAgent:  "Binance/golang",
-		HTTPClient: http.DefaultClient,
+		HTTPClient: o.client(),
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
+	if o.logger != nil {
+		c.Logger = o.logger
+	}
+	if len(opts) > 0 {
+		c.ws = o.wsClient()
+	}
+	return c
 }
 
 type doFunc func(req *http.Request) (*http.Response, error)
@@ -202,13 +220,38 @@ type doFunc func(req *http.Request) (*http.Response, error)
 type Client struct {
 	APIKey     string
 	SecretKey  string
+	Signer     common.Signer // signs SIGNED requests instead of the HMAC of SecretKey if set
 	BaseURL    string
 	UserAgent  string
 	HTTPClient *http.Client
 	Debug      bool
 	Logger     *log.Logger
 	TimeOffset int64
+	// RateLimiter delays requests that would exceed the rate limits if set
+	RateLimiter *common.RateLimiter
+	// RetryPolicy retries requests failing with a transient error if set
+	RetryPolicy *common.RetryPolicy
+	// OnResponse is called with the status code and headers of every response if set
+	OnResponse common.ResponseHook
 	do         doFunc
+	ws         *WsClient
+}
+
+// WsClient return the websocket client with the endpoints and settings given to NewClient,
+// or with those of the package variables
+func (c *Client) WsClient() *WsClient {
+	if c.ws != nil {
+		return c.ws
+	}
+	return defaultWsClient()
+}
+
+// signer return the signer of SIGNED requests
+func (c *Client) signer() common.Signer {
+	if c.Signer != nil {
+		return c.Signer
+	}
+	return common.NewHMACSigner(c.SecretKey)
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -217,6 +260,39 @@ func (c *Client) debug(format string, v ...interface{}) {
 	}
 }
 
+// newTransport return the transport sending requests with the settings of c
+func (c *Client) newTransport() *transport.Client {
+	f := c.do
+	if f == nil {
+		f = c.HTTPClient.Do
+	}
+	return &transport.Client{
+		APIKey:        c.APIKey,
+		Signer:        c.signer(),
+		BaseURL:       c.BaseURL,
+		TimeOffset:    c.TimeOffset,
+		RateLimiter:   c.RateLimiter,
+		RetryPolicy:   c.RetryPolicy,
+		Do:            f,
+		Debug:         c.debug,
+		OnResponse:    c.OnResponse,
+		OrderEndpoint: "/dapi/v1/order",
+		LookupOrder:   c.lookupOrder,
+		SyncTime: func(ctx context.Context) (int64, error) {
+			return c.NewSetServerTimeService().Do(ctx)
+		},
+	}
+}
+
+// lookupOrder return the JSON of an order by client order id, to check if a failed placement went through
+func (c *Client) lookupOrder(ctx context.Context, symbol, clientOrderID string) ([]byte, error) {
+	order, err := c.NewGetOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(order)
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -226,100 +302,26 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if err != nil {
 		return err
 	}
-
-	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.endpoint)
-	if r.recvWindow > 0 {
-		r.setParam(recvWindowKey, r.recvWindow)
-	}
-	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
-	}
-	queryString := r.query.Encode()
-	body := &bytes.Buffer{}
-	bodyString := r.form.Encode()
-	header := http.Header{}
-	if r.header != nil {
-		header = r.header.Clone()
-	}
-	if bodyString != "" {
-		header.Set("Content-Type", "application/x-www-form-urlencoded")
-		body = bytes.NewBufferString(bodyString)
-	}
-	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
-		header.Set("X-MBX-APIKEY", c.APIKey)
-	}
-
-	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		mac := hmac.New(sha256.New, []byte(c.SecretKey))
-		_, err = mac.Write([]byte(raw))
-		if err != nil {
-			return err
-		}
-		v := url.Values{}
-		v.Set(signatureKey, fmt.Sprintf("%x", (mac.Sum(nil))))
-		if queryString == "" {
-			queryString = v.Encode()
-		} else {
-			queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
-		}
-	}
-	if queryString != "" {
-		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
+	tr := r.toTransport()
+	err = c.newTransport().Parse(tr)
+	if err != nil {
+		return err
 	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
-
-	r.fullURL = fullURL
-	r.header = header
-	r.body = body
+	r.fullURL, r.header, r.body = tr.FullURL, tr.Header, tr.Body
 	return nil
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
-	err = c.parseRequest(r, opts...)
-	if err != nil {
-		return []byte{}, err
-	}
-	req, err := http.NewRequest(r.method, r.fullURL, r.body)
-	if err != nil {
-		return []byte{}, err
-	}
-	req = req.WithContext(ctx)
-	req.Header = r.header
-	c.debug("request: %#v", req)
-	f := c.do
-	if f == nil {
-		f = c.HTTPClient.Do
-	}
-	res, err := f(req)
-	if err != nil {
-		return []byte{}, err
+	// set request options from user
+	for _, opt := range opts {
+		opt(r)
 	}
-	data, err = ioutil.ReadAll(res.Body)
+	err = r.validate()
 	if err != nil {
 		return []byte{}, err
 	}
-	defer func() {
-		cerr := res.Body.Close()
-		// Only overwrite the retured error if the original error was nil and an
-		// error occurred while closing the body.
-		if err == nil && cerr != nil {
-			err = cerr
-		}
-	}()
-	c.debug("response: %#v", res)
-	c.debug("response body: %s", string(data))
-	c.debug("response status code: %d", res.StatusCode)
-
-	if res.StatusCode >= http.StatusBadRequest {
-		apiErr := new(common.APIError)
-		e := json.Unmarshal(data, apiErr)
-		if e != nil {
-			c.debug("failed to unmarshal json: %s", e)
-		}
-		return nil, apiErr
-	}
-	return data, nil
+	data, _, err = c.newTransport().Call(ctx, r.toTransport())
+	return data, err
 }
 
 // SetApiEndpoint set api Endpoint
@@ -348,6 +350,61 @@ func (c *Client) NewKlinesService() *KlinesService {
 	return &KlinesService{c: c}
 }
 
+// NewDepthService init depth service
+func (c *Client) NewDepthService() *DepthService {
+	return &DepthService{c: c}
+}
+
+// NewRecentTradesService init recent trades service
+func (c *Client) NewRecentTradesService() *RecentTradesService {
+	return &RecentTradesService{c: c}
+}
+
+// NewHistoricalTradesService init historical trades service
+func (c *Client) NewHistoricalTradesService() *HistoricalTradesService {
+	return &HistoricalTradesService{c: c}
+}
+
+// NewAggTradesService init aggregate trades service
+func (c *Client) NewAggTradesService() *AggTradesService {
+	return &AggTradesService{c: c}
+}
+
+// NewPremiumIndexService init premium index service
+func (c *Client) NewPremiumIndexService() *PremiumIndexService {
+	return &PremiumIndexService{c: c}
+}
+
+// NewFundingRateService init funding rate service
+func (c *Client) NewFundingRateService() *FundingRateService {
+	return &FundingRateService{c: c}
+}
+
+// NewGetOpenInterestService init open interest service
+func (c *Client) NewGetOpenInterestService() *GetOpenInterestService {
+	return &GetOpenInterestService{c: c}
+}
+
+// NewOpenInterestStatisticsService init open interest statistics service
+func (c *Client) NewOpenInterestStatisticsService() *OpenInterestStatisticsService {
+	return &OpenInterestStatisticsService{c: c}
+}
+
+// NewBasisService init basis service
+func (c *Client) NewBasisService() *BasisService {
+	return &BasisService{c: c}
+}
+
+// NewTakerBuySellVolumeService init taker buy/sell volume service
+func (c *Client) NewTakerBuySellVolumeService() *TakerBuySellVolumeService {
+	return &TakerBuySellVolumeService{c: c}
+}
+
+// NewLongShortRatioService init long/short ratio service
+func (c *Client) NewLongShortRatioService() *LongShortRatioService {
+	return &LongShortRatioService{c: c}
+}
+
 // NewListPriceChangeStatsService init list prices change stats service
 func (c *Client) NewListPriceChangeStatsService() *ListPriceChangeStatsService {
 	return &ListPriceChangeStatsService{c: c}
@@ -378,6 +435,11 @@ func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
 	return &CloseUserStreamService{c: c}
 }
 
+// NewUserDataStream init a user data stream passing its events to handler, opened by Start
+func (c *Client) NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) *UserDataStream {
+	return &UserDataStream{c: c, handler: handler, errHandler: errHandler}
+}
+
 // NewExchangeInfoService init exchange info service
 func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
@@ -413,6 +475,31 @@ func (c *Client) NewListOrdersService() *ListOrdersService {
 	return &ListOrdersService{c: c}
 }
 
+// NewCreateBatchOrdersService init create batch orders service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewCancelMultipleOrdersService init cancel multiple orders service
+func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
+	return &CancelMultiplesOrdersService{c: c}
+}
+
+// NewModifyOrderService init modify order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewListUserLiquidationOrdersService init list user's force orders service
+func (c *Client) NewListUserLiquidationOrdersService() *ListUserLiquidationOrdersService {
+	return &ListUserLiquidationOrdersService{c: c}
+}
+
+// NewGetADLQuantileService init ADL quantile service
+func (c *Client) NewGetADLQuantileService() *GetADLQuantileService {
+	return &GetADLQuantileService{c: c}
+}
+
 // NewListLiquidationOrdersService init funding rate service
 func (c *Client) NewListLiquidationOrdersService() *ListLiquidationOrdersService {
 	return &ListLiquidationOrdersService{c: c}
@@ -428,6 +515,26 @@ func (c *Client) NewGetBalanceService() *GetBalanceService {
 	return &GetBalanceService{c: c}
 }
 
+// NewListAccountTradeService init account trade list service
+func (c *Client) NewListAccountTradeService() *ListAccountTradeService {
+	return &ListAccountTradeService{c: c}
+}
+
+// NewGetIncomeHistoryService init income history service
+func (c *Client) NewGetIncomeHistoryService() *GetIncomeHistoryService {
+	return &GetIncomeHistoryService{c: c}
+}
+
+// NewCommissionRateService init commission rate service
+func (c *Client) NewCommissionRateService() *CommissionRateService {
+	return &CommissionRateService{c: c}
+}
+
+// NewGetLeverageBracketService init leverage bracket service
+func (c *Client) NewGetLeverageBracketService() *GetLeverageBracketService {
+	return &GetLeverageBracketService{c: c}
+}
+
 // NewGetPositionRiskService init getting position risk service
 func (c *Client) NewGetPositionRiskService() *GetPositionRiskService {
 	return &GetPositionRiskService{c: c}
@@ -0,0 +1,96 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type incomeHistoryServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestIncomeHistoryService(t *testing.T) {
+	suite.Run(t, new(incomeHistoryServiceTestSuite))
+}
+
+func (s *incomeHistoryServiceTestSuite) TestGetIncomeHistory() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_PERP",
+			"incomeType": "FUNDING_FEE",
+			"income": "-0.00000412",
+			"asset": "BTC",
+			"info": "",
+			"time": 1570608000000,
+			"tranId": 9689322392,
+			"tradeId": ""
+		},
+		{
+			"symbol": "BTCUSD_200925",
+			"incomeType": "COMMISSION",
+			"income": "-0.00000076",
+			"asset": "BTC",
+			"info": "",
+			"time": 1570636800000,
+			"tranId": 9689322393,
+			"tradeId": "2059192"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	startTime := int64(1570600000000)
+	endTime := int64(1570700000000)
+	limit := int64(100)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"startTime": startTime,
+			"endTime":   endTime,
+			"limit":     limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetIncomeHistoryService().StartTime(startTime).EndTime(endTime).
+		Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*IncomeHistory{
+		{
+			Symbol:     "BTCUSD_PERP",
+			IncomeType: "FUNDING_FEE",
+			Income:     "-0.00000412",
+			Asset:      "BTC",
+			Time:       1570608000000,
+			TranID:     9689322392,
+		},
+		{
+			Symbol:     "BTCUSD_200925",
+			IncomeType: "COMMISSION",
+			Income:     "-0.00000076",
+			Asset:      "BTC",
+			Time:       1570636800000,
+			TranID:     9689322393,
+			TradeID:    "2059192",
+		},
+	}, res)
+}
+
+func (s *incomeHistoryServiceTestSuite) TestGetIncomeHistoryOfSymbol() {
+	data := []byte(`[]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":     "BTCUSD_PERP",
+			"incomeType": "REALIZED_PNL",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetIncomeHistoryService().Symbol("BTCUSD_PERP").IncomeType("REALIZED_PNL").
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Empty(res)
+}
@@ -0,0 +1,39 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Largest pages of the paginated endpoints
+const (
+	maxKlinesLimit = 1500
+	maxOrdersLimit = 1000
+)
+
+// Range iterate over the klines opening between startTime and endTime, in milliseconds. The
+// checkpoint is the start time following the last kline returned.
+func (s *KlinesService) Range(ctx context.Context, startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Kline] {
+	limit := common.PageLimit(s.limit, maxKlinesLimit)
+	s.Limit(limit).EndTime(endTime)
+	return common.NewTimeIterator(ctx, startTime, endTime, limit, func(ctx context.Context, startTime int64) ([]*Kline, error) {
+		return s.StartTime(startTime).Do(ctx, opts...)
+	}, func(k *Kline) int64 {
+		return k.OpenTime
+	})
+}
+
+// All iterate over the orders of the symbol or pair from the one with id fromOrderID, up to the end
+// time if set. The checkpoint is the order id following the last order returned.
+func (s *ListOrdersService) All(ctx context.Context, fromOrderID int64, opts ...RequestOption) *common.Iterator[*Order] {
+	limit := common.PageLimit(s.limit, maxOrdersLimit)
+	endTime := s.endTime
+	s.startTime, s.endTime = nil, nil
+	s.Limit(limit)
+	return common.NewIDIterator(ctx, fromOrderID, endTime, limit, func(ctx context.Context, fromOrderID int64) ([]*Order, error) {
+		return s.OrderID(fromOrderID).Do(ctx, opts...)
+	}, func(o *Order) (int64, int64) {
+		return o.OrderID, o.Time
+	})
+}
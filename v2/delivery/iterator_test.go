@@ -0,0 +1,41 @@
+package delivery
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type iteratorTestSuite struct {
+	baseTestSuite
+	queries []url.Values
+}
+
+func TestIterator(t *testing.T) {
+	suite.Run(t, new(iteratorTestSuite))
+}
+
+// mockPages answer the requests with pages in order and record their queries
+func (s *iteratorTestSuite) mockPages(pages ...string) {
+	s.client.Client.do = s.client.do
+	for _, page := range pages {
+		s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(page), 200), nil).Once()
+	}
+	s.queries = nil
+	s.assertReq(func(r *request) {
+		s.queries = append(s.queries, r.query)
+	})
+}
+
+func (s *iteratorTestSuite) TestListOrdersAll() {
+	s.mockPages(
+		`[{"orderId":1,"time":10},{"orderId":2,"time":20}]`,
+		`[{"orderId":3,"time":30}]`,
+	)
+	orders, err := s.client.NewListOrdersService().Symbol("BTCUSD_PERP").Limit(2).All(newContext(), 1).All()
+	s.r().NoError(err)
+	s.r().Len(orders, 3)
+	s.r().Len(s.queries, 2)
+	s.Equal("3", s.queries[1].Get("orderId"))
+}
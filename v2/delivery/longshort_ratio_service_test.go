@@ -0,0 +1,51 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type longShortRatioServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestLongShortRatioService(t *testing.T) {
+	suite.Run(t, new(longShortRatioServiceTestSuite))
+}
+
+func (s *longShortRatioServiceTestSuite) TestLongShortRatio() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "0.1960",
+			"longAccount": "0.6622",
+			"shortAccount": "0.3378",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "1h"
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":   pair,
+			"period": period,
+			"limit":  limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewLongShortRatioService().Pair(pair).Period(period).Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*LongShortRatio{{
+		Pair:           "BTCUSD",
+		LongShortRatio: "0.1960",
+		LongAccount:    "0.6622",
+		ShortAccount:   "0.3378",
+		Timestamp:      1583139600000,
+	}}, res)
+}
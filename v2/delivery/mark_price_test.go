@@ -0,0 +1,171 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type markPriceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarkPriceService(t *testing.T) {
+	suite.Run(t, new(markPriceServiceTestSuite))
+}
+
+func (s *markPriceServiceTestSuite) TestPremiumIndex() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"markPrice": "11029.69574559",
+			"indexPrice": "10979.14437500",
+			"estimatedSettlePrice": "10981.74168236",
+			"lastFundingRate": "0.00071003",
+			"interestRate": "0.00010000",
+			"nextFundingTime": 1596096000000,
+			"time": 1596094042000
+		},
+		{
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"markPrice": "12077.01343750",
+			"indexPrice": "10979.10312500",
+			"estimatedSettlePrice": "10981.74168236",
+			"lastFundingRate": "",
+			"interestRate": "",
+			"nextFundingTime": 0,
+			"time": 1596094042000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair": pair,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewPremiumIndexService().Pair(pair).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*PremiumIndex{
+		{
+			Symbol:               "BTCUSD_PERP",
+			Pair:                 "BTCUSD",
+			MarkPrice:            "11029.69574559",
+			IndexPrice:           "10979.14437500",
+			EstimatedSettlePrice: "10981.74168236",
+			LastFundingRate:      "0.00071003",
+			InterestRate:         "0.00010000",
+			NextFundingTime:      1596096000000,
+			Time:                 1596094042000,
+		},
+		{
+			Symbol:               "BTCUSD_200925",
+			Pair:                 "BTCUSD",
+			MarkPrice:            "12077.01343750",
+			IndexPrice:           "10979.10312500",
+			EstimatedSettlePrice: "10981.74168236",
+			Time:                 1596094042000,
+		},
+	}, res)
+}
+
+func (s *markPriceServiceTestSuite) TestFundingRate() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_PERP",
+			"fundingTime": 1596038400000,
+			"fundingRate": "-0.00300000"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	startTime := int64(1596038400000)
+	endTime := int64(1596096000000)
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    symbol,
+			"startTime": startTime,
+			"endTime":   endTime,
+			"limit":     limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewFundingRateService().Symbol(symbol).StartTime(startTime).
+		EndTime(endTime).Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*FundingRate{{
+		Symbol:      "BTCUSD_PERP",
+		FundingRate: "-0.00300000",
+		FundingTime: 1596038400000,
+	}}, res)
+}
+
+func (s *markPriceServiceTestSuite) TestGetLeverageBracket() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_PERP",
+			"notionalCoef": 1.50,
+			"brackets": [
+				{
+					"bracket": 1,
+					"initialLeverage": 125,
+					"qtyCap": 50,
+					"qtylFloor": 0,
+					"maintMarginRatio": 0.004,
+					"cum": 0.0
+				},
+				{
+					"bracket": 2,
+					"initialLeverage": 100,
+					"qtyCap": 250,
+					"qtylFloor": 50,
+					"maintMarginRatio": 0.005,
+					"cum": 0.05
+				}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol": symbol,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetLeverageBracketService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*LeverageBracket{{
+		Symbol:       "BTCUSD_PERP",
+		NotionalCoef: 1.5,
+		Brackets: []Bracket{
+			{
+				Bracket:          1,
+				InitialLeverage:  125,
+				QtyCap:           50,
+				MaintMarginRatio: 0.004,
+			},
+			{
+				Bracket:          2,
+				InitialLeverage:  100,
+				QtyCap:           250,
+				QtyFloor:         50,
+				MaintMarginRatio: 0.005,
+				Cum:              0.05,
+			},
+		},
+	}}, res)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/internal/transport"
 )
 
 // CreateOrderService create order
@@ -123,12 +126,7 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
-	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
-	}
+func (s *CreateOrderService) params() params {
 	m := params{
 		"symbol":           s.symbol,
 		"side":             s.side,
@@ -169,7 +167,16 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
-	r.setFormParams(m)
+	return m
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.params())
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -219,6 +226,36 @@ type CreateOrderResponse struct {
 	PriceProtect     bool             `json:"priceProtect"`
 }
 
+// PriceDecimal return Price as a decimal
+func (c *CreateOrderResponse) PriceDecimal() common.Decimal {
+	return common.ToDecimal(c.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal
+func (c *CreateOrderResponse) OrigQuantityDecimal() common.Decimal {
+	return common.ToDecimal(c.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() common.Decimal {
+	return common.ToDecimal(c.ExecutedQuantity)
+}
+
+// CumBaseDecimal return CumBase as a decimal
+func (c *CreateOrderResponse) CumBaseDecimal() common.Decimal {
+	return common.ToDecimal(c.CumBase)
+}
+
+// StopPriceDecimal return StopPrice as a decimal
+func (c *CreateOrderResponse) StopPriceDecimal() common.Decimal {
+	return common.ToDecimal(c.StopPrice)
+}
+
+// AvgPriceDecimal return AvgPrice as a decimal
+func (c *CreateOrderResponse) AvgPriceDecimal() common.Decimal {
+	return common.ToDecimal(c.AvgPrice)
+}
+
 // ListOpenOrdersService list opened orders
 type ListOpenOrdersService struct {
 	c      *Client
@@ -343,6 +380,36 @@ type Order struct {
 	PriceProtect     bool             `json:"priceProtect"`
 }
 
+// PriceDecimal return Price as a decimal
+func (o *Order) PriceDecimal() common.Decimal {
+	return common.ToDecimal(o.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal
+func (o *Order) OrigQuantityDecimal() common.Decimal {
+	return common.ToDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (o *Order) ExecutedQuantityDecimal() common.Decimal {
+	return common.ToDecimal(o.ExecutedQuantity)
+}
+
+// CumBaseDecimal return CumBase as a decimal
+func (o *Order) CumBaseDecimal() common.Decimal {
+	return common.ToDecimal(o.CumBase)
+}
+
+// StopPriceDecimal return StopPrice as a decimal
+func (o *Order) StopPriceDecimal() common.Decimal {
+	return common.ToDecimal(o.StopPrice)
+}
+
+// AvgPriceDecimal return AvgPrice as a decimal
+func (o *Order) AvgPriceDecimal() common.Decimal {
+	return common.ToDecimal(o.AvgPrice)
+}
+
 // ListOrdersService all account orders; active, canceled, or filled
 type ListOrdersService struct {
 	c         *Client
@@ -621,3 +688,326 @@ type LiquidationOrder struct {
 	Side             SideType        `json:"side"`
 	Time             int64           `json:"time"`
 }
+
+// ListUserLiquidationOrdersService list user's force orders
+type ListUserLiquidationOrdersService struct {
+	c             *Client
+	symbol        *string
+	autoCloseType *ForceOrderCloseType
+	startTime     *int64
+	endTime       *int64
+	limit         *int
+}
+
+// Symbol set symbol
+func (s *ListUserLiquidationOrdersService) Symbol(symbol string) *ListUserLiquidationOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// AutoCloseType set autoCloseType, both types are returned if not set
+func (s *ListUserLiquidationOrdersService) AutoCloseType(autoCloseType ForceOrderCloseType) *ListUserLiquidationOrdersService {
+	s.autoCloseType = &autoCloseType
+	return s
+}
+
+// StartTime set startTime
+func (s *ListUserLiquidationOrdersService) StartTime(startTime int64) *ListUserLiquidationOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListUserLiquidationOrdersService) EndTime(endTime int64) *ListUserLiquidationOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListUserLiquidationOrdersService) Limit(limit int) *ListUserLiquidationOrdersService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*UserLiquidationOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/forceOrders",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	if s.autoCloseType != nil {
+		r.setParam("autoCloseType", *s.autoCloseType)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*UserLiquidationOrder{}, err
+	}
+	res = make([]*UserLiquidationOrder, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*UserLiquidationOrder{}, err
+	}
+	return res, nil
+}
+
+// UserLiquidationOrder define user's force order
+type UserLiquidationOrder struct {
+	OrderID          int64            `json:"orderId"`
+	Symbol           string           `json:"symbol"`
+	Pair             string           `json:"pair"`
+	Status           OrderStatusType  `json:"status"`
+	ClientOrderID    string           `json:"clientOrderId"`
+	Price            string           `json:"price"`
+	AvgPrice         string           `json:"avgPrice"`
+	OrigQuantity     string           `json:"origQty"`
+	ExecutedQuantity string           `json:"executedQty"`
+	CumBase          string           `json:"cumBase"`
+	TimeInForce      TimeInForceType  `json:"timeInForce"`
+	Type             OrderType        `json:"type"`
+	ReduceOnly       bool             `json:"reduceOnly"`
+	ClosePosition    bool             `json:"closePosition"`
+	Side             SideType         `json:"side"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	StopPrice        string           `json:"stopPrice"`
+	WorkingType      WorkingType      `json:"workingType"`
+	PriceProtect     bool             `json:"priceProtect"`
+	OrigType         OrderType        `json:"origType"`
+	Time             int64            `json:"time"`
+	UpdateTime       int64            `json:"updateTime"`
+}
+
+// CreateBatchOrdersService place up to 5 orders in one request
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+// OrderList set the orders, built with NewCreateOrderService
+func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	orders := make([]params, 0, len(s.orders))
+	for _, order := range s.orders {
+		orders = append(orders, order.params())
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	r.setFormParam("batchOrders", string(b))
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rawMessages, errs, err := transport.SplitBatchResponse(data)
+	if err != nil {
+		return nil, err
+	}
+	res = &CreateBatchOrdersResponse{
+		Orders: make([]*Order, len(rawMessages)),
+		Errors: errs,
+	}
+	for i, j := range rawMessages {
+		if errs[i] != nil {
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(j, o); err != nil {
+			return nil, err
+		}
+		res.Orders[i] = o
+	}
+	return res, nil
+}
+
+// CreateBatchOrdersResponse define the result of every order of a batch, in the order they were
+// sent: Orders[i] is nil and Errors[i] is a *common.APIError when the i-th order was rejected
+type CreateBatchOrdersResponse struct {
+	Orders []*Order
+	Errors []error
+}
+
+// CancelMultiplesOrdersService cancel up to 10 orders of a symbol
+type CancelMultiplesOrdersService struct {
+	c                     *Client
+	symbol                string
+	orderIDList           []int64
+	origClientOrderIDList []string
+}
+
+// Symbol set symbol
+func (s *CancelMultiplesOrdersService) Symbol(symbol string) *CancelMultiplesOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderIDList set orderIDList
+func (s *CancelMultiplesOrdersService) OrderIDList(orderIDList []int64) *CancelMultiplesOrdersService {
+	s.orderIDList = orderIDList
+	return s
+}
+
+// OrigClientOrderIDList set origClientOrderIDList
+func (s *CancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDList []string) *CancelMultiplesOrdersService {
+	s.origClientOrderIDList = origClientOrderIDList
+	return s
+}
+
+// Do send request
+func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CancelMultipleOrdersResponse, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("symbol", s.symbol)
+	if s.orderIDList != nil {
+		b, err := json.Marshal(s.orderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.setFormParam("orderIdList", string(b))
+	}
+	if s.origClientOrderIDList != nil {
+		b, err := json.Marshal(s.origClientOrderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.setFormParam("origClientOrderIdList", string(b))
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rawMessages, errs, err := transport.SplitBatchResponse(data)
+	if err != nil {
+		return nil, err
+	}
+	res = &CancelMultipleOrdersResponse{
+		Orders: make([]*CancelOrderResponse, len(rawMessages)),
+		Errors: errs,
+	}
+	for i, j := range rawMessages {
+		if errs[i] != nil {
+			continue
+		}
+		o := new(CancelOrderResponse)
+		if err := json.Unmarshal(j, o); err != nil {
+			return nil, err
+		}
+		res.Orders[i] = o
+	}
+	return res, nil
+}
+
+// CancelMultipleOrdersResponse define the result of every cancel, in the order of the ids sent:
+// Orders[i] is nil and Errors[i] is a *common.APIError when the i-th order failed to be canceled
+type CancelMultipleOrdersResponse struct {
+	Orders []*CancelOrderResponse
+	Errors []error
+}
+
+// ModifyOrderService modify the price and quantity of an open LIMIT order, keeping its order id
+type ModifyOrderService struct {
+	c                 *Client
+	symbol            string
+	side              SideType
+	orderID           *int64
+	origClientOrderID *string
+	quantity          *string
+	price             *string
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side, which must be the side of the order
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.side = side
+	return s
+}
+
+// OrderID set orderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.price = &price
+	return s
+}
+
+// Do send request
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/dapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol": s.symbol,
+		"side":   s.side,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -3,6 +3,7 @@ package delivery
 import (
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -598,3 +599,291 @@ func (s *orderServiceTestSuite) assertLiquidationEqual(e, a *LiquidationOrder) {
 	r.Equal(e.Side, a.Side, "Side")
 	r.Equal(e.Time, a.Time, "Time")
 }
+
+func (s *orderServiceTestSuite) TestListUserLiquidationOrders() {
+	data := []byte(`[
+		{
+			"orderId": 165123080,
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"status": "FILLED",
+			"clientOrderId": "autoclose-1596542005017000006",
+			"price": "11326.9",
+			"avgPrice": "11326.9",
+			"origQty": "1",
+			"executedQty": "1",
+			"cumBase": "0.00882854",
+			"timeInForce": "IOC",
+			"type": "LIMIT",
+			"reduceOnly": false,
+			"closePosition": false,
+			"side": "SELL",
+			"positionSide": "BOTH",
+			"stopPrice": "0",
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false,
+			"origType": "LIMIT",
+			"time": 1596542005019,
+			"updateTime": 1596542005050
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200925"
+	startTime := int64(1596542000000)
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":        symbol,
+			"autoCloseType": ForceOrderCloseTypeLiquidation,
+			"startTime":     startTime,
+			"limit":         limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	orders, err := s.client.NewListUserLiquidationOrdersService().Symbol(symbol).
+		AutoCloseType(ForceOrderCloseTypeLiquidation).StartTime(startTime).Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*UserLiquidationOrder{{
+		OrderID:          165123080,
+		Symbol:           "BTCUSD_200925",
+		Pair:             "BTCUSD",
+		Status:           OrderStatusTypeFilled,
+		ClientOrderID:    "autoclose-1596542005017000006",
+		Price:            "11326.9",
+		AvgPrice:         "11326.9",
+		OrigQuantity:     "1",
+		ExecutedQuantity: "1",
+		CumBase:          "0.00882854",
+		TimeInForce:      TimeInForceTypeIOC,
+		Type:             OrderTypeLimit,
+		Side:             SideTypeSell,
+		PositionSide:     PositionSideTypeBoth,
+		StopPrice:        "0",
+		WorkingType:      WorkingTypeContractPrice,
+		OrigType:         OrderTypeLimit,
+		Time:             1596542005019,
+		UpdateTime:       1596542005050,
+	}}, orders)
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "testOrder1",
+			"cumQty": "0",
+			"cumBase": "0",
+			"executedQty": "0",
+			"orderId": 22542179,
+			"avgPrice": "0.0",
+			"origQty": "10",
+			"price": "9000",
+			"reduceOnly": false,
+			"side": "BUY",
+			"positionSide": "BOTH",
+			"status": "NEW",
+			"stopPrice": "0",
+			"closePosition": false,
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"origType": "LIMIT",
+			"updateTime": 1566818724722,
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false
+		},
+		{
+			"code": -2019,
+			"msg": "Margin is insufficient."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"newClientOrderId":"testOrder1","newOrderRespType":"","price":"9000","quantity":"10","side":"BUY","symbol":"BTCUSD_200925","timeInForce":"GTC","type":"LIMIT"},` +
+				`{"newOrderRespType":"","quantity":"1000","reduceOnly":true,"side":"SELL","symbol":"BTCUSD_200925","type":"MARKET"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSD_200925").Side(SideTypeBuy).Type(OrderTypeLimit).
+			TimeInForce(TimeInForceTypeGTC).Quantity("10").Price("9000").NewClientOrderID("testOrder1"),
+		s.client.NewCreateOrderService().Symbol("BTCUSD_200925").Side(SideTypeSell).Type(OrderTypeMarket).
+			Quantity("1000").ReduceOnly(true),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 2)
+	r.Len(res.Errors, 2)
+	s.assertOrderEqual(&Order{
+		AvgPrice:         "0.0",
+		ClientOrderID:    "testOrder1",
+		CumBase:          "0",
+		ExecutedQuantity: "0",
+		OrderID:          22542179,
+		OrigQuantity:     "10",
+		OrigType:         OrderTypeLimit,
+		Price:            "9000",
+		Side:             SideTypeBuy,
+		PositionSide:     PositionSideTypeBoth,
+		Status:           OrderStatusTypeNew,
+		StopPrice:        "0",
+		Symbol:           "BTCUSD_200925",
+		Pair:             "BTCUSD",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeLimit,
+		UpdateTime:       1566818724722,
+		WorkingType:      WorkingTypeContractPrice,
+	}, res.Orders[0])
+	r.NoError(res.Errors[0])
+	r.Nil(res.Orders[1])
+	r.True(common.IsAPIErrorCode(res.Errors[1], -2019))
+}
+
+func (s *orderServiceTestSuite) TestCancelMultipleOrders() {
+	data := []byte(`[
+		{
+			"avgPrice": "0.0",
+			"clientOrderId": "myOrder1",
+			"cumQty": "0",
+			"cumBase": "0",
+			"executedQty": "0",
+			"orderId": 283194212,
+			"origQty": "11",
+			"origType": "TRAILING_STOP_MARKET",
+			"price": "0",
+			"reduceOnly": false,
+			"side": "BUY",
+			"positionSide": "SHORT",
+			"status": "CANCELED",
+			"stopPrice": "9300",
+			"closePosition": false,
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"timeInForce": "GTC",
+			"type": "TRAILING_STOP_MARKET",
+			"activatePrice": "9020",
+			"priceRate": "0.3",
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false,
+			"updateTime": 1571110484038
+		},
+		{
+			"code": -2011,
+			"msg": "Unknown order sent."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                "BTCUSD_200925",
+			"orderIdList":           "[283194212,283194213]",
+			"origClientOrderIdList": `["myOrder1","myOrder2"]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCancelMultipleOrdersService().Symbol("BTCUSD_200925").
+		OrderIDList([]int64{283194212, 283194213}).
+		OrigClientOrderIDList([]string{"myOrder1", "myOrder2"}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 2)
+	r.Len(res.Errors, 2)
+	s.assertCancelOrderResponseEqual(&CancelOrderResponse{
+		AvgPrice:         "0.0",
+		ClientOrderID:    "myOrder1",
+		CumQuantity:      "0",
+		CumBase:          "0",
+		ExecutedQuantity: "0",
+		OrderID:          283194212,
+		OrigQuantity:     "11",
+		OrigType:         OrderTypeTrailingStopMarket,
+		Price:            "0",
+		Side:             SideTypeBuy,
+		PositionSide:     PositionSideTypeShort,
+		Status:           OrderStatusTypeCanceled,
+		StopPrice:        "9300",
+		Symbol:           "BTCUSD_200925",
+		Pair:             "BTCUSD",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeTrailingStopMarket,
+		ActivatePrice:    "9020",
+		PriceRate:        "0.3",
+		WorkingType:      WorkingTypeContractPrice,
+		UpdateTime:       1571110484038,
+	}, res.Orders[0])
+	r.NoError(res.Errors[0])
+	r.Nil(res.Orders[1])
+	r.True(common.IsAPIErrorCode(res.Errors[1], -2011))
+}
+
+func (s *orderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumQty": "0",
+		"cumBase": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"closePosition": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   "BTCUSD_PERP",
+			"side":     SideTypeBuy,
+			"orderId":  20072994037,
+			"quantity": "1",
+			"price":    "30005",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	order, err := s.client.NewModifyOrderService().Symbol("BTCUSD_PERP").Side(SideTypeBuy).
+		OrderID(20072994037).Quantity("1").Price("30005").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	s.assertOrderEqual(&Order{
+		AvgPrice:         "0.0",
+		ClientOrderID:    "LJ9R4QZDihCaS8UAOOLpgW",
+		CumBase:          "0",
+		ExecutedQuantity: "0",
+		OrderID:          20072994037,
+		OrigQuantity:     "1",
+		OrigType:         OrderTypeLimit,
+		Price:            "30005",
+		Side:             SideTypeBuy,
+		PositionSide:     PositionSideTypeLong,
+		Status:           OrderStatusTypeNew,
+		StopPrice:        "0",
+		Symbol:           "BTCUSD_PERP",
+		Pair:             "BTCUSD",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeLimit,
+		UpdateTime:       1629182711600,
+		WorkingType:      WorkingTypeContractPrice,
+	}, order)
+}
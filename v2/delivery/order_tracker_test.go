@@ -0,0 +1,102 @@
+package delivery
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderTrackerTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderTracker(t *testing.T) {
+	suite.Run(t, new(orderTrackerTestSuite))
+}
+
+// orderTradeUpdate decode an ORDER_TRADE_UPDATE event of a COIN-M order of 10 contracts, as sent by
+// the user data stream
+func (s *orderTrackerTestSuite) orderTradeUpdate(executionType, status string, tradeID int64, last, filled, average, commission string) *WsUserDataEvent {
+	data := fmt.Sprintf(`{"e":"ORDER_TRADE_UPDATE","E":1591274595442,"T":1591274595453,"i":"SfsR",
+		"o":{"s":"BTCUSD_200925","c":"TEST","S":"BUY","o":"LIMIT","f":"GTC","q":"10","p":"9000",
+		"ap":%q,"sp":"0","x":%q,"X":%q,"i":8886774,"l":%q,"z":%q,"L":"9000","ma":"BTC","N":"BTC",
+		"n":%q,"T":%d,"t":%d,"b":"0","a":"0","m":false,"R":false,"wt":"CONTRACT_PRICE",
+		"ot":"LIMIT","ps":"LONG","cp":false,"rp":"0","pP":false}}`,
+		average, executionType, status, last, filled, commission, 1591274595000+tradeID, tradeID)
+	event := new(WsUserDataEvent)
+	s.r().NoError(json.Unmarshal([]byte(data), event))
+	return event
+}
+
+func (s *orderTrackerTestSuite) TestHandleEvent() {
+	tracker := s.client.NewOrderTracker()
+	var changes []*TrackedOrder
+	tracker.Subscribe(func(o *TrackedOrder) {
+		changes = append(changes, o)
+	})
+	tracker.HandleEvent(s.orderTradeUpdate("NEW", "NEW", 0, "0", "0", "0", "0"))
+	tracker.HandleEvent(s.orderTradeUpdate("TRADE", "PARTIALLY_FILLED", 1, "4", "4", "9000", "0.00000222"))
+	tracker.HandleEvent(&WsUserDataEvent{Event: UserDataEventTypeAccountUpdate})
+	tracker.HandleEvent(s.orderTradeUpdate("TRADE", "FILLED", 2, "6", "10", "9000", "0.00000333"))
+	// replayed after a reconnection
+	tracker.HandleEvent(s.orderTradeUpdate("TRADE", "PARTIALLY_FILLED", 1, "4", "4", "9000", "0.00000222"))
+	s.Len(changes, 3)
+
+	o, ok := tracker.Order("TEST")
+	s.r().True(ok)
+	s.Equal("BTCUSD_200925", o.Symbol)
+	s.Equal(int64(8886774), o.OrderID)
+	s.Equal(string(SideTypeBuy), o.Side)
+	s.Equal(string(OrderTypeLimit), o.Type)
+	s.Equal(string(OrderStatusTypeFilled), o.Status)
+	s.True(o.Quantity.Equal(common.ToDecimal("10")), "contracts")
+	s.True(o.FilledQuantity.Equal(common.ToDecimal("10")))
+	s.r().Len(o.Fills, 2)
+	s.Equal(int64(1), o.Fills[0].TradeID)
+	s.True(o.Commissions["BTC"].Equal(common.ToDecimal("0.00000555")))
+	s.Empty(tracker.OpenOrders())
+
+	tracker.Forget()
+	_, ok = tracker.OrderByID("BTCUSD_200925", 8886774)
+	s.False(ok)
+}
+
+func (s *orderTrackerTestSuite) TestBackfill() {
+	var paths []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		s.Equal("BTCUSD_200925", req.URL.Query().Get("symbol"))
+		s.Equal("8886774", req.URL.Query().Get("orderId"))
+		data := `{"symbol":"BTCUSD_200925","orderId":8886774,"clientOrderId":"TEST","status":"FILLED",
+			"origQty":"10","executedQty":"10","avgPrice":"9000","updateTime":1591274596000}`
+		if req.URL.Path == "/dapi/v1/userTrades" {
+			data = `[{"symbol":"BTCUSD_200925","id":1,"orderId":8886774,"price":"9000","qty":"4",
+				"commission":"0.00000222","commissionAsset":"BTC","time":1591274595001},
+				{"symbol":"BTCUSD_200925","id":2,"orderId":8886774,"price":"9000","qty":"6",
+				"commission":"0.00000333","commissionAsset":"BTC","time":1591274595002,"maker":true}]`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewBufferString(data))}, nil
+	}
+
+	// the fill completing the order was missed
+	tracker := s.client.NewOrderTracker()
+	tracker.HandleEvent(s.orderTradeUpdate("TRADE", "PARTIALLY_FILLED", 1, "4", "4", "9000", "0.00000222"))
+	s.r().NoError(tracker.Backfill(newContext()))
+	s.Equal([]string{"/dapi/v1/order", "/dapi/v1/userTrades"}, paths)
+
+	o, _ := tracker.Order("TEST")
+	s.Equal(string(OrderStatusTypeFilled), o.Status)
+	s.r().Len(o.Fills, 2)
+	s.True(o.Fills[1].IsMaker)
+	s.True(o.Commissions["BTC"].Equal(common.ToDecimal("0.00000555")))
+
+	// complete orders are not fetched again
+	s.r().NoError(tracker.Backfill(newContext()))
+	s.Len(paths, 2)
+}
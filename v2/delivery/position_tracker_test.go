@@ -0,0 +1,68 @@
+package delivery
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type positionTrackerTestSuite struct {
+	baseTestSuite
+}
+
+func TestPositionTracker(t *testing.T) {
+	suite.Run(t, new(positionTrackerTestSuite))
+}
+
+func (s *positionTrackerTestSuite) TestTracker() {
+	responses := map[string]string{
+		"/dapi/v1/exchangeInfo": `{"symbols":[{"symbol":"BTCUSD_PERP","marginAsset":"BTC","contractSize":100}]}`,
+		"/dapi/v1/positionRisk": `[{"symbol":"BTCUSD_PERP","positionSide":"BOTH","positionAmt":"10","entryPrice":"20000",
+			"markPrice":"25000","unRealizedProfit":"0.01","marginType":"isolated","isolatedMargin":"0.02","leverage":"20"}]`,
+		"/dapi/v1/account": `{"assets":[{"asset":"BTC","crossWalletBalance":"0.5"}],
+			"positions":[{"symbol":"BTCUSD_PERP","positionSide":"BOTH","maintMargin":"0.0002"}]}`,
+	}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(responses[req.URL.Path])),
+		}, nil
+	}
+
+	tracker := s.client.NewPositionTracker()
+	s.Require().NoError(tracker.Sync(newContext()))
+	p, ok := tracker.Position("BTCUSD_PERP", PositionSideTypeBoth)
+	s.Require().True(ok)
+	s.True(p.IsolatedWallet.Equal(common.ToDecimal("0.01")))
+	s.True(p.Notional.Equal(common.ToDecimal("0.04")))
+	s.True(p.UnrealizedPnL.Equal(common.ToDecimal("0.01")))
+	s.True(p.ROE.Equal(common.ToDecimal("5")))
+	s.True(p.MarginRatio.Equal(common.ToDecimal("0.01")))
+
+	tracker.HandleMarkPrice(&WsMarkPriceEvent{Symbol: "BTCUSD_PERP", MarkPrice: "20000", Time: 1000})
+	tracker.HandleEvent(&WsUserDataEvent{
+		Event:               UserDataEventTypeAccountConfigUpdate,
+		TransactionTime:     1100,
+		AccountConfigUpdate: WsAccountConfigUpdate{Symbol: "BTCUSD_PERP", Leverage: 10},
+	})
+	p, _ = tracker.Position("BTCUSD_PERP", PositionSideTypeBoth)
+	s.True(p.UnrealizedPnL.IsZero())
+	s.True(p.Notional.Equal(common.ToDecimal("0.05")))
+	s.True(p.InitialMargin.Equal(common.ToDecimal("0.005")))
+	s.True(p.MarginRatio.Equal(common.ToDecimal("0.02")))
+
+	tracker.HandleEvent(&WsUserDataEvent{
+		Event:           UserDataEventTypeAccountUpdate,
+		TransactionTime: 1200,
+		AccountUpdate: WsAccountUpdate{
+			Balances:  []WsBalance{{Asset: "BTC", CrossWalletBalance: "0.51"}},
+			Positions: []WsPosition{{Symbol: "BTCUSD_PERP", Side: PositionSideTypeBoth, Amount: "0", EntryPrice: "0"}},
+		},
+	})
+	s.Empty(tracker.Positions())
+	s.True(tracker.CrossWalletBalance("BTC").Equal(common.ToDecimal("0.51")))
+}
@@ -0,0 +1,125 @@
+package delivery
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// requestWeights define the weight of /dapi endpoints whose weight does not depend on parameters,
+// other /dapi endpoints weigh 1
+var requestWeights = map[string]int64{
+	http.MethodGet + " /dapi/v1/trades":            5,
+	http.MethodGet + " /dapi/v1/historicalTrades":  20,
+	http.MethodGet + " /dapi/v1/aggTrades":         20,
+	http.MethodGet + " /dapi/v1/allForceOrders":    20,
+	http.MethodGet + " /dapi/v1/premiumIndex":      10,
+	http.MethodGet + " /dapi/v1/allOrders":         5,
+	http.MethodGet + " /dapi/v1/commissionRate":    20,
+	http.MethodGet + " /dapi/v1/income":            30,
+	http.MethodGet + " /dapi/v1/account":           5,
+	http.MethodGet + " /dapi/v1/balance":           5,
+	http.MethodGet + " /dapi/v1/positionRisk":      5,
+	http.MethodGet + " /dapi/v1/positionSide/dual": 30,
+	http.MethodGet + " /dapi/v1/adlQuantile":       5,
+	http.MethodPost + " /dapi/v1/batchOrders":      5,
+}
+
+// orderEndpoints count against the ORDERS limits, batches for each of their orders
+var orderEndpoints = map[string]bool{
+	http.MethodPost + " /dapi/v1/order":       true,
+	http.MethodPut + " /dapi/v1/order":        true,
+	http.MethodPost + " /dapi/v1/batchOrders": true,
+}
+
+// NewRateLimiter init a limiter for Client.RateLimiter with the rate limits of exchangeInfo
+func NewRateLimiter(rateLimits []RateLimit) *common.RateLimiter {
+	limits := make([]common.RateLimit, len(rateLimits))
+	for i, l := range rateLimits {
+		limits[i] = common.RateLimit{
+			RateLimitType: l.RateLimitType,
+			Interval:      l.Interval,
+			IntervalNum:   l.IntervalNum,
+			Limit:         l.Limit,
+		}
+	}
+	return common.NewRateLimiter(limits, requestCost)
+}
+
+// requestCost return the weight of a delivery request and the number of orders it places
+func requestCost(method, endpoint string, query url.Values) (weight, orders int64) {
+	if !strings.HasPrefix(endpoint, "/dapi/") {
+		return 0, 0
+	}
+	key := method + " " + endpoint
+	if orderEndpoints[key] {
+		orders = common.BatchOrderCount(query)
+	}
+	_, hasSymbol := query["symbol"]
+	switch key {
+	case http.MethodGet + " /dapi/v1/depth":
+		switch limit := queryLimit(query, 500); {
+		case limit <= 50:
+			return 2, orders
+		case limit <= 100:
+			return 5, orders
+		case limit <= 500:
+			return 10, orders
+		default:
+			return 20, orders
+		}
+	case http.MethodGet + " /dapi/v1/klines", http.MethodGet + " /dapi/v1/continuousKlines",
+		http.MethodGet + " /dapi/v1/indexPriceKlines", http.MethodGet + " /dapi/v1/markPriceKlines":
+		switch limit := queryLimit(query, 500); {
+		case limit < 100:
+			return 1, orders
+		case limit < 500:
+			return 2, orders
+		case limit <= 1000:
+			return 5, orders
+		default:
+			return 10, orders
+		}
+	case http.MethodGet + " /dapi/v1/ticker/24hr":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 40, orders
+	case http.MethodGet + " /dapi/v1/ticker/price", http.MethodGet + " /dapi/v1/ticker/bookTicker":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 2, orders
+	case http.MethodGet + " /dapi/v1/openOrders":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 40, orders
+	case http.MethodGet + " /dapi/v1/userTrades":
+		if _, hasPair := query["pair"]; hasPair {
+			return 40, orders
+		}
+		return 5, orders
+	case http.MethodGet + " /dapi/v1/forceOrders":
+		if hasSymbol {
+			return 20, orders
+		}
+		return 50, orders
+	}
+	if weight, ok := requestWeights[key]; ok {
+		return weight, orders
+	}
+	return 1, orders
+}
+
+// queryLimit return the limit parameter of a request, or def if it is not set
+func queryLimit(query url.Values, def int) int {
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		return def
+	}
+	return limit
+}
@@ -0,0 +1,33 @@
+package delivery
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestCost(t *testing.T) {
+	tests := []struct {
+		method   string
+		endpoint string
+		query    url.Values
+		weight   int64
+		orders   int64
+	}{
+		{http.MethodGet, "/dapi/v1/ping", nil, 1, 0},
+		{http.MethodGet, "/dapi/v1/depth", url.Values{"limit": {"50"}}, 2, 0},
+		{http.MethodGet, "/dapi/v1/positionRisk", nil, 5, 0},
+		{http.MethodPost, "/dapi/v1/order", nil, 1, 1},
+		{http.MethodPut, "/dapi/v1/order", nil, 1, 1},
+		{http.MethodPost, "/dapi/v1/batchOrders", nil, 5, 1},
+		{http.MethodPost, "/dapi/v1/batchOrders", url.Values{"batchOrders": {`[{"symbol":"BTCUSD_PERP"},{"symbol":"ETHUSD_PERP"}]`}}, 5, 2},
+		{http.MethodGet, "/fapi/v1/ping", nil, 0, 0},
+	}
+	for _, tt := range tests {
+		weight, orders := requestCost(tt.method, tt.endpoint, tt.query)
+		assert.Equal(t, tt.weight, weight, tt.endpoint)
+		assert.Equal(t, tt.orders, orders, tt.endpoint)
+	}
+}
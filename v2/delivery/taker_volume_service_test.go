@@ -0,0 +1,59 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type takerVolumeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestTakerVolumeService(t *testing.T) {
+	suite.Run(t, new(takerVolumeServiceTestSuite))
+}
+
+func (s *takerVolumeServiceTestSuite) TestTakerBuySellVolume() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"contractType": "ALL",
+			"takerBuyVol": "387",
+			"takerSellVol": "248",
+			"takerBuyVolValue": "2342.1220",
+			"takerSellVolValue": "4213.9800",
+			"timestamp": 1591261042378
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "5m"
+	startTime := int64(1591261000000)
+	endTime := int64(1591262000000)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         pair,
+			"contractType": ContractTypeAll,
+			"period":       period,
+			"startTime":    startTime,
+			"endTime":      endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewTakerBuySellVolumeService().Pair(pair).ContractType(ContractTypeAll).
+		Period(period).StartTime(startTime).EndTime(endTime).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*TakerBuySellVolume{{
+		Pair:                 "BTCUSD",
+		ContractType:         ContractTypeAll,
+		TakerBuyVolume:       "387",
+		TakerSellVolume:      "248",
+		TakerBuyVolumeValue:  "2342.1220",
+		TakerSellVolumeValue: "4213.9800",
+		Timestamp:            1591261042378,
+	}}, res)
+}
@@ -0,0 +1,111 @@
+package delivery
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type userDataStreamTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+	mu          sync.Mutex
+	requests    []string
+	endpoints   []string
+	handlers    []WsHandler
+	dropCs      []chan struct{}
+}
+
+func TestUserDataStream(t *testing.T) {
+	suite.Run(t, new(userDataStreamTestSuite))
+}
+
+func (s *userDataStreamTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServe
+	s.requests, s.endpoints, s.handlers, s.dropCs = nil, nil, nil, nil
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, fmt.Sprintf("%s %s", req.Method, req.URL.Path))
+		return newHTTPResponse([]byte(`{"listenKey":"key1"}`), http.StatusOK), nil
+	}
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.endpoints = append(s.endpoints, cfg.Endpoint)
+		s.handlers = append(s.handlers, handler)
+		doneC, stopC, dropC := make(chan struct{}), make(chan struct{}), make(chan struct{})
+		s.dropCs = append(s.dropCs, dropC)
+		go func() {
+			select {
+			case <-stopC:
+			case <-dropC:
+			}
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+}
+
+func (s *userDataStreamTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+func (s *userDataStreamTestSuite) TestReconnect() {
+	var events []*WsUserDataEvent
+	var errs []error
+	resynced := make(chan struct{}, 2)
+	stream := s.client.NewUserDataStream(func(event *WsUserDataEvent) {
+		events = append(events, event)
+	}, func(err error) {
+		errs = append(errs, err)
+	}).OnResync(func() { resynced <- struct{}{} })
+	s.Require().NoError(stream.Start(newContext()))
+
+	s.mu.Lock()
+	close(s.dropCs[0])
+	s.mu.Unlock()
+	select {
+	case <-resynced:
+	case <-time.After(time.Second):
+		s.FailNow("not resynced")
+	}
+	s.mu.Lock()
+	handler := s.handlers[1]
+	s.mu.Unlock()
+	handler([]byte(`{"e":"listenKeyExpired","E":1576653824250}`))
+	s.Eventually(func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.endpoints) == 3
+	}, time.Second, time.Millisecond)
+	stream.Stop()
+
+	s.Require().Len(events, 1)
+	s.Equal(UserDataEventTypeListenKeyExpired, events[0].Event)
+	s.Equal([]string{baseWsMainUrl + "/key1", baseWsMainUrl + "/key1", baseWsMainUrl + "/key1"}, s.endpoints)
+	// the stream is reopened with the same listen key after a drop, and with a new one after it expired
+	s.Equal([]string{
+		"POST /dapi/v1/listenKey",
+		"PUT /dapi/v1/listenKey",
+		"POST /dapi/v1/listenKey",
+		"DELETE /dapi/v1/listenKey",
+	}, s.requests)
+	s.Len(errs, 2)
+}
+
+func (s *userDataStreamTestSuite) TestNotStarted() {
+	stream := s.client.NewUserDataStream(func(event *WsUserDataEvent) {}, func(err error) {})
+	s.Empty(stream.ListenKey())
+	stream.Stop()
+	select {
+	case <-stream.Done():
+	default:
+		s.Fail("done channel not closed")
+	}
+}
@@ -19,18 +19,12 @@ var (
 	WebsocketTimeout = time.Second * 60
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = false
+	// WebsocketReconnect enables redialing dropped streams with the given policy, nil disables it
+	WebsocketReconnect *WsReconnectPolicy
 	// UseTestnet switch all the WS streams from production to the testnet
 	UseTestnet = false
 )
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getWsEndpoint() string {
-	if UseTestnet {
-		return baseWsTestnetUrl
-	}
-	return baseWsMainUrl
-}
-
 // WsAggTradeEvent define websocket aggTrde event.
 type WsAggTradeEvent struct {
 	Event            string `json:"e"`
@@ -48,10 +42,15 @@ type WsAggTradeEvent struct {
 // WsAggTradeHandler handle websocket that push trade information that is aggregated for a single taker order.
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
-// WsAggTradeServe serve websocket that push trade information that is aggregated for a single taker order.
+// WsAggTradeServe is WsClient.WsAggTradeServe with the endpoints and settings of the package variables
 func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAggTradeServe(symbol, handler, errHandler)
+}
+
+// WsAggTradeServe serve websocket that push trade information that is aggregated for a single taker order.
+func (c *WsClient) WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@aggTrade", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, &event)
@@ -75,10 +74,15 @@ type WsIndexPriceEvent struct {
 // WsIndexPriceHandler handle websocket that push index price for a pair.
 type WsIndexPriceHandler func(event *WsIndexPriceEvent)
 
-// WsIndexPriceServe serve websocket that pushes index price for a pair.
+// WsIndexPriceServe is WsClient.WsIndexPriceServe with the endpoints and settings of the package variables
 func WsIndexPriceServe(symbol string, handler WsIndexPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@indexPrice", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsIndexPriceServe(symbol, handler, errHandler)
+}
+
+// WsIndexPriceServe serve websocket that pushes index price for a pair.
+func (c *WsClient) WsIndexPriceServe(symbol string, handler WsIndexPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@indexPrice", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsIndexPriceEvent)
 		err := json.Unmarshal(message, &event)
@@ -105,10 +109,15 @@ type WsMarkPriceEvent struct {
 // WsMarkPriceHandler handle websocket that pushes price and funding rate for a single symbol.
 type WsMarkPriceHandler func(event *WsMarkPriceEvent)
 
-// WsMarkPriceServe serve websocket that pushes price and funding rate for a single symbol.
+// WsMarkPriceServe is WsClient.WsMarkPriceServe with the endpoints and settings of the package variables
 func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsMarkPriceServe(symbol, handler, errHandler)
+}
+
+// WsMarkPriceServe serve websocket that pushes price and funding rate for a single symbol.
+func (c *WsClient) WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPrice", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMarkPriceEvent)
 		err := json.Unmarshal(message, &event)
@@ -127,10 +136,15 @@ type WsPairMarkPriceEvent []*WsMarkPriceEvent
 // WsPairMarkPriceHandler handle websocket that pushes price and funding rate for all symbol.
 type WsPairMarkPriceHandler func(event WsPairMarkPriceEvent)
 
-// WsPairMarkPriceServe serve websocket that pushes price and funding rate for all symbol.
+// WsPairMarkPriceServe is WsClient.WsPairMarkPriceServe with the endpoints and settings of the package variables
 func WsPairMarkPriceServe(handler WsPairMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/markPrice@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsPairMarkPriceServe(handler, errHandler)
+}
+
+// WsPairMarkPriceServe serve websocket that pushes price and funding rate for all symbol.
+func (c *WsClient) WsPairMarkPriceServe(handler WsPairMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/markPrice@arr", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsPairMarkPriceEvent
 		err := json.Unmarshal(message, &event)
@@ -174,10 +188,15 @@ type WsKline struct {
 // WsKlineHandler handle websocket kline event
 type WsKlineHandler func(event *WsKlineEvent)
 
-// WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
+// WsKlineServe is WsClient.WsKlineServe with the endpoints and settings of the package variables
 func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsKlineServe(symbol, interval, handler, errHandler)
+}
+
+// WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
+func (c *WsClient) WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@kline_%s", c.BaseURL, strings.ToLower(symbol), interval)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -221,10 +240,15 @@ type WsContinuousKline struct {
 // WsContinuousKlineHandler handle websocket continuous kline event
 type WsContinuousKlineHandler func(event *WsContinuousKlineEvent)
 
-// WsContinuousKlineServe serve websocket kline handler with a pair, a contract type and interval like 15m, 30s
+// WsContinuousKlineServe is WsClient.WsContinuousKlineServe with the endpoints and settings of the package variables
 func WsContinuousKlineServe(pair string, contractType string, interval string, handler WsContinuousKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", getWsEndpoint(), strings.ToLower(pair), strings.ToLower(contractType), interval)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsContinuousKlineServe(pair, contractType, interval, handler, errHandler)
+}
+
+// WsContinuousKlineServe serve websocket kline handler with a pair, a contract type and interval like 15m, 30s
+func (c *WsClient) WsContinuousKlineServe(pair string, contractType string, interval string, handler WsContinuousKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", c.BaseURL, strings.ToLower(pair), strings.ToLower(contractType), interval)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsContinuousKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -262,10 +286,15 @@ type WsIndexPriceKline struct {
 // WsIndexPriceKlineHandler handle websocket index kline event
 type WsIndexPriceKlineHandler func(event *WsIndexPriceKlineEvent)
 
-// WsIndexPriceKlineServe serve websocket kline handler with a pair and interval like 15m, 30s
+// WsIndexPriceKlineServe is WsClient.WsIndexPriceKlineServe with the endpoints and settings of the package variables
 func WsIndexPriceKlineServe(pair string, interval string, handler WsIndexPriceKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@indexPriceKline_%s", getWsEndpoint(), strings.ToLower(pair), interval)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsIndexPriceKlineServe(pair, interval, handler, errHandler)
+}
+
+// WsIndexPriceKlineServe serve websocket kline handler with a pair and interval like 15m, 30s
+func (c *WsClient) WsIndexPriceKlineServe(pair string, interval string, handler WsIndexPriceKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@indexPriceKline_%s", c.BaseURL, strings.ToLower(pair), interval)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsIndexPriceKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -304,10 +333,15 @@ type WsMarkPriceKline struct {
 // WsMarkPriceKlineHandler handle websocket market price kline event
 type WsMarkPriceKlineHandler func(event *WsMarkPriceKlineEvent)
 
-// WsMarkPriceKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
+// WsMarkPriceKlineServe is WsClient.WsMarkPriceKlineServe with the endpoints and settings of the package variables
 func WsMarkPriceKlineServe(symbol string, interval string, handler WsMarkPriceKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@markPriceKline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsMarkPriceKlineServe(symbol, interval, handler, errHandler)
+}
+
+// WsMarkPriceKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
+func (c *WsClient) WsMarkPriceKlineServe(symbol string, interval string, handler WsMarkPriceKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPriceKline_%s", c.BaseURL, strings.ToLower(symbol), interval)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMarkPriceKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -337,10 +371,15 @@ type WsMiniMarketTickerEvent struct {
 // WsMiniMarketTickerHandler handle websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
 type WsMiniMarketTickerHandler func(event *WsMiniMarketTickerEvent)
 
-// WsMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
+// WsMiniMarketTickerServe is WsClient.WsMiniMarketTickerServe with the endpoints and settings of the package variables
 func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@miniTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsMiniMarketTickerServe(symbol, handler, errHandler)
+}
+
+// WsMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
+func (c *WsClient) WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@miniTicker", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMiniMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -359,10 +398,15 @@ type WsAllMiniMarketTickerEvent []*WsMiniMarketTickerEvent
 // WsAllMiniMarketTickerHandler handle websocket that pushes price and funding rate for all markets.
 type WsAllMiniMarketTickerHandler func(event WsAllMiniMarketTickerEvent)
 
-// WsAllMiniMarketTickerServe serve websocket that pushes price and funding rate for all markets.
+// WsAllMiniMarketTickerServe is WsClient.WsAllMiniMarketTickerServe with the endpoints and settings of the package variables
 func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllMiniMarketTickerServe(handler, errHandler)
+}
+
+// WsAllMiniMarketTickerServe serve websocket that pushes price and funding rate for all markets.
+func (c *WsClient) WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!miniTicker@arr", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -401,10 +445,15 @@ type WsMarketTickerEvent struct {
 // WsMarketTickerHandler handle websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
 type WsMarketTickerHandler func(event *WsMarketTickerEvent)
 
-// WsMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
+// WsMarketTickerServe is WsClient.WsMarketTickerServe with the endpoints and settings of the package variables
 func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsMarketTickerServe(symbol, handler, errHandler)
+}
+
+// WsMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
+func (c *WsClient) WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -423,10 +472,15 @@ type WsAllMarketTickerEvent []*WsMarketTickerEvent
 // WsAllMarketTickerHandler handle websocket that pushes price and funding rate for all markets.
 type WsAllMarketTickerHandler func(event WsAllMarketTickerEvent)
 
-// WsAllMarketTickerServe serve websocket that pushes price and funding rate for all markets.
+// WsAllMarketTickerServe is WsClient.WsAllMarketTickerServe with the endpoints and settings of the package variables
 func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllMarketTickerServe(handler, errHandler)
+}
+
+// WsAllMarketTickerServe serve websocket that pushes price and funding rate for all markets.
+func (c *WsClient) WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!ticker@arr", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -456,10 +510,15 @@ type WsBookTickerEvent struct {
 // WsBookTickerHandler handle websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
-// WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
+// WsBookTickerServe is WsClient.WsBookTickerServe with the endpoints and settings of the package variables
 func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsBookTickerServe(symbol, handler, errHandler)
+}
+
+// WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
+func (c *WsClient) WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@bookTicker", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -472,10 +531,15 @@ func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler Er
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
+// WsAllBookTickerServe is WsClient.WsAllBookTickerServe with the endpoints and settings of the package variables
 func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllBookTickerServe(handler, errHandler)
+}
+
+// WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
+func (c *WsClient) WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!bookTicker", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -514,10 +578,15 @@ type WsLiquidationOrder struct {
 // WsLiquidationOrderHandler handle websocket that pushes force liquidation order information for specific symbol.
 type WsLiquidationOrderHandler func(event *WsLiquidationOrderEvent)
 
-// WsLiquidationOrderServe serve websocket that pushes force liquidation order information for specific symbol.
+// WsLiquidationOrderServe is WsClient.WsLiquidationOrderServe with the endpoints and settings of the package variables
 func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@forceOrder", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsLiquidationOrderServe(symbol, handler, errHandler)
+}
+
+// WsLiquidationOrderServe serve websocket that pushes force liquidation order information for specific symbol.
+func (c *WsClient) WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@forceOrder", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -530,10 +599,15 @@ func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, e
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsAllLiquidationOrderServe serve websocket that pushes force liquidation order information for all symbols.
+// WsAllLiquidationOrderServe is WsClient.WsAllLiquidationOrderServe with the endpoints and settings of the package variables
 func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!forceOrder@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllLiquidationOrderServe(handler, errHandler)
+}
+
+// WsAllLiquidationOrderServe serve websocket that pushes force liquidation order information for all symbols.
+func (c *WsClient) WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!forceOrder@arr", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -563,35 +637,55 @@ type WsDepthEvent struct {
 // WsDepthHandler handle websocket depth event
 type WsDepthHandler func(event *WsDepthEvent)
 
-func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func (c *WsClient) wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	if levels != 5 && levels != 10 && levels != 20 {
 		return nil, nil, errors.New("Invalid levels")
 	}
 	levelsStr := fmt.Sprintf("%d", levels)
-	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler)
+	return c.wsDepthServe(symbol, levelsStr, rate, handler, errHandler)
 }
 
-// WsPartialDepthServe serve websocket partial depth handler.
+// WsPartialDepthServe is WsClient.WsPartialDepthServe with the endpoints and settings of the package variables
 func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler)
+	return defaultWsClient().WsPartialDepthServe(symbol, levels, handler, errHandler)
 }
 
-// WsPartialDepthServeWithRate serve websocket partial depth handler with rate.
+// WsPartialDepthServe serve websocket partial depth handler.
+func (c *WsClient) WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return c.wsPartialDepthServe(symbol, levels, nil, handler, errHandler)
+}
+
+// WsPartialDepthServeWithRate is WsClient.WsPartialDepthServeWithRate with the endpoints and settings of the package variables
 func WsPartialDepthServeWithRate(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, rate, handler, errHandler)
+	return defaultWsClient().WsPartialDepthServeWithRate(symbol, levels, rate, handler, errHandler)
 }
 
-// WsDiffDepthServe serve websocket diff. depth handler.
+// WsPartialDepthServeWithRate serve websocket partial depth handler with rate.
+func (c *WsClient) WsPartialDepthServeWithRate(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return c.wsPartialDepthServe(symbol, levels, rate, handler, errHandler)
+}
+
+// WsDiffDepthServe is WsClient.WsDiffDepthServe with the endpoints and settings of the package variables
 func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", nil, handler, errHandler)
+	return defaultWsClient().WsDiffDepthServe(symbol, handler, errHandler)
 }
 
-// WsDiffDepthServe serve websocket diff. depth handler with rate.
+// WsDiffDepthServe serve websocket diff. depth handler.
+func (c *WsClient) WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return c.wsDepthServe(symbol, "", nil, handler, errHandler)
+}
+
+// WsDiffDepthServeWithRate is WsClient.WsDiffDepthServeWithRate with the endpoints and settings of the package variables
 func WsDiffDepthServeWithRate(symbol string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", rate, handler, errHandler)
+	return defaultWsClient().WsDiffDepthServeWithRate(symbol, rate, handler, errHandler)
+}
+
+// WsDiffDepthServe serve websocket diff. depth handler with rate.
+func (c *WsClient) WsDiffDepthServeWithRate(symbol string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return c.wsDepthServe(symbol, "", rate, handler, errHandler)
 }
 
-func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func (c *WsClient) wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	if rate != nil {
 		switch *rate {
@@ -606,8 +700,8 @@ func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 		}
 	}
 
-	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(), strings.ToLower(symbol), levels, rateStr)
-	cfg := newWsConfig(endpoint)
+	endpoint := fmt.Sprintf("%s/%s@depth%s%s", c.BaseURL, strings.ToLower(symbol), levels, rateStr)
+	cfg := c.newWsConfig(endpoint)
 
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
@@ -649,14 +743,15 @@ func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 
 // WsUserDataEvent define user data event
 type WsUserDataEvent struct {
-	Event               UserDataEventType  `json:"e"`
-	Time                int64              `json:"E"`
-	Alias               string             `json:"i"`
-	CrossWalletBalance  string             `json:"cw"`
-	MarginCallPositions []WsPosition       `json:"p"`
-	TransactionTime     int64              `json:"T"`
-	AccountUpdate       WsAccountUpdate    `json:"a"`
-	OrderTradeUpdate    WsOrderTradeUpdate `json:"o"`
+	Event               UserDataEventType     `json:"e"`
+	Time                int64                 `json:"E"`
+	Alias               string                `json:"i"`
+	CrossWalletBalance  string                `json:"cw"`
+	MarginCallPositions []WsPosition          `json:"p"`
+	TransactionTime     int64                 `json:"T"`
+	AccountUpdate       WsAccountUpdate       `json:"a"`
+	OrderTradeUpdate    WsOrderTradeUpdate    `json:"o"`
+	AccountConfigUpdate WsAccountConfigUpdate `json:"ac"`
 }
 
 // WsAccountUpdate define account update
@@ -733,10 +828,15 @@ type WsAccountConfigUpdate struct {
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
-// WsUserDataServe serve user data handler with listen key
+// WsUserDataServe is WsClient.WsUserDataServe with the endpoints and settings of the package variables
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsUserDataServe(listenKey, handler, errHandler)
+}
+
+// WsUserDataServe serve user data handler with listen key
+func (c *WsClient) WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", c.BaseURL, listenKey)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
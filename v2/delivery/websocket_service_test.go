@@ -54,6 +54,22 @@ func (s *websocketServiceTestSuite) assertWsServe(count ...int) {
 }
 
 // https://binance-docs.github.io/apidocs/delivery/en/#aggregate-trade-streams
+func (s *websocketServiceTestSuite) TestWsClientConfig() {
+	var cfg *WsConfig
+	wsServe = func(c *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		cfg = c
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+	policy := NewWsReconnectPolicy()
+	ws := NewWsClient(WithWsURL("ws://127.0.0.1:8081/ws"), WithWsKeepalive(time.Second), WithWsReconnect(policy))
+	_, _, err := ws.WsAggTradeServe("BTCUSD_PERP", func(event *WsAggTradeEvent) {}, func(err error) {})
+	s.Require().NoError(err)
+	s.Equal("ws://127.0.0.1:8081/ws/btcusd_perp@aggTrade", cfg.Endpoint)
+	s.True(cfg.Keepalive)
+	s.Equal(time.Second, cfg.Timeout)
+	s.Same(policy, cfg.Reconnect)
+}
+
 func (s *websocketServiceTestSuite) TestAggTradeServe() {
 	data := []byte(`{
 		  "e":"aggTrade",
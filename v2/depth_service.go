@@ -10,6 +10,7 @@ import (
 // DepthService show depth info
 type DepthService struct {
 	c      *Client
+	ws     *WsAPIClient
 	symbol string
 	limit  *int
 }
@@ -36,7 +37,12 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	if s.limit != nil {
 		r.setParam("limit", *s.limit)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	var data []byte
+	if s.ws != nil {
+		data, err = s.ws.callAPI(ctx, r, opts...)
+	} else {
+		data, err = s.c.callAPI(ctx, r, opts...)
+	}
 	if err != nil {
 		return nil, err
 	}
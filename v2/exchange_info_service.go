@@ -83,23 +83,25 @@ type RateLimit struct {
 
 // Symbol market symbol
 type Symbol struct {
-	Symbol                     string                   `json:"symbol"`
-	Status                     string                   `json:"status"`
-	BaseAsset                  string                   `json:"baseAsset"`
-	BaseAssetPrecision         int                      `json:"baseAssetPrecision"`
-	QuoteAsset                 string                   `json:"quoteAsset"`
-	QuotePrecision             int                      `json:"quotePrecision"`
-	QuoteAssetPrecision        int                      `json:"quoteAssetPrecision"`
-	BaseCommissionPrecision    int32                    `json:"baseCommissionPrecision"`
-	QuoteCommissionPrecision   int32                    `json:"quoteCommissionPrecision"`
-	OrderTypes                 []string                 `json:"orderTypes"`
-	IcebergAllowed             bool                     `json:"icebergAllowed"`
-	OcoAllowed                 bool                     `json:"ocoAllowed"`
-	QuoteOrderQtyMarketAllowed bool                     `json:"quoteOrderQtyMarketAllowed"`
-	IsSpotTradingAllowed       bool                     `json:"isSpotTradingAllowed"`
-	IsMarginTradingAllowed     bool                     `json:"isMarginTradingAllowed"`
-	Filters                    []map[string]interface{} `json:"filters"`
-	Permissions                []string                 `json:"permissions"`
+	Symbol                          string                        `json:"symbol"`
+	Status                          string                        `json:"status"`
+	BaseAsset                       string                        `json:"baseAsset"`
+	BaseAssetPrecision              int                           `json:"baseAssetPrecision"`
+	QuoteAsset                      string                        `json:"quoteAsset"`
+	QuotePrecision                  int                           `json:"quotePrecision"`
+	QuoteAssetPrecision             int                           `json:"quoteAssetPrecision"`
+	BaseCommissionPrecision         int32                         `json:"baseCommissionPrecision"`
+	QuoteCommissionPrecision        int32                         `json:"quoteCommissionPrecision"`
+	OrderTypes                      []string                      `json:"orderTypes"`
+	IcebergAllowed                  bool                          `json:"icebergAllowed"`
+	OcoAllowed                      bool                          `json:"ocoAllowed"`
+	QuoteOrderQtyMarketAllowed      bool                          `json:"quoteOrderQtyMarketAllowed"`
+	IsSpotTradingAllowed            bool                          `json:"isSpotTradingAllowed"`
+	IsMarginTradingAllowed          bool                          `json:"isMarginTradingAllowed"`
+	Filters                         []map[string]interface{}      `json:"filters"`
+	Permissions                     []string                      `json:"permissions"`
+	DefaultSelfTradePreventionMode  SelfTradePreventionModeType   `json:"defaultSelfTradePreventionMode"`
+	AllowedSelfTradePreventionModes []SelfTradePreventionModeType `json:"allowedSelfTradePreventionModes"`
 }
 
 // LotSizeFilter define lot size filter of symbol
@@ -152,6 +154,11 @@ type MarketLotSizeFilter struct {
 	StepSize    string `json:"stepSize"`
 }
 
+// MaxNumOrdersFilter define max num orders filter of symbol
+type MaxNumOrdersFilter struct {
+	MaxNumOrders int `json:"maxNumOrders"`
+}
+
 // MaxNumAlgoOrdersFilter define max num algo orders filter of symbol
 type MaxNumAlgoOrdersFilter struct {
 	MaxNumAlgoOrders int `json:"maxNumAlgoOrders"`
@@ -298,6 +305,20 @@ func (s *Symbol) MarketLotSizeFilter() *MarketLotSizeFilter {
 	return nil
 }
 
+// MaxNumOrdersFilter return max num orders filter of symbol
+func (s *Symbol) MaxNumOrdersFilter() *MaxNumOrdersFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypeMaxNumOrders) {
+			f := &MaxNumOrdersFilter{}
+			if i, ok := filter["maxNumOrders"]; ok {
+				f.MaxNumOrders = int(i.(float64))
+			}
+			return f
+		}
+	}
+	return nil
+}
+
 // MaxNumAlgoOrdersFilter return max num algo orders filter of symbol
 func (s *Symbol) MaxNumAlgoOrdersFilter() *MaxNumAlgoOrdersFilter {
 	for _, filter := range s.Filters {
@@ -0,0 +1,81 @@
+package futures
+
+// MarketDataAPI define the market data services of Client
+type MarketDataAPI interface {
+	NewPingService() PingServiceAPI
+	NewServerTimeService() ServerTimeServiceAPI
+	NewExchangeInfoService() ExchangeInfoServiceAPI
+	NewDepthService() DepthServiceAPI
+	NewAggTradesService() AggTradesServiceAPI
+	NewRecentTradesService() RecentTradesServiceAPI
+	NewHistoricalTradesService() HistoricalTradesServiceAPI
+	NewKlinesService() KlinesServiceAPI
+	NewContinuousKlinesService() ContinuousKlinesServiceAPI
+	NewIndexPriceKlinesService() IndexPriceKlinesServiceAPI
+	NewMarkPriceKlinesService() MarkPriceKlinesServiceAPI
+	NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI
+	NewListPricesService() ListPricesServiceAPI
+	NewListBookTickersService() ListBookTickersServiceAPI
+	NewPremiumIndexService() PremiumIndexServiceAPI
+	NewFundingRateService() FundingRateServiceAPI
+	NewGetOpenInterestService() GetOpenInterestServiceAPI
+	NewOpenInterestStatisticsService() OpenInterestStatisticsServiceAPI
+	NewLongShortRatioService() LongShortRatioServiceAPI
+	NewListLiquidationOrdersService() ListLiquidationOrdersServiceAPI
+}
+
+// TradingAPI define the order and position services of Client
+type TradingAPI interface {
+	NewCreateOrderService() CreateOrderServiceAPI
+	NewCreateBatchOrdersService() CreateBatchOrdersServiceAPI
+	NewGetOrderService() GetOrderServiceAPI
+	NewCancelOrderService() CancelOrderServiceAPI
+	NewCancelAllOpenOrdersService() CancelAllOpenOrdersServiceAPI
+	NewCancelMultipleOrdersService() CancelMultiplesOrdersServiceAPI
+	NewGetOpenOrderService() GetOpenOrderServiceAPI
+	NewListOpenOrdersService() ListOpenOrdersServiceAPI
+	NewListOrdersService() ListOrdersServiceAPI
+	NewListAccountTradeService() ListAccountTradeServiceAPI
+	NewListUserLiquidationOrdersService() ListUserLiquidationOrdersServiceAPI
+	NewChangeLeverageService() ChangeLeverageServiceAPI
+	NewChangeMarginTypeService() ChangeMarginTypeServiceAPI
+	NewUpdatePositionMarginService() UpdatePositionMarginServiceAPI
+	NewChangePositionModeService() ChangePositionModeServiceAPI
+	NewGetPositionModeService() GetPositionModeServiceAPI
+	NewChangeMultiAssetModeService() ChangeMultiAssetModeServiceAPI
+	NewGetMultiAssetModeService() GetMultiAssetModeServiceAPI
+}
+
+// AccountAPI define the account services of Client
+type AccountAPI interface {
+	NewGetAccountService() GetAccountServiceAPI
+	NewGetBalanceService() GetBalanceServiceAPI
+	NewGetPositionRiskService() GetPositionRiskServiceAPI
+	NewGetPositionMarginHistoryService() GetPositionMarginHistoryServiceAPI
+	NewGetIncomeHistoryService() GetIncomeHistoryServiceAPI
+	NewGetLeverageBracketService() GetLeverageBracketServiceAPI
+	NewCommissionRateService() CommissionRateServiceAPI
+}
+
+// UserStreamAPI define the user data stream services of Client
+type UserStreamAPI interface {
+	NewStartUserStreamService() StartUserStreamServiceAPI
+	NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI
+	NewCloseUserStreamService() CloseUserStreamServiceAPI
+	NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) UserDataStreamAPI
+}
+
+// API define the services of Client covered by the interfaces above, returned by Client.API and
+// implemented by the fakes of binancetest
+type API interface {
+	MarketDataAPI
+	TradingAPI
+	AccountAPI
+	UserStreamAPI
+}
+
+// API return the services of c behind the interfaces above, so that code depending on them can be
+// tested with a fake of binancetest
+func (c *Client) API() API {
+	return clientAPI{c}
+}
@@ -0,0 +1,1646 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package futures
+
+import (
+	"context"
+	"time"
+)
+
+type clientAPI struct {
+	c *Client
+}
+
+func (a clientAPI) NewPingService() PingServiceAPI {
+	return pingServiceAPI{a.c.NewPingService()}
+}
+
+func (a clientAPI) NewServerTimeService() ServerTimeServiceAPI {
+	return serverTimeServiceAPI{a.c.NewServerTimeService()}
+}
+
+func (a clientAPI) NewExchangeInfoService() ExchangeInfoServiceAPI {
+	return exchangeInfoServiceAPI{a.c.NewExchangeInfoService()}
+}
+
+func (a clientAPI) NewDepthService() DepthServiceAPI {
+	return depthServiceAPI{a.c.NewDepthService()}
+}
+
+func (a clientAPI) NewAggTradesService() AggTradesServiceAPI {
+	return aggTradesServiceAPI{a.c.NewAggTradesService()}
+}
+
+func (a clientAPI) NewRecentTradesService() RecentTradesServiceAPI {
+	return recentTradesServiceAPI{a.c.NewRecentTradesService()}
+}
+
+func (a clientAPI) NewHistoricalTradesService() HistoricalTradesServiceAPI {
+	return historicalTradesServiceAPI{a.c.NewHistoricalTradesService()}
+}
+
+func (a clientAPI) NewKlinesService() KlinesServiceAPI {
+	return klinesServiceAPI{a.c.NewKlinesService()}
+}
+
+func (a clientAPI) NewContinuousKlinesService() ContinuousKlinesServiceAPI {
+	return continuousKlinesServiceAPI{a.c.NewContinuousKlinesService()}
+}
+
+func (a clientAPI) NewIndexPriceKlinesService() IndexPriceKlinesServiceAPI {
+	return indexPriceKlinesServiceAPI{a.c.NewIndexPriceKlinesService()}
+}
+
+func (a clientAPI) NewMarkPriceKlinesService() MarkPriceKlinesServiceAPI {
+	return markPriceKlinesServiceAPI{a.c.NewMarkPriceKlinesService()}
+}
+
+func (a clientAPI) NewListPriceChangeStatsService() ListPriceChangeStatsServiceAPI {
+	return listPriceChangeStatsServiceAPI{a.c.NewListPriceChangeStatsService()}
+}
+
+func (a clientAPI) NewListPricesService() ListPricesServiceAPI {
+	return listPricesServiceAPI{a.c.NewListPricesService()}
+}
+
+func (a clientAPI) NewListBookTickersService() ListBookTickersServiceAPI {
+	return listBookTickersServiceAPI{a.c.NewListBookTickersService()}
+}
+
+func (a clientAPI) NewPremiumIndexService() PremiumIndexServiceAPI {
+	return premiumIndexServiceAPI{a.c.NewPremiumIndexService()}
+}
+
+func (a clientAPI) NewFundingRateService() FundingRateServiceAPI {
+	return fundingRateServiceAPI{a.c.NewFundingRateService()}
+}
+
+func (a clientAPI) NewGetOpenInterestService() GetOpenInterestServiceAPI {
+	return getOpenInterestServiceAPI{a.c.NewGetOpenInterestService()}
+}
+
+func (a clientAPI) NewOpenInterestStatisticsService() OpenInterestStatisticsServiceAPI {
+	return openInterestStatisticsServiceAPI{a.c.NewOpenInterestStatisticsService()}
+}
+
+func (a clientAPI) NewLongShortRatioService() LongShortRatioServiceAPI {
+	return longShortRatioServiceAPI{a.c.NewLongShortRatioService()}
+}
+
+func (a clientAPI) NewListLiquidationOrdersService() ListLiquidationOrdersServiceAPI {
+	return listLiquidationOrdersServiceAPI{a.c.NewListLiquidationOrdersService()}
+}
+
+func (a clientAPI) NewCreateOrderService() CreateOrderServiceAPI {
+	return createOrderServiceAPI{a.c.NewCreateOrderService()}
+}
+
+func (a clientAPI) NewCreateBatchOrdersService() CreateBatchOrdersServiceAPI {
+	return createBatchOrdersServiceAPI{a.c.NewCreateBatchOrdersService()}
+}
+
+func (a clientAPI) NewGetOrderService() GetOrderServiceAPI {
+	return getOrderServiceAPI{a.c.NewGetOrderService()}
+}
+
+func (a clientAPI) NewCancelOrderService() CancelOrderServiceAPI {
+	return cancelOrderServiceAPI{a.c.NewCancelOrderService()}
+}
+
+func (a clientAPI) NewCancelAllOpenOrdersService() CancelAllOpenOrdersServiceAPI {
+	return cancelAllOpenOrdersServiceAPI{a.c.NewCancelAllOpenOrdersService()}
+}
+
+func (a clientAPI) NewCancelMultipleOrdersService() CancelMultiplesOrdersServiceAPI {
+	return cancelMultiplesOrdersServiceAPI{a.c.NewCancelMultipleOrdersService()}
+}
+
+func (a clientAPI) NewGetOpenOrderService() GetOpenOrderServiceAPI {
+	return getOpenOrderServiceAPI{a.c.NewGetOpenOrderService()}
+}
+
+func (a clientAPI) NewListOpenOrdersService() ListOpenOrdersServiceAPI {
+	return listOpenOrdersServiceAPI{a.c.NewListOpenOrdersService()}
+}
+
+func (a clientAPI) NewListOrdersService() ListOrdersServiceAPI {
+	return listOrdersServiceAPI{a.c.NewListOrdersService()}
+}
+
+func (a clientAPI) NewListAccountTradeService() ListAccountTradeServiceAPI {
+	return listAccountTradeServiceAPI{a.c.NewListAccountTradeService()}
+}
+
+func (a clientAPI) NewListUserLiquidationOrdersService() ListUserLiquidationOrdersServiceAPI {
+	return listUserLiquidationOrdersServiceAPI{a.c.NewListUserLiquidationOrdersService()}
+}
+
+func (a clientAPI) NewChangeLeverageService() ChangeLeverageServiceAPI {
+	return changeLeverageServiceAPI{a.c.NewChangeLeverageService()}
+}
+
+func (a clientAPI) NewChangeMarginTypeService() ChangeMarginTypeServiceAPI {
+	return changeMarginTypeServiceAPI{a.c.NewChangeMarginTypeService()}
+}
+
+func (a clientAPI) NewUpdatePositionMarginService() UpdatePositionMarginServiceAPI {
+	return updatePositionMarginServiceAPI{a.c.NewUpdatePositionMarginService()}
+}
+
+func (a clientAPI) NewChangePositionModeService() ChangePositionModeServiceAPI {
+	return changePositionModeServiceAPI{a.c.NewChangePositionModeService()}
+}
+
+func (a clientAPI) NewGetPositionModeService() GetPositionModeServiceAPI {
+	return getPositionModeServiceAPI{a.c.NewGetPositionModeService()}
+}
+
+func (a clientAPI) NewChangeMultiAssetModeService() ChangeMultiAssetModeServiceAPI {
+	return changeMultiAssetModeServiceAPI{a.c.NewChangeMultiAssetModeService()}
+}
+
+func (a clientAPI) NewGetMultiAssetModeService() GetMultiAssetModeServiceAPI {
+	return getMultiAssetModeServiceAPI{a.c.NewGetMultiAssetModeService()}
+}
+
+func (a clientAPI) NewGetAccountService() GetAccountServiceAPI {
+	return getAccountServiceAPI{a.c.NewGetAccountService()}
+}
+
+func (a clientAPI) NewGetBalanceService() GetBalanceServiceAPI {
+	return getBalanceServiceAPI{a.c.NewGetBalanceService()}
+}
+
+func (a clientAPI) NewGetPositionRiskService() GetPositionRiskServiceAPI {
+	return getPositionRiskServiceAPI{a.c.NewGetPositionRiskService()}
+}
+
+func (a clientAPI) NewGetPositionMarginHistoryService() GetPositionMarginHistoryServiceAPI {
+	return getPositionMarginHistoryServiceAPI{a.c.NewGetPositionMarginHistoryService()}
+}
+
+func (a clientAPI) NewGetIncomeHistoryService() GetIncomeHistoryServiceAPI {
+	return getIncomeHistoryServiceAPI{a.c.NewGetIncomeHistoryService()}
+}
+
+func (a clientAPI) NewGetLeverageBracketService() GetLeverageBracketServiceAPI {
+	return getLeverageBracketServiceAPI{a.c.NewGetLeverageBracketService()}
+}
+
+func (a clientAPI) NewCommissionRateService() CommissionRateServiceAPI {
+	return commissionRateServiceAPI{a.c.NewCommissionRateService()}
+}
+
+func (a clientAPI) NewStartUserStreamService() StartUserStreamServiceAPI {
+	return startUserStreamServiceAPI{a.c.NewStartUserStreamService()}
+}
+
+func (a clientAPI) NewKeepaliveUserStreamService() KeepaliveUserStreamServiceAPI {
+	return keepaliveUserStreamServiceAPI{a.c.NewKeepaliveUserStreamService()}
+}
+
+func (a clientAPI) NewCloseUserStreamService() CloseUserStreamServiceAPI {
+	return closeUserStreamServiceAPI{a.c.NewCloseUserStreamService()}
+}
+
+func (a clientAPI) NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) UserDataStreamAPI {
+	return userDataStreamAPI{a.c.NewUserDataStream(handler, errHandler)}
+}
+
+// PingServiceAPI define the setters and Do of PingService
+type PingServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type pingServiceAPI struct {
+	s *PingService
+}
+
+func (a pingServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// ServerTimeServiceAPI define the setters and Do of ServerTimeService
+type ServerTimeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (int64, error)
+}
+
+type serverTimeServiceAPI struct {
+	s *ServerTimeService
+}
+
+func (a serverTimeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (int64, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ExchangeInfoServiceAPI define the setters and Do of ExchangeInfoService
+type ExchangeInfoServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error)
+}
+
+type exchangeInfoServiceAPI struct {
+	s *ExchangeInfoService
+}
+
+func (a exchangeInfoServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*ExchangeInfo, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// DepthServiceAPI define the setters and Do of DepthService
+type DepthServiceAPI interface {
+	Symbol(symbol string) DepthServiceAPI
+	Limit(limit int) DepthServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error)
+}
+
+type depthServiceAPI struct {
+	s *DepthService
+}
+
+func (a depthServiceAPI) Symbol(symbol string) DepthServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a depthServiceAPI) Limit(limit int) DepthServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a depthServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*DepthResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// AggTradesServiceAPI define the setters and Do of AggTradesService
+type AggTradesServiceAPI interface {
+	Symbol(symbol string) AggTradesServiceAPI
+	FromID(fromID int64) AggTradesServiceAPI
+	StartTime(startTime int64) AggTradesServiceAPI
+	EndTime(endTime int64) AggTradesServiceAPI
+	Limit(limit int) AggTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*AggTrade, error)
+}
+
+type aggTradesServiceAPI struct {
+	s *AggTradesService
+}
+
+func (a aggTradesServiceAPI) Symbol(symbol string) AggTradesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a aggTradesServiceAPI) FromID(fromID int64) AggTradesServiceAPI {
+	a.s.FromID(fromID)
+	return a
+}
+
+func (a aggTradesServiceAPI) StartTime(startTime int64) AggTradesServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a aggTradesServiceAPI) EndTime(endTime int64) AggTradesServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a aggTradesServiceAPI) Limit(limit int) AggTradesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a aggTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*AggTrade, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// RecentTradesServiceAPI define the setters and Do of RecentTradesService
+type RecentTradesServiceAPI interface {
+	Symbol(symbol string) RecentTradesServiceAPI
+	Limit(limit int) RecentTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error)
+}
+
+type recentTradesServiceAPI struct {
+	s *RecentTradesService
+}
+
+func (a recentTradesServiceAPI) Symbol(symbol string) RecentTradesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a recentTradesServiceAPI) Limit(limit int) RecentTradesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a recentTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// HistoricalTradesServiceAPI define the setters and Do of HistoricalTradesService
+type HistoricalTradesServiceAPI interface {
+	Symbol(symbol string) HistoricalTradesServiceAPI
+	Limit(limit int) HistoricalTradesServiceAPI
+	FromID(fromID int64) HistoricalTradesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error)
+}
+
+type historicalTradesServiceAPI struct {
+	s *HistoricalTradesService
+}
+
+func (a historicalTradesServiceAPI) Symbol(symbol string) HistoricalTradesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a historicalTradesServiceAPI) Limit(limit int) HistoricalTradesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a historicalTradesServiceAPI) FromID(fromID int64) HistoricalTradesServiceAPI {
+	a.s.FromID(fromID)
+	return a
+}
+
+func (a historicalTradesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Trade, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// KlinesServiceAPI define the setters and Do of KlinesService
+type KlinesServiceAPI interface {
+	Symbol(symbol string) KlinesServiceAPI
+	Interval(interval string) KlinesServiceAPI
+	Limit(limit int) KlinesServiceAPI
+	StartTime(startTime int64) KlinesServiceAPI
+	EndTime(endTime int64) KlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type klinesServiceAPI struct {
+	s *KlinesService
+}
+
+func (a klinesServiceAPI) Symbol(symbol string) KlinesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a klinesServiceAPI) Interval(interval string) KlinesServiceAPI {
+	a.s.Interval(interval)
+	return a
+}
+
+func (a klinesServiceAPI) Limit(limit int) KlinesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a klinesServiceAPI) StartTime(startTime int64) KlinesServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a klinesServiceAPI) EndTime(endTime int64) KlinesServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a klinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ContinuousKlinesServiceAPI define the setters and Do of ContinuousKlinesService
+type ContinuousKlinesServiceAPI interface {
+	Pair(pair string) ContinuousKlinesServiceAPI
+	ContractType(contractType string) ContinuousKlinesServiceAPI
+	Interval(interval string) ContinuousKlinesServiceAPI
+	Limit(limit int) ContinuousKlinesServiceAPI
+	StartTime(startTime int64) ContinuousKlinesServiceAPI
+	EndTime(endTime int64) ContinuousKlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*ContinuousKline, error)
+}
+
+type continuousKlinesServiceAPI struct {
+	s *ContinuousKlinesService
+}
+
+func (a continuousKlinesServiceAPI) Pair(pair string) ContinuousKlinesServiceAPI {
+	a.s.Pair(pair)
+	return a
+}
+
+func (a continuousKlinesServiceAPI) ContractType(contractType string) ContinuousKlinesServiceAPI {
+	a.s.ContractType(contractType)
+	return a
+}
+
+func (a continuousKlinesServiceAPI) Interval(interval string) ContinuousKlinesServiceAPI {
+	a.s.Interval(interval)
+	return a
+}
+
+func (a continuousKlinesServiceAPI) Limit(limit int) ContinuousKlinesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a continuousKlinesServiceAPI) StartTime(startTime int64) ContinuousKlinesServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a continuousKlinesServiceAPI) EndTime(endTime int64) ContinuousKlinesServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a continuousKlinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*ContinuousKline, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// IndexPriceKlinesServiceAPI define the setters and Do of IndexPriceKlinesService
+type IndexPriceKlinesServiceAPI interface {
+	Pair(pair string) IndexPriceKlinesServiceAPI
+	Interval(interval string) IndexPriceKlinesServiceAPI
+	Limit(limit int) IndexPriceKlinesServiceAPI
+	StartTime(startTime int64) IndexPriceKlinesServiceAPI
+	EndTime(endTime int64) IndexPriceKlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type indexPriceKlinesServiceAPI struct {
+	s *IndexPriceKlinesService
+}
+
+func (a indexPriceKlinesServiceAPI) Pair(pair string) IndexPriceKlinesServiceAPI {
+	a.s.Pair(pair)
+	return a
+}
+
+func (a indexPriceKlinesServiceAPI) Interval(interval string) IndexPriceKlinesServiceAPI {
+	a.s.Interval(interval)
+	return a
+}
+
+func (a indexPriceKlinesServiceAPI) Limit(limit int) IndexPriceKlinesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a indexPriceKlinesServiceAPI) StartTime(startTime int64) IndexPriceKlinesServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a indexPriceKlinesServiceAPI) EndTime(endTime int64) IndexPriceKlinesServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a indexPriceKlinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// MarkPriceKlinesServiceAPI define the setters and Do of MarkPriceKlinesService
+type MarkPriceKlinesServiceAPI interface {
+	Symbol(symbol string) MarkPriceKlinesServiceAPI
+	Interval(interval string) MarkPriceKlinesServiceAPI
+	Limit(limit int) MarkPriceKlinesServiceAPI
+	StartTime(startTime int64) MarkPriceKlinesServiceAPI
+	EndTime(endTime int64) MarkPriceKlinesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error)
+}
+
+type markPriceKlinesServiceAPI struct {
+	s *MarkPriceKlinesService
+}
+
+func (a markPriceKlinesServiceAPI) Symbol(symbol string) MarkPriceKlinesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a markPriceKlinesServiceAPI) Interval(interval string) MarkPriceKlinesServiceAPI {
+	a.s.Interval(interval)
+	return a
+}
+
+func (a markPriceKlinesServiceAPI) Limit(limit int) MarkPriceKlinesServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a markPriceKlinesServiceAPI) StartTime(startTime int64) MarkPriceKlinesServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a markPriceKlinesServiceAPI) EndTime(endTime int64) MarkPriceKlinesServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a markPriceKlinesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Kline, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListPriceChangeStatsServiceAPI define the setters and Do of ListPriceChangeStatsService
+type ListPriceChangeStatsServiceAPI interface {
+	Symbol(symbol string) ListPriceChangeStatsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error)
+}
+
+type listPriceChangeStatsServiceAPI struct {
+	s *ListPriceChangeStatsService
+}
+
+func (a listPriceChangeStatsServiceAPI) Symbol(symbol string) ListPriceChangeStatsServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listPriceChangeStatsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PriceChangeStats, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListPricesServiceAPI define the setters and Do of ListPricesService
+type ListPricesServiceAPI interface {
+	Symbol(symbol string) ListPricesServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error)
+}
+
+type listPricesServiceAPI struct {
+	s *ListPricesService
+}
+
+func (a listPricesServiceAPI) Symbol(symbol string) ListPricesServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listPricesServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*SymbolPrice, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListBookTickersServiceAPI define the setters and Do of ListBookTickersService
+type ListBookTickersServiceAPI interface {
+	Symbol(symbol string) ListBookTickersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error)
+}
+
+type listBookTickersServiceAPI struct {
+	s *ListBookTickersService
+}
+
+func (a listBookTickersServiceAPI) Symbol(symbol string) ListBookTickersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listBookTickersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*BookTicker, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// PremiumIndexServiceAPI define the setters and Do of PremiumIndexService
+type PremiumIndexServiceAPI interface {
+	Symbol(symbol string) PremiumIndexServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PremiumIndex, error)
+}
+
+type premiumIndexServiceAPI struct {
+	s *PremiumIndexService
+}
+
+func (a premiumIndexServiceAPI) Symbol(symbol string) PremiumIndexServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a premiumIndexServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PremiumIndex, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// FundingRateServiceAPI define the setters and Do of FundingRateService
+type FundingRateServiceAPI interface {
+	Symbol(symbol string) FundingRateServiceAPI
+	StartTime(startTime int64) FundingRateServiceAPI
+	EndTime(endTime int64) FundingRateServiceAPI
+	Limit(limit int) FundingRateServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*FundingRate, error)
+}
+
+type fundingRateServiceAPI struct {
+	s *FundingRateService
+}
+
+func (a fundingRateServiceAPI) Symbol(symbol string) FundingRateServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a fundingRateServiceAPI) StartTime(startTime int64) FundingRateServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a fundingRateServiceAPI) EndTime(endTime int64) FundingRateServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a fundingRateServiceAPI) Limit(limit int) FundingRateServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a fundingRateServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*FundingRate, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetOpenInterestServiceAPI define the setters and Do of GetOpenInterestService
+type GetOpenInterestServiceAPI interface {
+	Symbol(symbol string) GetOpenInterestServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*OpenInterest, error)
+}
+
+type getOpenInterestServiceAPI struct {
+	s *GetOpenInterestService
+}
+
+func (a getOpenInterestServiceAPI) Symbol(symbol string) GetOpenInterestServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a getOpenInterestServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*OpenInterest, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// OpenInterestStatisticsServiceAPI define the setters and Do of OpenInterestStatisticsService
+type OpenInterestStatisticsServiceAPI interface {
+	Symbol(symbol string) OpenInterestStatisticsServiceAPI
+	Period(period string) OpenInterestStatisticsServiceAPI
+	Limit(limit int) OpenInterestStatisticsServiceAPI
+	StartTime(startTime int64) OpenInterestStatisticsServiceAPI
+	EndTime(endTime int64) OpenInterestStatisticsServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*OpenInterestStatistic, error)
+}
+
+type openInterestStatisticsServiceAPI struct {
+	s *OpenInterestStatisticsService
+}
+
+func (a openInterestStatisticsServiceAPI) Symbol(symbol string) OpenInterestStatisticsServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a openInterestStatisticsServiceAPI) Period(period string) OpenInterestStatisticsServiceAPI {
+	a.s.Period(period)
+	return a
+}
+
+func (a openInterestStatisticsServiceAPI) Limit(limit int) OpenInterestStatisticsServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a openInterestStatisticsServiceAPI) StartTime(startTime int64) OpenInterestStatisticsServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a openInterestStatisticsServiceAPI) EndTime(endTime int64) OpenInterestStatisticsServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a openInterestStatisticsServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*OpenInterestStatistic, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// LongShortRatioServiceAPI define the setters and Do of LongShortRatioService
+type LongShortRatioServiceAPI interface {
+	Symbol(symbol string) LongShortRatioServiceAPI
+	Period(period string) LongShortRatioServiceAPI
+	Limit(limit int) LongShortRatioServiceAPI
+	StartTime(startTime int64) LongShortRatioServiceAPI
+	EndTime(endTime int64) LongShortRatioServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LongShortRatio, error)
+}
+
+type longShortRatioServiceAPI struct {
+	s *LongShortRatioService
+}
+
+func (a longShortRatioServiceAPI) Symbol(symbol string) LongShortRatioServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a longShortRatioServiceAPI) Period(period string) LongShortRatioServiceAPI {
+	a.s.Period(period)
+	return a
+}
+
+func (a longShortRatioServiceAPI) Limit(limit int) LongShortRatioServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a longShortRatioServiceAPI) StartTime(startTime int64) LongShortRatioServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a longShortRatioServiceAPI) EndTime(endTime int64) LongShortRatioServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a longShortRatioServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LongShortRatio, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListLiquidationOrdersServiceAPI define the setters and Do of ListLiquidationOrdersService
+type ListLiquidationOrdersServiceAPI interface {
+	Symbol(symbol string) ListLiquidationOrdersServiceAPI
+	StartTime(startTime int64) ListLiquidationOrdersServiceAPI
+	EndTime(endTime int64) ListLiquidationOrdersServiceAPI
+	Limit(limit int) ListLiquidationOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LiquidationOrder, error)
+}
+
+type listLiquidationOrdersServiceAPI struct {
+	s *ListLiquidationOrdersService
+}
+
+func (a listLiquidationOrdersServiceAPI) Symbol(symbol string) ListLiquidationOrdersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listLiquidationOrdersServiceAPI) StartTime(startTime int64) ListLiquidationOrdersServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listLiquidationOrdersServiceAPI) EndTime(endTime int64) ListLiquidationOrdersServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listLiquidationOrdersServiceAPI) Limit(limit int) ListLiquidationOrdersServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LiquidationOrder, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CreateOrderServiceAPI define the setters and Do of CreateOrderService
+type CreateOrderServiceAPI interface {
+	Symbol(symbol string) CreateOrderServiceAPI
+	Side(side SideType) CreateOrderServiceAPI
+	PositionSide(positionSide PositionSideType) CreateOrderServiceAPI
+	Type(orderType OrderType) CreateOrderServiceAPI
+	TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI
+	Quantity(quantity string) CreateOrderServiceAPI
+	ReduceOnly(reduceOnly bool) CreateOrderServiceAPI
+	Price(price string) CreateOrderServiceAPI
+	NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI
+	StopPrice(stopPrice string) CreateOrderServiceAPI
+	WorkingType(workingType WorkingType) CreateOrderServiceAPI
+	ActivationPrice(activationPrice string) CreateOrderServiceAPI
+	CallbackRate(callbackRate string) CreateOrderServiceAPI
+	PriceProtect(priceProtect bool) CreateOrderServiceAPI
+	NewOrderResponseType(newOrderResponseType NewOrderRespType) CreateOrderServiceAPI
+	ClosePosition(closePosition bool) CreateOrderServiceAPI
+	SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error)
+}
+
+type createOrderServiceAPI struct {
+	s *CreateOrderService
+}
+
+func (a createOrderServiceAPI) Symbol(symbol string) CreateOrderServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a createOrderServiceAPI) Side(side SideType) CreateOrderServiceAPI {
+	a.s.Side(side)
+	return a
+}
+
+func (a createOrderServiceAPI) PositionSide(positionSide PositionSideType) CreateOrderServiceAPI {
+	a.s.PositionSide(positionSide)
+	return a
+}
+
+func (a createOrderServiceAPI) Type(orderType OrderType) CreateOrderServiceAPI {
+	a.s.Type(orderType)
+	return a
+}
+
+func (a createOrderServiceAPI) TimeInForce(timeInForce TimeInForceType) CreateOrderServiceAPI {
+	a.s.TimeInForce(timeInForce)
+	return a
+}
+
+func (a createOrderServiceAPI) Quantity(quantity string) CreateOrderServiceAPI {
+	a.s.Quantity(quantity)
+	return a
+}
+
+func (a createOrderServiceAPI) ReduceOnly(reduceOnly bool) CreateOrderServiceAPI {
+	a.s.ReduceOnly(reduceOnly)
+	return a
+}
+
+func (a createOrderServiceAPI) Price(price string) CreateOrderServiceAPI {
+	a.s.Price(price)
+	return a
+}
+
+func (a createOrderServiceAPI) NewClientOrderID(newClientOrderID string) CreateOrderServiceAPI {
+	a.s.NewClientOrderID(newClientOrderID)
+	return a
+}
+
+func (a createOrderServiceAPI) StopPrice(stopPrice string) CreateOrderServiceAPI {
+	a.s.StopPrice(stopPrice)
+	return a
+}
+
+func (a createOrderServiceAPI) WorkingType(workingType WorkingType) CreateOrderServiceAPI {
+	a.s.WorkingType(workingType)
+	return a
+}
+
+func (a createOrderServiceAPI) ActivationPrice(activationPrice string) CreateOrderServiceAPI {
+	a.s.ActivationPrice(activationPrice)
+	return a
+}
+
+func (a createOrderServiceAPI) CallbackRate(callbackRate string) CreateOrderServiceAPI {
+	a.s.CallbackRate(callbackRate)
+	return a
+}
+
+func (a createOrderServiceAPI) PriceProtect(priceProtect bool) CreateOrderServiceAPI {
+	a.s.PriceProtect(priceProtect)
+	return a
+}
+
+func (a createOrderServiceAPI) NewOrderResponseType(newOrderResponseType NewOrderRespType) CreateOrderServiceAPI {
+	a.s.NewOrderResponseType(newOrderResponseType)
+	return a
+}
+
+func (a createOrderServiceAPI) ClosePosition(closePosition bool) CreateOrderServiceAPI {
+	a.s.ClosePosition(closePosition)
+	return a
+}
+
+func (a createOrderServiceAPI) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) CreateOrderServiceAPI {
+	a.s.SelfTradePreventionMode(selfTradePreventionMode)
+	return a
+}
+
+func (a createOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateOrderResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CreateBatchOrdersServiceAPI define the setters and Do of CreateBatchOrdersService
+type CreateBatchOrdersServiceAPI interface {
+	OrderList(orders []*CreateOrderService) CreateBatchOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CreateBatchOrdersResponse, error)
+}
+
+type createBatchOrdersServiceAPI struct {
+	s *CreateBatchOrdersService
+}
+
+func (a createBatchOrdersServiceAPI) OrderList(orders []*CreateOrderService) CreateBatchOrdersServiceAPI {
+	a.s.OrderList(orders)
+	return a
+}
+
+func (a createBatchOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CreateBatchOrdersResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetOrderServiceAPI define the setters and Do of GetOrderService
+type GetOrderServiceAPI interface {
+	Symbol(symbol string) GetOrderServiceAPI
+	OrderID(orderID int64) GetOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getOrderServiceAPI struct {
+	s *GetOrderService
+}
+
+func (a getOrderServiceAPI) Symbol(symbol string) GetOrderServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a getOrderServiceAPI) OrderID(orderID int64) GetOrderServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a getOrderServiceAPI) OrigClientOrderID(origClientOrderID string) GetOrderServiceAPI {
+	a.s.OrigClientOrderID(origClientOrderID)
+	return a
+}
+
+func (a getOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CancelOrderServiceAPI define the setters and Do of CancelOrderService
+type CancelOrderServiceAPI interface {
+	Symbol(symbol string) CancelOrderServiceAPI
+	OrderID(orderID int64) CancelOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error)
+}
+
+type cancelOrderServiceAPI struct {
+	s *CancelOrderService
+}
+
+func (a cancelOrderServiceAPI) Symbol(symbol string) CancelOrderServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a cancelOrderServiceAPI) OrderID(orderID int64) CancelOrderServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a cancelOrderServiceAPI) OrigClientOrderID(origClientOrderID string) CancelOrderServiceAPI {
+	a.s.OrigClientOrderID(origClientOrderID)
+	return a
+}
+
+func (a cancelOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CancelOrderResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CancelAllOpenOrdersServiceAPI define the setters and Do of CancelAllOpenOrdersService
+type CancelAllOpenOrdersServiceAPI interface {
+	Symbol(symbol string) CancelAllOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type cancelAllOpenOrdersServiceAPI struct {
+	s *CancelAllOpenOrdersService
+}
+
+func (a cancelAllOpenOrdersServiceAPI) Symbol(symbol string) CancelAllOpenOrdersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a cancelAllOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// CancelMultiplesOrdersServiceAPI define the setters and Do of CancelMultiplesOrdersService
+type CancelMultiplesOrdersServiceAPI interface {
+	Symbol(symbol string) CancelMultiplesOrdersServiceAPI
+	OrderIDList(orderIDList []int64) CancelMultiplesOrdersServiceAPI
+	OrigClientOrderIDList(origClientOrderIDList []string) CancelMultiplesOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*CancelOrderResponse, error)
+}
+
+type cancelMultiplesOrdersServiceAPI struct {
+	s *CancelMultiplesOrdersService
+}
+
+func (a cancelMultiplesOrdersServiceAPI) Symbol(symbol string) CancelMultiplesOrdersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a cancelMultiplesOrdersServiceAPI) OrderIDList(orderIDList []int64) CancelMultiplesOrdersServiceAPI {
+	a.s.OrderIDList(orderIDList)
+	return a
+}
+
+func (a cancelMultiplesOrdersServiceAPI) OrigClientOrderIDList(origClientOrderIDList []string) CancelMultiplesOrdersServiceAPI {
+	a.s.OrigClientOrderIDList(origClientOrderIDList)
+	return a
+}
+
+func (a cancelMultiplesOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*CancelOrderResponse, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetOpenOrderServiceAPI define the setters and Do of GetOpenOrderService
+type GetOpenOrderServiceAPI interface {
+	Symbol(symbol string) GetOpenOrderServiceAPI
+	OrderID(orderID int64) GetOpenOrderServiceAPI
+	OrigClientOrderID(origClientOrderID string) GetOpenOrderServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*Order, error)
+}
+
+type getOpenOrderServiceAPI struct {
+	s *GetOpenOrderService
+}
+
+func (a getOpenOrderServiceAPI) Symbol(symbol string) GetOpenOrderServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a getOpenOrderServiceAPI) OrderID(orderID int64) GetOpenOrderServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a getOpenOrderServiceAPI) OrigClientOrderID(origClientOrderID string) GetOpenOrderServiceAPI {
+	a.s.OrigClientOrderID(origClientOrderID)
+	return a
+}
+
+func (a getOpenOrderServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Order, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListOpenOrdersServiceAPI define the setters and Do of ListOpenOrdersService
+type ListOpenOrdersServiceAPI interface {
+	Symbol(symbol string) ListOpenOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOpenOrdersServiceAPI struct {
+	s *ListOpenOrdersService
+}
+
+func (a listOpenOrdersServiceAPI) Symbol(symbol string) ListOpenOrdersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listOpenOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListOrdersServiceAPI define the setters and Do of ListOrdersService
+type ListOrdersServiceAPI interface {
+	Symbol(symbol string) ListOrdersServiceAPI
+	OrderID(orderID int64) ListOrdersServiceAPI
+	StartTime(startTime int64) ListOrdersServiceAPI
+	EndTime(endTime int64) ListOrdersServiceAPI
+	Limit(limit int) ListOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*Order, error)
+}
+
+type listOrdersServiceAPI struct {
+	s *ListOrdersService
+}
+
+func (a listOrdersServiceAPI) Symbol(symbol string) ListOrdersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listOrdersServiceAPI) OrderID(orderID int64) ListOrdersServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a listOrdersServiceAPI) StartTime(startTime int64) ListOrdersServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listOrdersServiceAPI) EndTime(endTime int64) ListOrdersServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listOrdersServiceAPI) Limit(limit int) ListOrdersServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Order, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListAccountTradeServiceAPI define the setters and Do of ListAccountTradeService
+type ListAccountTradeServiceAPI interface {
+	Symbol(symbol string) ListAccountTradeServiceAPI
+	OrderID(orderID int64) ListAccountTradeServiceAPI
+	StartTime(startTime int64) ListAccountTradeServiceAPI
+	EndTime(endTime int64) ListAccountTradeServiceAPI
+	FromID(fromID int64) ListAccountTradeServiceAPI
+	Limit(limit int) ListAccountTradeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*AccountTrade, error)
+}
+
+type listAccountTradeServiceAPI struct {
+	s *ListAccountTradeService
+}
+
+func (a listAccountTradeServiceAPI) Symbol(symbol string) ListAccountTradeServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listAccountTradeServiceAPI) OrderID(orderID int64) ListAccountTradeServiceAPI {
+	a.s.OrderID(orderID)
+	return a
+}
+
+func (a listAccountTradeServiceAPI) StartTime(startTime int64) ListAccountTradeServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listAccountTradeServiceAPI) EndTime(endTime int64) ListAccountTradeServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listAccountTradeServiceAPI) FromID(fromID int64) ListAccountTradeServiceAPI {
+	a.s.FromID(fromID)
+	return a
+}
+
+func (a listAccountTradeServiceAPI) Limit(limit int) ListAccountTradeServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listAccountTradeServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*AccountTrade, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ListUserLiquidationOrdersServiceAPI define the setters and Do of ListUserLiquidationOrdersService
+type ListUserLiquidationOrdersServiceAPI interface {
+	Symbol(symbol string) ListUserLiquidationOrdersServiceAPI
+	AutoCloseType(autoCloseType ForceOrderCloseType) ListUserLiquidationOrdersServiceAPI
+	StartTime(startTime int64) ListUserLiquidationOrdersServiceAPI
+	EndTime(endTime int64) ListUserLiquidationOrdersServiceAPI
+	Limit(limit int) ListUserLiquidationOrdersServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*UserLiquidationOrder, error)
+}
+
+type listUserLiquidationOrdersServiceAPI struct {
+	s *ListUserLiquidationOrdersService
+}
+
+func (a listUserLiquidationOrdersServiceAPI) Symbol(symbol string) ListUserLiquidationOrdersServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a listUserLiquidationOrdersServiceAPI) AutoCloseType(autoCloseType ForceOrderCloseType) ListUserLiquidationOrdersServiceAPI {
+	a.s.AutoCloseType(autoCloseType)
+	return a
+}
+
+func (a listUserLiquidationOrdersServiceAPI) StartTime(startTime int64) ListUserLiquidationOrdersServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a listUserLiquidationOrdersServiceAPI) EndTime(endTime int64) ListUserLiquidationOrdersServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a listUserLiquidationOrdersServiceAPI) Limit(limit int) ListUserLiquidationOrdersServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a listUserLiquidationOrdersServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*UserLiquidationOrder, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ChangeLeverageServiceAPI define the setters and Do of ChangeLeverageService
+type ChangeLeverageServiceAPI interface {
+	Symbol(symbol string) ChangeLeverageServiceAPI
+	Leverage(leverage int) ChangeLeverageServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*SymbolLeverage, error)
+}
+
+type changeLeverageServiceAPI struct {
+	s *ChangeLeverageService
+}
+
+func (a changeLeverageServiceAPI) Symbol(symbol string) ChangeLeverageServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a changeLeverageServiceAPI) Leverage(leverage int) ChangeLeverageServiceAPI {
+	a.s.Leverage(leverage)
+	return a
+}
+
+func (a changeLeverageServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*SymbolLeverage, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ChangeMarginTypeServiceAPI define the setters and Do of ChangeMarginTypeService
+type ChangeMarginTypeServiceAPI interface {
+	Symbol(symbol string) ChangeMarginTypeServiceAPI
+	MarginType(marginType MarginType) ChangeMarginTypeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type changeMarginTypeServiceAPI struct {
+	s *ChangeMarginTypeService
+}
+
+func (a changeMarginTypeServiceAPI) Symbol(symbol string) ChangeMarginTypeServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a changeMarginTypeServiceAPI) MarginType(marginType MarginType) ChangeMarginTypeServiceAPI {
+	a.s.MarginType(marginType)
+	return a
+}
+
+func (a changeMarginTypeServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// UpdatePositionMarginServiceAPI define the setters and Do of UpdatePositionMarginService
+type UpdatePositionMarginServiceAPI interface {
+	Symbol(symbol string) UpdatePositionMarginServiceAPI
+	PositionSide(positionSide PositionSideType) UpdatePositionMarginServiceAPI
+	Amount(amount string) UpdatePositionMarginServiceAPI
+	Type(actionType int) UpdatePositionMarginServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type updatePositionMarginServiceAPI struct {
+	s *UpdatePositionMarginService
+}
+
+func (a updatePositionMarginServiceAPI) Symbol(symbol string) UpdatePositionMarginServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a updatePositionMarginServiceAPI) PositionSide(positionSide PositionSideType) UpdatePositionMarginServiceAPI {
+	a.s.PositionSide(positionSide)
+	return a
+}
+
+func (a updatePositionMarginServiceAPI) Amount(amount string) UpdatePositionMarginServiceAPI {
+	a.s.Amount(amount)
+	return a
+}
+
+func (a updatePositionMarginServiceAPI) Type(actionType int) UpdatePositionMarginServiceAPI {
+	a.s.Type(actionType)
+	return a
+}
+
+func (a updatePositionMarginServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// ChangePositionModeServiceAPI define the setters and Do of ChangePositionModeService
+type ChangePositionModeServiceAPI interface {
+	DualSide(dualSide bool) ChangePositionModeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type changePositionModeServiceAPI struct {
+	s *ChangePositionModeService
+}
+
+func (a changePositionModeServiceAPI) DualSide(dualSide bool) ChangePositionModeServiceAPI {
+	a.s.DualSide(dualSide)
+	return a
+}
+
+func (a changePositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetPositionModeServiceAPI define the setters and Do of GetPositionModeService
+type GetPositionModeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error)
+}
+
+type getPositionModeServiceAPI struct {
+	s *GetPositionModeService
+}
+
+func (a getPositionModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*PositionMode, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// ChangeMultiAssetModeServiceAPI define the setters and Do of ChangeMultiAssetModeService
+type ChangeMultiAssetModeServiceAPI interface {
+	MultiAssetsMargin(multiAssetsMargin bool) ChangeMultiAssetModeServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type changeMultiAssetModeServiceAPI struct {
+	s *ChangeMultiAssetModeService
+}
+
+func (a changeMultiAssetModeServiceAPI) MultiAssetsMargin(multiAssetsMargin bool) ChangeMultiAssetModeServiceAPI {
+	a.s.MultiAssetsMargin(multiAssetsMargin)
+	return a
+}
+
+func (a changeMultiAssetModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetMultiAssetModeServiceAPI define the setters and Do of GetMultiAssetModeService
+type GetMultiAssetModeServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*MultiAssetMode, error)
+}
+
+type getMultiAssetModeServiceAPI struct {
+	s *GetMultiAssetModeService
+}
+
+func (a getMultiAssetModeServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*MultiAssetMode, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetAccountServiceAPI define the setters and Do of GetAccountService
+type GetAccountServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (*Account, error)
+}
+
+type getAccountServiceAPI struct {
+	s *GetAccountService
+}
+
+func (a getAccountServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*Account, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetBalanceServiceAPI define the setters and Do of GetBalanceService
+type GetBalanceServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) ([]*Balance, error)
+}
+
+type getBalanceServiceAPI struct {
+	s *GetBalanceService
+}
+
+func (a getBalanceServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*Balance, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetPositionRiskServiceAPI define the setters and Do of GetPositionRiskService
+type GetPositionRiskServiceAPI interface {
+	Symbol(symbol string) GetPositionRiskServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PositionRisk, error)
+}
+
+type getPositionRiskServiceAPI struct {
+	s *GetPositionRiskService
+}
+
+func (a getPositionRiskServiceAPI) Symbol(symbol string) GetPositionRiskServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a getPositionRiskServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PositionRisk, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetPositionMarginHistoryServiceAPI define the setters and Do of GetPositionMarginHistoryService
+type GetPositionMarginHistoryServiceAPI interface {
+	Symbol(symbol string) GetPositionMarginHistoryServiceAPI
+	Type(_type int) GetPositionMarginHistoryServiceAPI
+	StartTime(startTime int64) GetPositionMarginHistoryServiceAPI
+	EndTime(endTime int64) GetPositionMarginHistoryServiceAPI
+	Limit(limit int64) GetPositionMarginHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*PositionMarginHistory, error)
+}
+
+type getPositionMarginHistoryServiceAPI struct {
+	s *GetPositionMarginHistoryService
+}
+
+func (a getPositionMarginHistoryServiceAPI) Symbol(symbol string) GetPositionMarginHistoryServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a getPositionMarginHistoryServiceAPI) Type(_type int) GetPositionMarginHistoryServiceAPI {
+	a.s.Type(_type)
+	return a
+}
+
+func (a getPositionMarginHistoryServiceAPI) StartTime(startTime int64) GetPositionMarginHistoryServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a getPositionMarginHistoryServiceAPI) EndTime(endTime int64) GetPositionMarginHistoryServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a getPositionMarginHistoryServiceAPI) Limit(limit int64) GetPositionMarginHistoryServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a getPositionMarginHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*PositionMarginHistory, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetIncomeHistoryServiceAPI define the setters and Do of GetIncomeHistoryService
+type GetIncomeHistoryServiceAPI interface {
+	Symbol(symbol string) GetIncomeHistoryServiceAPI
+	IncomeType(incomeType string) GetIncomeHistoryServiceAPI
+	StartTime(startTime int64) GetIncomeHistoryServiceAPI
+	EndTime(endTime int64) GetIncomeHistoryServiceAPI
+	Limit(limit int64) GetIncomeHistoryServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*IncomeHistory, error)
+}
+
+type getIncomeHistoryServiceAPI struct {
+	s *GetIncomeHistoryService
+}
+
+func (a getIncomeHistoryServiceAPI) Symbol(symbol string) GetIncomeHistoryServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a getIncomeHistoryServiceAPI) IncomeType(incomeType string) GetIncomeHistoryServiceAPI {
+	a.s.IncomeType(incomeType)
+	return a
+}
+
+func (a getIncomeHistoryServiceAPI) StartTime(startTime int64) GetIncomeHistoryServiceAPI {
+	a.s.StartTime(startTime)
+	return a
+}
+
+func (a getIncomeHistoryServiceAPI) EndTime(endTime int64) GetIncomeHistoryServiceAPI {
+	a.s.EndTime(endTime)
+	return a
+}
+
+func (a getIncomeHistoryServiceAPI) Limit(limit int64) GetIncomeHistoryServiceAPI {
+	a.s.Limit(limit)
+	return a
+}
+
+func (a getIncomeHistoryServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*IncomeHistory, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// GetLeverageBracketServiceAPI define the setters and Do of GetLeverageBracketService
+type GetLeverageBracketServiceAPI interface {
+	Symbol(symbol string) GetLeverageBracketServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) ([]*LeverageBracket, error)
+}
+
+type getLeverageBracketServiceAPI struct {
+	s *GetLeverageBracketService
+}
+
+func (a getLeverageBracketServiceAPI) Symbol(symbol string) GetLeverageBracketServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a getLeverageBracketServiceAPI) Do(ctx context.Context, opts ...RequestOption) ([]*LeverageBracket, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// CommissionRateServiceAPI define the setters and Do of CommissionRateService
+type CommissionRateServiceAPI interface {
+	Symbol(symbol string) CommissionRateServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error)
+}
+
+type commissionRateServiceAPI struct {
+	s *CommissionRateService
+}
+
+func (a commissionRateServiceAPI) Symbol(symbol string) CommissionRateServiceAPI {
+	a.s.Symbol(symbol)
+	return a
+}
+
+func (a commissionRateServiceAPI) Do(ctx context.Context, opts ...RequestOption) (*CommissionRate, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// StartUserStreamServiceAPI define the setters and Do of StartUserStreamService
+type StartUserStreamServiceAPI interface {
+	Do(ctx context.Context, opts ...RequestOption) (string, error)
+}
+
+type startUserStreamServiceAPI struct {
+	s *StartUserStreamService
+}
+
+func (a startUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) (string, error) {
+	return a.s.Do(ctx, opts...)
+}
+
+// KeepaliveUserStreamServiceAPI define the setters and Do of KeepaliveUserStreamService
+type KeepaliveUserStreamServiceAPI interface {
+	ListenKey(listenKey string) KeepaliveUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type keepaliveUserStreamServiceAPI struct {
+	s *KeepaliveUserStreamService
+}
+
+func (a keepaliveUserStreamServiceAPI) ListenKey(listenKey string) KeepaliveUserStreamServiceAPI {
+	a.s.ListenKey(listenKey)
+	return a
+}
+
+func (a keepaliveUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// CloseUserStreamServiceAPI define the setters and Do of CloseUserStreamService
+type CloseUserStreamServiceAPI interface {
+	ListenKey(listenKey string) CloseUserStreamServiceAPI
+	Do(ctx context.Context, opts ...RequestOption) error
+}
+
+type closeUserStreamServiceAPI struct {
+	s *CloseUserStreamService
+}
+
+func (a closeUserStreamServiceAPI) ListenKey(listenKey string) CloseUserStreamServiceAPI {
+	a.s.ListenKey(listenKey)
+	return a
+}
+
+func (a closeUserStreamServiceAPI) Do(ctx context.Context, opts ...RequestOption) error {
+	return a.s.Do(ctx, opts...)
+}
+
+// UserDataStreamAPI define the methods of UserDataStream
+type UserDataStreamAPI interface {
+	OnResync(f func()) UserDataStreamAPI
+	KeepaliveInterval(d time.Duration) UserDataStreamAPI
+	Start(ctx context.Context) error
+	Stop()
+	Done() <-chan struct{}
+	ListenKey() string
+}
+
+type userDataStreamAPI struct {
+	s *UserDataStream
+}
+
+func (a userDataStreamAPI) OnResync(f func()) UserDataStreamAPI {
+	a.s.OnResync(f)
+	return a
+}
+
+func (a userDataStreamAPI) KeepaliveInterval(d time.Duration) UserDataStreamAPI {
+	a.s.KeepaliveInterval(d)
+	return a
+}
+
+func (a userDataStreamAPI) Start(ctx context.Context) error {
+	return a.s.Start(ctx)
+}
+
+func (a userDataStreamAPI) Stop() {
+	a.s.Stop()
+}
+
+func (a userDataStreamAPI) Done() <-chan struct{} {
+	return a.s.Done()
+}
+
+func (a userDataStreamAPI) ListenKey() string {
+	return a.s.ListenKey()
+}
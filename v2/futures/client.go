@@ -1,14 +1,9 @@
 package futures
 
 import (
-	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
 	"crypto/tls"
 	"encoding/json"
-	"fmt"
-	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
@@ -18,6 +13,7 @@ import (
 	"github.com/bitly/go-simplejson"
 
 	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/internal/transport"
 )
 
 // SideType define side type of order
@@ -41,6 +37,9 @@ type OrderExecutionType string
 // OrderStatusType define order status type
 type OrderStatusType string
 
+// SelfTradePreventionModeType define the self-trade prevention mode of an order
+type SelfTradePreventionModeType string
+
 // SymbolType define symbol type
 type SymbolType string
 
@@ -118,6 +117,12 @@ const (
 	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
 	OrderStatusTypeNewInsurance    OrderStatusType = "NEW_INSURANCE"
 	OrderStatusTypeNewADL          OrderStatusType = "NEW_ADL"
+	OrderStatusTypeExpiredInMatch  OrderStatusType = "EXPIRED_IN_MATCH"
+
+	SelfTradePreventionModeTypeNone        SelfTradePreventionModeType = "NONE"
+	SelfTradePreventionModeTypeExpireTaker SelfTradePreventionModeType = "EXPIRE_TAKER"
+	SelfTradePreventionModeTypeExpireMaker SelfTradePreventionModeType = "EXPIRE_MAKER"
+	SelfTradePreventionModeTypeExpireBoth  SelfTradePreventionModeType = "EXPIRE_BOTH"
 
 	SymbolTypeFuture SymbolType = "FUTURE"
 
@@ -201,15 +206,24 @@ func getApiEndpoint() string {
 // NewClient initialize an API client instance with API key and secret key.
 // You should always call this function before using this SDK.
 // Services will be created by the form client.NewXXXService().
-func NewClient(apiKey, secretKey string) *Client {
-	return &Client{
+// Without options, the client is configured with the package variables.
+func NewClient(apiKey, secretKey string, opts ...ClientOption) *Client {
+	o := newClientOptions(opts)
+	c := &Client{
 		APIKey:     apiKey,
 		SecretKey:  secretKey,
-		BaseURL:    getApiEndpoint(),
+		BaseURL:    o.apiURL(),
 		UserAgent:  "Binance/golang",
-		HTTPClient: http.DefaultClient,
+		HTTPClient: o.client(),
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
 	}
+	if o.logger != nil {
+		c.Logger = o.logger
+	}
+	if len(opts) > 0 {
+		c.ws = o.wsClient()
+	}
+	return c
 }
 
 // NewProxiedClient passing a proxy url
@@ -240,13 +254,38 @@ type doFunc func(req *http.Request) (*http.Response, error)
 type Client struct {
 	APIKey     string
 	SecretKey  string
+	Signer     common.Signer // signs SIGNED requests instead of the HMAC of SecretKey if set
 	BaseURL    string
 	UserAgent  string
 	HTTPClient *http.Client
 	Debug      bool
 	Logger     *log.Logger
 	TimeOffset int64
+	// RateLimiter delays requests that would exceed the rate limits if set
+	RateLimiter *common.RateLimiter
+	// RetryPolicy retries requests failing with a transient error if set
+	RetryPolicy *common.RetryPolicy
+	// OnResponse is called with the status code and headers of every response if set
+	OnResponse common.ResponseHook
 	do         doFunc
+	ws         *WsClient
+}
+
+// WsClient return the websocket client with the endpoints and settings given to NewClient,
+// or with those of the package variables
+func (c *Client) WsClient() *WsClient {
+	if c.ws != nil {
+		return c.ws
+	}
+	return defaultWsClient()
+}
+
+// signer return the signer of SIGNED requests
+func (c *Client) signer() common.Signer {
+	if c.Signer != nil {
+		return c.Signer
+	}
+	return common.NewHMACSigner(c.SecretKey)
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -255,6 +294,39 @@ func (c *Client) debug(format string, v ...interface{}) {
 	}
 }
 
+// newTransport return the transport sending requests with the settings of c
+func (c *Client) newTransport() *transport.Client {
+	f := c.do
+	if f == nil {
+		f = c.HTTPClient.Do
+	}
+	return &transport.Client{
+		APIKey:        c.APIKey,
+		Signer:        c.signer(),
+		BaseURL:       c.BaseURL,
+		TimeOffset:    c.TimeOffset,
+		RateLimiter:   c.RateLimiter,
+		RetryPolicy:   c.RetryPolicy,
+		Do:            f,
+		Debug:         c.debug,
+		OnResponse:    c.OnResponse,
+		OrderEndpoint: "/fapi/v1/order",
+		LookupOrder:   c.lookupOrder,
+		SyncTime: func(ctx context.Context) (int64, error) {
+			return c.NewSetServerTimeService().Do(ctx)
+		},
+	}
+}
+
+// lookupOrder return the JSON of an order by client order id, to check if a failed placement went through
+func (c *Client) lookupOrder(ctx context.Context, symbol, clientOrderID string) ([]byte, error) {
+	order, err := c.NewGetOrderService().Symbol(symbol).OrigClientOrderID(clientOrderID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(order)
+}
+
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	// set request options from user
 	for _, opt := range opts {
@@ -264,100 +336,27 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if err != nil {
 		return err
 	}
-
-	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.endpoint)
-	if r.recvWindow > 0 {
-		r.setParam(recvWindowKey, r.recvWindow)
-	}
-	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
-	}
-	queryString := r.query.Encode()
-	body := &bytes.Buffer{}
-	bodyString := r.form.Encode()
-	header := http.Header{}
-	if r.header != nil {
-		header = r.header.Clone()
-	}
-	if bodyString != "" {
-		header.Set("Content-Type", "application/x-www-form-urlencoded")
-		body = bytes.NewBufferString(bodyString)
-	}
-	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
-		header.Set("X-MBX-APIKEY", c.APIKey)
-	}
-
-	if r.secType == secTypeSigned {
-		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		mac := hmac.New(sha256.New, []byte(c.SecretKey))
-		_, err = mac.Write([]byte(raw))
-		if err != nil {
-			return err
-		}
-		v := url.Values{}
-		v.Set(signatureKey, fmt.Sprintf("%x", (mac.Sum(nil))))
-		if queryString == "" {
-			queryString = v.Encode()
-		} else {
-			queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
-		}
-	}
-	if queryString != "" {
-		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
+	tr := r.toTransport()
+	err = c.newTransport().Parse(tr)
+	if err != nil {
+		return err
 	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
-
-	r.fullURL = fullURL
-	r.header = header
-	r.body = body
+	r.fullURL, r.header, r.body = tr.FullURL, tr.Header, tr.Body
 	return nil
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
-	err = c.parseRequest(r, opts...)
-	if err != nil {
-		return []byte{}, &http.Header{}, err
-	}
-	req, err := http.NewRequest(r.method, r.fullURL, r.body)
-	if err != nil {
-		return []byte{}, &http.Header{}, err
-	}
-	req = req.WithContext(ctx)
-	req.Header = r.header
-	c.debug("request: %#v", req)
-	f := c.do
-	if f == nil {
-		f = c.HTTPClient.Do
-	}
-	res, err := f(req)
-	if err != nil {
-		return []byte{}, &http.Header{}, err
+	// set request options from user
+	for _, opt := range opts {
+		opt(r)
 	}
-	data, err = ioutil.ReadAll(res.Body)
+	err = r.validate()
 	if err != nil {
 		return []byte{}, &http.Header{}, err
 	}
-	defer func() {
-		cerr := res.Body.Close()
-		// Only overwrite the retured error if the original error was nil and an
-		// error occurred while closing the body.
-		if err == nil && cerr != nil {
-			err = cerr
-		}
-	}()
-	c.debug("response: %#v", res)
-	c.debug("response body: %s", string(data))
-	c.debug("response status code: %d", res.StatusCode)
-
-	if res.StatusCode >= http.StatusBadRequest {
-		apiErr := new(common.APIError)
-		e := json.Unmarshal(data, apiErr)
-		if e != nil {
-			c.debug("failed to unmarshal json: %s", e)
-		}
-		return nil, &http.Header{}, apiErr
-	}
-	return data, &res.Header, nil
+	var h http.Header
+	data, h, err = c.newTransport().Call(ctx, r.toTransport())
+	return data, &h, err
 }
 
 // SetApiEndpoint set api Endpoint
@@ -526,6 +525,11 @@ func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
 	return &CloseUserStreamService{c: c}
 }
 
+// NewUserDataStream init a user data stream passing its events to handler, opened by Start
+func (c *Client) NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) *UserDataStream {
+	return &UserDataStream{c: c, handler: handler, errHandler: errHandler}
+}
+
 // NewExchangeInfoService init exchange info service
 func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
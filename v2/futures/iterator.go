@@ -0,0 +1,137 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Largest pages of the paginated endpoints
+const (
+	maxKlinesLimit        = 1500
+	maxAggTradesLimit     = 1000
+	maxOrdersLimit        = 1000
+	maxAccountTradesLimit = 1000
+	maxIncomeHistoryLimit = 1000
+)
+
+// Range iterate over the klines opening between startTime and endTime, in milliseconds. The
+// checkpoint is the start time following the last kline returned.
+func (s *KlinesService) Range(ctx context.Context, startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Kline] {
+	limit := common.PageLimit(s.limit, maxKlinesLimit)
+	s.Limit(limit).EndTime(endTime)
+	return common.NewTimeIterator(ctx, startTime, endTime, limit, func(ctx context.Context, startTime int64) ([]*Kline, error) {
+		return s.StartTime(startTime).Do(ctx, opts...)
+	}, func(k *Kline) int64 {
+		return k.OpenTime
+	})
+}
+
+// Range iterate over the continuous contract klines opening between startTime and endTime, in milliseconds. The
+// checkpoint is the start time following the last kline returned.
+func (s *ContinuousKlinesService) Range(ctx context.Context, startTime, endTime int64, opts ...RequestOption) *common.Iterator[*ContinuousKline] {
+	limit := common.PageLimit(s.limit, maxKlinesLimit)
+	s.Limit(limit).EndTime(endTime)
+	return common.NewTimeIterator(ctx, startTime, endTime, limit, func(ctx context.Context, startTime int64) ([]*ContinuousKline, error) {
+		return s.StartTime(startTime).Do(ctx, opts...)
+	}, func(k *ContinuousKline) int64 {
+		return k.OpenTime
+	})
+}
+
+// Range iterate over the mark price klines opening between startTime and endTime, in milliseconds. The
+// checkpoint is the start time following the last kline returned.
+func (mpks *MarkPriceKlinesService) Range(ctx context.Context, startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Kline] {
+	limit := common.PageLimit(mpks.limit, maxKlinesLimit)
+	mpks.Limit(limit).EndTime(endTime)
+	return common.NewTimeIterator(ctx, startTime, endTime, limit, func(ctx context.Context, startTime int64) ([]*Kline, error) {
+		return mpks.StartTime(startTime).Do(ctx, opts...)
+	}, func(k *Kline) int64 {
+		return k.OpenTime
+	})
+}
+
+// Range iterate over the index price klines opening between startTime and endTime, in milliseconds. The
+// checkpoint is the start time following the last kline returned.
+func (ipks *IndexPriceKlinesService) Range(ctx context.Context, startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Kline] {
+	limit := common.PageLimit(ipks.limit, maxKlinesLimit)
+	ipks.Limit(limit).EndTime(endTime)
+	return common.NewTimeIterator(ctx, startTime, endTime, limit, func(ctx context.Context, startTime int64) ([]*Kline, error) {
+		return ipks.StartTime(startTime).Do(ctx, opts...)
+	}, func(k *Kline) int64 {
+		return k.OpenTime
+	})
+}
+
+// All iterate over the aggregate trades from the one with id fromID, up to the end time if set.
+// The checkpoint is the id following the last trade returned.
+func (s *AggTradesService) All(ctx context.Context, fromID int64, opts ...RequestOption) *common.Iterator[*AggTrade] {
+	limit := common.PageLimit(s.limit, maxAggTradesLimit)
+	endTime := s.endTime
+	s.startTime, s.endTime = nil, nil
+	s.Limit(limit)
+	return common.NewIDIterator(ctx, fromID, endTime, limit, func(ctx context.Context, fromID int64) ([]*AggTrade, error) {
+		return s.FromID(fromID).Do(ctx, opts...)
+	}, func(t *AggTrade) (int64, int64) {
+		return t.AggTradeID, t.Timestamp
+	})
+}
+
+// All iterate over the orders of the symbol from the one with id fromOrderID, up to the end
+// time if set. The checkpoint is the order id following the last order returned.
+func (s *ListOrdersService) All(ctx context.Context, fromOrderID int64, opts ...RequestOption) *common.Iterator[*Order] {
+	limit := common.PageLimit(s.limit, maxOrdersLimit)
+	endTime := s.endTime
+	s.startTime, s.endTime = nil, nil
+	s.Limit(limit)
+	return common.NewIDIterator(ctx, fromOrderID, endTime, limit, func(ctx context.Context, fromOrderID int64) ([]*Order, error) {
+		return s.OrderID(fromOrderID).Do(ctx, opts...)
+	}, func(o *Order) (int64, int64) {
+		return o.OrderID, o.Time
+	})
+}
+
+// All iterate over the trades of the symbol from the one with id fromID, up to the end time if
+// set. The checkpoint is the trade id following the last trade returned.
+func (s *ListAccountTradeService) All(ctx context.Context, fromID int64, opts ...RequestOption) *common.Iterator[*AccountTrade] {
+	limit := common.PageLimit(s.limit, maxAccountTradesLimit)
+	endTime := s.endTime
+	s.startTime, s.endTime = nil, nil
+	s.Limit(limit)
+	return common.NewIDIterator(ctx, fromID, endTime, limit, func(ctx context.Context, fromID int64) ([]*AccountTrade, error) {
+		return s.FromID(fromID).Do(ctx, opts...)
+	}, func(t *AccountTrade) (int64, int64) {
+		return t.ID, t.Time
+	})
+}
+
+// Range iterate over the income history between startTime and endTime, in milliseconds. Records
+// sharing the time of the last one of a full page are requested again with the next page, so that
+// none is skipped. The checkpoint is the start time of the page of the next record, whose records
+// preceding it are returned again on resume.
+func (s *GetIncomeHistoryService) Range(ctx context.Context, startTime, endTime int64, opts ...RequestOption) *common.Iterator[*IncomeHistory] {
+	limit := maxIncomeHistoryLimit
+	if s.limit != nil && *s.limit > 0 {
+		limit = int(*s.limit)
+	}
+	s.Limit(int64(limit)).EndTime(endTime)
+	return common.NewIterator(ctx, startTime, func(ctx context.Context, cursor int64) ([]*IncomeHistory, int64, bool, error) {
+		incomes, err := s.StartTime(cursor).Do(ctx, opts...)
+		if err != nil || len(incomes) == 0 {
+			return nil, cursor, false, err
+		}
+		last := incomes[len(incomes)-1].Time
+		if len(incomes) < limit {
+			return incomes, last + 1, false, nil
+		}
+		n := len(incomes)
+		for n > 0 && incomes[n-1].Time == last {
+			n--
+		}
+		if n == 0 {
+			// a whole page shares a time, the records of that time beyond it can't be listed
+			return incomes, last + 1, last < endTime, nil
+		}
+		return incomes[:n], last, true, nil
+	}, nil)
+}
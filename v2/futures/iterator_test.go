@@ -0,0 +1,63 @@
+package futures
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type iteratorTestSuite struct {
+	baseTestSuite
+	queries []url.Values
+}
+
+func TestIterator(t *testing.T) {
+	suite.Run(t, new(iteratorTestSuite))
+}
+
+// mockPages answer the requests with pages in order and record their queries
+func (s *iteratorTestSuite) mockPages(pages ...string) {
+	s.client.Client.do = s.client.do
+	for _, page := range pages {
+		s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(page), 200), nil).Once()
+	}
+	s.queries = nil
+	s.assertReq(func(r *request) {
+		s.queries = append(s.queries, r.query)
+	})
+}
+
+func (s *iteratorTestSuite) TestIncomeHistoryRange() {
+	s.mockPages(
+		`[{"tranId":1,"time":100},{"tranId":2,"time":200},{"tranId":3,"time":200}]`,
+		`[{"tranId":2,"time":200},{"tranId":3,"time":200},{"tranId":4,"time":300}]`,
+		`[{"tranId":4,"time":300},{"tranId":5,"time":400}]`,
+	)
+	it := s.client.NewGetIncomeHistoryService().Limit(3).Range(newContext(), 0, 1000)
+	incomes, err := it.All()
+	s.r().NoError(err)
+	var ids []int64
+	for _, income := range incomes {
+		ids = append(ids, income.TranID)
+	}
+	s.Equal([]int64{1, 2, 3, 4, 5}, ids)
+	s.r().Len(s.queries, 3)
+	s.Equal("200", s.queries[1].Get("startTime"))
+	s.Equal("300", s.queries[2].Get("startTime"))
+	s.Equal(int64(401), it.Checkpoint())
+}
+
+func (s *iteratorTestSuite) TestAccountTradeAll() {
+	s.mockPages(
+		`[{"id":10,"time":1},{"id":11,"time":2}]`,
+		`[]`,
+	)
+	it := s.client.NewListAccountTradeService().Symbol("BTCUSDT").Limit(2).All(newContext(), 10)
+	trades, err := it.All()
+	s.r().NoError(err)
+	s.r().Len(trades, 2)
+	s.r().Len(s.queries, 2)
+	s.Equal("12", s.queries[1].Get("fromId"))
+	s.Equal(int64(12), it.Checkpoint())
+}
@@ -0,0 +1,137 @@
+package futures
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ClientOption configure a Client or WsClient at construction, instead of the package variables
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	testnet     bool
+	baseURL     string
+	wsURL       string
+	httpClient  *http.Client
+	timeout     time.Duration
+	logger      *log.Logger
+	wsKeepalive bool
+	wsTimeout   time.Duration
+	wsReconnect *WsReconnectPolicy
+}
+
+// newClientOptions apply opts on top of the package variables
+func newClientOptions(opts []ClientOption) *clientOptions {
+	o := &clientOptions{
+		testnet:     UseTestnet,
+		wsKeepalive: WebsocketKeepalive,
+		wsTimeout:   WebsocketTimeout,
+		wsReconnect: WebsocketReconnect,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithTestnet use the testnet endpoints
+func WithTestnet() ClientOption {
+	return func(o *clientOptions) {
+		o.testnet = true
+	}
+}
+
+// WithBaseURL set the base URL of the REST API, overriding WithTestnet
+func WithBaseURL(url string) ClientOption {
+	return func(o *clientOptions) {
+		o.baseURL = url
+	}
+}
+
+// WithWsURL set the endpoint of websocket streams, such as "wss://fstream.binance.com/ws",
+// overriding WithTestnet. Combined streams are served from the /stream path of the same host.
+func WithWsURL(url string) ClientOption {
+	return func(o *clientOptions) {
+		o.wsURL = url
+	}
+}
+
+// WithHTTPClient set the HTTP client sending REST requests
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(o *clientOptions) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTimeout set the timeout of REST requests
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithLogger set the logger of debug messages
+func WithLogger(logger *log.Logger) ClientOption {
+	return func(o *clientOptions) {
+		o.logger = logger
+	}
+}
+
+// WithWsKeepalive send ping messages on websocket streams, closing them if no pong
+// is received within timeout
+func WithWsKeepalive(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.wsKeepalive = true
+		o.wsTimeout = timeout
+	}
+}
+
+// WithWsReconnect redial dropped websocket streams with policy, nil disables it
+func WithWsReconnect(policy *WsReconnectPolicy) ClientOption {
+	return func(o *clientOptions) {
+		o.wsReconnect = policy
+	}
+}
+
+func (o *clientOptions) apiURL() string {
+	if o.baseURL != "" {
+		return o.baseURL
+	}
+	if o.testnet {
+		return baseApiTestnetUrl
+	}
+	return baseApiMainUrl
+}
+
+func (o *clientOptions) client() *http.Client {
+	httpClient := o.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if o.timeout > 0 {
+		c := *httpClient
+		c.Timeout = o.timeout
+		httpClient = &c
+	}
+	return httpClient
+}
+
+func (o *clientOptions) wsClient() *WsClient {
+	c := &WsClient{
+		BaseURL:     baseWsMainUrl,
+		CombinedURL: baseCombinedMainURL,
+		Keepalive:   o.wsKeepalive,
+		Timeout:     o.wsTimeout,
+		Reconnect:   o.wsReconnect,
+	}
+	if o.testnet {
+		c.BaseURL, c.CombinedURL = baseWsTestnetUrl, baseCombinedTestnetURL
+	}
+	if o.wsURL != "" {
+		c.BaseURL = o.wsURL
+		c.CombinedURL = strings.TrimSuffix(o.wsURL, "/ws") + "/stream?streams="
+	}
+	return c
+}
@@ -0,0 +1,47 @@
+package futures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type clientOptionsTestSuite struct {
+	suite.Suite
+}
+
+func TestClientOptions(t *testing.T) {
+	suite.Run(t, new(clientOptionsTestSuite))
+}
+
+func (s *clientOptionsTestSuite) TestTestnet() {
+	c := NewClient("key", "secret", WithTestnet(), WithTimeout(time.Second))
+	s.Equal(baseApiTestnetUrl, c.BaseURL)
+	s.Equal(time.Second, c.HTTPClient.Timeout)
+	s.Equal(baseWsTestnetUrl, c.WsClient().BaseURL)
+	s.Equal(baseCombinedTestnetURL, c.WsClient().CombinedURL)
+
+	c = NewClient("key", "secret")
+	s.Equal(baseApiMainUrl, c.BaseURL)
+	s.Equal(baseWsMainUrl, c.WsClient().BaseURL)
+}
+
+func (s *clientOptionsTestSuite) TestWsClientServe() {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	var cfg *WsConfig
+	wsServe = func(c *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		cfg = c
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	policy := NewWsReconnectPolicy()
+	ws := NewWsClient(WithWsURL("ws://127.0.0.1:8081/ws"), WithWsKeepalive(time.Second), WithWsReconnect(policy))
+	_, _, err := ws.WsMarkPriceServe("BTCUSDT", func(event *WsMarkPriceEvent) {}, func(err error) {})
+	s.Require().NoError(err)
+	s.Equal("ws://127.0.0.1:8081/ws/btcusdt@markPrice", cfg.Endpoint)
+	s.True(cfg.Keepalive)
+	s.Equal(time.Second, cfg.Timeout)
+	s.Same(policy, cfg.Reconnect)
+}
@@ -0,0 +1,355 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// ErrOrderBookGap is passed to the ErrHandler when a diff. depth event does not follow
+// the previous one. The book is out of sync until a new snapshot has been applied.
+var ErrOrderBookGap = errors.New("order book: gap in depth update sequence, resyncing")
+
+// OrderBook maintain a local order book for a symbol by applying the diff. depth
+// stream on top of a depth snapshot, resyncing whenever an update is missed
+type OrderBook struct {
+	c             *Client
+	symbol        string
+	snapshotLimit int
+	bufferLimit   int
+	rate          *time.Duration
+	retryDelay    time.Duration
+
+	mu           sync.RWMutex
+	synced       bool
+	first        bool
+	lastUpdateID int64
+	buffer       []*WsDepthEvent
+	bids         orderBookSide
+	asks         orderBookSide
+	resyncC      chan struct{}
+}
+
+// NewOrderBook init an order book for symbol, call Serve to start syncing it
+func (c *Client) NewOrderBook(symbol string) *OrderBook {
+	return &OrderBook{
+		c:             c,
+		symbol:        symbol,
+		snapshotLimit: 1000,
+		bufferLimit:   1000,
+		retryDelay:    time.Second,
+		bids:          orderBookSide{descending: true},
+		resyncC:       make(chan struct{}, 1),
+	}
+}
+
+// SnapshotLimit set the number of levels fetched by the depth snapshot, 1000 by default
+func (b *OrderBook) SnapshotLimit(limit int) *OrderBook {
+	b.snapshotLimit = limit
+	return b
+}
+
+// Rate set the update speed of the diff. depth stream, 250ms, 500ms or 100ms
+func (b *OrderBook) Rate(rate time.Duration) *OrderBook {
+	b.rate = &rate
+	return b
+}
+
+// BufferLimit set the number of events kept while the book is out of sync, 1000 by default.
+// When the limit is hit, the oldest half is dropped and a new snapshot is requested.
+func (b *OrderBook) BufferLimit(limit int) *OrderBook {
+	b.bufferLimit = limit
+	return b
+}
+
+// Serve start the diff. depth stream and keep the book in sync with it.
+// Snapshot errors and ErrOrderBookGap are reported to errHandler along with stream errors.
+func (b *OrderBook) Serve(errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	wsErrHandler := func(err error) {
+		var reconnected *WsReconnectedEvent
+		if errors.As(err, &reconnected) {
+			b.resync()
+		}
+		errHandler(err)
+	}
+	doneC, stopC, err = b.c.WsClient().wsDepthServe(b.symbol, "", b.rate, b.handleEvent(errHandler), wsErrHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+	go b.syncLoop(doneC, errHandler)
+	b.resync()
+	return doneC, stopC, nil
+}
+
+// syncLoop fetch a new snapshot each time the book goes out of sync, until doneC is closed
+func (b *OrderBook) syncLoop(doneC chan struct{}, errHandler ErrHandler) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-doneC
+		cancel()
+	}()
+	for {
+		select {
+		case <-doneC:
+			return
+		case <-b.resyncC:
+		}
+		for {
+			err := b.sync(ctx)
+			if err == nil {
+				break
+			}
+			errHandler(err)
+			select {
+			case <-doneC:
+				return
+			case <-time.After(b.retryDelay):
+			}
+		}
+	}
+}
+
+// resync mark the book out of sync and schedule a new snapshot
+func (b *OrderBook) resync() {
+	b.mu.Lock()
+	b.synced = false
+	b.mu.Unlock()
+	select {
+	case b.resyncC <- struct{}{}:
+	default:
+	}
+}
+
+// sync fetch a depth snapshot and apply the events buffered since the book went out of sync
+func (b *OrderBook) sync(ctx context.Context) error {
+	res, err := b.c.NewDepthService().Symbol(b.symbol).Limit(b.snapshotLimit).Do(ctx)
+	if err != nil {
+		return err
+	}
+	return b.applySnapshot(res)
+}
+
+func (b *OrderBook) applySnapshot(res *DepthResponse) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bids.reset(res.Bids)
+	b.asks.reset(res.Asks)
+	b.lastUpdateID = res.LastUpdateID
+	b.first = true
+	buffer := b.buffer
+	b.buffer = nil
+	for i, event := range buffer {
+		if err := b.apply(event); err != nil {
+			// the snapshot is older than the buffered events, keep them for the next one
+			b.buffer = buffer[i:]
+			return err
+		}
+	}
+	b.synced = true
+	return nil
+}
+
+func (b *OrderBook) handleEvent(errHandler ErrHandler) WsDepthHandler {
+	return func(event *WsDepthEvent) {
+		b.mu.Lock()
+		if !b.synced {
+			b.buffer = append(b.buffer, event)
+			full := b.bufferLimit > 0 && len(b.buffer) > b.bufferLimit
+			if full {
+				// copy the newest events so that the dropped ones can be collected
+				b.buffer = append([]*WsDepthEvent(nil), b.buffer[len(b.buffer)-(b.bufferLimit+1)/2:]...)
+			}
+			b.mu.Unlock()
+			if full {
+				b.resync()
+			}
+			return
+		}
+		err := b.apply(event)
+		if err != nil {
+			b.synced = false
+			b.buffer = []*WsDepthEvent{event}
+		}
+		b.mu.Unlock()
+		if err != nil {
+			errHandler(err)
+			b.resync()
+		}
+	}
+}
+
+// apply update the book with event, returning ErrOrderBookGap if an event was missed.
+// Unlike spot, futures events carry the last update ID of the previous event.
+func (b *OrderBook) apply(event *WsDepthEvent) error {
+	if b.first {
+		// the first event must straddle the snapshot
+		if event.LastUpdateID < b.lastUpdateID {
+			return nil
+		}
+		if event.FirstUpdateID > b.lastUpdateID {
+			return ErrOrderBookGap
+		}
+	} else {
+		if event.LastUpdateID <= b.lastUpdateID {
+			return nil
+		}
+		if event.PrevLastUpdateID != b.lastUpdateID {
+			return ErrOrderBookGap
+		}
+	}
+	for _, bid := range event.Bids {
+		b.bids.update(bid)
+	}
+	for _, ask := range event.Asks {
+		b.asks.update(ask)
+	}
+	b.lastUpdateID = event.LastUpdateID
+	b.first = false
+	return nil
+}
+
+// Synced report whether the book reflects the exchange order book. Queries on a book
+// out of sync return nothing.
+func (b *OrderBook) Synced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.synced
+}
+
+// LastUpdateID return the ID of the last update applied to the book
+func (b *OrderBook) LastUpdateID() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.lastUpdateID
+}
+
+// BestBid return the highest bid
+func (b *OrderBook) BestBid() (bid Bid, ok bool) {
+	bids := b.Bids(1)
+	if len(bids) == 0 {
+		return Bid{}, false
+	}
+	return bids[0], true
+}
+
+// BestAsk return the lowest ask
+func (b *OrderBook) BestAsk() (ask Ask, ok bool) {
+	asks := b.Asks(1)
+	if len(asks) == 0 {
+		return Ask{}, false
+	}
+	return asks[0], true
+}
+
+// Bids return the n best bids, or all of them if n <= 0
+func (b *OrderBook) Bids(n int) []Bid {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return []Bid{}
+	}
+	return b.bids.top(n)
+}
+
+// Asks return the n best asks, or all of them if n <= 0
+func (b *OrderBook) Asks(n int) []Ask {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return []Ask{}
+	}
+	return b.asks.top(n)
+}
+
+// QuantityAt return the quantity resting at price on the given side
+func (b *OrderBook) QuantityAt(side SideType, price string) (quantity string, ok bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return "", false
+	}
+	if side == SideTypeBuy {
+		return b.bids.quantityAt(price)
+	}
+	return b.asks.quantityAt(price)
+}
+
+// orderBookSide keep price levels sorted from best to worst
+type orderBookSide struct {
+	descending bool
+	prices     []float64
+	levels     []common.PriceLevel
+}
+
+func (s *orderBookSide) reset(levels []common.PriceLevel) {
+	s.prices = s.prices[:0]
+	s.levels = s.levels[:0]
+	for _, level := range levels {
+		s.update(level)
+	}
+}
+
+// search return the index where price is or would be inserted
+func (s *orderBookSide) search(price float64) int {
+	return sort.Search(len(s.prices), func(i int) bool {
+		if s.descending {
+			return s.prices[i] <= price
+		}
+		return s.prices[i] >= price
+	})
+}
+
+func (s *orderBookSide) update(level common.PriceLevel) {
+	price, err := strconv.ParseFloat(level.Price, 64)
+	if err != nil {
+		return
+	}
+	quantity, err := strconv.ParseFloat(level.Quantity, 64)
+	if err != nil {
+		return
+	}
+	i := s.search(price)
+	found := i < len(s.prices) && s.prices[i] == price
+	switch {
+	case quantity == 0 && found:
+		s.prices = append(s.prices[:i], s.prices[i+1:]...)
+		s.levels = append(s.levels[:i], s.levels[i+1:]...)
+	case quantity == 0:
+	case found:
+		s.levels[i] = level
+	default:
+		s.prices = append(s.prices, 0)
+		s.levels = append(s.levels, common.PriceLevel{})
+		copy(s.prices[i+1:], s.prices[i:])
+		copy(s.levels[i+1:], s.levels[i:])
+		s.prices[i] = price
+		s.levels[i] = level
+	}
+}
+
+func (s *orderBookSide) top(n int) []common.PriceLevel {
+	if n <= 0 || n > len(s.levels) {
+		n = len(s.levels)
+	}
+	levels := make([]common.PriceLevel, n)
+	copy(levels, s.levels[:n])
+	return levels
+}
+
+func (s *orderBookSide) quantityAt(price string) (string, bool) {
+	p, err := strconv.ParseFloat(price, 64)
+	if err != nil {
+		return "", false
+	}
+	i := s.search(p)
+	if i < len(s.prices) && s.prices[i] == p {
+		return s.levels[i].Quantity, true
+	}
+	return "", false
+}
@@ -0,0 +1,105 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) TestSync() {
+	data := []byte(`{
+		"lastUpdateId": 100,
+		"E": 1569398242213,
+		"T": 1569398242204,
+		"bids": [
+			["9000.10", "10"],
+			["9000.00", "5"]
+		],
+		"asks": [
+			["9000.20", "1"],
+			["9000.30", "2"]
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": "BTCUSDT",
+			"limit":  1000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	var errs []error
+	book := s.client.NewOrderBook("BTCUSDT")
+	handler := book.handleEvent(func(err error) {
+		errs = append(errs, err)
+	})
+	book.resync()
+	handler(&WsDepthEvent{FirstUpdateID: 80, LastUpdateID: 90, PrevLastUpdateID: 79})
+	handler(&WsDepthEvent{
+		FirstUpdateID:    91,
+		LastUpdateID:     105,
+		PrevLastUpdateID: 90,
+		Bids:             []Bid{{Price: "9000.10", Quantity: "0"}},
+	})
+	s.r().NoError(book.sync(newContext()))
+	s.r().True(book.Synced())
+
+	// futures updates may skip IDs, continuity is checked with pu
+	handler(&WsDepthEvent{
+		FirstUpdateID:    110,
+		LastUpdateID:     112,
+		PrevLastUpdateID: 105,
+		Asks:             []Ask{{Price: "9000.15", Quantity: "4"}},
+	})
+	s.r().Empty(errs)
+	s.r().Equal(int64(112), book.LastUpdateID())
+
+	bid, ok := book.BestBid()
+	s.r().True(ok)
+	s.r().Equal(Bid{Price: "9000.00", Quantity: "5"}, bid)
+	s.r().Equal([]Ask{
+		{Price: "9000.15", Quantity: "4"},
+		{Price: "9000.20", Quantity: "1"},
+	}, book.Asks(2))
+	quantity, ok := book.QuantityAt(SideTypeSell, "9000.3")
+	s.r().True(ok)
+	s.r().Equal("2", quantity)
+
+	handler(&WsDepthEvent{FirstUpdateID: 120, LastUpdateID: 121, PrevLastUpdateID: 115})
+	s.r().Equal([]error{ErrOrderBookGap}, errs)
+	s.r().False(book.Synced())
+	s.r().Empty(book.Bids(0))
+}
+
+func (s *orderBookTestSuite) TestBufferLimit() {
+	book := s.client.NewOrderBook("BTCUSDT").BufferLimit(4)
+	handler := book.handleEvent(func(err error) {
+		s.r().FailNow("unexpected error", err)
+	})
+	for i := int64(1); i <= 4; i++ {
+		handler(&WsDepthEvent{FirstUpdateID: i, LastUpdateID: i, PrevLastUpdateID: i - 1})
+	}
+	s.r().Len(book.buffer, 4)
+	s.r().Len(book.resyncC, 0)
+
+	// the oldest events are dropped and a new snapshot is requested
+	handler(&WsDepthEvent{FirstUpdateID: 5, LastUpdateID: 5, PrevLastUpdateID: 4})
+	s.r().Len(book.resyncC, 1)
+	s.r().Len(book.buffer, 2)
+	s.r().Equal(int64(4), book.buffer[0].FirstUpdateID)
+	s.r().Equal(int64(5), book.buffer[1].FirstUpdateID)
+
+	s.r().NoError(book.applySnapshot(&DepthResponse{LastUpdateID: 4}))
+	s.r().True(book.Synced())
+	s.r().Equal(int64(5), book.LastUpdateID())
+}
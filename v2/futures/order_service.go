@@ -7,27 +7,31 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/internal/transport"
 )
 
 // CreateOrderService create order
 type CreateOrderService struct {
-	c                *Client
-	symbol           string
-	side             SideType
-	positionSide     *PositionSideType
-	orderType        OrderType
-	timeInForce      *TimeInForceType
-	quantity         string
-	reduceOnly       *bool
-	price            *string
-	newClientOrderID *string
-	stopPrice        *string
-	workingType      *WorkingType
-	activationPrice  *string
-	callbackRate     *string
-	priceProtect     *bool
-	newOrderRespType NewOrderRespType
-	closePosition    *bool
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	positionSide            *PositionSideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	quantity                string
+	reduceOnly              *bool
+	price                   *string
+	newClientOrderID        *string
+	stopPrice               *string
+	workingType             *WorkingType
+	activationPrice         *string
+	callbackRate            *string
+	priceProtect            *bool
+	newOrderRespType        NewOrderRespType
+	closePosition           *bool
+	selfTradePreventionMode *SelfTradePreventionModeType
 }
 
 // Symbol set symbol
@@ -126,6 +130,12 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOrderService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) *CreateOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
 
 	r := &request{
@@ -175,6 +185,9 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
 	r.setFormParams(m)
 	data, header, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -202,29 +215,60 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 
 // CreateOrderResponse define create order response
 type CreateOrderResponse struct {
-	Symbol            string           `json:"symbol"`
-	OrderID           int64            `json:"orderId"`
-	ClientOrderID     string           `json:"clientOrderId"`
-	Price             string           `json:"price"`
-	OrigQuantity      string           `json:"origQty"`
-	ExecutedQuantity  string           `json:"executedQty"`
-	CumQuote          string           `json:"cumQuote"`
-	ReduceOnly        bool             `json:"reduceOnly"`
-	Status            OrderStatusType  `json:"status"`
-	StopPrice         string           `json:"stopPrice"`
-	TimeInForce       TimeInForceType  `json:"timeInForce"`
-	Type              OrderType        `json:"type"`
-	Side              SideType         `json:"side"`
-	UpdateTime        int64            `json:"updateTime"`
-	WorkingType       WorkingType      `json:"workingType"`
-	ActivatePrice     string           `json:"activatePrice"`
-	PriceRate         string           `json:"priceRate"`
-	AvgPrice          string           `json:"avgPrice"`
-	PositionSide      PositionSideType `json:"positionSide"`
-	ClosePosition     bool             `json:"closePosition"`
-	PriceProtect      bool             `json:"priceProtect"`
-	RateLimitOrder10s string           `json:"rateLimitOrder10s,omitempty"`
-	RateLimitOrder1m  string           `json:"rateLimitOrder1m,omitempty"`
+	Symbol                  string                      `json:"symbol"`
+	OrderID                 int64                       `json:"orderId"`
+	ClientOrderID           string                      `json:"clientOrderId"`
+	Price                   string                      `json:"price"`
+	OrigQuantity            string                      `json:"origQty"`
+	ExecutedQuantity        string                      `json:"executedQty"`
+	CumQuote                string                      `json:"cumQuote"`
+	ReduceOnly              bool                        `json:"reduceOnly"`
+	Status                  OrderStatusType             `json:"status"`
+	StopPrice               string                      `json:"stopPrice"`
+	TimeInForce             TimeInForceType             `json:"timeInForce"`
+	Type                    OrderType                   `json:"type"`
+	Side                    SideType                    `json:"side"`
+	UpdateTime              int64                       `json:"updateTime"`
+	WorkingType             WorkingType                 `json:"workingType"`
+	ActivatePrice           string                      `json:"activatePrice"`
+	PriceRate               string                      `json:"priceRate"`
+	AvgPrice                string                      `json:"avgPrice"`
+	PositionSide            PositionSideType            `json:"positionSide"`
+	ClosePosition           bool                        `json:"closePosition"`
+	PriceProtect            bool                        `json:"priceProtect"`
+	SelfTradePreventionMode SelfTradePreventionModeType `json:"selfTradePreventionMode"`
+	RateLimitOrder10s       string                      `json:"rateLimitOrder10s,omitempty"`
+	RateLimitOrder1m        string                      `json:"rateLimitOrder1m,omitempty"`
+}
+
+// PriceDecimal return Price as a decimal
+func (c *CreateOrderResponse) PriceDecimal() common.Decimal {
+	return common.ToDecimal(c.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal
+func (c *CreateOrderResponse) OrigQuantityDecimal() common.Decimal {
+	return common.ToDecimal(c.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() common.Decimal {
+	return common.ToDecimal(c.ExecutedQuantity)
+}
+
+// CumQuoteDecimal return CumQuote as a decimal
+func (c *CreateOrderResponse) CumQuoteDecimal() common.Decimal {
+	return common.ToDecimal(c.CumQuote)
+}
+
+// StopPriceDecimal return StopPrice as a decimal
+func (c *CreateOrderResponse) StopPriceDecimal() common.Decimal {
+	return common.ToDecimal(c.StopPrice)
+}
+
+// AvgPriceDecimal return AvgPrice as a decimal
+func (c *CreateOrderResponse) AvgPriceDecimal() common.Decimal {
+	return common.ToDecimal(c.AvgPrice)
 }
 
 // ListOpenOrdersService list opened orders
@@ -366,30 +410,61 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 
 // Order define order info
 type Order struct {
-	Symbol           string           `json:"symbol"`
-	OrderID          int64            `json:"orderId"`
-	ClientOrderID    string           `json:"clientOrderId"`
-	Price            string           `json:"price"`
-	ReduceOnly       bool             `json:"reduceOnly"`
-	OrigQuantity     string           `json:"origQty"`
-	ExecutedQuantity string           `json:"executedQty"`
-	CumQuantity      string           `json:"cumQty"`
-	CumQuote         string           `json:"cumQuote"`
-	Status           OrderStatusType  `json:"status"`
-	TimeInForce      TimeInForceType  `json:"timeInForce"`
-	Type             OrderType        `json:"type"`
-	Side             SideType         `json:"side"`
-	StopPrice        string           `json:"stopPrice"`
-	Time             int64            `json:"time"`
-	UpdateTime       int64            `json:"updateTime"`
-	WorkingType      WorkingType      `json:"workingType"`
-	ActivatePrice    string           `json:"activatePrice"`
-	PriceRate        string           `json:"priceRate"`
-	AvgPrice         string           `json:"avgPrice"`
-	OrigType         string           `json:"origType"`
-	PositionSide     PositionSideType `json:"positionSide"`
-	PriceProtect     bool             `json:"priceProtect"`
-	ClosePosition    bool             `json:"closePosition"`
+	Symbol                  string                      `json:"symbol"`
+	OrderID                 int64                       `json:"orderId"`
+	ClientOrderID           string                      `json:"clientOrderId"`
+	Price                   string                      `json:"price"`
+	ReduceOnly              bool                        `json:"reduceOnly"`
+	OrigQuantity            string                      `json:"origQty"`
+	ExecutedQuantity        string                      `json:"executedQty"`
+	CumQuantity             string                      `json:"cumQty"`
+	CumQuote                string                      `json:"cumQuote"`
+	Status                  OrderStatusType             `json:"status"`
+	TimeInForce             TimeInForceType             `json:"timeInForce"`
+	Type                    OrderType                   `json:"type"`
+	Side                    SideType                    `json:"side"`
+	StopPrice               string                      `json:"stopPrice"`
+	Time                    int64                       `json:"time"`
+	UpdateTime              int64                       `json:"updateTime"`
+	WorkingType             WorkingType                 `json:"workingType"`
+	ActivatePrice           string                      `json:"activatePrice"`
+	PriceRate               string                      `json:"priceRate"`
+	AvgPrice                string                      `json:"avgPrice"`
+	OrigType                string                      `json:"origType"`
+	PositionSide            PositionSideType            `json:"positionSide"`
+	PriceProtect            bool                        `json:"priceProtect"`
+	ClosePosition           bool                        `json:"closePosition"`
+	SelfTradePreventionMode SelfTradePreventionModeType `json:"selfTradePreventionMode"`
+}
+
+// PriceDecimal return Price as a decimal
+func (o *Order) PriceDecimal() common.Decimal {
+	return common.ToDecimal(o.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal
+func (o *Order) OrigQuantityDecimal() common.Decimal {
+	return common.ToDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (o *Order) ExecutedQuantityDecimal() common.Decimal {
+	return common.ToDecimal(o.ExecutedQuantity)
+}
+
+// CumQuoteDecimal return CumQuote as a decimal
+func (o *Order) CumQuoteDecimal() common.Decimal {
+	return common.ToDecimal(o.CumQuote)
+}
+
+// StopPriceDecimal return StopPrice as a decimal
+func (o *Order) StopPriceDecimal() common.Decimal {
+	return common.ToDecimal(o.StopPrice)
+}
+
+// AvgPriceDecimal return AvgPrice as a decimal
+func (o *Order) AvgPriceDecimal() common.Decimal {
+	return common.ToDecimal(o.AvgPrice)
 }
 
 // ListOrdersService all account orders; active, canceled, or filled
@@ -802,8 +877,11 @@ type CreateBatchOrdersService struct {
 	orders []*CreateOrderService
 }
 
+// CreateBatchOrdersResponse define the result of every order of a batch, in the order they were
+// sent: Orders[i] is nil and Errors[i] is a *common.APIError when the i-th order was rejected
 type CreateBatchOrdersResponse struct {
 	Orders []*Order
+	Errors []error
 }
 
 func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
@@ -861,6 +939,9 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 		if order.closePosition != nil {
 			m["closePosition"] = *order.closePosition
 		}
+		if order.selfTradePreventionMode != nil {
+			m["selfTradePreventionMode"] = *order.selfTradePreventionMode
+		}
 		orders = append(orders, m)
 	}
 	b, err := json.Marshal(orders)
@@ -879,29 +960,23 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 		return &CreateBatchOrdersResponse{}, err
 	}
 
-	rawMessages := make([]*json.RawMessage, 0)
-
-	err = json.Unmarshal(data, &rawMessages)
-
+	rawMessages, errs, err := transport.SplitBatchResponse(data)
 	if err != nil {
 		return &CreateBatchOrdersResponse{}, err
 	}
-
-	batchCreateOrdersResponse := new(CreateBatchOrdersResponse)
-
-	for _, j := range rawMessages {
+	res = &CreateBatchOrdersResponse{
+		Orders: make([]*Order, len(rawMessages)),
+		Errors: errs,
+	}
+	for i, j := range rawMessages {
+		if errs[i] != nil {
+			continue
+		}
 		o := new(Order)
-		if err := json.Unmarshal(*j, o); err != nil {
+		if err := json.Unmarshal(j, o); err != nil {
 			return &CreateBatchOrdersResponse{}, err
 		}
-
-		if o.ClientOrderID != "" {
-			batchCreateOrdersResponse.Orders = append(batchCreateOrdersResponse.Orders, o)
-			continue
-		}
-
+		res.Orders[i] = o
 	}
-
-	return batchCreateOrdersResponse, nil
-
+	return res, nil
 }
@@ -1,8 +1,10 @@
 package futures
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -39,7 +41,8 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 		"priceRate": "0.1",
 		"positionSide": "BOTH",
 		"closePosition": false,
-		"priceProtect": true
+		"priceProtect": true,
+		"selfTradePreventionMode": "EXPIRE_MAKER"
 	}`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
@@ -59,24 +62,26 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	priceProtect := true
 	newOrderResponseType := NewOrderRespTypeRESULT
 	closePosition := false
+	selfTradePreventionMode := SelfTradePreventionModeTypeExpireMaker
 	s.assertReq(func(r *request) {
 		e := newSignedRequest().setFormParams(params{
-			"symbol":           symbol,
-			"side":             side,
-			"type":             orderType,
-			"timeInForce":      timeInForce,
-			"positionSide":     positionSide,
-			"quantity":         quantity,
-			"reduceOnly":       reduceOnly,
-			"price":            price,
-			"newClientOrderId": newClientOrderID,
-			"stopPrice":        stopPrice,
-			"workingType":      workingType,
-			"activationPrice":  activationPrice,
-			"callbackRate":     callbackRate,
-			"priceProtect":     priceProtect,
-			"newOrderRespType": newOrderResponseType,
-			"closePosition":    closePosition,
+			"symbol":                  symbol,
+			"side":                    side,
+			"type":                    orderType,
+			"timeInForce":             timeInForce,
+			"positionSide":            positionSide,
+			"quantity":                quantity,
+			"reduceOnly":              reduceOnly,
+			"price":                   price,
+			"newClientOrderId":        newClientOrderID,
+			"stopPrice":               stopPrice,
+			"workingType":             workingType,
+			"activationPrice":         activationPrice,
+			"callbackRate":            callbackRate,
+			"priceProtect":            priceProtect,
+			"newOrderRespType":        newOrderResponseType,
+			"closePosition":           closePosition,
+			"selfTradePreventionMode": selfTradePreventionMode,
 		})
 		s.assertRequestEqual(e, r)
 	})
@@ -86,29 +91,30 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 		StopPrice(stopPrice).WorkingType(workingType).ActivationPrice(activationPrice).
 		CallbackRate(callbackRate).PositionSide(positionSide).
 		PriceProtect(priceProtect).NewOrderResponseType(newOrderResponseType).
-		Do(newContext())
+		SelfTradePreventionMode(selfTradePreventionMode).Do(newContext())
 	s.r().NoError(err)
 	e := &CreateOrderResponse{
-		ClientOrderID:    newClientOrderID,
-		CumQuote:         "0",
-		ExecutedQuantity: "0",
-		OrderID:          22542179,
-		OrigQuantity:     "10",
-		PositionSide:     positionSide,
-		Price:            "10000",
-		ReduceOnly:       false,
-		Side:             SideTypeSell,
-		Status:           OrderStatusTypeNew,
-		StopPrice:        "0",
-		Symbol:           symbol,
-		TimeInForce:      TimeInForceTypeGTC,
-		Type:             OrderTypeLimit,
-		UpdateTime:       1566818724722,
-		WorkingType:      WorkingTypeContractPrice,
-		ActivatePrice:    activationPrice,
-		PriceRate:        callbackRate,
-		ClosePosition:    false,
-		PriceProtect:     priceProtect,
+		ClientOrderID:           newClientOrderID,
+		CumQuote:                "0",
+		ExecutedQuantity:        "0",
+		OrderID:                 22542179,
+		OrigQuantity:            "10",
+		PositionSide:            positionSide,
+		Price:                   "10000",
+		ReduceOnly:              false,
+		Side:                    SideTypeSell,
+		Status:                  OrderStatusTypeNew,
+		StopPrice:               "0",
+		Symbol:                  symbol,
+		TimeInForce:             TimeInForceTypeGTC,
+		Type:                    OrderTypeLimit,
+		UpdateTime:              1566818724722,
+		WorkingType:             WorkingTypeContractPrice,
+		ActivatePrice:           activationPrice,
+		PriceRate:               callbackRate,
+		ClosePosition:           false,
+		PriceProtect:            priceProtect,
+		SelfTradePreventionMode: selfTradePreventionMode,
 	}
 	s.assertCreateOrderResponseEqual(e, res)
 }
@@ -135,6 +141,48 @@ func (s *baseOrderTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderRes
 	r.Equal(e.ActivatePrice, a.ActivatePrice, "ActivatePrice")
 	r.Equal(e.PriceRate, a.PriceRate, "PriceRate")
 	r.Equal(e.ClosePosition, a.ClosePosition, "ClosePosition")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "testOrder",
+			"orderId": 22542179,
+			"symbol": "BTCUSDT",
+			"status": "NEW",
+			"selfTradePreventionMode": "EXPIRE_BOTH"
+		},
+		{
+			"code": -2022,
+			"msg": "ReduceOnly Order is rejected."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		var orders []map[string]interface{}
+		s.r().NoError(json.Unmarshal([]byte(r.form.Get("batchOrders")), &orders))
+		s.r().Len(orders, 2)
+		s.r().Equal("EXPIRE_BOTH", orders[0]["selfTradePreventionMode"])
+		s.r().NotContains(orders[1], "selfTradePreventionMode")
+	})
+
+	res, err := s.client.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimit).
+			TimeInForce(TimeInForceTypeGTC).Quantity("10").Price("10000").NewClientOrderID("testOrder").
+			SelfTradePreventionMode(SelfTradePreventionModeTypeExpireBoth),
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket).
+			Quantity("10").ReduceOnly(true),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 2)
+	r.Len(res.Errors, 2)
+	r.Equal(SelfTradePreventionModeTypeExpireBoth, res.Orders[0].SelfTradePreventionMode)
+	r.NoError(res.Errors[0])
+	r.Nil(res.Orders[1])
+	r.True(common.IsAPIErrorCode(res.Errors[1], -2022))
 }
 
 func (s *orderServiceTestSuite) TestListOpenOrders() {
@@ -226,6 +274,7 @@ func (s *baseOrderTestSuite) assertOrderEqual(e, a *Order) {
 	r.Equal(e.PriceRate, a.PriceRate, "PriceRate")
 	r.Equal(e.PositionSide, a.PositionSide, "PositionSide")
 	r.Equal(e.PriceProtect, a.PriceProtect, "PriceProtect")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
 }
 
 func (s *orderServiceTestSuite) TestGetOpenOrder() {
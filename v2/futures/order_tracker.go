@@ -0,0 +1,137 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/internal/ordertrack"
+)
+
+// TrackedOrder define the state of an order aggregated by an OrderTracker
+type TrackedOrder = ordertrack.Order
+
+// OrderFill define a trade of a TrackedOrder
+type OrderFill = ordertrack.Fill
+
+// OrderTracker aggregate the ORDER_TRADE_UPDATE events of the USDⓈ-M user data stream into the
+// state of every order, keyed by client order id and by order id. The status follows the NEW,
+// PARTIALLY_FILLED then FILLED, CANCELED or EXPIRED state machine, and fills are accumulated with
+// their commission by asset. Events may come out of order or more than once. After the stream
+// reconnects, call Backfill to fetch the state missed meanwhile.
+type OrderTracker struct {
+	c       *Client
+	tracker *ordertrack.Tracker
+}
+
+// NewOrderTracker init an order tracker fed with HandleEvent
+func (c *Client) NewOrderTracker() *OrderTracker {
+	return &OrderTracker{c: c, tracker: ordertrack.New()}
+}
+
+// HandleEvent apply an event of the user data stream, ignoring the events of other types than
+// ORDER_TRADE_UPDATE
+func (t *OrderTracker) HandleEvent(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeOrderTradeUpdate {
+		return
+	}
+	e := &event.OrderTradeUpdate
+	u := &ordertrack.Update{
+		Symbol:         e.Symbol,
+		OrderID:        e.ID,
+		ClientOrderID:  e.ClientOrderID,
+		Side:           string(e.Side),
+		Type:           string(e.Type),
+		Price:          e.OriginalPrice,
+		Quantity:       e.OriginalQty,
+		Status:         string(e.Status),
+		FilledQuantity: e.AccumulatedFilledQty,
+		AveragePrice:   common.ToDecimal(e.AveragePrice),
+		Time:           e.TradeTime,
+	}
+	if e.ExecutionType == OrderExecutionTypeTrade {
+		u.Fill = &OrderFill{
+			TradeID:         e.TradeID,
+			Price:           common.ToDecimal(e.LastFilledPrice),
+			Quantity:        common.ToDecimal(e.LastFilledQty),
+			Commission:      common.ToDecimal(e.Commission),
+			CommissionAsset: e.CommissionAsset,
+			IsMaker:         e.IsMaker,
+			Time:            e.TradeTime,
+		}
+	}
+	t.tracker.Apply(u)
+}
+
+// Backfill fetch the orders that may have missed events, with their trades, and apply them
+func (t *OrderTracker) Backfill(ctx context.Context) error {
+	return t.tracker.Backfill(ctx, false, func(ctx context.Context, o *TrackedOrder) ([]*ordertrack.Update, error) {
+		order, err := t.c.NewGetOrderService().Symbol(o.Symbol).OrderID(o.OrderID).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		trades, err := t.c.NewListAccountTradeService().Symbol(o.Symbol).OrderID(o.OrderID).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		updates := []*ordertrack.Update{{
+			Symbol:         order.Symbol,
+			OrderID:        order.OrderID,
+			ClientOrderID:  order.ClientOrderID,
+			Side:           string(order.Side),
+			Type:           string(order.Type),
+			Price:          order.Price,
+			Quantity:       order.OrigQuantity,
+			Status:         string(order.Status),
+			FilledQuantity: order.ExecutedQuantity,
+			AveragePrice:   common.ToDecimal(order.AvgPrice),
+			Time:           order.UpdateTime,
+		}}
+		for _, trade := range trades {
+			updates = append(updates, &ordertrack.Update{
+				Symbol:  trade.Symbol,
+				OrderID: trade.OrderID,
+				Fill: &OrderFill{
+					TradeID:         trade.ID,
+					Price:           common.ToDecimal(trade.Price),
+					Quantity:        common.ToDecimal(trade.Quantity),
+					Commission:      common.ToDecimal(trade.Commission),
+					CommissionAsset: trade.CommissionAsset,
+					IsMaker:         trade.Maker,
+					Time:            trade.Time,
+				},
+				Time: trade.Time,
+			})
+		}
+		return updates, nil
+	})
+}
+
+// Subscribe call f with the new state of every order that changes, until unsubscribe is called
+func (t *OrderTracker) Subscribe(f func(o *TrackedOrder)) (unsubscribe func()) {
+	return t.tracker.Subscribe(f)
+}
+
+// Order return the order with the client order id
+func (t *OrderTracker) Order(clientOrderID string) (*TrackedOrder, bool) {
+	return t.tracker.Order(clientOrderID)
+}
+
+// OrderByID return the order of symbol with the order id
+func (t *OrderTracker) OrderByID(symbol string, orderID int64) (*TrackedOrder, bool) {
+	return t.tracker.OrderByID(symbol, orderID)
+}
+
+// OpenOrders return the orders that haven't reached a final status, sorted by symbol and order id
+func (t *OrderTracker) OpenOrders() []*TrackedOrder {
+	return t.tracker.Orders(true)
+}
+
+// Orders return all the tracked orders, sorted by symbol and order id
+func (t *OrderTracker) Orders() []*TrackedOrder {
+	return t.tracker.Orders(false)
+}
+
+// Forget stop tracking the orders that reached a final status
+func (t *OrderTracker) Forget() {
+	t.tracker.Forget()
+}
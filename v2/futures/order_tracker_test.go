@@ -0,0 +1,78 @@
+package futures
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderTrackerTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderTracker(t *testing.T) {
+	suite.Run(t, new(orderTrackerTestSuite))
+}
+
+func orderTradeUpdate(executionType OrderExecutionType, status OrderStatusType, tradeID int64, last, filled, average string) *WsUserDataEvent {
+	return &WsUserDataEvent{
+		Event: UserDataEventTypeOrderTradeUpdate,
+		OrderTradeUpdate: WsOrderTradeUpdate{
+			Symbol:               "BTCUSDT",
+			ClientOrderID:        "client",
+			Side:                 SideTypeSell,
+			Type:                 OrderTypeLimit,
+			OriginalQty:          "2",
+			OriginalPrice:        "30000",
+			AveragePrice:         average,
+			ExecutionType:        executionType,
+			Status:               status,
+			ID:                   1,
+			LastFilledQty:        last,
+			AccumulatedFilledQty: filled,
+			LastFilledPrice:      "30000",
+			CommissionAsset:      "USDT",
+			Commission:           "0.5",
+			TradeTime:            1000 + tradeID,
+			TradeID:              tradeID,
+		},
+	}
+}
+
+func (s *orderTrackerTestSuite) TestTracker() {
+	var paths []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path+"?orderId="+req.URL.Query().Get("orderId"))
+		data := `{"symbol":"BTCUSDT","orderId":1,"clientOrderId":"client","status":"FILLED",
+			"executedQty":"2","avgPrice":"30010","updateTime":2000}`
+		if req.URL.Path == "/fapi/v1/userTrades" {
+			data = `[{"symbol":"BTCUSDT","id":8,"orderId":1,"price":"30020","qty":"1",
+				"commission":"0.6","commissionAsset":"USDT","time":1500,"maker":true}]`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewBufferString(data))}, nil
+	}
+
+	tracker := s.client.NewOrderTracker()
+	tracker.HandleEvent(orderTradeUpdate(OrderExecutionTypeNew, OrderStatusTypeNew, 0, "0", "0", "0"))
+	tracker.HandleEvent(orderTradeUpdate(OrderExecutionTypeTrade, OrderStatusTypePartiallyFilled, 7, "1", "1", "30000"))
+	tracker.HandleEvent(orderTradeUpdate(OrderExecutionTypeTrade, OrderStatusTypePartiallyFilled, 7, "1", "1", "30000"))
+	tracker.HandleEvent(&WsUserDataEvent{Event: UserDataEventTypeAccountUpdate})
+	s.Require().Len(tracker.OpenOrders(), 1)
+	o, _ := tracker.Order("client")
+	s.Len(o.Fills, 1)
+	s.True(o.AveragePrice.Equal(common.ToDecimal("30000")))
+
+	s.Require().NoError(tracker.Backfill(newContext()))
+	s.Equal([]string{"/fapi/v1/order?orderId=1", "/fapi/v1/userTrades?orderId=1"}, paths)
+	o, _ = tracker.Order("client")
+	s.Equal(string(OrderStatusTypeFilled), o.Status)
+	s.True(o.FilledQuantity.Equal(common.ToDecimal("2")))
+	s.True(o.AveragePrice.Equal(common.ToDecimal("30010")))
+	s.Len(o.Fills, 2)
+	s.True(o.Commissions["USDT"].Equal(common.ToDecimal("1.1")))
+	s.Empty(tracker.OpenOrders())
+}
@@ -0,0 +1,111 @@
+package futures
+
+import (
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderValidator check orders against the symbol filters of exchange info before they are sent
+type OrderValidator struct {
+	// AutoRound rounds price and quantity to the tick and step size instead of reporting them
+	AutoRound bool
+	// ReferencePrice return the mark price of a symbol, PERCENT_PRICE and the notional
+	// of market orders are checked against it if set
+	ReferencePrice func(symbol string) string
+	// OpenOrders return the number of open orders and algo orders of a symbol,
+	// MAX_NUM_ORDERS and MAX_NUM_ALGO_ORDERS are checked if set
+	OpenOrders func(symbol string) (orders, algoOrders int)
+
+	symbols map[string]*common.SymbolFilters
+}
+
+// NewOrderValidator init an order validator with the symbols of exchange info
+func NewOrderValidator(info *ExchangeInfo) *OrderValidator {
+	v := &OrderValidator{symbols: make(map[string]*common.SymbolFilters, len(info.Symbols))}
+	for i := range info.Symbols {
+		v.symbols[info.Symbols[i].Symbol] = symbolFilters(&info.Symbols[i])
+	}
+	return v
+}
+
+func symbolFilters(s *Symbol) *common.SymbolFilters {
+	f := &common.SymbolFilters{}
+	if p := s.PriceFilter(); p != nil {
+		f.MinPrice, f.MaxPrice, f.TickSize = p.MinPrice, p.MaxPrice, p.TickSize
+	}
+	if p := s.PercentPriceFilter(); p != nil {
+		f.MultiplierUp, f.MultiplierDown = p.MultiplierUp, p.MultiplierDown
+	}
+	if l := s.LotSizeFilter(); l != nil {
+		f.MinQuantity, f.MaxQuantity, f.StepSize = l.MinQuantity, l.MaxQuantity, l.StepSize
+	}
+	if l := s.MarketLotSizeFilter(); l != nil {
+		f.MarketMinQuantity, f.MarketMaxQuantity, f.MarketStepSize = l.MinQuantity, l.MaxQuantity, l.StepSize
+	}
+	if n := s.MinNotionalFilter(); n != nil {
+		f.NotionalFilter = common.FilterTypeMinNotional
+		f.MinNotional, f.ApplyMinNotionalToMarket = n.Notional, true
+	}
+	if m := s.MaxNumOrdersFilter(); m != nil {
+		f.MaxNumOrders = int(m.Limit)
+	}
+	if m := s.MaxNumAlgoOrdersFilter(); m != nil {
+		f.MaxNumAlgoOrders = int(m.Limit)
+	}
+	return f
+}
+
+// ValidateOrder check an order against the filters of its symbol and return a
+// *common.OrderValidationError listing every violation. With AutoRound, the price
+// and quantity of s are rounded first.
+func (v *OrderValidator) ValidateOrder(s *CreateOrderService) error {
+	o := &common.OrderParams{
+		Symbol:    s.symbol,
+		Buy:       s.side == SideTypeBuy,
+		Price:     stringValue(s.price),
+		StopPrice: stringValue(s.stopPrice),
+		Quantity:  s.quantity,
+	}
+	switch s.orderType {
+	case OrderTypeMarket:
+		o.Market = true
+	case OrderTypeStopMarket, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket:
+		o.Market = true
+		o.Algo = true
+	case OrderTypeStop, OrderTypeTakeProfit:
+		o.Algo = true
+	}
+	if v.ReferencePrice != nil {
+		o.ReferencePrice = v.ReferencePrice(s.symbol)
+	}
+	if v.OpenOrders != nil {
+		o.OpenOrders, o.OpenAlgoOrders = v.OpenOrders(s.symbol)
+	}
+
+	f, ok := v.symbols[s.symbol]
+	if !ok {
+		return fmt.Errorf("%w: %s", common.ErrUnknownSymbol, s.symbol)
+	}
+	err := common.ValidateOrder(f, o, v.AutoRound)
+	if v.AutoRound {
+		setRounded(&s.price, o.Price)
+		setRounded(&s.stopPrice, o.StopPrice)
+		s.quantity = o.Quantity
+	}
+	return err
+}
+
+// setRounded update a parameter that was set with its rounded value
+func setRounded(p **string, rounded string) {
+	if *p != nil {
+		*p = &rounded
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
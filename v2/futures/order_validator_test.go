@@ -0,0 +1,54 @@
+package futures
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderValidator(t *testing.T) {
+	info := new(ExchangeInfo)
+	err := json.Unmarshal([]byte(`{
+		"symbols": [{
+			"symbol": "BTCUSDT",
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+				{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+				{"filterType": "MARKET_LOT_SIZE", "minQty": "0.001", "maxQty": "120", "stepSize": "0.001"},
+				{"filterType": "MAX_NUM_ORDERS", "limit": 200},
+				{"filterType": "MAX_NUM_ALGO_ORDERS", "limit": 10},
+				{"filterType": "MIN_NOTIONAL", "notional": "5"},
+				{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"}
+			]
+		}]
+	}`), info)
+	require.NoError(t, err)
+	v := NewOrderValidator(info)
+	v.ReferencePrice = func(symbol string) string {
+		return "20000"
+	}
+
+	order := (&Client{}).NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimit).Price("21000.15").Quantity("0.0001")
+	err = v.ValidateOrder(order)
+	var validationErr *common.OrderValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, []*common.FilterError{
+		{Filter: common.FilterTypePrice, Field: "price", Violation: common.FilterViolationTickSize, Value: "21000.15", Bound: "0.10"},
+		{Filter: common.FilterTypePercentPrice, Field: "price", Violation: common.FilterViolationAboveMax, Value: "21000.15", Bound: "21000.0000"},
+		{Filter: common.FilterTypeLotSize, Field: "quantity", Violation: common.FilterViolationBelowMin, Value: "0.0001", Bound: "0.001"},
+		{Filter: common.FilterTypeLotSize, Field: "quantity", Violation: common.FilterViolationStepSize, Value: "0.0001", Bound: "0.001"},
+		{Filter: common.FilterTypeMinNotional, Field: "notional", Violation: common.FilterViolationBelowMin, Value: "2.100015", Bound: "5"},
+	}, validationErr.Errors)
+
+	v.AutoRound = true
+	order = (&Client{}).NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimit).Price("20000.01").Quantity("0.0129")
+	require.NoError(t, v.ValidateOrder(order))
+	assert.Equal(t, "20000.1", *order.price)
+	assert.Equal(t, "0.012", order.quantity)
+}
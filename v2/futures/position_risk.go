@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // GetPositionRiskService get account balance
@@ -57,3 +59,28 @@ type PositionRisk struct {
 	Notional         string `json:"notional"`
 	IsolatedWallet   string `json:"isolatedWallet"`
 }
+
+// PositionAmtDecimal return PositionAmt as a decimal
+func (p *PositionRisk) PositionAmtDecimal() common.Decimal {
+	return common.ToDecimal(p.PositionAmt)
+}
+
+// EntryPriceDecimal return EntryPrice as a decimal
+func (p *PositionRisk) EntryPriceDecimal() common.Decimal {
+	return common.ToDecimal(p.EntryPrice)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal
+func (p *PositionRisk) MarkPriceDecimal() common.Decimal {
+	return common.ToDecimal(p.MarkPrice)
+}
+
+// UnRealizedProfitDecimal return UnRealizedProfit as a decimal
+func (p *PositionRisk) UnRealizedProfitDecimal() common.Decimal {
+	return common.ToDecimal(p.UnRealizedProfit)
+}
+
+// LiquidationPriceDecimal return LiquidationPrice as a decimal
+func (p *PositionRisk) LiquidationPriceDecimal() common.Decimal {
+	return common.ToDecimal(p.LiquidationPrice)
+}
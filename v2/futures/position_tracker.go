@@ -0,0 +1,157 @@
+package futures
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/internal/positiontrack"
+)
+
+// TrackedPosition define the state of a position maintained by a PositionTracker
+type TrackedPosition = positiontrack.Position
+
+// PositionTracker maintain the USDⓈ-M positions from a snapshot and the ACCOUNT_UPDATE and
+// ACCOUNT_CONFIG_UPDATE events of the user data stream, in one-way and hedge mode. Mark prices passed
+// to HandleMarkPrice keep the unrealized PnL, notional, initial margin, ROE and margin ratio of the
+// positions current. Margin ratios use the maintenance margin last reported by the exchange, and
+// the cross margin ratio ignores the multi-assets mode.
+type PositionTracker struct {
+	c       *Client
+	tracker *positiontrack.Tracker
+}
+
+// NewPositionTracker init a position tracker, fed with HandleEvent and HandleMarkPrice once synced
+// with Sync
+func (c *Client) NewPositionTracker() *PositionTracker {
+	return &PositionTracker{c: c, tracker: positiontrack.New(false)}
+}
+
+// Sync fetch the symbols, positions and account, replace the positions with them and apply the
+// events received meanwhile. Call it again after the user data stream reconnects. On error,
+// the tracker stays out of sync without buffering the events, which the next Sync reflects.
+func (t *PositionTracker) Sync(ctx context.Context) error {
+	t.tracker.Unsync()
+	contracts, snapshot, err := t.snapshot(ctx)
+	if err != nil {
+		t.tracker.Abort()
+		return err
+	}
+	t.tracker.Reset(contracts, snapshot)
+	return nil
+}
+
+func (t *PositionTracker) snapshot(ctx context.Context) (map[string]positiontrack.Contract, *positiontrack.Event, error) {
+	info, err := t.c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	risks, err := t.c.NewGetPositionRiskService().Do(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	account, err := t.c.NewGetAccountService().Do(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contracts := make(map[string]positiontrack.Contract, len(info.Symbols))
+	for _, s := range info.Symbols {
+		contracts[s.Symbol] = positiontrack.Contract{MarginAsset: s.MarginAsset}
+	}
+	maintenanceMargins := make(map[string]string, len(account.Positions))
+	for _, p := range account.Positions {
+		maintenanceMargins[p.Symbol+string(p.PositionSide)] = p.MaintMargin
+	}
+	snapshot := &positiontrack.Event{Wallets: make(map[string]string, len(account.Assets))}
+	for _, a := range account.Assets {
+		snapshot.Wallets[a.Asset] = a.CrossWalletBalance
+	}
+	for _, r := range risks {
+		snapshot.Positions = append(snapshot.Positions, positiontrack.Update{
+			Symbol:            r.Symbol,
+			Side:              r.PositionSide,
+			Amount:            r.PositionAmt,
+			EntryPrice:        r.EntryPrice,
+			MarkPrice:         r.MarkPrice,
+			MarginType:        r.MarginType,
+			IsolatedWallet:    r.IsolatedWallet,
+			MaintenanceMargin: maintenanceMargins[r.Symbol+r.PositionSide],
+			Leverage:          r.Leverage,
+		})
+	}
+	return contracts, snapshot, nil
+}
+
+// HandleEvent apply an event of the user data stream, ignoring the events of other types than
+// ACCOUNT_UPDATE and ACCOUNT_CONFIG_UPDATE
+func (t *PositionTracker) HandleEvent(event *WsUserDataEvent) {
+	e := &positiontrack.Event{Time: event.TransactionTime}
+	if e.Time == 0 {
+		e.Time = event.Time
+	}
+	switch event.Event {
+	case UserDataEventTypeAccountUpdate:
+		e.Wallets = make(map[string]string, len(event.AccountUpdate.Balances))
+		for _, b := range event.AccountUpdate.Balances {
+			e.Wallets[b.Asset] = b.CrossWalletBalance
+		}
+		for _, p := range event.AccountUpdate.Positions {
+			e.Positions = append(e.Positions, positiontrack.Update{
+				Symbol:            p.Symbol,
+				Side:              string(p.Side),
+				Amount:            p.Amount,
+				EntryPrice:        p.EntryPrice,
+				MarkPrice:         p.MarkPrice,
+				MarginType:        string(p.MarginType),
+				IsolatedWallet:    p.IsolatedWallet,
+				MaintenanceMargin: p.MaintenanceMarginRequired,
+			})
+		}
+	case UserDataEventTypeAccountConfigUpdate:
+		if event.AccountConfigUpdate.Symbol == "" {
+			return
+		}
+		e.Leverages = map[string]string{
+			event.AccountConfigUpdate.Symbol: strconv.FormatInt(event.AccountConfigUpdate.Leverage, 10),
+		}
+	default:
+		return
+	}
+	t.tracker.Apply(e)
+}
+
+// HandleMarkPrice update the positions of the symbol of a mark price event
+func (t *PositionTracker) HandleMarkPrice(event *WsMarkPriceEvent) {
+	t.tracker.SetMarkPrice(event.Symbol, event.MarkPrice, event.Time)
+}
+
+// Synced report whether the positions reflect the account
+func (t *PositionTracker) Synced() bool {
+	return t.tracker.Synced()
+}
+
+// Subscribe call f with the new state of every position that changes, until unsubscribe is called
+func (t *PositionTracker) Subscribe(f func(p *TrackedPosition)) (unsubscribe func()) {
+	return t.tracker.Subscribe(f)
+}
+
+// Position return the position of symbol on side, PositionSideTypeBoth in one-way mode
+func (t *PositionTracker) Position(symbol string, side PositionSideType) (*TrackedPosition, bool) {
+	return t.tracker.Position(symbol, string(side))
+}
+
+// Positions return the open positions, sorted by symbol and side
+func (t *PositionTracker) Positions() []*TrackedPosition {
+	return t.tracker.Positions()
+}
+
+// MarginRatio return the margin ratio of the cross positions margined in asset
+func (t *PositionTracker) MarginRatio(asset string) common.Decimal {
+	return t.tracker.MarginRatio(asset)
+}
+
+// CrossWalletBalance return the cross wallet balance of asset
+func (t *PositionTracker) CrossWalletBalance(asset string) common.Decimal {
+	return t.tracker.CrossWalletBalance(asset)
+}
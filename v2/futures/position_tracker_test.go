@@ -0,0 +1,88 @@
+package futures
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type positionTrackerTestSuite struct {
+	baseTestSuite
+}
+
+func TestPositionTracker(t *testing.T) {
+	suite.Run(t, new(positionTrackerTestSuite))
+}
+
+func (s *positionTrackerTestSuite) TestTracker() {
+	responses := map[string]string{
+		"/fapi/v1/exchangeInfo": `{"symbols":[{"symbol":"BTCUSDT","marginAsset":"USDT"},
+			{"symbol":"ETHUSDT","marginAsset":"USDT"}]}`,
+		"/fapi/v2/positionRisk": `[{"symbol":"BTCUSDT","positionSide":"LONG","positionAmt":"0.500","entryPrice":"30000.0",
+			"markPrice":"31000.00000000","marginType":"isolated","isolatedWallet":"1500.00000000","leverage":"10"},
+			{"symbol":"BTCUSDT","positionSide":"SHORT","positionAmt":"0.000","entryPrice":"0.0",
+			"markPrice":"31000.00000000","marginType":"isolated","isolatedWallet":"0","leverage":"10"}]`,
+		"/fapi/v2/account": `{"assets":[{"asset":"USDT","crossWalletBalance":"2000.00000000"}],
+			"positions":[{"symbol":"BTCUSDT","positionSide":"LONG","maintMargin":"62.00000000"},
+			{"symbol":"BTCUSDT","positionSide":"SHORT","maintMargin":"0"}]}`,
+	}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(responses[req.URL.Path])),
+		}, nil
+	}
+
+	tracker := s.client.NewPositionTracker()
+	var changes []*TrackedPosition
+	tracker.Subscribe(func(p *TrackedPosition) {
+		changes = append(changes, p)
+	})
+	s.Require().NoError(tracker.Sync(newContext()))
+	s.True(tracker.Synced())
+	s.Require().Len(changes, 1)
+	p, ok := tracker.Position("BTCUSDT", PositionSideTypeLong)
+	s.Require().True(ok)
+	s.True(p.Isolated)
+	s.Equal("USDT", p.MarginAsset)
+	s.True(p.UnrealizedPnL.Equal(common.ToDecimal("500")))
+	s.True(p.InitialMargin.Equal(common.ToDecimal("1550")))
+	s.True(p.MarginRatio.Equal(common.ToDecimal("0.031")))
+
+	tracker.HandleEvent(&WsUserDataEvent{
+		Event:           UserDataEventTypeAccountUpdate,
+		Time:            1001,
+		TransactionTime: 1000,
+		AccountUpdate: WsAccountUpdate{
+			Balances: []WsBalance{{Asset: "USDT", Balance: "2010", CrossWalletBalance: "2010"}},
+			Positions: []WsPosition{{Symbol: "BTCUSDT", Side: PositionSideTypeShort, Amount: "-0.100",
+				MarginType: "cross", EntryPrice: "31000", MaintenanceMarginRequired: "12.4"}},
+		},
+	})
+	tracker.HandleEvent(&WsUserDataEvent{
+		Event:               UserDataEventTypeAccountConfigUpdate,
+		Time:                1100,
+		TransactionTime:     1100,
+		AccountConfigUpdate: WsAccountConfigUpdate{Symbol: "BTCUSDT", Leverage: 20},
+	})
+	tracker.HandleEvent(&WsUserDataEvent{Event: UserDataEventTypeOrderTradeUpdate, Time: 1200})
+	tracker.HandleMarkPrice(&WsMarkPriceEvent{Symbol: "BTCUSDT", MarkPrice: "30000", Time: 1300})
+
+	positions := tracker.Positions()
+	s.Require().Len(positions, 2)
+	long, short := positions[0], positions[1]
+	s.True(long.Leverage.Equal(common.ToDecimal("20")))
+	s.True(long.UnrealizedPnL.IsZero())
+	s.True(long.ROE.IsZero())
+	s.Equal(string(PositionSideTypeShort), short.Side)
+	s.False(short.Isolated)
+	s.True(short.UnrealizedPnL.Equal(common.ToDecimal("100")))
+	s.True(short.Notional.Equal(common.ToDecimal("-3000")))
+	s.True(short.ROE.Equal(common.ToDecimal("0.66666667")))
+	s.True(tracker.CrossWalletBalance("USDT").Equal(common.ToDecimal("2010")))
+	s.True(tracker.MarginRatio("USDT").Equal(common.ToDecimal("0.00587678")))
+}
@@ -0,0 +1,118 @@
+package futures
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// requestWeights define the weight of /fapi endpoints whose weight does not depend on parameters,
+// other /fapi endpoints weigh 1
+var requestWeights = map[string]int64{
+	http.MethodGet + " /fapi/v1/historicalTrades":  20,
+	http.MethodGet + " /fapi/v1/aggTrades":         20,
+	http.MethodGet + " /fapi/v1/allForceOrders":    20,
+	http.MethodGet + " /fapi/v1/allOrders":         5,
+	http.MethodGet + " /fapi/v1/commissionRate":    20,
+	http.MethodGet + " /fapi/v1/income":            30,
+	http.MethodGet + " /fapi/v1/userTrades":        5,
+	http.MethodGet + " /fapi/v2/account":           5,
+	http.MethodGet + " /fapi/v2/balance":           5,
+	http.MethodGet + " /fapi/v2/positionRisk":      5,
+	http.MethodGet + " /fapi/v1/positionSide/dual": 30,
+	http.MethodGet + " /fapi/v1/multiAssetsMargin": 30,
+	http.MethodPost + " /fapi/v1/batchOrders":      5,
+}
+
+// orderEndpoints count against the ORDERS limits, batches for each of their orders
+var orderEndpoints = map[string]bool{
+	http.MethodPost + " /fapi/v1/order":       true,
+	http.MethodPost + " /fapi/v1/batchOrders": true,
+}
+
+// NewRateLimiter init a limiter for Client.RateLimiter with the rate limits of exchangeInfo
+func NewRateLimiter(rateLimits []RateLimit) *common.RateLimiter {
+	limits := make([]common.RateLimit, len(rateLimits))
+	for i, l := range rateLimits {
+		limits[i] = common.RateLimit{
+			RateLimitType: l.RateLimitType,
+			Interval:      l.Interval,
+			IntervalNum:   l.IntervalNum,
+			Limit:         l.Limit,
+		}
+	}
+	return common.NewRateLimiter(limits, requestCost)
+}
+
+// requestCost return the weight of a futures request and the number of orders it places
+func requestCost(method, endpoint string, query url.Values) (weight, orders int64) {
+	if !strings.HasPrefix(endpoint, "/fapi/") {
+		return 0, 0
+	}
+	key := method + " " + endpoint
+	if orderEndpoints[key] {
+		orders = common.BatchOrderCount(query)
+	}
+	_, hasSymbol := query["symbol"]
+	switch key {
+	case http.MethodGet + " /fapi/v1/depth":
+		switch limit := queryLimit(query, 500); {
+		case limit <= 50:
+			return 2, orders
+		case limit <= 100:
+			return 5, orders
+		case limit <= 500:
+			return 10, orders
+		default:
+			return 20, orders
+		}
+	case http.MethodGet + " /fapi/v1/klines", http.MethodGet + " /fapi/v1/continuousKlines",
+		http.MethodGet + " /fapi/v1/indexPriceKlines", http.MethodGet + " /fapi/v1/markPriceKlines":
+		switch limit := queryLimit(query, 500); {
+		case limit < 100:
+			return 1, orders
+		case limit < 500:
+			return 2, orders
+		case limit <= 1000:
+			return 5, orders
+		default:
+			return 10, orders
+		}
+	case http.MethodGet + " /fapi/v1/ticker/24hr":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 40, orders
+	case http.MethodGet + " /fapi/v1/ticker/price", http.MethodGet + " /fapi/v1/ticker/bookTicker":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 2, orders
+	case http.MethodGet + " /fapi/v1/openOrders":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 40, orders
+	case http.MethodGet + " /fapi/v1/forceOrders":
+		if hasSymbol {
+			return 20, orders
+		}
+		return 50, orders
+	}
+	if weight, ok := requestWeights[key]; ok {
+		return weight, orders
+	}
+	return 1, orders
+}
+
+// queryLimit return the limit parameter of a request, or def if it is not set
+func queryLimit(query url.Values, def int) int {
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		return def
+	}
+	return limit
+}
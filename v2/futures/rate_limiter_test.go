@@ -0,0 +1,37 @@
+package futures
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestCost(t *testing.T) {
+	tests := []struct {
+		method   string
+		endpoint string
+		query    url.Values
+		weight   int64
+		orders   int64
+	}{
+		{http.MethodGet, "/fapi/v1/ping", nil, 1, 0},
+		{http.MethodGet, "/fapi/v1/depth", nil, 10, 0},
+		{http.MethodGet, "/fapi/v1/depth", url.Values{"limit": {"50"}}, 2, 0},
+		{http.MethodGet, "/fapi/v1/depth", url.Values{"limit": {"1000"}}, 20, 0},
+		{http.MethodGet, "/fapi/v1/klines", url.Values{"limit": {"99"}}, 1, 0},
+		{http.MethodGet, "/fapi/v1/klines", nil, 5, 0},
+		{http.MethodGet, "/fapi/v1/klines", url.Values{"limit": {"1500"}}, 10, 0},
+		{http.MethodGet, "/fapi/v1/ticker/price", nil, 2, 0},
+		{http.MethodGet, "/fapi/v1/income", nil, 30, 0},
+		{http.MethodPost, "/fapi/v1/order", nil, 1, 1},
+		{http.MethodPost, "/fapi/v1/batchOrders", nil, 5, 1},
+		{http.MethodPost, "/fapi/v1/batchOrders", url.Values{"batchOrders": {`[{"symbol":"BTCUSDT"},{"symbol":"ETHUSDT"},{"symbol":"BNBUSDT"}]`}}, 5, 3},
+	}
+	for _, tt := range tests {
+		weight, orders := requestCost(tt.method, tt.endpoint, tt.query)
+		assert.Equal(t, tt.weight, weight, tt.endpoint)
+		assert.Equal(t, tt.orders, orders, tt.endpoint)
+	}
+}
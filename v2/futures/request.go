@@ -5,14 +5,16 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"github.com/adshao/go-binance/v2/internal/transport"
 )
 
-type secType int
+type secType = transport.SecType
 
 const (
-	secTypeNone secType = iota
-	secTypeAPIKey
-	secTypeSigned
+	secTypeNone   = transport.SecTypeNone
+	secTypeAPIKey = transport.SecTypeAPIKey
+	secTypeSigned = transport.SecTypeSigned // if the 'timestamp' parameter is required
 )
 
 type params map[string]interface{}
@@ -74,6 +76,19 @@ func (r *request) validate() (err error) {
 	return nil
 }
 
+// toTransport return r as a request of the shared transport
+func (r *request) toTransport() *transport.Request {
+	return &transport.Request{
+		Method:     r.method,
+		Endpoint:   r.endpoint,
+		Query:      r.query,
+		Form:       r.form,
+		RecvWindow: r.recvWindow,
+		SecType:    r.secType,
+		Header:     r.header,
+	}
+}
+
 // RequestOption define option type for request
 type RequestOption func(*request)
 
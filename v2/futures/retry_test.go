@@ -0,0 +1,58 @@
+package futures
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type retryTestSuite struct {
+	baseTestSuite
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(retryTestSuite))
+}
+
+func (s *retryTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.client.Client.do = s.client.do
+	s.client.RetryPolicy = &common.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}
+}
+
+func (s *retryTestSuite) TestRetryRead() {
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{}`), http.StatusServiceUnavailable), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{"serverTime": 1499827319559}`), http.StatusOK), nil).Once()
+	serverTime, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1499827319559), serverTime)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *retryTestSuite) TestRetryOrderNotPlaced() {
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{"code":-1001,"msg":"Internal error"}`), http.StatusServiceUnavailable), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{"code":-2013,"msg":"Order does not exist."}`), http.StatusBadRequest), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{"symbol":"BTCUSDT","orderId":2,"clientOrderId":"myOrder"}`), http.StatusOK), nil).Once()
+	order, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderID("myOrder").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(2), order.OrderID)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *retryTestSuite) TestRetryOrderPlaced() {
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{}`), http.StatusBadGateway), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{"symbol":"BTCUSDT","orderId":3,"clientOrderId":"myOrder","status":"NEW"}`), http.StatusOK), nil).Once()
+	order, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderID("myOrder").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(3), order.OrderID)
+	s.r().Equal(OrderStatusTypeNew, order.Status)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
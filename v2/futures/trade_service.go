@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // HistoricalTradesService trades
@@ -69,6 +71,21 @@ type Trade struct {
 	IsBuyerMaker  bool   `json:"isBuyerMaker"`
 }
 
+// PriceDecimal return Price as a decimal
+func (t *Trade) PriceDecimal() common.Decimal {
+	return common.ToDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal
+func (t *Trade) QuantityDecimal() common.Decimal {
+	return common.ToDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as a decimal
+func (t *Trade) QuoteQuantityDecimal() common.Decimal {
+	return common.ToDecimal(t.QuoteQuantity)
+}
+
 // TradeV3 define v3 trade info
 type TradeV3 struct {
 	ID              int64  `json:"id"`
@@ -273,7 +290,7 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 		r.setParam("endTime", *s.endTime)
 	}
 	if s.fromID != nil {
-		r.setParam("fromID", *s.fromID)
+		r.setParam("fromId", *s.fromID)
 	}
 	if s.limit != nil {
 		r.setParam("limit", *s.limit)
@@ -307,3 +324,28 @@ type AccountTrade struct {
 	Symbol          string           `json:"symbol"`
 	Time            int64            `json:"time"`
 }
+
+// PriceDecimal return Price as a decimal
+func (a *AccountTrade) PriceDecimal() common.Decimal {
+	return common.ToDecimal(a.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal
+func (a *AccountTrade) QuantityDecimal() common.Decimal {
+	return common.ToDecimal(a.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as a decimal
+func (a *AccountTrade) QuoteQuantityDecimal() common.Decimal {
+	return common.ToDecimal(a.QuoteQuantity)
+}
+
+// CommissionDecimal return Commission as a decimal
+func (a *AccountTrade) CommissionDecimal() common.Decimal {
+	return common.ToDecimal(a.Commission)
+}
+
+// RealizedPnlDecimal return RealizedPnl as a decimal
+func (a *AccountTrade) RealizedPnlDecimal() common.Decimal {
+	return common.ToDecimal(a.RealizedPnl)
+}
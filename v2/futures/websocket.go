@@ -1,99 +1,66 @@
 package futures
 
 import (
-	"net/http"
 	"time"
 
-	"github.com/gorilla/websocket"
+	"github.com/adshao/go-binance/v2/internal/transport"
 )
 
 // WsHandler handle raw websocket message
-type WsHandler func(message []byte)
+type WsHandler = transport.WsHandler
 
 // ErrHandler handles errors
-type ErrHandler func(err error)
+type ErrHandler = transport.ErrHandler
+
+// ErrWsReconnectAttemptsExhausted is passed to the ErrHandler when a stream gives up redialing
+var ErrWsReconnectAttemptsExhausted = transport.ErrWsReconnectAttemptsExhausted
 
 // WsConfig webservice configuration
-type WsConfig struct {
-	Endpoint string
+type WsConfig = transport.WsConfig
+
+// WsClient serve websocket streams with its own endpoints and settings, so that streams
+// of several environments can run side by side
+type WsClient struct {
+	BaseURL     string // endpoint of single streams
+	CombinedURL string // endpoint of combined streams
+	// Keepalive enables sending ping/pong messages every Timeout to check the connection stability
+	Keepalive bool
+	Timeout   time.Duration
+	// Reconnect enables redialing dropped streams with the given policy, nil disables it
+	Reconnect *WsReconnectPolicy
 }
 
-func newWsConfig(endpoint string) *WsConfig {
-	return &WsConfig{
-		Endpoint: endpoint,
-	}
+// NewWsClient init a websocket client with the settings of the package variables, overridden by opts
+func NewWsClient(opts ...ClientOption) *WsClient {
+	return newClientOptions(opts).wsClient()
 }
 
-var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	Dialer := websocket.Dialer{
-		Proxy:             http.ProxyFromEnvironment,
-		HandshakeTimeout:  45 * time.Second,
-		EnableCompression: false,
-	}
+// defaultWsClient return the websocket client of the package level serve functions
+func defaultWsClient() *WsClient {
+	return NewWsClient()
+}
 
-	c, _, err := Dialer.Dial(cfg.Endpoint, nil)
-	if err != nil {
-		return nil, nil, err
+func (c *WsClient) newWsConfig(endpoint string) *WsConfig {
+	return &WsConfig{
+		Endpoint:  endpoint,
+		Reconnect: c.Reconnect,
+		Keepalive: c.Keepalive,
+		Timeout:   c.Timeout,
 	}
-	c.SetReadLimit(655350)
-	doneC = make(chan struct{})
-	stopC = make(chan struct{})
-	go func() {
-		// This function will exit either on error from
-		// websocket.Conn.ReadMessage or when the stopC channel is
-		// closed by the client.
-		defer close(doneC)
-		if WebsocketKeepalive {
-			keepAlive(c, WebsocketTimeout)
-		}
-		// Wait for the stopC channel to be closed.  We do that in a
-		// separate goroutine because ReadMessage is a blocking
-		// operation.
-		silent := false
-		go func() {
-			select {
-			case <-stopC:
-				silent = true
-			case <-doneC:
-			}
-			c.Close()
-		}()
-		for {
-			_, message, err := c.ReadMessage()
-			if err != nil {
-				if !silent {
-					errHandler(err)
-				}
-				return
-			}
-			handler(message)
-		}
-	}()
-	return
 }
 
-func keepAlive(c *websocket.Conn, timeout time.Duration) {
-	ticker := time.NewTicker(timeout)
-
-	lastResponse := time.Now()
-	c.SetPongHandler(func(msg string) error {
-		lastResponse = time.Now()
-		return nil
-	})
+// WsReconnectPolicy define how a dropped websocket stream is redialed
+type WsReconnectPolicy = transport.WsReconnectPolicy
 
-	go func() {
-		defer ticker.Stop()
-		for {
-			deadline := time.Now().Add(10 * time.Second)
-			err := c.WriteControl(websocket.PingMessage, []byte{}, deadline)
-			if err != nil {
-				return
-			}
-			<-ticker.C
-			if time.Since(lastResponse) > timeout {
-				c.Close()
-				return
-			}
-		}
-	}()
+// NewWsReconnectPolicy returns a policy with exponential backoff from 1s up to 1min
+// and unlimited attempts
+func NewWsReconnectPolicy() *WsReconnectPolicy {
+	return transport.NewWsReconnectPolicy()
 }
+
+// WsReconnectedEvent is passed to the ErrHandler after a dropped stream has been redialed.
+// Messages sent by the server while the stream was down are lost, so consumers keeping
+// local state should resync it.
+type WsReconnectedEvent = transport.WsReconnectedEvent
+
+var wsServe = transport.WsServe
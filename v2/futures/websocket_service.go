@@ -21,26 +21,12 @@ var (
 	WebsocketTimeout = time.Second * 60
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = false
+	// WebsocketReconnect enables redialing dropped streams with the given policy, nil disables it
+	WebsocketReconnect *WsReconnectPolicy
 	// UseTestnet switch all the WS streams from production to the testnet
 	UseTestnet = false
 )
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getWsEndpoint() string {
-	if UseTestnet {
-		return baseWsTestnetUrl
-	}
-	return baseWsMainUrl
-}
-
-// getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
-func getCombinedEndpoint() string {
-	if UseTestnet {
-		return baseCombinedTestnetURL
-	}
-	return baseCombinedMainURL
-}
-
 // WsAggTradeEvent define websocket aggTrde event.
 type WsAggTradeEvent struct {
 	Event            string `json:"e"`
@@ -58,10 +44,15 @@ type WsAggTradeEvent struct {
 // WsAggTradeHandler handle websocket that push trade information that is aggregated for a single taker order.
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
-// WsAggTradeServe serve websocket that push trade information that is aggregated for a single taker order.
+// WsAggTradeServe is WsClient.WsAggTradeServe with the endpoints and settings of the package variables
 func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAggTradeServe(symbol, handler, errHandler)
+}
+
+// WsAggTradeServe serve websocket that push trade information that is aggregated for a single taker order.
+func (c *WsClient) WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@aggTrade", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, &event)
@@ -74,14 +65,19 @@ func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHan
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbols
+// WsCombinedAggTradeServe is WsClient.WsCombinedAggTradeServe with the endpoints and settings of the package variables
 func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedAggTradeServe(symbols, handler, errHandler)
+}
+
+// WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbols
+func (c *WsClient) WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -124,8 +120,8 @@ type WsMarkPriceEvent struct {
 // WsMarkPriceHandler handle websocket that pushes price and funding rate for a single symbol.
 type WsMarkPriceHandler func(event *WsMarkPriceEvent)
 
-func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func (c *WsClient) wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMarkPriceEvent)
 		err := json.Unmarshal(message, &event)
@@ -138,14 +134,24 @@ func wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler Er
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsMarkPriceServe serve websocket that pushes price and funding rate for a single symbol.
+// WsMarkPriceServe is WsClient.WsMarkPriceServe with the endpoints and settings of the package variables
 func WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@markPrice", getWsEndpoint(), strings.ToLower(symbol))
-	return wsMarkPriceServe(endpoint, handler, errHandler)
+	return defaultWsClient().WsMarkPriceServe(symbol, handler, errHandler)
 }
 
-// WsMarkPriceServeWithRate serve websocket that pushes price and funding rate for a single symbol and rate.
+// WsMarkPriceServe serve websocket that pushes price and funding rate for a single symbol.
+func (c *WsClient) WsMarkPriceServe(symbol string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@markPrice", c.BaseURL, strings.ToLower(symbol))
+	return c.wsMarkPriceServe(endpoint, handler, errHandler)
+}
+
+// WsMarkPriceServeWithRate is WsClient.WsMarkPriceServeWithRate with the endpoints and settings of the package variables
 func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return defaultWsClient().WsMarkPriceServeWithRate(symbol, rate, handler, errHandler)
+}
+
+// WsMarkPriceServeWithRate serve websocket that pushes price and funding rate for a single symbol and rate.
+func (c *WsClient) WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	switch rate {
 	case 3 * time.Second:
@@ -155,12 +161,12 @@ func WsMarkPriceServeWithRate(symbol string, rate time.Duration, handler WsMarkP
 	default:
 		return nil, nil, errors.New("Invalid rate")
 	}
-	endpoint := fmt.Sprintf("%s/%s@markPrice%s", getWsEndpoint(), strings.ToLower(symbol), rateStr)
-	return wsMarkPriceServe(endpoint, handler, errHandler)
+	endpoint := fmt.Sprintf("%s/%s@markPrice%s", c.BaseURL, strings.ToLower(symbol), rateStr)
+	return c.wsMarkPriceServe(endpoint, handler, errHandler)
 }
 
-func wsCombinedMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func (c *WsClient) wsCombinedMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -184,20 +190,30 @@ func wsCombinedMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHa
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsCombinedMarkPriceServe is similar to WsMarkPriceServe, but it handles multiple symbols
+// WsCombinedMarkPriceServe is WsClient.WsCombinedMarkPriceServe with the endpoints and settings of the package variables
 func WsCombinedMarkPriceServe(symbols []string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedMarkPriceServe(symbols, handler, errHandler)
+}
+
+// WsCombinedMarkPriceServe is similar to WsMarkPriceServe, but it handles multiple symbols
+func (c *WsClient) WsCombinedMarkPriceServe(symbols []string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@markPrice", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
 
-	return wsCombinedMarkPriceServe(endpoint, handler, errHandler)
+	return c.wsCombinedMarkPriceServe(endpoint, handler, errHandler)
 }
 
-// WsCombinedMarkPriceServeWithRate is similar to WsMarkPriceServeWithRate, but it for multiple symbols
+// WsCombinedMarkPriceServeWithRate is WsClient.WsCombinedMarkPriceServeWithRate with the endpoints and settings of the package variables
 func WsCombinedMarkPriceServeWithRate(symbolLevels map[string]time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedMarkPriceServeWithRate(symbolLevels, handler, errHandler)
+}
+
+// WsCombinedMarkPriceServeWithRate is similar to WsMarkPriceServeWithRate, but it for multiple symbols
+func (c *WsClient) WsCombinedMarkPriceServeWithRate(symbolLevels map[string]time.Duration, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for symbol, rate := range symbolLevels {
 		var rateStr string
 		switch rate {
@@ -214,7 +230,7 @@ func WsCombinedMarkPriceServeWithRate(symbolLevels map[string]time.Duration, han
 
 	endpoint = endpoint[:len(endpoint)-1]
 
-	return wsCombinedMarkPriceServe(endpoint, handler, errHandler)
+	return c.wsCombinedMarkPriceServe(endpoint, handler, errHandler)
 }
 
 // WsAllMarkPriceEvent defines an array of websocket markPriceUpdate events.
@@ -223,8 +239,8 @@ type WsAllMarkPriceEvent []*WsMarkPriceEvent
 // WsAllMarkPriceHandler handle websocket that pushes price and funding rate for all symbol.
 type WsAllMarkPriceHandler func(event WsAllMarkPriceEvent)
 
-func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func (c *WsClient) wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMarkPriceEvent
 		err := json.Unmarshal(message, &event)
@@ -237,14 +253,24 @@ func wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHand
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsAllMarkPriceServe serve websocket that pushes price and funding rate for all symbol.
+// WsAllMarkPriceServe is WsClient.WsAllMarkPriceServe with the endpoints and settings of the package variables
 func WsAllMarkPriceServe(handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!markPrice@arr", getWsEndpoint())
-	return wsAllMarkPriceServe(endpoint, handler, errHandler)
+	return defaultWsClient().WsAllMarkPriceServe(handler, errHandler)
 }
 
-// WsAllMarkPriceServeWithRate serve websocket that pushes price and funding rate for all symbol and rate.
+// WsAllMarkPriceServe serve websocket that pushes price and funding rate for all symbol.
+func (c *WsClient) WsAllMarkPriceServe(handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!markPrice@arr", c.BaseURL)
+	return c.wsAllMarkPriceServe(endpoint, handler, errHandler)
+}
+
+// WsAllMarkPriceServeWithRate is WsClient.WsAllMarkPriceServeWithRate with the endpoints and settings of the package variables
 func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return defaultWsClient().WsAllMarkPriceServeWithRate(rate, handler, errHandler)
+}
+
+// WsAllMarkPriceServeWithRate serve websocket that pushes price and funding rate for all symbol and rate.
+func (c *WsClient) WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	switch rate {
 	case 3 * time.Second:
@@ -254,8 +280,8 @@ func WsAllMarkPriceServeWithRate(rate time.Duration, handler WsAllMarkPriceHandl
 	default:
 		return nil, nil, errors.New("Invalid rate")
 	}
-	endpoint := fmt.Sprintf("%s/!markPrice@arr%s", getWsEndpoint(), rateStr)
-	return wsAllMarkPriceServe(endpoint, handler, errHandler)
+	endpoint := fmt.Sprintf("%s/!markPrice@arr%s", c.BaseURL, rateStr)
+	return c.wsAllMarkPriceServe(endpoint, handler, errHandler)
 }
 
 // WsKlineEvent define websocket kline event
@@ -289,10 +315,15 @@ type WsKline struct {
 // WsKlineHandler handle websocket kline event
 type WsKlineHandler func(event *WsKlineEvent)
 
-// WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
+// WsKlineServe is WsClient.WsKlineServe with the endpoints and settings of the package variables
 func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsKlineServe(symbol, interval, handler, errHandler)
+}
+
+// WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
+func (c *WsClient) WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@kline_%s", c.BaseURL, strings.ToLower(symbol), interval)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -305,14 +336,19 @@ func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHan
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
+// WsCombinedKlineServe is WsClient.WsCombinedKlineServe with the endpoints and settings of the package variables
 func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedKlineServe(symbolIntervalPair, handler, errHandler)
+}
+
+// WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
+func (c *WsClient) WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for symbol, interval := range symbolIntervalPair {
 		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -378,12 +414,18 @@ type WsContinuousKlineSubcribeArgs struct {
 // WsContinuousKlineHandler handle websocket continuous kline event
 type WsContinuousKlineHandler func(event *WsContinuousKlineEvent)
 
-// WsContinuousKlineServe serve websocket continuous kline handler with a pair and contractType and interval like 15m, 30s
+// WsContinuousKlineServe is WsClient.WsContinuousKlineServe with the endpoints and settings of the package variables
 func WsContinuousKlineServe(subscribeArgs *WsContinuousKlineSubcribeArgs, handler WsContinuousKlineHandler,
 	errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", getWsEndpoint(), strings.ToLower(subscribeArgs.Pair),
+	return defaultWsClient().WsContinuousKlineServe(subscribeArgs, handler, errHandler)
+}
+
+// WsContinuousKlineServe serve websocket continuous kline handler with a pair and contractType and interval like 15m, 30s
+func (c *WsClient) WsContinuousKlineServe(subscribeArgs *WsContinuousKlineSubcribeArgs, handler WsContinuousKlineHandler,
+	errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", c.BaseURL, strings.ToLower(subscribeArgs.Pair),
 		strings.ToLower(subscribeArgs.ContractType), subscribeArgs.Interval)
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsContinuousKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -396,16 +438,22 @@ func WsContinuousKlineServe(subscribeArgs *WsContinuousKlineSubcribeArgs, handle
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsCombinedContinuousKlineServe is similar to WsContinuousKlineServe, but it handles multiple pairs of different contractType with its interval
+// WsCombinedContinuousKlineServe is WsClient.WsCombinedContinuousKlineServe with the endpoints and settings of the package variables
 func WsCombinedContinuousKlineServe(subscribeArgsList []*WsContinuousKlineSubcribeArgs,
 	handler WsContinuousKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedContinuousKlineServe(subscribeArgsList, handler, errHandler)
+}
+
+// WsCombinedContinuousKlineServe is similar to WsContinuousKlineServe, but it handles multiple pairs of different contractType with its interval
+func (c *WsClient) WsCombinedContinuousKlineServe(subscribeArgsList []*WsContinuousKlineSubcribeArgs,
+	handler WsContinuousKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for _, val := range subscribeArgsList {
 		endpoint += fmt.Sprintf("%s_%s@continuousKline_%s", strings.ToLower(val.Pair),
 			strings.ToLower(val.ContractType), val.Interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -445,10 +493,15 @@ type WsMiniMarketTickerEvent struct {
 // WsMiniMarketTickerHandler handle websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
 type WsMiniMarketTickerHandler func(event *WsMiniMarketTickerEvent)
 
-// WsMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
+// WsMiniMarketTickerServe is WsClient.WsMiniMarketTickerServe with the endpoints and settings of the package variables
 func WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@miniTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsMiniMarketTickerServe(symbol, handler, errHandler)
+}
+
+// WsMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
+func (c *WsClient) WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@miniTicker", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMiniMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -467,10 +520,15 @@ type WsAllMiniMarketTickerEvent []*WsMiniMarketTickerEvent
 // WsAllMiniMarketTickerHandler handle websocket that pushes price and funding rate for all markets.
 type WsAllMiniMarketTickerHandler func(event WsAllMiniMarketTickerEvent)
 
-// WsAllMiniMarketTickerServe serve websocket that pushes price and funding rate for all markets.
+// WsAllMiniMarketTickerServe is WsClient.WsAllMiniMarketTickerServe with the endpoints and settings of the package variables
 func WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllMiniMarketTickerServe(handler, errHandler)
+}
+
+// WsAllMiniMarketTickerServe serve websocket that pushes price and funding rate for all markets.
+func (c *WsClient) WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!miniTicker@arr", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -508,10 +566,15 @@ type WsMarketTickerEvent struct {
 // WsMarketTickerHandler handle websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
 type WsMarketTickerHandler func(event *WsMarketTickerEvent)
 
-// WsMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
+// WsMarketTickerServe is WsClient.WsMarketTickerServe with the endpoints and settings of the package variables
 func WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsMarketTickerServe(symbol, handler, errHandler)
+}
+
+// WsMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
+func (c *WsClient) WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -530,10 +593,15 @@ type WsAllMarketTickerEvent []*WsMarketTickerEvent
 // WsAllMarketTickerHandler handle websocket that pushes price and funding rate for all markets.
 type WsAllMarketTickerHandler func(event WsAllMarketTickerEvent)
 
-// WsAllMarketTickerServe serve websocket that pushes price and funding rate for all markets.
+// WsAllMarketTickerServe is WsClient.WsAllMarketTickerServe with the endpoints and settings of the package variables
 func WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllMarketTickerServe(handler, errHandler)
+}
+
+// WsAllMarketTickerServe serve websocket that pushes price and funding rate for all markets.
+func (c *WsClient) WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!ticker@arr", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -562,10 +630,15 @@ type WsBookTickerEvent struct {
 // WsBookTickerHandler handle websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
-// WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
+// WsBookTickerServe is WsClient.WsBookTickerServe with the endpoints and settings of the package variables
 func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsBookTickerServe(symbol, handler, errHandler)
+}
+
+// WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
+func (c *WsClient) WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@bookTicker", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -578,10 +651,15 @@ func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler Er
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
+// WsAllBookTickerServe is WsClient.WsAllBookTickerServe with the endpoints and settings of the package variables
 func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllBookTickerServe(handler, errHandler)
+}
+
+// WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
+func (c *WsClient) WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!bookTicker", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -619,10 +697,15 @@ type WsLiquidationOrder struct {
 // WsLiquidationOrderHandler handle websocket that pushes force liquidation order information for specific symbol.
 type WsLiquidationOrderHandler func(event *WsLiquidationOrderEvent)
 
-// WsLiquidationOrderServe serve websocket that pushes force liquidation order information for specific symbol.
+// WsLiquidationOrderServe is WsClient.WsLiquidationOrderServe with the endpoints and settings of the package variables
 func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@forceOrder", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsLiquidationOrderServe(symbol, handler, errHandler)
+}
+
+// WsLiquidationOrderServe serve websocket that pushes force liquidation order information for specific symbol.
+func (c *WsClient) WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@forceOrder", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -635,10 +718,15 @@ func WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, e
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsAllLiquidationOrderServe serve websocket that pushes force liquidation order information for all symbols.
+// WsAllLiquidationOrderServe is WsClient.WsAllLiquidationOrderServe with the endpoints and settings of the package variables
 func WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!forceOrder@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllLiquidationOrderServe(handler, errHandler)
+}
+
+// WsAllLiquidationOrderServe serve websocket that pushes force liquidation order information for all symbols.
+func (c *WsClient) WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!forceOrder@arr", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -667,37 +755,57 @@ type WsDepthEvent struct {
 // WsDepthHandler handle websocket depth event
 type WsDepthHandler func(event *WsDepthEvent)
 
-func wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func (c *WsClient) wsPartialDepthServe(symbol string, levels int, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	if levels != 5 && levels != 10 && levels != 20 {
 		return nil, nil, errors.New("Invalid levels")
 	}
 	levelsStr := fmt.Sprintf("%d", levels)
-	return wsDepthServe(symbol, levelsStr, rate, handler, errHandler)
+	return c.wsDepthServe(symbol, levelsStr, rate, handler, errHandler)
 }
 
-// WsPartialDepthServe serve websocket partial depth handler.
+// WsPartialDepthServe is WsClient.WsPartialDepthServe with the endpoints and settings of the package variables
 func WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, nil, handler, errHandler)
+	return defaultWsClient().WsPartialDepthServe(symbol, levels, handler, errHandler)
 }
 
-// WsPartialDepthServeWithRate serve websocket partial depth handler with rate.
+// WsPartialDepthServe serve websocket partial depth handler.
+func (c *WsClient) WsPartialDepthServe(symbol string, levels int, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return c.wsPartialDepthServe(symbol, levels, nil, handler, errHandler)
+}
+
+// WsPartialDepthServeWithRate is WsClient.WsPartialDepthServeWithRate with the endpoints and settings of the package variables
 func WsPartialDepthServeWithRate(symbol string, levels int, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsPartialDepthServe(symbol, levels, &rate, handler, errHandler)
+	return defaultWsClient().WsPartialDepthServeWithRate(symbol, levels, rate, handler, errHandler)
 }
 
-// WsDiffDepthServe serve websocket diff. depth handler.
+// WsPartialDepthServeWithRate serve websocket partial depth handler with rate.
+func (c *WsClient) WsPartialDepthServeWithRate(symbol string, levels int, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return c.wsPartialDepthServe(symbol, levels, &rate, handler, errHandler)
+}
+
+// WsDiffDepthServe is WsClient.WsDiffDepthServe with the endpoints and settings of the package variables
 func WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", nil, handler, errHandler)
+	return defaultWsClient().WsDiffDepthServe(symbol, handler, errHandler)
 }
 
-// WsCombinedDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
+// WsDiffDepthServe serve websocket diff. depth handler.
+func (c *WsClient) WsDiffDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return c.wsDepthServe(symbol, "", nil, handler, errHandler)
+}
+
+// WsCombinedDepthServe is WsClient.WsCombinedDepthServe with the endpoints and settings of the package variables
 func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedDepthServe(symbolLevels, handler, errHandler)
+}
+
+// WsCombinedDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
+func (c *WsClient) WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for s, l := range symbolLevels {
 		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -736,14 +844,19 @@ func WsCombinedDepthServe(symbolLevels map[string]string, handler WsDepthHandler
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsCombinedDiffDepthServe is similar to WsDiffDepthServe, but it for multiple symbols
+// WsCombinedDiffDepthServe is WsClient.WsCombinedDiffDepthServe with the endpoints and settings of the package variables
 func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedDiffDepthServe(symbols, handler, errHandler)
+}
+
+// WsCombinedDiffDepthServe is similar to WsDiffDepthServe, but it for multiple symbols
+func (c *WsClient) WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -782,12 +895,17 @@ func WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandler, errHandl
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsDiffDepthServeWithRate serve websocket diff. depth handler with rate.
+// WsDiffDepthServeWithRate is WsClient.WsDiffDepthServeWithRate with the endpoints and settings of the package variables
 func WsDiffDepthServeWithRate(symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsDepthServe(symbol, "", &rate, handler, errHandler)
+	return defaultWsClient().WsDiffDepthServeWithRate(symbol, rate, handler, errHandler)
+}
+
+// WsDiffDepthServeWithRate serve websocket diff. depth handler with rate.
+func (c *WsClient) WsDiffDepthServeWithRate(symbol string, rate time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return c.wsDepthServe(symbol, "", &rate, handler, errHandler)
 }
 
-func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+func (c *WsClient) wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	var rateStr string
 	if rate != nil {
 		switch *rate {
@@ -801,8 +919,8 @@ func wsDepthServe(symbol string, levels string, rate *time.Duration, handler WsD
 			return nil, nil, errors.New("Invalid rate")
 		}
 	}
-	endpoint := fmt.Sprintf("%s/%s@depth%s%s", getWsEndpoint(), strings.ToLower(symbol), levels, rateStr)
-	cfg := newWsConfig(endpoint)
+	endpoint := fmt.Sprintf("%s/%s@depth%s%s", c.BaseURL, strings.ToLower(symbol), levels, rateStr)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -862,10 +980,15 @@ type WsBLVTBasket struct {
 // WsBLVTInfoHandler handle websocket BLVT event
 type WsBLVTInfoHandler func(event *WsBLVTInfoEvent)
 
-// WsBLVTInfoServe serve BLVT info stream
+// WsBLVTInfoServe is WsClient.WsBLVTInfoServe with the endpoints and settings of the package variables
 func WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@tokenNav", getWsEndpoint(), strings.ToUpper(name))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsBLVTInfoServe(name, handler, errHandler)
+}
+
+// WsBLVTInfoServe serve BLVT info stream
+func (c *WsClient) WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@tokenNav", c.BaseURL, strings.ToUpper(name))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTInfoEvent)
 		err := json.Unmarshal(message, &event)
@@ -905,10 +1028,15 @@ type WsBLVTKline struct {
 // WsBLVTKlineHandler BLVT kline handler
 type WsBLVTKlineHandler func(event *WsBLVTKlineEvent)
 
-// WsBLVTKlineServe serve BLVT kline stream
+// WsBLVTKlineServe is WsClient.WsBLVTKlineServe with the endpoints and settings of the package variables
 func WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@nav_Kline_%s", getWsEndpoint(), strings.ToUpper(name), interval)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsBLVTKlineServe(name, interval, handler, errHandler)
+}
+
+// WsBLVTKlineServe serve BLVT kline stream
+func (c *WsClient) WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@nav_Kline_%s", c.BaseURL, strings.ToUpper(name), interval)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -940,10 +1068,15 @@ type WsComposition struct {
 // WsCompositeIndexHandler websocket composite index handler
 type WsCompositeIndexHandler func(event *WsCompositeIndexEvent)
 
-// WsCompositiveIndexServe serve composite index information for index symbols
+// WsCompositiveIndexServe is WsClient.WsCompositiveIndexServe with the endpoints and settings of the package variables
 func WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@compositeIndex", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsCompositiveIndexServe(symbol, handler, errHandler)
+}
+
+// WsCompositiveIndexServe serve composite index information for index symbols
+func (c *WsClient) WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@compositeIndex", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsCompositeIndexEvent)
 		err := json.Unmarshal(message, event)
@@ -999,36 +1132,37 @@ type WsPosition struct {
 
 // WsOrderTradeUpdate define order trade update
 type WsOrderTradeUpdate struct {
-	Symbol               string             `json:"s"`
-	ClientOrderID        string             `json:"c"`
-	Side                 SideType           `json:"S"`
-	Type                 OrderType          `json:"o"`
-	TimeInForce          TimeInForceType    `json:"f"`
-	OriginalQty          string             `json:"q"`
-	OriginalPrice        string             `json:"p"`
-	AveragePrice         string             `json:"ap"`
-	StopPrice            string             `json:"sp"`
-	ExecutionType        OrderExecutionType `json:"x"`
-	Status               OrderStatusType    `json:"X"`
-	ID                   int64              `json:"i"`
-	LastFilledQty        string             `json:"l"`
-	AccumulatedFilledQty string             `json:"z"`
-	LastFilledPrice      string             `json:"L"`
-	CommissionAsset      string             `json:"N"`
-	Commission           string             `json:"n"`
-	TradeTime            int64              `json:"T"`
-	TradeID              int64              `json:"t"`
-	BidsNotional         string             `json:"b"`
-	AsksNotional         string             `json:"a"`
-	IsMaker              bool               `json:"m"`
-	IsReduceOnly         bool               `json:"R"`
-	WorkingType          WorkingType        `json:"wt"`
-	OriginalType         OrderType          `json:"ot"`
-	PositionSide         PositionSideType   `json:"ps"`
-	IsClosingPosition    bool               `json:"cp"`
-	ActivationPrice      string             `json:"AP"`
-	CallbackRate         string             `json:"cr"`
-	RealizedPnL          string             `json:"rp"`
+	Symbol                  string                      `json:"s"`
+	ClientOrderID           string                      `json:"c"`
+	Side                    SideType                    `json:"S"`
+	Type                    OrderType                   `json:"o"`
+	TimeInForce             TimeInForceType             `json:"f"`
+	OriginalQty             string                      `json:"q"`
+	OriginalPrice           string                      `json:"p"`
+	AveragePrice            string                      `json:"ap"`
+	StopPrice               string                      `json:"sp"`
+	ExecutionType           OrderExecutionType          `json:"x"`
+	Status                  OrderStatusType             `json:"X"`
+	ID                      int64                       `json:"i"`
+	LastFilledQty           string                      `json:"l"`
+	AccumulatedFilledQty    string                      `json:"z"`
+	LastFilledPrice         string                      `json:"L"`
+	CommissionAsset         string                      `json:"N"`
+	Commission              string                      `json:"n"`
+	TradeTime               int64                       `json:"T"`
+	TradeID                 int64                       `json:"t"`
+	BidsNotional            string                      `json:"b"`
+	AsksNotional            string                      `json:"a"`
+	IsMaker                 bool                        `json:"m"`
+	IsReduceOnly            bool                        `json:"R"`
+	WorkingType             WorkingType                 `json:"wt"`
+	OriginalType            OrderType                   `json:"ot"`
+	PositionSide            PositionSideType            `json:"ps"`
+	IsClosingPosition       bool                        `json:"cp"`
+	ActivationPrice         string                      `json:"AP"`
+	CallbackRate            string                      `json:"cr"`
+	RealizedPnL             string                      `json:"rp"`
+	SelfTradePreventionMode SelfTradePreventionModeType `json:"V"`
 }
 
 // WsAccountConfigUpdate define account config update
@@ -1040,10 +1174,15 @@ type WsAccountConfigUpdate struct {
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
-// WsUserDataServe serve user data handler with listen key
+// WsUserDataServe is WsClient.WsUserDataServe with the endpoints and settings of the package variables
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsUserDataServe(listenKey, handler, errHandler)
+}
+
+// WsUserDataServe serve user data handler with listen key
+func (c *WsClient) WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", c.BaseURL, listenKey)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
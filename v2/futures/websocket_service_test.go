@@ -1804,7 +1804,8 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdate() {
 		  "cp":false,
 		  "AP":"7476.89",
 		  "cr":"5.0",
-		  "rp":"0"
+		  "rp":"0",
+		  "V":"EXPIRE_TAKER"
 		}
 	}`)
 	expectedEvent := &WsUserDataEvent{
@@ -1812,36 +1813,37 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdate() {
 		Time:            1568879465651,
 		TransactionTime: 1568879465650,
 		OrderTradeUpdate: WsOrderTradeUpdate{
-			Symbol:               "BTCUSDT",
-			ClientOrderID:        "TEST",
-			Side:                 "SELL",
-			Type:                 "TRAILING_STOP_MARKET",
-			TimeInForce:          "GTC",
-			OriginalQty:          "0.001",
-			OriginalPrice:        "0",
-			AveragePrice:         "0",
-			StopPrice:            "7103.04",
-			ExecutionType:        "NEW",
-			Status:               "NEW",
-			ID:                   8886774,
-			LastFilledQty:        "0",
-			AccumulatedFilledQty: "0",
-			LastFilledPrice:      "0",
-			CommissionAsset:      "USDT",
-			Commission:           "0",
-			TradeTime:            1568879465651,
-			TradeID:              0,
-			BidsNotional:         "0",
-			AsksNotional:         "9.91",
-			IsMaker:              false,
-			IsReduceOnly:         false,
-			WorkingType:          "CONTRACT_PRICE",
-			OriginalType:         "TRAILING_STOP_MARKET",
-			PositionSide:         "LONG",
-			IsClosingPosition:    false,
-			ActivationPrice:      "7476.89",
-			CallbackRate:         "5.0",
-			RealizedPnL:          "0",
+			Symbol:                  "BTCUSDT",
+			ClientOrderID:           "TEST",
+			Side:                    "SELL",
+			Type:                    "TRAILING_STOP_MARKET",
+			TimeInForce:             "GTC",
+			OriginalQty:             "0.001",
+			OriginalPrice:           "0",
+			AveragePrice:            "0",
+			StopPrice:               "7103.04",
+			ExecutionType:           "NEW",
+			Status:                  "NEW",
+			ID:                      8886774,
+			LastFilledQty:           "0",
+			AccumulatedFilledQty:    "0",
+			LastFilledPrice:         "0",
+			CommissionAsset:         "USDT",
+			Commission:              "0",
+			TradeTime:               1568879465651,
+			TradeID:                 0,
+			BidsNotional:            "0",
+			AsksNotional:            "9.91",
+			IsMaker:                 false,
+			IsReduceOnly:            false,
+			WorkingType:             "CONTRACT_PRICE",
+			OriginalType:            "TRAILING_STOP_MARKET",
+			PositionSide:            "LONG",
+			IsClosingPosition:       false,
+			ActivationPrice:         "7476.89",
+			CallbackRate:            "5.0",
+			RealizedPnL:             "0",
+			SelfTradePreventionMode: SelfTradePreventionModeTypeExpireTaker,
 		},
 	}
 	s.testWsUserDataServe(data, expectedEvent)
@@ -1945,6 +1947,7 @@ func (s *websocketServiceTestSuite) assertOrderTradeUpdate(e, a WsOrderTradeUpda
 	r.Equal(e.ActivationPrice, a.ActivationPrice, "ActivationPrice")
 	r.Equal(e.CallbackRate, a.CallbackRate, "CallbackRate")
 	r.Equal(e.RealizedPnL, a.RealizedPnL, "RealizedPnL")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
 }
 
 func (s *websocketServiceTestSuite) assertAccountConfigUpdate(e, a WsAccountConfigUpdate) {
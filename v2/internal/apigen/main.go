@@ -0,0 +1,508 @@
+// Command apigen generate the service interfaces returned by the API of the spot, futures and
+// delivery clients, the adapters of the clients to them, and the fakes of binancetest implementing
+// them. It reads the interfaces of api.go in each package and is run from the module root by
+// go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const modulePath = "github.com/adshao/go-binance/v2"
+
+const header = "// Code generated by internal/apigen. DO NOT EDIT.\n\n"
+
+// streamType is the only type returned by the API which is not a service, faked by
+// binancetest.FakeUserDataStream
+const streamType = "UserDataStream"
+
+type target struct {
+	dir    string
+	name   string
+	path   string
+	prefix string // prefix of the fake types in binancetest
+}
+
+var targets = []target{
+	{dir: ".", name: "binance", path: modulePath, prefix: "Spot"},
+	{dir: "futures", name: "futures", path: modulePath + "/futures", prefix: "Futures"},
+	{dir: "delivery", name: "delivery", path: modulePath + "/delivery", prefix: "Delivery"},
+}
+
+type param struct {
+	name     string
+	typ      ast.Expr
+	variadic bool
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []ast.Expr
+	setter  bool
+	imports map[string]string // imports of the file declaring the method
+}
+
+// constructor is a method of an API interface, returning the interface of a service
+type constructor struct {
+	method
+	typ string
+}
+
+type pkg struct {
+	target
+	constructors []*constructor
+	methods      map[string][]*method // exported methods of the returned types
+	types        map[string]ast.Expr  // type declarations
+}
+
+func main() {
+	for _, t := range targets {
+		p, err := load(t)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := write(filepath.Join(t.dir, "api_gen.go"), p.generateAPI()); err != nil {
+			log.Fatal(err)
+		}
+		fake := filepath.Join("binancetest", strings.ToLower(t.prefix)+"_api_gen.go")
+		if err := write(fake, p.generateFake()); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func write(path string, src []byte) error {
+	out, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %w\n%s", path, err, src)
+	}
+	return os.WriteFile(path, out, 0o644)
+}
+
+func load(t target) (*pkg, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, t.dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), "_gen.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	files := pkgs[t.name].Files
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	p := &pkg{target: t, methods: make(map[string][]*method), types: make(map[string]ast.Expr)}
+	for _, name := range names {
+		for _, decl := range files[name].Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+				for _, spec := range gen.Specs {
+					spec := spec.(*ast.TypeSpec)
+					p.types[spec.Name.Name] = spec.Type
+				}
+			}
+		}
+	}
+	api := files[filepath.Join(t.dir, "api.go")]
+	if api == nil {
+		return nil, fmt.Errorf("%s: api.go not found", t.dir)
+	}
+	seen := make(map[string]bool)
+	for _, decl := range api.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			iface, ok := spec.(*ast.TypeSpec).Type.(*ast.InterfaceType)
+			if !ok {
+				continue
+			}
+			for _, field := range iface.Methods.List {
+				if len(field.Names) == 0 || seen[field.Names[0].Name] {
+					continue
+				}
+				seen[field.Names[0].Name] = true
+				m := newMethod(field.Names[0].Name, field.Type.(*ast.FuncType), fileImports(api))
+				res, ok := m.results[0].(*ast.Ident)
+				if len(m.results) != 1 || !ok || !strings.HasSuffix(res.Name, "API") {
+					return nil, fmt.Errorf("%s: %s must return the interface of a service", t.dir, m.name)
+				}
+				p.constructors = append(p.constructors, &constructor{method: *m, typ: strings.TrimSuffix(res.Name, "API")})
+			}
+		}
+	}
+	for _, name := range names {
+		file := files[name]
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			recv := star.X.(*ast.Ident).Name
+			m := newMethod(fn.Name.Name, fn.Type, fileImports(file))
+			if len(m.results) == 1 {
+				if res, ok := m.results[0].(*ast.StarExpr); ok {
+					if id, ok := res.X.(*ast.Ident); ok && id.Name == recv {
+						m.setter = true
+					}
+				}
+			}
+			// services are covered by their setters and Do, leaving out iterators and other helpers
+			if recv != streamType && !m.setter && m.name != "Do" {
+				continue
+			}
+			p.methods[recv] = append(p.methods[recv], m)
+		}
+	}
+	for _, c := range p.constructors {
+		if len(p.methods[c.typ]) == 0 {
+			return nil, fmt.Errorf("%s: no method found for %s", t.dir, c.typ)
+		}
+		if c.typ != streamType && !strings.HasSuffix(c.typ, "Service") {
+			return nil, fmt.Errorf("%s: %s is neither a service nor the user data stream", t.dir, c.typ)
+		}
+	}
+	return p, nil
+}
+
+func newMethod(name string, fn *ast.FuncType, imports map[string]string) *method {
+	m := &method{name: name, imports: imports}
+	for _, field := range fn.Params.List {
+		typ, variadic := field.Type, false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = ellipsis.Elt, true
+		}
+		if len(field.Names) == 0 {
+			m.params = append(m.params, param{name: fmt.Sprintf("p%d", len(m.params)), typ: typ, variadic: variadic})
+		}
+		for _, n := range field.Names {
+			m.params = append(m.params, param{name: n.Name, typ: typ, variadic: variadic})
+		}
+	}
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.results = append(m.results, field.Type)
+			}
+		}
+	}
+	return m
+}
+
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		path := strings.Trim(spec.Path.Value, `"`)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+// writer build a source file, tracking the imports used by the types written
+type writer struct {
+	bytes.Buffer
+	qualifier string // package qualifying the exported types of the target package, if written outside of it
+	path      string
+	imports   map[string]string
+}
+
+func newWriter(qualifier, path string) *writer {
+	return &writer{qualifier: qualifier, path: path, imports: make(map[string]string)}
+}
+
+func (w *writer) p(format string, args ...interface{}) {
+	fmt.Fprintf(w, format, args...)
+}
+
+func (w *writer) source(pkgName string) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkgName)
+	var std, other []string
+	for _, path := range w.imports {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	b.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(&b, "\t%q\n", path)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		b.WriteString("\n")
+	}
+	for _, path := range other {
+		fmt.Fprintf(&b, "\t%q\n", path)
+	}
+	b.WriteString(")\n\n")
+	b.Write(w.Bytes())
+	return b.Bytes()
+}
+
+// typ return the source of a type used by m
+func (w *writer) typ(m *method, e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if w.qualifier != "" && e.IsExported() {
+			w.imports[w.qualifier] = w.path
+			return w.qualifier + "." + e.Name
+		}
+		return e.Name
+	case *ast.SelectorExpr:
+		name := e.X.(*ast.Ident).Name
+		w.imports[name] = m.imports[name]
+		return name + "." + e.Sel.Name
+	case *ast.StarExpr:
+		return "*" + w.typ(m, e.X)
+	case *ast.ArrayType:
+		if e.Len != nil {
+			return "[" + e.Len.(*ast.BasicLit).Value + "]" + w.typ(m, e.Elt)
+		}
+		return "[]" + w.typ(m, e.Elt)
+	case *ast.MapType:
+		return "map[" + w.typ(m, e.Key) + "]" + w.typ(m, e.Value)
+	case *ast.ChanType:
+		switch e.Dir {
+		case ast.RECV:
+			return "<-chan " + w.typ(m, e.Value)
+		case ast.SEND:
+			return "chan<- " + w.typ(m, e.Value)
+		}
+		return "chan " + w.typ(m, e.Value)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.StructType:
+		return "struct{}"
+	case *ast.FuncType:
+		fm := newMethod("", e, m.imports)
+		return "func" + w.signature(fm, "")
+	}
+	panic(fmt.Sprintf("apigen: unsupported type %T", e))
+}
+
+// params return the parameter list of m
+func (w *writer) params(m *method) string {
+	var params []string
+	for _, p := range m.params {
+		typ := w.typ(m, p.typ)
+		if p.variadic {
+			typ = "..." + typ
+		}
+		params = append(params, p.name+" "+typ)
+	}
+	return "(" + strings.Join(params, ", ") + ")"
+}
+
+// signature return the parameters and results of m, the results of setters being replaced by iface
+func (w *writer) signature(m *method, iface string) string {
+	var results []string
+	if m.setter {
+		results = []string{iface}
+	} else {
+		for _, r := range m.results {
+			results = append(results, w.typ(m, r))
+		}
+	}
+	s := w.params(m)
+	switch len(results) {
+	case 0:
+	case 1:
+		s += " " + results[0]
+	default:
+		s += " (" + strings.Join(results, ", ") + ")"
+	}
+	return s
+}
+
+// args return the arguments passing the parameters of m on
+func args(m *method) string {
+	var args []string
+	for _, p := range m.params {
+		if p.variadic {
+			args = append(args, p.name+"...")
+		} else {
+			args = append(args, p.name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+func lowerFirst(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+// generateAPI generate the service interfaces of the package and the adapters of Client to them
+func (p *pkg) generateAPI() []byte {
+	w := newWriter("", "")
+	w.p("type clientAPI struct {\n\tc *Client\n}\n\n")
+	for _, c := range p.constructors {
+		w.p("func (a clientAPI) %s%s {\n", c.name, w.signature(&c.method, ""))
+		w.p("\treturn %s{a.c.%s(%s)}\n}\n\n", lowerFirst(c.typ)+"API", c.name, args(&c.method))
+	}
+	for _, c := range p.constructors {
+		iface := c.typ + "API"
+		adapter := lowerFirst(iface)
+		if c.typ == streamType {
+			w.p("// %s define the methods of %s\n", iface, c.typ)
+		} else {
+			w.p("// %s define the setters and Do of %s\n", iface, c.typ)
+		}
+		w.p("type %s interface {\n", iface)
+		for _, m := range p.methods[c.typ] {
+			w.p("\t%s%s\n", m.name, w.signature(m, iface))
+		}
+		w.p("}\n\n")
+		w.p("type %s struct {\n\ts *%s\n}\n\n", adapter, c.typ)
+		for _, m := range p.methods[c.typ] {
+			w.p("func (a %s) %s%s {\n", adapter, m.name, w.signature(m, iface))
+			switch {
+			case m.setter:
+				w.p("\ta.s.%s(%s)\n\treturn a\n", m.name, args(m))
+			case len(m.results) == 0:
+				w.p("\ta.s.%s(%s)\n", m.name, args(m))
+			default:
+				w.p("\treturn a.s.%s(%s)\n", m.name, args(m))
+			}
+			w.p("}\n\n")
+		}
+	}
+	return w.source(p.name)
+}
+
+// generateFake generate the fake of the API of the package in binancetest
+func (p *pkg) generateFake() []byte {
+	w := newWriter(p.name, p.path)
+	api := p.prefix + "API"
+	w.p("// %s is an in-memory fake of %s.API. Its services record the arguments of their setters and\n", api, p.name)
+	w.p("// answer Do with the FakeService of the same name, its user data streams are served by\n")
+	w.p("// UserDataStream. The zero value is ready to use.\n")
+	w.p("type %s struct {\n", api)
+	for _, c := range p.constructors {
+		if c.typ == streamType {
+			w.p("\t%s FakeUserDataStream[%s]\n", c.typ, w.typ(&c.method, p.streamEvent(c)))
+			continue
+		}
+		do := p.do(c.typ)
+		res := "struct{}"
+		if len(do.results) == 2 {
+			res = w.typ(do, do.results[0])
+		}
+		w.p("\t%s FakeService[%s]\n", c.typ, res)
+	}
+	w.p("}\n\n")
+	w.p("var _ %s.API = (*%s)(nil)\n\n", p.name, api)
+	for _, c := range p.constructors {
+		fake := lowerFirst(p.prefix) + c.typ
+		w.p("// %s implement %s.API\n", c.name, p.name)
+		w.p("func (a *%s) %s%s {\n", api, c.name, w.signature(&c.method, ""))
+		if c.typ == streamType {
+			w.p("\treturn &%s{s: a.%s.newStream(%s)}\n}\n\n", fake, c.typ, args(&c.method))
+		} else {
+			w.p("\treturn &%s{f: &a.%s, args: make(Args)}\n}\n\n", fake, c.typ)
+		}
+	}
+	for _, c := range p.constructors {
+		iface := p.name + "." + c.typ + "API"
+		fake := lowerFirst(p.prefix) + c.typ
+		if c.typ == streamType {
+			w.p("type %s struct {\n\ts *fakeStream[%s]\n}\n\n", fake, w.typ(&c.method, p.streamEvent(c)))
+		} else {
+			do := p.do(c.typ)
+			res := "struct{}"
+			if len(do.results) == 2 {
+				res = w.typ(do, do.results[0])
+			}
+			w.p("type %s struct {\n\tf *FakeService[%s]\n\targs Args\n}\n\n", fake, res)
+		}
+		for _, m := range p.methods[c.typ] {
+			w.p("func (s *%s) %s%s {\n", fake, m.name, w.signature(m, iface))
+			switch {
+			case m.setter && c.typ == streamType:
+				w.p("\ts.s.set(%q, %s)\n\treturn s\n", m.name, setValue(m))
+			case m.setter:
+				w.p("\ts.args[%q] = %s\n\treturn s\n", m.name, setValue(m))
+			case c.typ == streamType && len(m.results) == 0:
+				w.p("\ts.s.%s(%s)\n", m.name, args(m))
+			case c.typ == streamType:
+				w.p("\treturn s.s.%s(%s)\n", m.name, args(m))
+			case len(m.results) == 1:
+				w.p("\t_, err := s.f.do(%s, s.args)\n\treturn err\n", m.params[0].name)
+			default:
+				w.p("\treturn s.f.do(%s, s.args)\n", m.params[0].name)
+			}
+			w.p("}\n\n")
+		}
+	}
+	return w.source("binancetest")
+}
+
+// setValue return the value recorded for a setter: its argument, true without one, or a slice
+// of its arguments
+func setValue(m *method) string {
+	switch {
+	case len(m.params) == 0:
+		return "true"
+	case len(m.params) == 1:
+		return m.params[0].name
+	}
+	return "[]interface{}{" + args(m) + "}"
+}
+
+// do return the Do method of a service
+func (p *pkg) do(typ string) *method {
+	for _, m := range p.methods[typ] {
+		if m.name == "Do" {
+			if len(m.params) == 0 || len(m.results) == 0 || len(m.results) > 2 {
+				log.Fatalf("%s: unsupported signature of %s.Do", p.dir, typ)
+			}
+			return m
+		}
+	}
+	log.Fatalf("%s: %s has no Do method", p.dir, typ)
+	return nil
+}
+
+// streamEvent return the event type of the handler passed to the constructor of the user data stream
+func (p *pkg) streamEvent(c *constructor) ast.Expr {
+	id, ok := c.params[0].typ.(*ast.Ident)
+	if ok {
+		if fn, ok := p.types[id.Name].(*ast.FuncType); ok && len(fn.Params.List) == 1 {
+			return fn.Params.List[0].Type
+		}
+	}
+	log.Fatalf("%s: the first parameter of %s must be a handler of events", p.dir, c.name)
+	return nil
+}
@@ -0,0 +1,321 @@
+// Package ordertrack aggregates the execution reports of orders into their state, shared by the
+// order trackers of the spot and futures packages.
+package ordertrack
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Order statuses
+const (
+	StatusNew             = "NEW"
+	StatusPartiallyFilled = "PARTIALLY_FILLED"
+	StatusPendingCancel   = "PENDING_CANCEL"
+	StatusFilled          = "FILLED"
+	StatusCanceled        = "CANCELED"
+	StatusRejected        = "REJECTED"
+	StatusExpired         = "EXPIRED"
+	StatusExpiredInMatch  = "EXPIRED_IN_MATCH"
+)
+
+// statusRanks order the statuses of the state machine: an order only moves to a status of a higher
+// rank, final statuses having the highest one. Unknown statuses, such as the empty status of an
+// update carrying a fill only, never change the status.
+var statusRanks = map[string]int{
+	StatusNew:             1,
+	StatusPartiallyFilled: 2,
+	StatusPendingCancel:   3,
+	StatusFilled:          4,
+	StatusCanceled:        4,
+	StatusRejected:        4,
+	StatusExpired:         4,
+	StatusExpiredInMatch:  4,
+}
+
+const finalRank = 4
+
+// Fill define a trade of an order
+type Fill struct {
+	TradeID         int64
+	Price           common.Decimal
+	Quantity        common.Decimal
+	Commission      common.Decimal
+	CommissionAsset string
+	IsMaker         bool
+	Time            int64
+}
+
+// Order define the state of a tracked order
+type Order struct {
+	Symbol         string
+	OrderID        int64
+	ClientOrderID  string
+	Side           string
+	Type           string
+	Price          common.Decimal
+	Quantity       common.Decimal
+	Status         string
+	FilledQuantity common.Decimal
+	AveragePrice   common.Decimal
+	Fills          []Fill                    // sorted by trade ID
+	Commissions    map[string]common.Decimal // commission paid by the fills, by asset
+	UpdateTime     int64
+}
+
+// Final report whether the order reached a final status, after which it doesn't change
+func (o *Order) Final() bool {
+	return statusRanks[o.Status] == finalRank
+}
+
+func (o *Order) clone() *Order {
+	c := *o
+	c.Fills = append([]Fill(nil), o.Fills...)
+	c.Commissions = make(map[string]common.Decimal, len(o.Commissions))
+	for asset, commission := range o.Commissions {
+		c.Commissions[asset] = commission
+	}
+	return &c
+}
+
+// hasFill report whether the trade is already a fill of the order
+func (o *Order) hasFill(tradeID int64) bool {
+	i := sort.Search(len(o.Fills), func(i int) bool { return o.Fills[i].TradeID >= tradeID })
+	return i < len(o.Fills) && o.Fills[i].TradeID == tradeID
+}
+
+func (o *Order) addFill(f Fill) {
+	i := sort.Search(len(o.Fills), func(i int) bool { return o.Fills[i].TradeID >= f.TradeID })
+	o.Fills = append(o.Fills, Fill{})
+	copy(o.Fills[i+1:], o.Fills[i:])
+	o.Fills[i] = f
+	if f.CommissionAsset != "" {
+		o.Commissions[f.CommissionAsset] = o.Commissions[f.CommissionAsset].Add(f.Commission)
+	}
+}
+
+// Update define an execution report or an order snapshot. Empty fields are left as they are.
+type Update struct {
+	Symbol         string
+	OrderID        int64
+	ClientOrderID  string
+	Side           string
+	Type           string
+	Price          string
+	Quantity       string
+	Status         string
+	FilledQuantity string         // cumulative
+	AveragePrice   common.Decimal // of the cumulative filled quantity
+	Fill           *Fill
+	Time           int64
+}
+
+// orderKey identify an order, order ids being unique per symbol only
+type orderKey struct {
+	symbol  string
+	orderID int64
+}
+
+// Tracker aggregate updates into the state of orders. Updates may come in any order and more than
+// once: fills are deduplicated by trade ID, the filled quantity only grows and the status only
+// moves forward in the state machine.
+type Tracker struct {
+	mu          sync.RWMutex
+	orders      map[orderKey]*Order
+	byClientID  map[string]*Order
+	subscribers map[int]func(o *Order)
+	nextID      int
+}
+
+// New init a tracker
+func New() *Tracker {
+	return &Tracker{
+		orders:      make(map[orderKey]*Order),
+		byClientID:  make(map[string]*Order),
+		subscribers: make(map[int]func(o *Order)),
+	}
+}
+
+// Apply update the order of u, passing its new state to the subscribers if it changed
+func (t *Tracker) Apply(u *Update) {
+	t.mu.Lock()
+	o, changed := t.apply(u)
+	var subscribers []func(o *Order)
+	if changed {
+		o = o.clone()
+		subscribers = t.sortedSubscribers()
+	}
+	t.mu.Unlock()
+	for _, f := range subscribers {
+		f(o)
+	}
+}
+
+func (t *Tracker) apply(u *Update) (*Order, bool) {
+	key := orderKey{u.Symbol, u.OrderID}
+	o, ok := t.orders[key]
+	if !ok {
+		o = &Order{Symbol: u.Symbol, OrderID: u.OrderID, Commissions: make(map[string]common.Decimal)}
+		t.orders[key] = o
+	}
+	changed := !ok
+	if o.ClientOrderID == "" && u.ClientOrderID != "" {
+		o.ClientOrderID = u.ClientOrderID
+		t.byClientID[u.ClientOrderID] = o
+		changed = true
+	}
+	if o.Side == "" && u.Side != "" {
+		o.Side, changed = u.Side, true
+	}
+	if o.Type == "" && u.Type != "" {
+		o.Type, changed = u.Type, true
+	}
+	if o.Price.IsZero() && u.Price != "" {
+		o.Price = common.ToDecimal(u.Price)
+		changed = changed || !o.Price.IsZero()
+	}
+	if o.Quantity.IsZero() && u.Quantity != "" {
+		o.Quantity = common.ToDecimal(u.Quantity)
+		changed = changed || !o.Quantity.IsZero()
+	}
+	if u.Fill != nil && !o.hasFill(u.Fill.TradeID) {
+		o.addFill(*u.Fill)
+		changed = true
+	}
+	if filled := common.ToDecimal(u.FilledQuantity); filled.GreaterThan(o.FilledQuantity) {
+		o.FilledQuantity = filled
+		if !u.AveragePrice.IsZero() {
+			o.AveragePrice = u.AveragePrice
+		}
+		changed = true
+	}
+	if rank := statusRanks[u.Status]; rank > statusRanks[o.Status] {
+		o.Status = u.Status
+		changed = true
+	}
+	if changed && u.Time > o.UpdateTime {
+		o.UpdateTime = u.Time
+	}
+	return o, changed
+}
+
+func (t *Tracker) sortedSubscribers() []func(o *Order) {
+	ids := make([]int, 0, len(t.subscribers))
+	for id := range t.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	subscribers := make([]func(o *Order), len(ids))
+	for i, id := range ids {
+		subscribers[i] = t.subscribers[id]
+	}
+	return subscribers
+}
+
+// Subscribe call f with the new state of every order that changes, until unsubscribe is called
+func (t *Tracker) Subscribe(f func(o *Order)) (unsubscribe func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := t.nextID
+	t.nextID++
+	t.subscribers[id] = f
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.subscribers, id)
+	}
+}
+
+// Order return a copy of the order with the client order id
+func (t *Tracker) Order(clientOrderID string) (*Order, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	o, ok := t.byClientID[clientOrderID]
+	if !ok {
+		return nil, false
+	}
+	return o.clone(), true
+}
+
+// OrderByID return a copy of the order with the order id
+func (t *Tracker) OrderByID(symbol string, orderID int64) (*Order, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	o, ok := t.orders[orderKey{symbol, orderID}]
+	if !ok {
+		return nil, false
+	}
+	return o.clone(), true
+}
+
+// Orders return copies of the orders, open ones only if openOnly is set, sorted by symbol and id
+func (t *Tracker) Orders(openOnly bool) []*Order {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	orders := []*Order{}
+	for _, o := range t.orders {
+		if openOnly && o.Final() {
+			continue
+		}
+		orders = append(orders, o.clone())
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		if orders[i].Symbol != orders[j].Symbol {
+			return orders[i].Symbol < orders[j].Symbol
+		}
+		return orders[i].OrderID < orders[j].OrderID
+	})
+	return orders
+}
+
+// Forget stop tracking the final orders, to bound the memory of long running trackers
+func (t *Tracker) Forget() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, o := range t.orders {
+		if o.Final() {
+			delete(t.orders, key)
+			// the client order id may have been reused by an order placed since
+			if t.byClientID[o.ClientOrderID] == o {
+				delete(t.byClientID, o.ClientOrderID)
+			}
+		}
+	}
+}
+
+// Backfill apply the updates returned by fetch for the orders that may have missed some, such as
+// after a reconnection: open orders, and unless openOnly is set, final orders whose fills don't add
+// up to the filled quantity. All the orders are fetched, the first error being returned.
+func (t *Tracker) Backfill(ctx context.Context, openOnly bool, fetch func(ctx context.Context, o *Order) ([]*Update, error)) error {
+	t.mu.RLock()
+	var orders []*Order
+	for _, o := range t.orders {
+		filled := common.Decimal{}
+		for _, f := range o.Fills {
+			filled = filled.Add(f.Quantity)
+		}
+		if !o.Final() || (!openOnly && filled.LessThan(o.FilledQuantity)) {
+			orders = append(orders, o.clone())
+		}
+	}
+	t.mu.RUnlock()
+	sort.Slice(orders, func(i, j int) bool { return orders[i].OrderID < orders[j].OrderID })
+
+	var firstErr error
+	for _, o := range orders {
+		updates, err := fetch(ctx, o)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		for _, u := range updates {
+			t.Apply(u)
+		}
+	}
+	return firstErr
+}
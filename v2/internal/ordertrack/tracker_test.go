@@ -0,0 +1,238 @@
+package ordertrack
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type trackerTestSuite struct {
+	suite.Suite
+	tracker *Tracker
+	changes []*Order
+}
+
+func TestTracker(t *testing.T) {
+	suite.Run(t, new(trackerTestSuite))
+}
+
+func (s *trackerTestSuite) SetupTest() {
+	s.tracker = New()
+	s.changes = nil
+	s.tracker.Subscribe(func(o *Order) {
+		s.changes = append(s.changes, o)
+	})
+}
+
+func newOrder(status, filled string, time int64) *Update {
+	return &Update{
+		Symbol:         "BTCUSDT",
+		OrderID:        1,
+		ClientOrderID:  "client",
+		Side:           "BUY",
+		Type:           "LIMIT",
+		Price:          "100.00",
+		Quantity:       "2.00",
+		Status:         status,
+		FilledQuantity: filled,
+		Time:           time,
+	}
+}
+
+func fill(tradeID int64, quantity, commission, asset string) *Update {
+	return &Update{
+		Symbol:  "BTCUSDT",
+		OrderID: 1,
+		Fill: &Fill{
+			TradeID:         tradeID,
+			Price:           common.ToDecimal("100.00"),
+			Quantity:        common.ToDecimal(quantity),
+			Commission:      common.ToDecimal(commission),
+			CommissionAsset: asset,
+			Time:            tradeID * 100,
+		},
+		Time: tradeID * 100,
+	}
+}
+
+func (s *trackerTestSuite) TestLifecycle() {
+	s.tracker.Apply(newOrder(StatusNew, "0.00", 100))
+	u := fill(2, "0.50", "0.001", "BNB")
+	u.Status, u.FilledQuantity, u.AveragePrice = StatusPartiallyFilled, "0.50", common.ToDecimal("100")
+	s.tracker.Apply(u)
+	u = fill(3, "1.50", "0.002", "BNB")
+	u.Status, u.FilledQuantity, u.AveragePrice = StatusFilled, "2.00", common.ToDecimal("100")
+	s.tracker.Apply(u)
+
+	o, ok := s.tracker.Order("client")
+	s.Require().True(ok)
+	s.Equal(StatusFilled, o.Status)
+	s.True(o.Final())
+	s.Equal("BUY", o.Side)
+	s.Equal("LIMIT", o.Type)
+	s.True(o.Price.Equal(common.ToDecimal("100")))
+	s.True(o.Quantity.Equal(common.ToDecimal("2")))
+	s.True(o.FilledQuantity.Equal(common.ToDecimal("2")))
+	s.True(o.AveragePrice.Equal(common.ToDecimal("100")))
+	s.Len(o.Fills, 2)
+	s.True(o.Commissions["BNB"].Equal(common.ToDecimal("0.003")))
+	s.Equal(int64(300), o.UpdateTime)
+	s.Len(s.changes, 3)
+	s.Equal(StatusNew, s.changes[0].Status)
+	s.Equal(StatusPartiallyFilled, s.changes[1].Status)
+
+	byID, ok := s.tracker.OrderByID("BTCUSDT", 1)
+	s.Require().True(ok)
+	s.Equal(o, byID)
+	_, ok = s.tracker.OrderByID("ETHUSDT", 1)
+	s.False(ok)
+	_, ok = s.tracker.Order("other")
+	s.False(ok)
+}
+
+func (s *trackerTestSuite) TestOutOfOrder() {
+	u := fill(3, "1.50", "0.002", "USDT")
+	u.Status, u.FilledQuantity = StatusFilled, "2.00"
+	s.tracker.Apply(u)
+	// older events and duplicates don't move the order back
+	s.tracker.Apply(newOrder(StatusNew, "0.00", 100))
+	u = fill(2, "0.50", "0.001", "BNB")
+	u.Status, u.FilledQuantity = StatusPartiallyFilled, "0.50"
+	s.tracker.Apply(u)
+	s.tracker.Apply(fill(3, "1.50", "0.002", "USDT"))
+	changes := len(s.changes)
+	s.tracker.Apply(fill(2, "0.50", "0.001", "BNB"))
+	s.Len(s.changes, changes)
+
+	o, ok := s.tracker.Order("client")
+	s.Require().True(ok)
+	s.Equal(StatusFilled, o.Status)
+	s.True(o.FilledQuantity.Equal(common.ToDecimal("2")))
+	s.Equal([]int64{2, 3}, []int64{o.Fills[0].TradeID, o.Fills[1].TradeID})
+	s.True(o.Commissions["BNB"].Equal(common.ToDecimal("0.001")))
+	s.True(o.Commissions["USDT"].Equal(common.ToDecimal("0.002")))
+	s.Equal(int64(300), o.UpdateTime)
+}
+
+func (s *trackerTestSuite) TestPendingCancel() {
+	s.tracker.Apply(newOrder(StatusNew, "0", 100))
+	s.tracker.Apply(newOrder(StatusPendingCancel, "0", 200))
+	s.tracker.Apply(newOrder(StatusPartiallyFilled, "1", 150))
+	o, _ := s.tracker.Order("client")
+	s.Equal(StatusPendingCancel, o.Status)
+	s.True(o.FilledQuantity.Equal(common.ToDecimal("1")))
+	s.tracker.Apply(newOrder(StatusCanceled, "1", 300))
+	s.tracker.Apply(newOrder(StatusExpired, "1", 400))
+	o, _ = s.tracker.Order("client")
+	s.Equal(StatusCanceled, o.Status)
+	s.Equal(int64(300), o.UpdateTime)
+}
+
+func (s *trackerTestSuite) TestCopies() {
+	s.tracker.Apply(fill(1, "1", "0.1", "BNB"))
+	o, _ := s.tracker.OrderByID("BTCUSDT", 1)
+	o.Fills[0].TradeID = 10
+	o.Commissions["BNB"] = common.ToDecimal("5")
+	s.changes[0].Status = StatusFilled
+	o, _ = s.tracker.OrderByID("BTCUSDT", 1)
+	s.Equal(int64(1), o.Fills[0].TradeID)
+	s.True(o.Commissions["BNB"].Equal(common.ToDecimal("0.1")))
+	s.Empty(o.Status)
+}
+
+func (s *trackerTestSuite) TestOrdersAndForget() {
+	for _, u := range []*Update{
+		{Symbol: "ETHUSDT", OrderID: 5, ClientOrderID: "a", Status: StatusNew},
+		{Symbol: "BTCUSDT", OrderID: 9, ClientOrderID: "b", Status: StatusFilled},
+		{Symbol: "BTCUSDT", OrderID: 3, ClientOrderID: "c", Status: StatusPartiallyFilled},
+	} {
+		s.tracker.Apply(u)
+	}
+	ids := func(orders []*Order) []int64 {
+		var ids []int64
+		for _, o := range orders {
+			ids = append(ids, o.OrderID)
+		}
+		return ids
+	}
+	s.Equal([]int64{3, 9, 5}, ids(s.tracker.Orders(false)))
+	s.Equal([]int64{3, 5}, ids(s.tracker.Orders(true)))
+
+	s.tracker.Forget()
+	s.Equal([]int64{3, 5}, ids(s.tracker.Orders(false)))
+	_, ok := s.tracker.Order("b")
+	s.False(ok)
+	_, ok = s.tracker.Order("c")
+	s.True(ok)
+}
+
+func (s *trackerTestSuite) TestReusedClientOrderID() {
+	s.tracker.Apply(&Update{Symbol: "BTCUSDT", OrderID: 1, ClientOrderID: "client", Status: StatusCanceled})
+	s.tracker.Apply(&Update{Symbol: "BTCUSDT", OrderID: 2, ClientOrderID: "client", Status: StatusNew})
+	o, ok := s.tracker.Order("client")
+	s.Require().True(ok)
+	s.Equal(int64(2), o.OrderID)
+
+	// forgetting the canceled order keeps the open one reusing its client order id
+	s.tracker.Forget()
+	o, ok = s.tracker.Order("client")
+	s.Require().True(ok)
+	s.Equal(int64(2), o.OrderID)
+	_, ok = s.tracker.OrderByID("BTCUSDT", 1)
+	s.False(ok)
+}
+
+func (s *trackerTestSuite) TestSubscribe() {
+	var other int
+	unsubscribe := s.tracker.Subscribe(func(o *Order) { other++ })
+	s.tracker.Apply(newOrder(StatusNew, "0", 100))
+	s.tracker.Apply(newOrder(StatusNew, "0", 100))
+	unsubscribe()
+	s.tracker.Apply(newOrder(StatusFilled, "2", 200))
+	s.Equal(1, other)
+	s.Len(s.changes, 2)
+}
+
+func (s *trackerTestSuite) TestBackfill() {
+	s.tracker.Apply(&Update{Symbol: "BTCUSDT", OrderID: 1, Status: StatusNew})
+	// filled with all its fills
+	u := fill(10, "1", "0", "")
+	u.OrderID, u.Status, u.FilledQuantity = 2, StatusFilled, "1"
+	s.tracker.Apply(u)
+	// filled with a missed fill
+	u = fill(20, "1", "0", "")
+	u.OrderID, u.Status, u.FilledQuantity = 3, StatusFilled, "2"
+	s.tracker.Apply(u)
+	s.tracker.Apply(&Update{Symbol: "BTCUSDT", OrderID: 4, Status: StatusNew})
+
+	var fetched []int64
+	fetchErr := errors.New("fetch failed")
+	fetch := func(ctx context.Context, o *Order) ([]*Update, error) {
+		fetched = append(fetched, o.OrderID)
+		switch o.OrderID {
+		case 1:
+			u := fill(11, "1", "0", "")
+			u.Status, u.FilledQuantity = StatusFilled, "1"
+			return []*Update{u}, nil
+		case 3:
+			u := fill(21, "1", "0", "")
+			u.OrderID = 3
+			return []*Update{u}, nil
+		}
+		return nil, fetchErr
+	}
+	s.Equal(fetchErr, s.tracker.Backfill(context.Background(), false, fetch))
+	s.Equal([]int64{1, 3, 4}, fetched)
+	o, _ := s.tracker.OrderByID("BTCUSDT", 1)
+	s.Equal(StatusFilled, o.Status)
+	s.Len(o.Fills, 1)
+	o, _ = s.tracker.OrderByID("BTCUSDT", 3)
+	s.Len(o.Fills, 2)
+
+	fetched = nil
+	s.Equal(fetchErr, s.tracker.Backfill(context.Background(), true, fetch))
+	s.Equal([]int64{4}, fetched)
+}
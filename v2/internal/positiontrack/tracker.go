@@ -0,0 +1,408 @@
+// Package positiontrack maintains futures positions and computes their PnL and margin figures,
+// shared by the position trackers of the futures and delivery packages.
+package positiontrack
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// places is the number of decimal places of the computed prices, amounts and ratios
+const places = 8
+
+// Contract define the contract of a symbol. Size is the value of a contract in the quote asset for
+// coin-margined contracts, and is ignored for USDⓈ-M ones.
+type Contract struct {
+	MarginAsset string
+	Size        common.Decimal
+}
+
+// Position define the state of a position, with the figures computed from the mark price
+type Position struct {
+	Symbol            string
+	Side              string // BOTH in one-way mode, LONG or SHORT in hedge mode
+	MarginAsset       string
+	Isolated          bool
+	Amount            common.Decimal // negative for short positions
+	EntryPrice        common.Decimal
+	MarkPrice         common.Decimal
+	Leverage          common.Decimal
+	IsolatedWallet    common.Decimal // margin of an isolated position, without the unrealized PnL
+	MaintenanceMargin common.Decimal // as last reported by the exchange
+	UnrealizedPnL     common.Decimal // in the margin asset
+	Notional          common.Decimal // in the margin asset, negative for short positions
+	InitialMargin     common.Decimal // notional divided by the leverage
+	ROE               common.Decimal // unrealized PnL divided by the initial margin
+	MarginRatio       common.Decimal // of isolated positions, see Tracker.MarginRatio for cross ones
+	UpdateTime        int64
+}
+
+// Update define the state of a position in an event or a snapshot. Empty fields are left as they
+// are.
+type Update struct {
+	Symbol            string
+	Side              string
+	Amount            string
+	EntryPrice        string
+	MarkPrice         string
+	MarginType        string // isolated or cross, in any case
+	IsolatedWallet    string
+	MaintenanceMargin string
+	Leverage          string
+}
+
+// Event define the positions, cross wallet balances by asset and leverages by symbol changed at Time
+type Event struct {
+	Positions []Update
+	Wallets   map[string]string
+	Leverages map[string]string
+	Time      int64
+}
+
+// positionKey identify a position, hedge mode holding a position per side
+type positionKey struct {
+	symbol string
+	side   string
+}
+
+// mark is the last mark price of a symbol
+type mark struct {
+	price common.Decimal
+	time  int64
+}
+
+// Tracker maintain positions from a snapshot and the events following it, keeping the figures
+// computed from the mark prices current. Events received while a snapshot is fetched are buffered
+// and applied on top of it, while the ones received out of sync otherwise are dropped, the next
+// snapshot reflecting them.
+type Tracker struct {
+	inverse bool
+
+	mu          sync.RWMutex
+	synced      bool
+	syncing     bool
+	buffer      []*Event
+	contracts   map[string]Contract
+	positions   map[positionKey]*Position
+	wallets     map[string]common.Decimal
+	leverages   map[string]common.Decimal
+	marks       map[string]mark
+	subscribers map[int]func(p *Position)
+	nextID      int
+}
+
+// New init a tracker of USDⓈ-M positions, or of coin-margined positions if inverse is set
+func New(inverse bool) *Tracker {
+	return &Tracker{
+		inverse:     inverse,
+		contracts:   make(map[string]Contract),
+		positions:   make(map[positionKey]*Position),
+		wallets:     make(map[string]common.Decimal),
+		leverages:   make(map[string]common.Decimal),
+		marks:       make(map[string]mark),
+		subscribers: make(map[int]func(p *Position)),
+	}
+}
+
+// Unsync buffer the events until the next snapshot, to be called before fetching it
+func (t *Tracker) Unsync() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.synced = false
+	t.syncing = true
+	t.buffer = nil
+}
+
+// Abort drop the events buffered since Unsync when the snapshot couldn't be fetched, leaving the
+// tracker out of sync
+func (t *Tracker) Abort() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.syncing = false
+	t.buffer = nil
+}
+
+// Synced report whether the positions reflect the account
+func (t *Tracker) Synced() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.synced
+}
+
+// Reset replace the contracts and positions with the ones of a snapshot, then apply the buffered
+// events
+func (t *Tracker) Reset(contracts map[string]Contract, snapshot *Event) {
+	t.mu.Lock()
+	t.contracts = contracts
+	previous := t.positions
+	t.positions = make(map[positionKey]*Position)
+	t.wallets = make(map[string]common.Decimal)
+	var changed []*Position
+	for _, p := range t.apply(snapshot, true) {
+		// snapshots list the flat positions of every symbol, which are only notified when closed
+		if prev, ok := previous[positionKey{p.Symbol, p.Side}]; !p.Amount.IsZero() || (ok && !prev.Amount.IsZero()) {
+			changed = append(changed, p)
+		}
+	}
+	// positions missing from the snapshot are closed
+	for key, p := range previous {
+		if _, ok := t.positions[key]; !ok && !p.Amount.IsZero() {
+			closed := *p
+			closed.Amount = common.Decimal{}
+			t.compute(&closed)
+			t.positions[key] = &closed
+			changed = append(changed, &closed)
+		}
+	}
+	buffer := t.buffer
+	t.buffer = nil
+	for _, e := range buffer {
+		changed = append(changed, t.apply(e, false)...)
+	}
+	t.synced = true
+	t.syncing = false
+	t.mu.Unlock()
+	t.notify(changed)
+}
+
+// Apply update the positions with e, buffering it while a snapshot is fetched and dropping it
+// while the tracker is out of sync otherwise
+func (t *Tracker) Apply(e *Event) {
+	t.mu.Lock()
+	if !t.synced {
+		if t.syncing {
+			t.buffer = append(t.buffer, e)
+		}
+		t.mu.Unlock()
+		return
+	}
+	changed := t.apply(e, false)
+	t.mu.Unlock()
+	t.notify(changed)
+}
+
+// apply update the positions with e, ignoring the positions updated after it unless it is a
+// snapshot. It returns copies of the changed positions.
+func (t *Tracker) apply(e *Event, snapshot bool) []*Position {
+	for asset, wallet := range e.Wallets {
+		t.wallets[asset] = common.ToDecimal(wallet)
+	}
+	changed := make(map[positionKey]bool)
+	for symbol, leverage := range e.Leverages {
+		t.leverages[symbol] = common.ToDecimal(leverage)
+		for key := range t.positions {
+			if key.symbol == symbol {
+				changed[key] = true
+			}
+		}
+	}
+	for _, u := range e.Positions {
+		key := positionKey{u.Symbol, u.Side}
+		p, ok := t.positions[key]
+		if !ok {
+			p = &Position{Symbol: u.Symbol, Side: u.Side}
+			t.positions[key] = p
+		} else if !snapshot && p.UpdateTime > e.Time {
+			continue
+		}
+		if u.Amount != "" {
+			p.Amount = common.ToDecimal(u.Amount)
+		}
+		if u.EntryPrice != "" {
+			p.EntryPrice = common.ToDecimal(u.EntryPrice)
+		}
+		if u.MarginType != "" {
+			p.Isolated = strings.EqualFold(u.MarginType, "isolated")
+		}
+		if u.IsolatedWallet != "" {
+			p.IsolatedWallet = common.ToDecimal(u.IsolatedWallet)
+		}
+		if u.MaintenanceMargin != "" {
+			p.MaintenanceMargin = common.ToDecimal(u.MaintenanceMargin)
+		}
+		if u.Leverage != "" {
+			t.leverages[u.Symbol] = common.ToDecimal(u.Leverage)
+		}
+		// the mark price of a snapshot has no time, and is only used until a mark price event
+		if m, ok := t.marks[u.Symbol]; u.MarkPrice != "" && (!ok || (!snapshot && m.time <= e.Time)) {
+			t.marks[u.Symbol] = mark{price: common.ToDecimal(u.MarkPrice), time: e.Time}
+		}
+		if e.Time > p.UpdateTime {
+			p.UpdateTime = e.Time
+		}
+		changed[key] = true
+	}
+	return t.computeAll(changed)
+}
+
+// SetMarkPrice update the figures of the positions of symbol with a mark price at time, ignoring
+// it if older than the last one
+func (t *Tracker) SetMarkPrice(symbol string, price string, time int64) {
+	t.mu.Lock()
+	if m, ok := t.marks[symbol]; ok && m.time > time {
+		t.mu.Unlock()
+		return
+	}
+	t.marks[symbol] = mark{price: common.ToDecimal(price), time: time}
+	changed := make(map[positionKey]bool)
+	for key := range t.positions {
+		if key.symbol == symbol {
+			changed[key] = true
+		}
+	}
+	positions := t.computeAll(changed)
+	t.mu.Unlock()
+	t.notify(positions)
+}
+
+// computeAll compute the figures of the positions, returning copies of them sorted by symbol and
+// side
+func (t *Tracker) computeAll(keys map[positionKey]bool) []*Position {
+	positions := make([]*Position, 0, len(keys))
+	for key := range keys {
+		p := t.positions[key]
+		t.compute(p)
+		c := *p
+		positions = append(positions, &c)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+// compute update the figures of p from the mark price of its symbol
+func (t *Tracker) compute(p *Position) {
+	contract := t.contracts[p.Symbol]
+	p.MarginAsset = contract.MarginAsset
+	p.Leverage = t.leverages[p.Symbol]
+	if m, ok := t.marks[p.Symbol]; ok {
+		p.MarkPrice = m.price
+	}
+	p.UnrealizedPnL, p.Notional, p.InitialMargin, p.ROE, p.MarginRatio =
+		common.Decimal{}, common.Decimal{}, common.Decimal{}, common.Decimal{}, common.Decimal{}
+	if t.inverse {
+		// a coin-margined contract is worth Size of the quote asset, the figures are in the base asset
+		if contract.Size.IsZero() || p.MarkPrice.IsZero() || p.EntryPrice.IsZero() {
+			return
+		}
+		value := p.Amount.Mul(contract.Size)
+		p.Notional = value.Div(p.MarkPrice, places, common.RoundHalfUp)
+		p.UnrealizedPnL = value.Div(p.EntryPrice, places, common.RoundHalfUp).Sub(p.Notional)
+	} else {
+		p.Notional = p.Amount.Mul(p.MarkPrice)
+		p.UnrealizedPnL = p.Amount.Mul(p.MarkPrice.Sub(p.EntryPrice))
+	}
+	if !p.Leverage.IsZero() {
+		p.InitialMargin = p.Notional.Abs().Div(p.Leverage, places, common.RoundHalfUp)
+	}
+	if !p.InitialMargin.IsZero() {
+		p.ROE = p.UnrealizedPnL.Div(p.InitialMargin, places, common.RoundHalfUp)
+	}
+	if balance := p.IsolatedWallet.Add(p.UnrealizedPnL); p.Isolated && balance.Sign() > 0 {
+		p.MarginRatio = p.MaintenanceMargin.Div(balance, places, common.RoundHalfUp)
+	}
+}
+
+// MarginRatio return the margin ratio of the cross positions margined in asset: their maintenance
+// margin divided by the cross wallet balance plus their unrealized PnL. It is zero without cross
+// positions or margin balance.
+func (t *Tracker) MarginRatio(asset string) common.Decimal {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	maintenance, balance := common.Decimal{}, t.wallets[asset]
+	for _, p := range t.positions {
+		if p.Isolated || p.MarginAsset != asset || p.Amount.IsZero() {
+			continue
+		}
+		maintenance = maintenance.Add(p.MaintenanceMargin)
+		balance = balance.Add(p.UnrealizedPnL)
+	}
+	if maintenance.IsZero() || balance.Sign() <= 0 {
+		return common.Decimal{}
+	}
+	return maintenance.Div(balance, places, common.RoundHalfUp)
+}
+
+// CrossWalletBalance return the cross wallet balance of asset
+func (t *Tracker) CrossWalletBalance(asset string) common.Decimal {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.wallets[asset]
+}
+
+// Position return a copy of the position of symbol on side
+func (t *Tracker) Position(symbol, side string) (*Position, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	p, ok := t.positions[positionKey{symbol, side}]
+	if !ok {
+		return nil, false
+	}
+	c := *p
+	return &c, true
+}
+
+// Positions return copies of the open positions, sorted by symbol and side
+func (t *Tracker) Positions() []*Position {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	positions := []*Position{}
+	for _, p := range t.positions {
+		if p.Amount.IsZero() {
+			continue
+		}
+		c := *p
+		positions = append(positions, &c)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+func sortPositions(positions []*Position) {
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].Symbol != positions[j].Symbol {
+			return positions[i].Symbol < positions[j].Symbol
+		}
+		return positions[i].Side < positions[j].Side
+	})
+}
+
+// notify pass the positions to the subscribers, outside of the lock so that they can query the
+// tracker
+func (t *Tracker) notify(positions []*Position) {
+	if len(positions) == 0 {
+		return
+	}
+	t.mu.RLock()
+	ids := make([]int, 0, len(t.subscribers))
+	for id := range t.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	subscribers := make([]func(p *Position), len(ids))
+	for i, id := range ids {
+		subscribers[i] = t.subscribers[id]
+	}
+	t.mu.RUnlock()
+	for _, p := range positions {
+		for _, f := range subscribers {
+			f(p)
+		}
+	}
+}
+
+// Subscribe call f with the new state of every position that changes, until unsubscribe is called
+func (t *Tracker) Subscribe(f func(p *Position)) (unsubscribe func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := t.nextID
+	t.nextID++
+	t.subscribers[id] = f
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.subscribers, id)
+	}
+}
@@ -0,0 +1,204 @@
+package positiontrack
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type trackerTestSuite struct {
+	suite.Suite
+	changes []*Position
+}
+
+func TestTracker(t *testing.T) {
+	suite.Run(t, new(trackerTestSuite))
+}
+
+func (s *trackerTestSuite) SetupTest() {
+	s.changes = nil
+}
+
+func (s *trackerTestSuite) newTracker(inverse bool) *Tracker {
+	t := New(inverse)
+	t.Subscribe(func(p *Position) {
+		s.changes = append(s.changes, p)
+	})
+	return t
+}
+
+func (s *trackerTestSuite) assertDecimal(e string, a common.Decimal, field string) {
+	s.True(common.ToDecimal(e).Equal(a), "%s: expected %s, got %s", field, e, a)
+}
+
+func (s *trackerTestSuite) assertFigures(p *Position, pnl, notional, initialMargin, roe, marginRatio string) {
+	s.assertDecimal(pnl, p.UnrealizedPnL, "unrealized PnL")
+	s.assertDecimal(notional, p.Notional, "notional")
+	s.assertDecimal(initialMargin, p.InitialMargin, "initial margin")
+	s.assertDecimal(roe, p.ROE, "ROE")
+	s.assertDecimal(marginRatio, p.MarginRatio, "margin ratio")
+}
+
+var linearContracts = map[string]Contract{
+	"BTCUSDT": {MarginAsset: "USDT"},
+	"ETHUSDT": {MarginAsset: "USDT"},
+}
+
+func (s *trackerTestSuite) TestOneWay() {
+	t := s.newTracker(false)
+	t.Reset(linearContracts, &Event{
+		Positions: []Update{
+			{Symbol: "BTCUSDT", Side: "BOTH", Amount: "0.500", EntryPrice: "30000", MarkPrice: "31000",
+				MarginType: "cross", MaintenanceMargin: "60", Leverage: "10"},
+			{Symbol: "ETHUSDT", Side: "BOTH", Amount: "0", EntryPrice: "0", MarginType: "cross", Leverage: "20"},
+		},
+		Wallets: map[string]string{"USDT": "1000"},
+	})
+	s.True(t.Synced())
+	s.Require().Len(s.changes, 1)
+	p := s.changes[0]
+	s.Equal("USDT", p.MarginAsset)
+	s.False(p.Isolated)
+	s.assertFigures(p, "500", "15500", "1550", "0.32258065", "0")
+	s.assertDecimal("0.04", t.MarginRatio("USDT"), "cross margin ratio")
+	s.assertDecimal("1000", t.CrossWalletBalance("USDT"), "cross wallet")
+
+	t.SetMarkPrice("BTCUSDT", "29000", 100)
+	t.SetMarkPrice("BTCUSDT", "35000", 50)
+	p, ok := t.Position("BTCUSDT", "BOTH")
+	s.Require().True(ok)
+	s.assertDecimal("29000", p.MarkPrice, "mark price")
+	s.assertFigures(p, "-500", "14500", "1450", "-0.34482759", "0")
+	s.assertDecimal("0.12", t.MarginRatio("USDT"), "cross margin ratio")
+
+	// the position is closed, and the realized PnL credited to the wallet
+	t.Apply(&Event{
+		Positions: []Update{{Symbol: "BTCUSDT", Side: "BOTH", Amount: "0", EntryPrice: "0", MaintenanceMargin: "0"}},
+		Wallets:   map[string]string{"USDT": "500"},
+		Time:      200,
+	})
+	p, _ = t.Position("BTCUSDT", "BOTH")
+	s.True(p.Amount.IsZero())
+	s.Equal(int64(200), p.UpdateTime)
+	s.assertFigures(p, "0", "0", "0", "0", "0")
+	s.Empty(t.Positions())
+	s.True(t.MarginRatio("USDT").IsZero())
+	s.Len(s.changes, 3)
+}
+
+func (s *trackerTestSuite) TestHedge() {
+	t := s.newTracker(false)
+	t.Reset(linearContracts, &Event{Wallets: map[string]string{"USDT": "100"}})
+	t.Apply(&Event{
+		Positions: []Update{
+			{Symbol: "ETHUSDT", Side: "LONG", Amount: "2", EntryPrice: "2000", MarginType: "isolated",
+				IsolatedWallet: "400", MaintenanceMargin: "20"},
+			{Symbol: "ETHUSDT", Side: "SHORT", Amount: "-1", EntryPrice: "2100", MarginType: "cross",
+				MaintenanceMargin: "10"},
+		},
+		Time: 100,
+	})
+	t.Apply(&Event{Leverages: map[string]string{"ETHUSDT": "5"}, Time: 110})
+	s.changes = nil
+	t.SetMarkPrice("ETHUSDT", "2050", 120)
+	s.Require().Len(s.changes, 2)
+
+	positions := t.Positions()
+	s.Require().Len(positions, 2)
+	long, short := positions[0], positions[1]
+	s.Equal("LONG", long.Side)
+	s.True(long.Isolated)
+	s.assertDecimal("5", long.Leverage, "leverage")
+	s.assertFigures(long, "100", "4100", "820", "0.12195122", "0.04")
+	s.Equal("SHORT", short.Side)
+	s.False(short.Isolated)
+	s.assertFigures(short, "50", "-2050", "410", "0.12195122", "0")
+	// the isolated position is not part of the cross margin
+	s.assertDecimal("0.06666667", t.MarginRatio("USDT"), "cross margin ratio")
+	s.True(t.MarginRatio("BTC").IsZero())
+	_, ok := t.Position("ETHUSDT", "BOTH")
+	s.False(ok)
+}
+
+func (s *trackerTestSuite) TestInverse() {
+	t := s.newTracker(true)
+	contracts := map[string]Contract{"BTCUSD_PERP": {MarginAsset: "BTC", Size: common.NewDecimal(100, 0)}}
+	t.Reset(contracts, &Event{
+		Positions: []Update{
+			{Symbol: "BTCUSD_PERP", Side: "LONG", Amount: "10", EntryPrice: "20000", MarkPrice: "25000",
+				MarginType: "isolated", IsolatedWallet: "0.01", MaintenanceMargin: "0.0002", Leverage: "20"},
+			{Symbol: "BTCUSD_PERP", Side: "SHORT", Amount: "-10", EntryPrice: "20000", MarginType: "cross",
+				MaintenanceMargin: "0.0002"},
+		},
+		Wallets: map[string]string{"BTC": "0.05"},
+	})
+	long, _ := t.Position("BTCUSD_PERP", "LONG")
+	s.assertFigures(long, "0.01", "0.04", "0.002", "5", "0.01")
+	short, _ := t.Position("BTCUSD_PERP", "SHORT")
+	s.assertFigures(short, "-0.01", "-0.04", "0.002", "-5", "0")
+	s.assertDecimal("0.005", t.MarginRatio("BTC"), "cross margin ratio")
+
+	// without contract size, the figures are unknown
+	t.Apply(&Event{Positions: []Update{{Symbol: "ETHUSD_PERP", Side: "BOTH", Amount: "1", EntryPrice: "2000"}}})
+	t.SetMarkPrice("ETHUSD_PERP", "2100", 1)
+	p, _ := t.Position("ETHUSD_PERP", "BOTH")
+	s.assertFigures(p, "0", "0", "0", "0", "0")
+}
+
+func (s *trackerTestSuite) TestResync() {
+	t := s.newTracker(false)
+	s.False(t.Synced())
+	// buffered while the first snapshot is fetched
+	t.Unsync()
+	t.Apply(&Event{Positions: []Update{{Symbol: "BTCUSDT", Side: "BOTH", Amount: "2", EntryPrice: "100"}}, Time: 100})
+	s.Empty(s.changes)
+	t.Reset(linearContracts, &Event{
+		Positions: []Update{
+			{Symbol: "BTCUSDT", Side: "BOTH", Amount: "1", EntryPrice: "100", MarkPrice: "110", Leverage: "2"},
+			{Symbol: "ETHUSDT", Side: "BOTH", Amount: "3", EntryPrice: "10", MarkPrice: "10", Leverage: "1"},
+		},
+	})
+	p, _ := t.Position("BTCUSDT", "BOTH")
+	s.assertDecimal("2", p.Amount, "amount")
+	s.assertFigures(p, "20", "220", "110", "0.18181818", "0")
+
+	// events older than the position are ignored
+	t.Apply(&Event{Positions: []Update{{Symbol: "BTCUSDT", Side: "BOTH", Amount: "5"}}, Time: 90})
+	p, _ = t.Position("BTCUSDT", "BOTH")
+	s.assertDecimal("2", p.Amount, "amount")
+
+	// a mark price event takes over the mark price of the snapshot
+	t.SetMarkPrice("BTCUSDT", "120", 150)
+	t.Unsync()
+	t.Apply(&Event{Positions: []Update{{Symbol: "BTCUSDT", Side: "BOTH", Amount: "4", EntryPrice: "100"}}, Time: 200})
+	s.changes = nil
+	t.Reset(linearContracts, &Event{
+		Positions: []Update{{Symbol: "BTCUSDT", Side: "BOTH", Amount: "3", EntryPrice: "100", MarkPrice: "110"}},
+	})
+	p, _ = t.Position("BTCUSDT", "BOTH")
+	s.assertDecimal("4", p.Amount, "amount")
+	s.assertDecimal("120", p.MarkPrice, "mark price")
+	// the position missing from the snapshot is closed
+	p, _ = t.Position("ETHUSDT", "BOTH")
+	s.True(p.Amount.IsZero())
+	s.Require().Len(s.changes, 3)
+	s.Equal("BTCUSDT", s.changes[0].Symbol)
+	s.Equal("ETHUSDT", s.changes[1].Symbol)
+	s.True(s.changes[1].Amount.IsZero())
+	s.assertDecimal("4", s.changes[2].Amount, "amount")
+
+	// the events of a failed sync are dropped, as well as the ones following it
+	t.Unsync()
+	t.Apply(&Event{Positions: []Update{{Symbol: "BTCUSDT", Side: "BOTH", Amount: "6"}}, Time: 300})
+	t.Abort()
+	s.False(t.Synced())
+	t.Apply(&Event{Positions: []Update{{Symbol: "BTCUSDT", Side: "BOTH", Amount: "7"}}, Time: 400})
+	s.Empty(t.buffer)
+	s.changes = nil
+	t.Unsync()
+	t.Reset(linearContracts, &Event{Positions: []Update{{Symbol: "BTCUSDT", Side: "BOTH", Amount: "7", EntryPrice: "100"}}})
+	s.True(t.Synced())
+	p, _ = t.Position("BTCUSDT", "BOTH")
+	s.assertDecimal("7", p.Amount, "amount")
+}
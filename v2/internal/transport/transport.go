@@ -0,0 +1,290 @@
+// Package transport implements the REST and websocket plumbing shared by the spot,
+// futures and delivery clients, so that features land on all three markets at once.
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// SecType define the security type of an endpoint
+type SecType int
+
+// Security types
+const (
+	SecTypeNone SecType = iota
+	SecTypeAPIKey
+	SecTypeSigned // if the 'timestamp' parameter is required
+)
+
+const (
+	timestampKey  = "timestamp"
+	signatureKey  = "signature"
+	recvWindowKey = "recvWindow"
+)
+
+// Request define an API request
+type Request struct {
+	Method     string
+	Endpoint   string
+	Query      url.Values
+	Form       url.Values
+	RecvWindow int64
+	SecType    SecType
+	Header     http.Header
+
+	// set by Parse
+	FullURL string
+	Body    io.Reader
+}
+
+// costParams return the query and form parameters the cost of the request depends on, such as
+// the orders of a batch which are sent in the form
+func (r *Request) costParams() url.Values {
+	if len(r.Form) == 0 {
+		return r.Query
+	}
+	query := url.Values{}
+	for k, v := range r.Query {
+		query[k] = v
+	}
+	for k, v := range r.Form {
+		query[k] = append(query[k], v...)
+	}
+	return query
+}
+
+// Client send requests with the settings and hooks of a spot, futures or delivery client
+type Client struct {
+	APIKey      string
+	Signer      common.Signer
+	BaseURL     string
+	TimeOffset  int64
+	RateLimiter *common.RateLimiter
+	RetryPolicy *common.RetryPolicy
+	// Do send an HTTP request
+	Do func(req *http.Request) (*http.Response, error)
+	// Debug log debug messages
+	Debug func(format string, v ...interface{})
+	// OnResponse is called with the status code and headers of every response if set
+	OnResponse common.ResponseHook
+
+	// OrderEndpoint is the endpoint placing orders, which is only retried when
+	// LookupOrder shows the order was not placed
+	OrderEndpoint string
+	// LookupOrder return the JSON of an order by client order id, or an API error
+	// with code common.ErrorCodeNoSuchOrder if it does not exist
+	LookupOrder func(ctx context.Context, symbol, clientOrderID string) ([]byte, error)
+	// SyncTime sync the client with the server time and return the new time offset
+	SyncTime func(ctx context.Context) (int64, error)
+}
+
+func (c *Client) debug(format string, v ...interface{}) {
+	if c.Debug != nil {
+		c.Debug(format, v...)
+	}
+}
+
+// CurrentTimestamp return the current time in milliseconds
+func CurrentTimestamp() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// Parse set the full URL, headers and body of r, signing it if needed
+func (c *Client) Parse(r *Request) (err error) {
+	if r.Query == nil {
+		r.Query = url.Values{}
+	}
+	if r.Form == nil {
+		r.Form = url.Values{}
+	}
+
+	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.Endpoint)
+	if r.RecvWindow > 0 {
+		r.Query.Set(recvWindowKey, fmt.Sprintf("%v", r.RecvWindow))
+	}
+	if r.SecType == SecTypeSigned {
+		r.Query.Set(timestampKey, fmt.Sprintf("%v", CurrentTimestamp()-c.TimeOffset))
+	}
+	queryString := r.Query.Encode()
+	body := &bytes.Buffer{}
+	bodyString := r.Form.Encode()
+	header := http.Header{}
+	if r.Header != nil {
+		header = r.Header.Clone()
+	}
+	if bodyString != "" {
+		header.Set("Content-Type", "application/x-www-form-urlencoded")
+		body = bytes.NewBufferString(bodyString)
+	}
+	if r.SecType == SecTypeAPIKey || r.SecType == SecTypeSigned {
+		header.Set("X-MBX-APIKEY", c.APIKey)
+	}
+
+	if r.SecType == SecTypeSigned {
+		raw := fmt.Sprintf("%s%s", queryString, bodyString)
+		signature, err := c.Signer.Sign([]byte(raw))
+		if err != nil {
+			return err
+		}
+		v := url.Values{}
+		v.Set(signatureKey, signature)
+		if queryString == "" {
+			queryString = v.Encode()
+		} else {
+			queryString = fmt.Sprintf("%s&%s", queryString, v.Encode())
+		}
+	}
+	if queryString != "" {
+		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
+	}
+	c.debug("full url: %s, body: %s", fullURL, bodyString)
+
+	r.FullURL = fullURL
+	r.Header = header
+	r.Body = body
+	return nil
+}
+
+// Call send r, retrying it following RetryPolicy, and return the response body and headers
+func (c *Client) Call(ctx context.Context, r *Request) (data []byte, header http.Header, err error) {
+	for attempt := 1; ; attempt++ {
+		var statusCode int
+		data, header, statusCode, err = c.send(ctx, r)
+		if err == nil || c.RetryPolicy == nil || attempt >= c.RetryPolicy.MaxAttempts {
+			return data, header, err
+		}
+		retry, res := c.retryable(ctx, r, statusCode, err)
+		if res != nil {
+			return res, http.Header{}, nil
+		}
+		if !retry || c.RetryPolicy.Wait(ctx, attempt) != nil {
+			return data, header, err
+		}
+		c.debug("retrying request, attempt %d: %s", attempt+1, err)
+	}
+}
+
+// send make a single attempt of a request, the status code is 0 if no response was received
+func (c *Client) send(ctx context.Context, r *Request) (data []byte, header http.Header, statusCode int, err error) {
+	// wait for the weight before stamping and signing the request, which must reach the
+	// server within recvWindow of its timestamp
+	if c.RateLimiter != nil {
+		err = c.RateLimiter.Wait(ctx, r.Method, r.Endpoint, r.costParams())
+		if err != nil {
+			return []byte{}, http.Header{}, 0, err
+		}
+	}
+	err = c.Parse(r)
+	if err != nil {
+		return []byte{}, http.Header{}, 0, err
+	}
+	req, err := http.NewRequest(r.Method, r.FullURL, r.Body)
+	if err != nil {
+		return []byte{}, http.Header{}, 0, err
+	}
+	req = req.WithContext(ctx)
+	req.Header = r.Header
+	c.debug("request: %#v", req)
+	res, err := c.Do(req)
+	if err != nil {
+		return []byte{}, http.Header{}, 0, err
+	}
+	data, err = ioutil.ReadAll(res.Body)
+	if err != nil {
+		return []byte{}, http.Header{}, 0, err
+	}
+	defer func() {
+		cerr := res.Body.Close()
+		// Only overwrite the retured error if the original error was nil and an
+		// error occurred while closing the body.
+		if err == nil && cerr != nil {
+			err = cerr
+		}
+	}()
+	c.debug("response: %#v", res)
+	c.debug("response body: %s", string(data))
+	c.debug("response status code: %d", res.StatusCode)
+	if c.RateLimiter != nil {
+		c.RateLimiter.Update(res.StatusCode, res.Header)
+	}
+	if c.OnResponse != nil {
+		c.OnResponse(r.Method, r.Endpoint, res.StatusCode, res.Header)
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		apiErr := &common.APIError{StatusCode: res.StatusCode, Header: res.Header}
+		e := json.Unmarshal(data, apiErr)
+		if e != nil {
+			c.debug("failed to unmarshal json: %s", e)
+			apiErr.Body = data
+		}
+		return nil, http.Header{}, res.StatusCode, apiErr
+	}
+	if res.Header == nil {
+		res.Header = http.Header{}
+	}
+	return data, res.Header, res.StatusCode, nil
+}
+
+// retryable check if a failed request may be sent again. Requests rejected for their timestamp
+// are retried once the server time is synced. An order placement is only retried if looking up
+// its client order id shows it was not placed, the order found is returned otherwise.
+func (c *Client) retryable(ctx context.Context, r *Request, statusCode int, err error) (retry bool, res []byte) {
+	if common.IsAPIErrorCode(err, common.ErrorCodeInvalidTimestamp) {
+		if c.SyncTime == nil {
+			return false, nil
+		}
+		offset, e := c.SyncTime(ctx)
+		if e != nil {
+			return false, nil
+		}
+		c.TimeOffset = offset
+		return true, nil
+	}
+	if !common.IsTransientError(statusCode, err) {
+		return false, nil
+	}
+	switch {
+	case r.Method == http.MethodGet:
+		return true, nil
+	case r.Method == http.MethodPost && r.Endpoint == c.OrderEndpoint && c.LookupOrder != nil:
+		clientOrderID := r.Form.Get("newClientOrderId")
+		if clientOrderID == "" {
+			return false, nil
+		}
+		res, e := c.LookupOrder(ctx, r.Form.Get("symbol"), clientOrderID)
+		if e != nil {
+			return common.IsAPIErrorCode(e, common.ErrorCodeNoSuchOrder), nil
+		}
+		return false, res
+	}
+	return false, nil
+}
+
+// SplitBatchResponse split the items of a batch response, returning the error of the items
+// which are a *common.APIError at their index
+func SplitBatchResponse(data []byte) (items []json.RawMessage, errs []error, err error) {
+	items = make([]json.RawMessage, 0)
+	err = json.Unmarshal(data, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+	errs = make([]error, len(items))
+	for i, j := range items {
+		apiErr := new(common.APIError)
+		if err := json.Unmarshal(j, apiErr); err == nil && apiErr.Code != 0 {
+			errs[i] = apiErr
+		}
+	}
+	return items, errs, nil
+}
@@ -0,0 +1,185 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type transportTestSuite struct {
+	suite.Suite
+	requests  []*http.Request
+	responses []*http.Response
+}
+
+func TestTransport(t *testing.T) {
+	suite.Run(t, new(transportTestSuite))
+}
+
+func (s *transportTestSuite) SetupTest() {
+	s.requests = nil
+	s.responses = nil
+}
+
+func (s *transportTestSuite) respond(statusCode int, body string, header http.Header) {
+	s.responses = append(s.responses, &http.Response{
+		StatusCode: statusCode,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		Header:     header,
+	})
+}
+
+func (s *transportTestSuite) client() *Client {
+	return &Client{
+		APIKey:  "key",
+		Signer:  common.NewHMACSigner("secret"),
+		BaseURL: "https://api.binance.com",
+		Do: func(req *http.Request) (*http.Response, error) {
+			s.requests = append(s.requests, req)
+			res := s.responses[0]
+			s.responses = s.responses[1:]
+			return res, nil
+		},
+	}
+}
+
+func (s *transportTestSuite) TestParseSigned() {
+	c := s.client()
+	r := &Request{
+		Method:   http.MethodPost,
+		Endpoint: "/api/v3/order",
+		Query:    url.Values{"symbol": {"BTCUSDT"}},
+		Form:     url.Values{"side": {"BUY"}},
+		SecType:  SecTypeSigned,
+	}
+	s.Require().NoError(c.Parse(r))
+
+	u, err := url.Parse(r.FullURL)
+	s.Require().NoError(err)
+	q := u.Query()
+	s.Equal("/api/v3/order", u.Path)
+	s.Equal("BTCUSDT", q.Get("symbol"))
+	s.NotEmpty(q.Get(timestampKey))
+
+	body, err := ioutil.ReadAll(r.Body)
+	s.Require().NoError(err)
+	s.Equal("side=BUY", string(body))
+	q.Del(signatureKey)
+	signature, err := c.Signer.Sign([]byte(q.Encode() + string(body)))
+	s.Require().NoError(err)
+	s.Equal(signature, u.Query().Get(signatureKey))
+	s.Equal("key", r.Header.Get("X-MBX-APIKEY"))
+	s.Equal("application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+}
+
+func (s *transportTestSuite) TestCallResponseHook() {
+	c := s.client()
+	var gotEndpoint, gotWeight string
+	c.OnResponse = func(method, endpoint string, statusCode int, header http.Header) {
+		gotEndpoint, gotWeight = endpoint, header.Get("X-Mbx-Used-Weight-1m")
+	}
+	s.respond(http.StatusOK, "{}", http.Header{"X-Mbx-Used-Weight-1m": {"7"}})
+
+	data, header, err := c.Call(context.Background(), &Request{Method: http.MethodGet, Endpoint: "/api/v3/time"})
+	s.Require().NoError(err)
+	s.Equal("{}", string(data))
+	s.Equal("7", header.Get("X-Mbx-Used-Weight-1m"))
+	s.Equal("/api/v3/time", gotEndpoint)
+	s.Equal("7", gotWeight)
+}
+
+func (s *transportTestSuite) TestCallRetry() {
+	c := s.client()
+	c.RetryPolicy = &common.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	s.respond(http.StatusServiceUnavailable, "", nil)
+	s.respond(http.StatusOK, `{"serverTime":1}`, nil)
+
+	data, _, err := c.Call(context.Background(), &Request{Method: http.MethodGet, Endpoint: "/api/v3/time"})
+	s.Require().NoError(err)
+	s.Equal(`{"serverTime":1}`, string(data))
+	s.Len(s.requests, 2)
+}
+
+func (s *transportTestSuite) TestCallNoRetryOnPost() {
+	c := s.client()
+	c.RetryPolicy = &common.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	s.respond(http.StatusServiceUnavailable, `{"code":-1001,"msg":"internal error"}`, nil)
+
+	_, _, err := c.Call(context.Background(), &Request{Method: http.MethodPost, Endpoint: "/api/v3/userDataStream"})
+	s.Require().Error(err)
+	s.True(common.IsAPIErrorCode(err, common.ErrorCodeInternal))
+	s.Len(s.requests, 1)
+}
+
+func (s *transportTestSuite) TestCallErrorResponse() {
+	c := s.client()
+	s.respond(http.StatusTooManyRequests, `{"code":-1003,"msg":"Too many requests."}`,
+		http.Header{"Retry-After": {"30"}, "X-Mbx-Used-Weight-1m": {"1300"}})
+	s.respond(http.StatusBadGateway, `<html>Bad Gateway</html>`, nil)
+
+	_, _, err := c.Call(context.Background(), &Request{Method: http.MethodGet, Endpoint: "/api/v3/time"})
+	s.Require().True(errors.Is(err, common.ErrRateLimited), "got %v", err)
+	var apiErr *common.APIError
+	s.Require().True(errors.As(err, &apiErr))
+	s.Equal(int64(-1003), apiErr.Code)
+	s.Equal(30*time.Second, apiErr.RetryAfter())
+	s.Equal(int64(1300), apiErr.UsedWeight())
+
+	_, _, err = c.Call(context.Background(), &Request{Method: http.MethodPost, Endpoint: "/api/v3/order"})
+	s.True(errors.Is(err, common.ErrUnknownExecutionStatus), "got %v", err)
+	s.EqualError(err, "<APIError> status=502, body=<html>Bad Gateway</html>")
+}
+
+type signerFunc func(payload []byte) (string, error)
+
+func (f signerFunc) Sign(payload []byte) (string, error) {
+	return f(payload)
+}
+
+func (s *transportTestSuite) TestCallSignAfterRateLimit() {
+	c := s.client()
+	var steps []string
+	c.Signer = signerFunc(func(payload []byte) (string, error) {
+		steps = append(steps, "sign")
+		return "signature", nil
+	})
+	c.RateLimiter = common.NewRateLimiter(nil, func(method, endpoint string, query url.Values) (int64, int64) {
+		steps = append(steps, "wait")
+		return 1, 0
+	})
+	s.respond(http.StatusOK, "{}", nil)
+
+	_, _, err := c.Call(context.Background(), &Request{Method: http.MethodGet, Endpoint: "/api/v3/account", SecType: SecTypeSigned})
+	s.Require().NoError(err)
+	s.Equal([]string{"wait", "sign"}, steps)
+}
+
+func (s *transportTestSuite) TestCallCostForm() {
+	c := s.client()
+	var costQuery url.Values
+	c.RateLimiter = common.NewRateLimiter(nil, func(method, endpoint string, query url.Values) (int64, int64) {
+		costQuery = query
+		return 5, common.BatchOrderCount(query)
+	})
+	s.respond(http.StatusOK, "[]", nil)
+
+	r := &Request{
+		Method:   http.MethodPost,
+		Endpoint: "/fapi/v1/batchOrders",
+		Query:    url.Values{"recvWindow": {"5000"}},
+		Form:     url.Values{"batchOrders": {`[{"symbol":"BTCUSDT"},{"symbol":"ETHUSDT"}]`}},
+	}
+	_, _, err := c.Call(context.Background(), r)
+	s.Require().NoError(err)
+	s.Equal("5000", costQuery.Get("recvWindow"))
+	s.Equal(int64(2), common.BatchOrderCount(costQuery))
+	s.Equal(url.Values{"recvWindow": {"5000"}}, r.Query)
+}
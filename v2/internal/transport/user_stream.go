@@ -0,0 +1,231 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// UserStreamConfig define the listen key services and the stream served by a UserStream
+type UserStreamConfig struct {
+	Start     func(ctx context.Context) (string, error)
+	Keepalive func(ctx context.Context, listenKey string) error
+	Close     func(ctx context.Context, listenKey string) error
+	// Serve open the stream of listenKey, calling expired when the server sends listenKeyExpired
+	Serve func(listenKey string, expired func()) (doneC, stopC chan struct{}, err error)
+	// KeepaliveInterval is the delay between keepalive requests
+	KeepaliveInterval time.Duration
+	// Reconnect is the backoff between reopening attempts, which are unlimited if nil
+	Reconnect  *WsReconnectPolicy
+	ErrHandler ErrHandler
+	// OnResync is called after the stream is reopened, as the events sent meanwhile are lost
+	OnResync func()
+}
+
+// UserStream keep a user data stream open: it keeps the listen key alive, and reopens the stream
+// with a new listen key when it expires, or with the same one when the connection drops
+type UserStream struct {
+	cfg   *UserStreamConfig
+	stopC chan struct{}
+	doneC chan struct{}
+	once  sync.Once
+
+	mu        sync.Mutex
+	listenKey string
+}
+
+// userStreamConn is a connection of the stream
+type userStreamConn struct {
+	doneC    chan struct{}
+	stopC    chan struct{}
+	expiredC chan struct{}
+}
+
+// NewUserStream init a user stream, opened by Start
+func NewUserStream(cfg *UserStreamConfig) *UserStream {
+	return &UserStream{
+		cfg:   cfg,
+		stopC: make(chan struct{}),
+		doneC: make(chan struct{}),
+	}
+}
+
+// Start create the listen key and open the stream, which is kept open until ctx is done or Stop
+// is called
+func (s *UserStream) Start(ctx context.Context) error {
+	listenKey, err := s.cfg.Start(ctx)
+	if err != nil {
+		return err
+	}
+	conn, err := s.serve(listenKey)
+	if err != nil {
+		s.cfg.Close(ctx, listenKey)
+		return err
+	}
+	s.setListenKey(listenKey)
+	go s.run(ctx, conn)
+	return nil
+}
+
+// Stop close the stream and its listen key, and wait for them to be closed
+func (s *UserStream) Stop() {
+	s.once.Do(func() { close(s.stopC) })
+	<-s.doneC
+}
+
+// Done is closed once the stream is stopped, or gives up reopening
+func (s *UserStream) Done() <-chan struct{} {
+	return s.doneC
+}
+
+// ListenKey return the current listen key
+func (s *UserStream) ListenKey() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.listenKey
+}
+
+func (s *UserStream) setListenKey(listenKey string) {
+	s.mu.Lock()
+	s.listenKey = listenKey
+	s.mu.Unlock()
+}
+
+func (s *UserStream) serve(listenKey string) (*userStreamConn, error) {
+	conn := &userStreamConn{expiredC: make(chan struct{}, 1)}
+	var err error
+	conn.doneC, conn.stopC, err = s.cfg.Serve(listenKey, func() {
+		select {
+		case conn.expiredC <- struct{}{}:
+		default:
+		}
+	})
+	return conn, err
+}
+
+func (s *UserStream) run(ctx context.Context, conn *userStreamConn) {
+	defer close(s.doneC)
+	keepalive := time.NewTicker(s.cfg.KeepaliveInterval)
+	defer keepalive.Stop()
+	for {
+		var cause error
+		renew := false
+		select {
+		case <-s.stopC:
+			s.close(conn)
+			return
+		case <-ctx.Done():
+			s.close(conn)
+			return
+		case <-keepalive.C:
+			err := s.cfg.Keepalive(ctx, s.ListenKey())
+			if err == nil {
+				continue
+			}
+			s.cfg.ErrHandler(err)
+			// a missed keepalive is caught up by the next one, unless the key is gone
+			if !common.IsAPIError(err) {
+				continue
+			}
+			cause, renew = err, true
+		case <-conn.expiredC:
+			cause, renew = errListenKeyExpired, true
+		case <-conn.doneC:
+			cause = errUserStreamDropped
+		}
+		close(conn.stopC)
+		<-conn.doneC
+		if conn = s.reopen(ctx, cause, renew); conn == nil {
+			return
+		}
+		keepalive.Reset(s.cfg.KeepaliveInterval)
+	}
+}
+
+var (
+	errListenKeyExpired  = errors.New("user stream: listen key expired")
+	errUserStreamDropped = errors.New("user stream: connection dropped")
+)
+
+// reopen open the stream again, with a new listen key if renew is set or if the current one can't
+// be kept alive. It returns nil if the stream is stopped or the reconnect policy gives up.
+func (s *UserStream) reopen(ctx context.Context, cause error, renew bool) *userStreamConn {
+	downSince := time.Now()
+	policy := s.cfg.Reconnect
+	if policy == nil {
+		policy = NewWsReconnectPolicy()
+	}
+	for attempt := 1; policy.MaxAttempts <= 0 || attempt <= policy.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			timer := time.NewTimer(policy.Backoff(attempt - 1))
+			select {
+			case <-s.stopC:
+				timer.Stop()
+				s.closeListenKey()
+				return nil
+			case <-ctx.Done():
+				timer.Stop()
+				s.closeListenKey()
+				return nil
+			case <-timer.C:
+			}
+		}
+		conn, err := s.reopenOnce(ctx, renew)
+		if err != nil {
+			s.cfg.ErrHandler(err)
+			continue
+		}
+		s.cfg.ErrHandler(&WsReconnectedEvent{
+			Attempts: attempt,
+			Cause:    cause,
+			Downtime: time.Since(downSince),
+		})
+		if s.cfg.OnResync != nil {
+			s.cfg.OnResync()
+		}
+		return conn
+	}
+	s.cfg.ErrHandler(ErrWsReconnectAttemptsExhausted)
+	s.closeListenKey()
+	return nil
+}
+
+func (s *UserStream) reopenOnce(ctx context.Context, renew bool) (*userStreamConn, error) {
+	listenKey := s.ListenKey()
+	if !renew {
+		if err := s.cfg.Keepalive(ctx, listenKey); err != nil {
+			if !common.IsAPIError(err) {
+				return nil, err
+			}
+			renew = true
+		}
+	}
+	if renew {
+		newKey, err := s.cfg.Start(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if newKey != listenKey {
+			s.cfg.Close(ctx, listenKey)
+		}
+		listenKey = newKey
+		s.setListenKey(listenKey)
+	}
+	return s.serve(listenKey)
+}
+
+// close stop conn and close the listen key
+func (s *UserStream) close(conn *userStreamConn) {
+	close(conn.stopC)
+	<-conn.doneC
+	s.closeListenKey()
+}
+
+func (s *UserStream) closeListenKey() {
+	if err := s.cfg.Close(context.Background(), s.ListenKey()); err != nil {
+		s.cfg.ErrHandler(err)
+	}
+}
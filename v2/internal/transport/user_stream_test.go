@@ -0,0 +1,226 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type userStreamTestSuite struct {
+	suite.Suite
+	mu           sync.Mutex
+	calls        []string
+	keys         int
+	conns        []*fakeUserStreamConn
+	errs         []error
+	resyncs      int
+	keepaliveErr error
+	serveErr     error
+}
+
+// fakeUserStreamConn is a connection served by the suite, dropped by closing dropC
+type fakeUserStreamConn struct {
+	expired func()
+	dropC   chan struct{}
+}
+
+func TestUserStream(t *testing.T) {
+	suite.Run(t, new(userStreamTestSuite))
+}
+
+func (s *userStreamTestSuite) SetupTest() {
+	s.calls, s.keys, s.conns, s.errs, s.resyncs = nil, 0, nil, nil, 0
+	s.keepaliveErr, s.serveErr = nil, nil
+}
+
+func (s *userStreamTestSuite) call(format string, args ...interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, fmt.Sprintf(format, args...))
+}
+
+func (s *userStreamTestSuite) called() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.calls...)
+}
+
+func (s *userStreamTestSuite) conn(i int) *fakeUserStreamConn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns[i]
+}
+
+func (s *userStreamTestSuite) errors() []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]error(nil), s.errs...)
+}
+
+func (s *userStreamTestSuite) stream(keepalive time.Duration, policy *WsReconnectPolicy) *UserStream {
+	return NewUserStream(&UserStreamConfig{
+		Start: func(ctx context.Context) (string, error) {
+			s.mu.Lock()
+			s.keys++
+			key := fmt.Sprintf("key%d", s.keys)
+			s.mu.Unlock()
+			s.call("start %s", key)
+			return key, nil
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			s.call("keepalive %s", listenKey)
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return s.keepaliveErr
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			s.call("close %s", listenKey)
+			return nil
+		},
+		Serve: func(listenKey string, expired func()) (doneC, stopC chan struct{}, err error) {
+			s.call("serve %s", listenKey)
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.serveErr != nil {
+				return nil, nil, s.serveErr
+			}
+			conn := &fakeUserStreamConn{expired: expired, dropC: make(chan struct{})}
+			s.conns = append(s.conns, conn)
+			doneC, stopC = make(chan struct{}), make(chan struct{})
+			go func() {
+				select {
+				case <-stopC:
+				case <-conn.dropC:
+				}
+				close(doneC)
+			}()
+			return doneC, stopC, nil
+		},
+		KeepaliveInterval: keepalive,
+		Reconnect:         policy,
+		ErrHandler: func(err error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.errs = append(s.errs, err)
+		},
+		OnResync: func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.resyncs++
+		},
+	})
+}
+
+func (s *userStreamTestSuite) waitCalls(n int) {
+	s.Eventually(func() bool { return len(s.called()) >= n }, time.Second, time.Millisecond)
+}
+
+func (s *userStreamTestSuite) TestKeepalive() {
+	stream := s.stream(5*time.Millisecond, nil)
+	s.Require().NoError(stream.Start(context.Background()))
+	s.waitCalls(4)
+	stream.Stop()
+	calls := s.called()
+	s.Equal([]string{"start key1", "serve key1", "keepalive key1", "keepalive key1"}, calls[:4])
+	s.Equal("close key1", calls[len(calls)-1])
+	s.Empty(s.errors())
+	s.Zero(s.resyncs)
+}
+
+func (s *userStreamTestSuite) TestKeepaliveError() {
+	s.keepaliveErr = errors.New("timeout")
+	stream := s.stream(5*time.Millisecond, nil)
+	s.Require().NoError(stream.Start(context.Background()))
+	s.waitCalls(4)
+	stream.Stop()
+	// transient errors are reported and caught up by the next keepalive
+	s.Equal([]string{"start key1", "serve key1", "keepalive key1", "keepalive key1"}, s.called()[:4])
+	s.Equal(s.keepaliveErr, s.errors()[0])
+	s.Equal("key1", stream.ListenKey())
+}
+
+func (s *userStreamTestSuite) TestExpired() {
+	stream := s.stream(time.Hour, nil)
+	s.Require().NoError(stream.Start(context.Background()))
+	s.conn(0).expired()
+	s.waitCalls(5)
+	s.Equal([]string{"start key1", "serve key1", "start key2", "close key1", "serve key2"}, s.called())
+	s.Equal("key2", stream.ListenKey())
+	s.Eventually(func() bool { return len(s.errors()) == 1 }, time.Second, time.Millisecond)
+	event := new(WsReconnectedEvent)
+	s.Require().ErrorAs(s.errors()[0], &event)
+	s.Equal(1, event.Attempts)
+	s.Equal(errListenKeyExpired, event.Cause)
+
+	stream.Stop()
+	s.Equal("close key2", s.called()[5])
+	s.Equal(1, s.resyncs)
+}
+
+func (s *userStreamTestSuite) TestKeyGone() {
+	s.keepaliveErr = &common.APIError{Code: -1125, Message: "This listenKey does not exist."}
+	stream := s.stream(5*time.Millisecond, nil)
+	s.Require().NoError(stream.Start(context.Background()))
+	s.waitCalls(6)
+	stream.Stop()
+	s.Equal([]string{"start key1", "serve key1", "keepalive key1", "start key2", "close key1", "serve key2"}, s.called()[:6])
+	s.Equal(1, s.resyncs)
+}
+
+func (s *userStreamTestSuite) TestDropped() {
+	stream := s.stream(time.Hour, nil)
+	s.Require().NoError(stream.Start(context.Background()))
+	close(s.conn(0).dropC)
+	s.waitCalls(4)
+	s.Equal([]string{"start key1", "serve key1", "keepalive key1", "serve key1"}, s.called())
+
+	// the listen key is renewed if it can't be kept alive
+	s.mu.Lock()
+	s.keepaliveErr = &common.APIError{Code: -1125, Message: "This listenKey does not exist."}
+	s.mu.Unlock()
+	close(s.conn(1).dropC)
+	s.waitCalls(8)
+	s.Equal([]string{"keepalive key1", "start key2", "close key1", "serve key2"}, s.called()[4:8])
+	stream.Stop()
+	s.Equal(2, s.resyncs)
+}
+
+func (s *userStreamTestSuite) TestGiveUp() {
+	stream := s.stream(time.Hour, &WsReconnectPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond})
+	s.Require().NoError(stream.Start(context.Background()))
+	s.mu.Lock()
+	s.serveErr = errors.New("dial failed")
+	s.mu.Unlock()
+	close(s.conn(0).dropC)
+	select {
+	case <-stream.Done():
+	case <-time.After(time.Second):
+		s.FailNow("stream not done")
+	}
+	s.Equal([]string{"start key1", "serve key1", "keepalive key1", "serve key1", "keepalive key1", "serve key1", "close key1"}, s.called())
+	s.Equal([]error{s.serveErr, s.serveErr, ErrWsReconnectAttemptsExhausted}, s.errors())
+	s.Zero(s.resyncs)
+	stream.Stop()
+}
+
+func (s *userStreamTestSuite) TestContextDone() {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := s.stream(time.Hour, nil)
+	s.Require().NoError(stream.Start(ctx))
+	cancel()
+	<-stream.Done()
+	s.Equal([]string{"start key1", "serve key1", "close key1"}, s.called())
+}
+
+func (s *userStreamTestSuite) TestStartError() {
+	s.serveErr = errors.New("dial failed")
+	stream := s.stream(time.Hour, nil)
+	s.Equal(s.serveErr, stream.Start(context.Background()))
+	s.Equal([]string{"start key1", "serve key1", "close key1"}, s.called())
+}
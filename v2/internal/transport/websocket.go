@@ -0,0 +1,224 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// WsHandler handle raw websocket message
+type WsHandler func(message []byte)
+
+// ErrHandler handles errors
+type ErrHandler func(err error)
+
+// ErrWsReconnectAttemptsExhausted is passed to the ErrHandler when a stream gives up redialing
+var ErrWsReconnectAttemptsExhausted = errors.New("websocket: reconnect attempts exhausted")
+
+// WsConfig webservice configuration
+type WsConfig struct {
+	Endpoint  string
+	Reconnect *WsReconnectPolicy
+	// Keepalive pings the server and closes the connection if no pong is received within Timeout
+	Keepalive bool
+	Timeout   time.Duration
+}
+
+// WsReconnectPolicy define how a dropped websocket stream is redialed
+type WsReconnectPolicy struct {
+	// MaxAttempts is the number of consecutive failed dials before giving up, 0 means unlimited
+	MaxAttempts int
+	// InitialBackoff is the delay before the first redial
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between redials
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after each failed redial
+	Multiplier float64
+	// Jitter randomizes each delay by up to this fraction of it, between 0 and 1
+	Jitter float64
+}
+
+// NewWsReconnectPolicy returns a policy with exponential backoff from 1s up to 1min
+// and unlimited attempts
+func NewWsReconnectPolicy() *WsReconnectPolicy {
+	return &WsReconnectPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// Backoff return the delay before the given redial attempt, starting at 1
+func (p *WsReconnectPolicy) Backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	d := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d += d * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(d)
+}
+
+// WsReconnectedEvent is passed to the ErrHandler after a dropped stream has been redialed.
+// Messages sent by the server while the stream was down are lost, so consumers keeping
+// local state should resync it.
+type WsReconnectedEvent struct {
+	Endpoint string
+	Attempts int
+	Cause    error
+	Downtime time.Duration
+}
+
+// Error return a description of the reconnection
+func (e *WsReconnectedEvent) Error() string {
+	return fmt.Sprintf("websocket: reconnected to %s after %d attempt(s), down for %s: %v",
+		e.Endpoint, e.Attempts, e.Downtime, e.Cause)
+}
+
+// Unwrap return the error that dropped the stream
+func (e *WsReconnectedEvent) Unwrap() error {
+	return e.Cause
+}
+
+// WsServe dial cfg.Endpoint and pass its messages to handler until stopC is closed
+func WsServe(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	c, err := WsDial(cfg.Endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		// This function will exit either on error from
+		// websocket.Conn.ReadMessage or when the stopC channel is
+		// closed by the client. With a reconnect policy, read errors
+		// redial the endpoint instead.
+		defer close(doneC)
+		for {
+			readErr := wsRead(cfg, c, handler, errHandler, stopC)
+			if readErr == nil || cfg.Reconnect == nil {
+				return
+			}
+			c = wsRedial(cfg, readErr, errHandler, stopC)
+			if c == nil {
+				return
+			}
+		}
+	}()
+	return
+}
+
+// WsDial open a websocket connection to endpoint
+func WsDial(endpoint string) (*websocket.Conn, error) {
+	Dialer := websocket.Dialer{
+		Proxy:             http.ProxyFromEnvironment,
+		HandshakeTimeout:  45 * time.Second,
+		EnableCompression: false,
+	}
+
+	c, _, err := Dialer.Dial(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.SetReadLimit(655350)
+	return c, nil
+}
+
+// wsRead dispatch messages from c until it fails or stopC is closed.
+// It returns the read error, or nil if the stream was stopped.
+func wsRead(cfg *WsConfig, c *websocket.Conn, handler WsHandler, errHandler ErrHandler, stopC chan struct{}) error {
+	if cfg.Keepalive {
+		keepAlive(c, cfg.Timeout)
+	}
+	// Wait for the stopC channel to be closed.  We do that in a
+	// separate goroutine because ReadMessage is a blocking
+	// operation.
+	readDoneC := make(chan struct{})
+	defer close(readDoneC)
+	go func() {
+		select {
+		case <-stopC:
+		case <-readDoneC:
+		}
+		c.Close()
+	}()
+	for {
+		_, message, err := c.ReadMessage()
+		if err != nil {
+			select {
+			case <-stopC:
+				return nil
+			default:
+			}
+			errHandler(err)
+			return err
+		}
+		handler(message)
+	}
+}
+
+// wsRedial dial cfg.Endpoint again following cfg.Reconnect. It returns nil
+// if stopC is closed or the policy gives up.
+func wsRedial(cfg *WsConfig, cause error, errHandler ErrHandler, stopC chan struct{}) *websocket.Conn {
+	downSince := time.Now()
+	for attempt := 1; cfg.Reconnect.MaxAttempts <= 0 || attempt <= cfg.Reconnect.MaxAttempts; attempt++ {
+		timer := time.NewTimer(cfg.Reconnect.Backoff(attempt))
+		select {
+		case <-stopC:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		c, err := WsDial(cfg.Endpoint)
+		if err != nil {
+			errHandler(err)
+			continue
+		}
+		errHandler(&WsReconnectedEvent{
+			Endpoint: cfg.Endpoint,
+			Attempts: attempt,
+			Cause:    cause,
+			Downtime: time.Since(downSince),
+		})
+		return c
+	}
+	errHandler(ErrWsReconnectAttemptsExhausted)
+	return nil
+}
+
+func keepAlive(c *websocket.Conn, timeout time.Duration) {
+	ticker := time.NewTicker(timeout)
+
+	lastResponse := time.Now()
+	c.SetPongHandler(func(msg string) error {
+		lastResponse = time.Now()
+		return nil
+	})
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			deadline := time.Now().Add(10 * time.Second)
+			err := c.WriteControl(websocket.PingMessage, []byte{}, deadline)
+			if err != nil {
+				return
+			}
+			<-ticker.C
+			if time.Since(lastResponse) > timeout {
+				c.Close()
+				return
+			}
+		}
+	}()
+}
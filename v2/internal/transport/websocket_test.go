@@ -0,0 +1,182 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type websocketTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	dials  *int32
+}
+
+func TestWebsocket(t *testing.T) {
+	suite.Run(t, new(websocketTestSuite))
+}
+
+// startServer start a websocket server. accept decide whether the n-th dial
+// (starting at 1) is upgraded, and serve writes to an upgraded connection
+// before it is closed.
+func (s *websocketTestSuite) startServer(accept func(n int32) bool, serve func(n int32, c *websocket.Conn)) {
+	dials := new(int32)
+	s.dials = dials
+	upgrader := websocket.Upgrader{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(dials, 1)
+		if !accept(n) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		serve(n, c)
+	}))
+}
+
+func (s *websocketTestSuite) TearDownTest() {
+	if s.server != nil {
+		s.server.Close()
+		s.server = nil
+	}
+}
+
+func (s *websocketTestSuite) endpoint() string {
+	return "ws" + strings.TrimPrefix(s.server.URL, "http")
+}
+
+func (s *websocketTestSuite) newConfig(policy *WsReconnectPolicy) *WsConfig {
+	return &WsConfig{Endpoint: s.endpoint(), Reconnect: policy, Timeout: time.Minute}
+}
+
+func (s *websocketTestSuite) TestServeWithoutReconnect() {
+	s.startServer(func(n int32) bool {
+		return true
+	}, func(n int32, c *websocket.Conn) {
+		c.WriteMessage(websocket.TextMessage, []byte("1"))
+	})
+	var messages []string
+	var errs []error
+	doneC, _, err := WsServe(s.newConfig(nil), func(message []byte) {
+		messages = append(messages, string(message))
+	}, func(err error) {
+		errs = append(errs, err)
+	})
+	s.Require().NoError(err)
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream did not stop")
+	}
+	s.Equal([]string{"1"}, messages)
+	s.Len(errs, 1)
+	s.Equal(int32(1), atomic.LoadInt32(s.dials))
+}
+
+func (s *websocketTestSuite) TestServeReconnect() {
+	s.startServer(func(n int32) bool {
+		return n != 2
+	}, func(n int32, c *websocket.Conn) {
+		c.WriteMessage(websocket.TextMessage, []byte{byte('0' + n)})
+		if n == 3 {
+			c.ReadMessage()
+		}
+	})
+	var mu sync.Mutex
+	var messages []string
+	reconnectedC := make(chan *WsReconnectedEvent, 1)
+	policy := &WsReconnectPolicy{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+		Multiplier:     2,
+	}
+	doneC, stopC, err := WsServe(s.newConfig(policy), func(message []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		messages = append(messages, string(message))
+	}, func(err error) {
+		var e *WsReconnectedEvent
+		if errors.As(err, &e) {
+			reconnectedC <- e
+		}
+	})
+	s.Require().NoError(err)
+
+	var e *WsReconnectedEvent
+	select {
+	case e = <-reconnectedC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream did not reconnect")
+	}
+	s.Equal(s.endpoint(), e.Endpoint)
+	s.Equal(2, e.Attempts)
+	s.NotNil(e.Cause)
+
+	s.Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(messages) == 2
+	}, 5*time.Second, time.Millisecond)
+	s.Equal([]string{"1", "3"}, messages)
+
+	close(stopC)
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream did not stop")
+	}
+}
+
+func (s *websocketTestSuite) TestServeReconnectExhausted() {
+	s.startServer(func(n int32) bool {
+		return n == 1
+	}, func(n int32, c *websocket.Conn) {})
+	var errs []error
+	policy := &WsReconnectPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}
+	doneC, _, err := WsServe(s.newConfig(policy), func(message []byte) {}, func(err error) {
+		errs = append(errs, err)
+	})
+	s.Require().NoError(err)
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream did not give up")
+	}
+	s.Equal(int32(4), atomic.LoadInt32(s.dials))
+	// one read error, three dial errors and the final notification
+	s.Len(errs, 5)
+	s.Equal(ErrWsReconnectAttemptsExhausted, errs[len(errs)-1])
+}
+
+func (s *websocketTestSuite) TestReconnectPolicyBackoff() {
+	p := &WsReconnectPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+	}
+	s.Equal(time.Second, p.Backoff(1))
+	s.Equal(2*time.Second, p.Backoff(2))
+	s.Equal(4*time.Second, p.Backoff(3))
+	s.Equal(5*time.Second, p.Backoff(4))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.Backoff(2)
+		s.True(d >= time.Second && d <= 3*time.Second, d)
+	}
+}
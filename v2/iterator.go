@@ -0,0 +1,194 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Largest pages of the paginated endpoints
+const (
+	maxKlinesLimit      = 1000
+	maxAggTradesLimit   = 1000
+	maxOrdersLimit      = 1000
+	maxTradesLimit      = 1000
+	maxMarginRowsSize   = 100
+	maxHistoryLimit     = 1000
+	historyWindowMillis = 90 * 24 * 60 * 60 * 1000 // deposits and withdrawals are listed 90 days at a time
+)
+
+// Range iterate over the klines opening between startTime and endTime, in milliseconds. The
+// checkpoint is the start time following the last kline returned.
+func (s *KlinesService) Range(ctx context.Context, startTime, endTime int64, opts ...RequestOption) *common.Iterator[*Kline] {
+	limit := common.PageLimit(s.limit, maxKlinesLimit)
+	s.Limit(limit).EndTime(endTime)
+	return common.NewTimeIterator(ctx, startTime, endTime, limit, func(ctx context.Context, startTime int64) ([]*Kline, error) {
+		return s.StartTime(startTime).Do(ctx, opts...)
+	}, func(k *Kline) int64 {
+		return k.OpenTime
+	})
+}
+
+// All iterate over the aggregate trades from the one with id fromID, up to the end time if set.
+// The checkpoint is the id following the last trade returned.
+func (s *AggTradesService) All(ctx context.Context, fromID int64, opts ...RequestOption) *common.Iterator[*AggTrade] {
+	limit := common.PageLimit(s.limit, maxAggTradesLimit)
+	endTime := s.endTime
+	s.startTime, s.endTime = nil, nil
+	s.Limit(limit)
+	return common.NewIDIterator(ctx, fromID, endTime, limit, func(ctx context.Context, fromID int64) ([]*AggTrade, error) {
+		return s.FromID(fromID).Do(ctx, opts...)
+	}, func(t *AggTrade) (int64, int64) {
+		return t.AggTradeID, t.Timestamp
+	})
+}
+
+// All iterate over the orders of the symbol from the one with id fromOrderID, up to the end
+// time if set. The checkpoint is the order id following the last order returned.
+func (s *ListOrdersService) All(ctx context.Context, fromOrderID int64, opts ...RequestOption) *common.Iterator[*Order] {
+	limit := common.PageLimit(s.limit, maxOrdersLimit)
+	endTime := s.endTime
+	s.startTime, s.endTime = nil, nil
+	s.Limit(limit)
+	return common.NewIDIterator(ctx, fromOrderID, endTime, limit, func(ctx context.Context, fromOrderID int64) ([]*Order, error) {
+		return s.OrderID(fromOrderID).Do(ctx, opts...)
+	}, func(o *Order) (int64, int64) {
+		return o.OrderID, o.Time
+	})
+}
+
+// All iterate over the trades of the symbol from the one with id fromID, up to the end time if
+// set. The checkpoint is the trade id following the last trade returned.
+func (s *ListTradesService) All(ctx context.Context, fromID int64, opts ...RequestOption) *common.Iterator[*TradeV3] {
+	limit := common.PageLimit(s.limit, maxTradesLimit)
+	endTime := s.endTime
+	s.startTime, s.endTime = nil, nil
+	s.Limit(limit)
+	return common.NewIDIterator(ctx, fromID, endTime, limit, func(ctx context.Context, fromID int64) ([]*TradeV3, error) {
+		return s.FromID(fromID).Do(ctx, opts...)
+	}, func(t *TradeV3) (int64, int64) {
+		return t.ID, t.Time
+	})
+}
+
+// All iterate over the margin trades of the symbol from the one with id fromID, up to the end
+// time if set. The checkpoint is the trade id following the last trade returned.
+func (s *ListMarginTradesService) All(ctx context.Context, fromID int64, opts ...RequestOption) *common.Iterator[*TradeV3] {
+	limit := common.PageLimit(s.limit, maxTradesLimit)
+	endTime := s.endTime
+	s.startTime, s.endTime = nil, nil
+	s.Limit(limit)
+	return common.NewIDIterator(ctx, fromID, endTime, limit, func(ctx context.Context, fromID int64) ([]*TradeV3, error) {
+		return s.FromID(fromID).Do(ctx, opts...)
+	}, func(t *TradeV3) (int64, int64) {
+		return t.ID, t.Time
+	})
+}
+
+// All iterate over the margin orders of the symbol from the one with id fromOrderID, up to the
+// end time if set. The checkpoint is the order id following the last order returned.
+func (s *ListMarginOrdersService) All(ctx context.Context, fromOrderID int64, opts ...RequestOption) *common.Iterator[*Order] {
+	limit := common.PageLimit(s.limit, maxOrdersLimit)
+	endTime := s.endTime
+	s.startTime, s.endTime = nil, nil
+	s.Limit(limit)
+	return common.NewIDIterator(ctx, fromOrderID, endTime, limit, func(ctx context.Context, fromOrderID int64) ([]*Order, error) {
+		return s.OrderID(fromOrderID).Do(ctx, opts...)
+	}, func(o *Order) (int64, int64) {
+		return o.OrderID, o.Time
+	})
+}
+
+// marginRows page through the margin records listed size at a time, from page 1. fetch return the
+// records of a page and the total number of records.
+func marginRows[T any](size int64, fetch func(ctx context.Context, current int64) ([]T, int64, error)) common.PageFunc[T] {
+	return func(ctx context.Context, cursor int64) ([]T, int64, bool, error) {
+		rows, total, err := fetch(ctx, cursor)
+		if err != nil {
+			return nil, cursor, false, err
+		}
+		return rows, cursor + 1, cursor*size < total, nil
+	}
+}
+
+// All iterate over the loan records from page current, starting at 1. The checkpoint is the
+// page of the next record, whose records preceding it are returned again on resume.
+func (s *ListMarginLoansService) All(ctx context.Context, current int64, opts ...RequestOption) *common.Iterator[MarginLoan] {
+	size := int64(maxMarginRowsSize)
+	if s.size != nil && *s.size > 0 {
+		size = *s.size
+	}
+	s.Size(size)
+	return common.NewIterator(ctx, current, marginRows(size, func(ctx context.Context, current int64) ([]MarginLoan, int64, error) {
+		res, err := s.Current(current).Do(ctx, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Rows, res.Total, nil
+	}), nil)
+}
+
+// All iterate over the repay records from page current, starting at 1. The checkpoint is the
+// page of the next record, whose records preceding it are returned again on resume.
+func (s *ListMarginRepaysService) All(ctx context.Context, current int64, opts ...RequestOption) *common.Iterator[MarginRepay] {
+	size := int64(maxMarginRowsSize)
+	if s.size != nil && *s.size > 0 {
+		size = *s.size
+	}
+	s.Size(size)
+	return common.NewIterator(ctx, current, marginRows(size, func(ctx context.Context, current int64) ([]MarginRepay, int64, error) {
+		res, err := s.Current(current).Do(ctx, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Rows, res.Total, nil
+	}), nil)
+}
+
+// historyWindows page through an endpoint listing records 90 days at a time with an offset.
+// The cursor is the start of the current window.
+func historyWindows[T any](endTime int64, limit int, fetch func(ctx context.Context, start, end int64, offset int) ([]T, error)) common.PageFunc[T] {
+	offset := 0
+	return func(ctx context.Context, cursor int64) ([]T, int64, bool, error) {
+		for cursor <= endTime {
+			end := cursor + historyWindowMillis - 1
+			if end > endTime {
+				end = endTime
+			}
+			page, err := fetch(ctx, cursor, end, offset)
+			if err != nil {
+				return nil, cursor, false, err
+			}
+			if len(page) == limit {
+				offset += limit
+				return page, cursor, true, nil
+			}
+			offset = 0
+			if len(page) > 0 {
+				return page, end + 1, end < endTime, nil
+			}
+			cursor = end + 1
+		}
+		return nil, cursor, false, nil
+	}
+}
+
+// Range iterate over the deposits between startTime and endTime, in milliseconds, requesting
+// them 90 days at a time. The checkpoint is the start of the 90 days window of the next deposit,
+// whose deposits preceding it are returned again on resume.
+func (s *ListDepositsService) Range(ctx context.Context, startTime, endTime int64) *common.Iterator[*Deposit] {
+	limit := common.PageLimit(s.limit, maxHistoryLimit)
+	return common.NewIterator(ctx, startTime, historyWindows(endTime, limit, func(ctx context.Context, start, end int64, offset int) ([]*Deposit, error) {
+		return s.StartTime(start).EndTime(end).Offset(offset).Limit(limit).Do(ctx)
+	}), nil)
+}
+
+// Range iterate over the withdrawals between startTime and endTime, in milliseconds, requesting
+// them 90 days at a time. The checkpoint is the start of the 90 days window of the next
+// withdrawal, whose withdrawals preceding it are returned again on resume.
+func (s *ListWithdrawsService) Range(ctx context.Context, startTime, endTime int64) *common.Iterator[*Withdraw] {
+	limit := common.PageLimit(s.limit, maxHistoryLimit)
+	return common.NewIterator(ctx, startTime, historyWindows(endTime, limit, func(ctx context.Context, start, end int64, offset int) ([]*Withdraw, error) {
+		return s.StartTime(start).EndTime(end).Offset(offset).Limit(limit).Do(ctx)
+	}), nil)
+}
@@ -0,0 +1,95 @@
+package binance
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type iteratorTestSuite struct {
+	baseTestSuite
+	queries []url.Values
+}
+
+func TestIterator(t *testing.T) {
+	suite.Run(t, new(iteratorTestSuite))
+}
+
+// mockPages answer the requests with pages in order and record their queries
+func (s *iteratorTestSuite) mockPages(pages ...string) {
+	s.client.Client.do = s.client.do
+	for _, page := range pages {
+		s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(page), 200), nil).Once()
+	}
+	s.queries = nil
+	s.assertReq(func(r *request) {
+		s.queries = append(s.queries, r.query)
+	})
+}
+
+func (s *iteratorTestSuite) TestKlinesRange() {
+	s.mockPages(
+		`[[1000,"1","1","1","1","1",1999,"1",1,"1","1","0"],[2000,"2","2","2","2","2",2999,"2",1,"2","2","0"]]`,
+		`[[3000,"3","3","3","3","3",3999,"3",1,"3","3","0"]]`,
+	)
+	it := s.client.NewKlinesService().Symbol("BTCUSDT").Interval("1s").Limit(2).Range(newContext(), 0, 5000)
+	klines, err := it.All()
+	s.r().NoError(err)
+	s.r().Len(klines, 3)
+	s.Equal("3", klines[2].Close)
+	s.r().Len(s.queries, 2)
+	s.Equal("0", s.queries[0].Get("startTime"))
+	s.Equal("2001", s.queries[1].Get("startTime"))
+	s.Equal("5000", s.queries[1].Get("endTime"))
+	s.Equal(int64(3001), it.Checkpoint())
+}
+
+func (s *iteratorTestSuite) TestAggTradesAll() {
+	s.mockPages(
+		`[{"a":5,"p":"1","q":"1","T":10},{"a":6,"p":"1","q":"1","T":20}]`,
+		`[{"a":7,"p":"1","q":"1","T":30},{"a":8,"p":"1","q":"1","T":40}]`,
+	)
+	it := s.client.NewAggTradesService().Symbol("BTCUSDT").Limit(2).StartTime(1).EndTime(35).All(newContext(), 5)
+	trades, err := it.All()
+	s.r().NoError(err)
+	s.r().Len(trades, 3)
+	s.Equal(int64(7), trades[2].AggTradeID)
+	s.r().Len(s.queries, 2)
+	s.Equal("5", s.queries[0].Get("fromId"))
+	s.Equal("", s.queries[0].Get("startTime"))
+	s.Equal("7", s.queries[1].Get("fromId"))
+	s.Equal(int64(8), it.Checkpoint())
+}
+
+func (s *iteratorTestSuite) TestMarginLoansAll() {
+	s.mockPages(
+		`{"rows":[{"asset":"BNB","principal":"1"},{"asset":"BNB","principal":"2"}],"total":3}`,
+		`{"rows":[{"asset":"BNB","principal":"3"}],"total":3}`,
+	)
+	loans, err := s.client.NewListMarginLoansService().Asset("BNB").Size(2).All(newContext(), 1).All()
+	s.r().NoError(err)
+	s.r().Len(loans, 3)
+	s.Equal("3", loans[2].Principal)
+	s.r().Len(s.queries, 2)
+	s.Equal("2", s.queries[1].Get("current"))
+	s.Equal("2", s.queries[1].Get("size"))
+}
+
+func (s *iteratorTestSuite) TestDepositsRange() {
+	s.mockPages(
+		`[{"coin":"BTC","insertTime":1},{"coin":"BTC","insertTime":2}]`,
+		`[]`,
+		`[{"coin":"ETH","insertTime":7776000001}]`,
+	)
+	end := int64(2*historyWindowMillis - 1)
+	deposits, err := s.client.NewListDepositsService().Limit(2).Range(newContext(), 0, end).All()
+	s.r().NoError(err)
+	s.r().Len(deposits, 3)
+	s.Equal("ETH", deposits[2].Coin)
+	s.r().Len(s.queries, 3)
+	s.Equal("2", s.queries[1].Get("offset"))
+	s.Equal("7775999999", s.queries[1].Get("endTime"))
+	s.Equal("7776000000", s.queries[2].Get("startTime"))
+	s.Equal("0", s.queries[2].Get("offset"))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // KlinesService list klines
@@ -110,3 +112,33 @@ type Kline struct {
 	TakerBuyBaseAssetVolume  string `json:"takerBuyBaseAssetVolume"`
 	TakerBuyQuoteAssetVolume string `json:"takerBuyQuoteAssetVolume"`
 }
+
+// OpenDecimal return Open as a decimal
+func (k *Kline) OpenDecimal() common.Decimal {
+	return common.ToDecimal(k.Open)
+}
+
+// HighDecimal return High as a decimal
+func (k *Kline) HighDecimal() common.Decimal {
+	return common.ToDecimal(k.High)
+}
+
+// LowDecimal return Low as a decimal
+func (k *Kline) LowDecimal() common.Decimal {
+	return common.ToDecimal(k.Low)
+}
+
+// CloseDecimal return Close as a decimal
+func (k *Kline) CloseDecimal() common.Decimal {
+	return common.ToDecimal(k.Close)
+}
+
+// VolumeDecimal return Volume as a decimal
+func (k *Kline) VolumeDecimal() common.Decimal {
+	return common.ToDecimal(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal return QuoteAssetVolume as a decimal
+func (k *Kline) QuoteAssetVolumeDecimal() common.Decimal {
+	return common.ToDecimal(k.QuoteAssetVolume)
+}
@@ -112,3 +112,11 @@ func (s *klineServiceTestSuite) assertKlineEqual(e, a *Kline) {
 	r.Equal(e.TakerBuyBaseAssetVolume, a.TakerBuyBaseAssetVolume, "TakerBuyBaseAssetVolume")
 	r.Equal(e.TakerBuyQuoteAssetVolume, a.TakerBuyQuoteAssetVolume, "TakerBuyQuoteAssetVolume")
 }
+
+func (s *klineServiceTestSuite) TestKlineDecimals() {
+	k := &Kline{Open: "0.01634790", High: "0.80000000", Low: "0.01575800", Close: "0.01577100", Volume: "148976.11427815"}
+	s.r().Equal("0.01634790", k.OpenDecimal().String())
+	s.r().Equal("-0.00057690", k.CloseDecimal().Sub(k.OpenDecimal()).String())
+	s.r().True(k.HighDecimal().GreaterThan(k.LowDecimal()))
+	s.r().True(k.QuoteAssetVolumeDecimal().IsZero())
+}
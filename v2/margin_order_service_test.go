@@ -41,22 +41,23 @@ func (s *marginOrderServiceTestSuite) TestCreateOrder() {
 	newClientOrderID := "myOrder1"
 	s.assertReq(func(r *request) {
 		e := newSignedRequest().setFormParams(params{
-			"symbol":           symbol,
-			"side":             side,
-			"type":             orderType,
-			"timeInForce":      timeInForce,
-			"quantity":         quantity,
-			"quoteOrderQty":    quoteOrderQty,
-			"price":            price,
-			"newClientOrderId": newClientOrderID,
-			"sideEffectType":   SideEffectTypeNoSideEffect,
+			"symbol":                  symbol,
+			"side":                    side,
+			"type":                    orderType,
+			"timeInForce":             timeInForce,
+			"quantity":                quantity,
+			"quoteOrderQty":           quoteOrderQty,
+			"price":                   price,
+			"newClientOrderId":        newClientOrderID,
+			"sideEffectType":          SideEffectTypeNoSideEffect,
+			"selfTradePreventionMode": SelfTradePreventionModeTypeExpireBoth,
 		})
 		s.assertRequestEqual(e, r)
 	})
 	res, err := s.client.NewCreateMarginOrderService().Symbol(symbol).Side(side).
 		Type(orderType).TimeInForce(timeInForce).Quantity(quantity).QuoteOrderQty(quoteOrderQty).
 		Price(price).NewClientOrderID(newClientOrderID).SideEffectType(SideEffectTypeNoSideEffect).
-		Do(newContext())
+		SelfTradePreventionMode(SelfTradePreventionModeTypeExpireBoth).Do(newContext())
 	s.r().NoError(err)
 	e := &CreateOrderResponse{
 		Symbol:                   "LTCBTC",
@@ -675,6 +675,7 @@ type ListMarginTradesService struct {
 	endTime    *int64
 	limit      *int
 	fromID     *int64
+	orderID    *int64
 	isIsolated bool
 }
 
@@ -714,6 +715,12 @@ func (s *ListMarginTradesService) FromID(fromID int64) *ListMarginTradesService
 	return s
 }
 
+// OrderID set orderID, to list the trades of an order
+func (s *ListMarginTradesService) OrderID(orderID int64) *ListMarginTradesService {
+	s.orderID = &orderID
+	return s
+}
+
 // Do send request
 func (s *ListMarginTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*TradeV3, err error) {
 	r := &request{
@@ -734,6 +741,9 @@ func (s *ListMarginTradesService) Do(ctx context.Context, opts ...RequestOption)
 	if s.fromID != nil {
 		r.setParam("fromId", *s.fromID)
 	}
+	if s.orderID != nil {
+		r.setParam("orderId", *s.orderID)
+	}
 	if s.isIsolated {
 		r.setParam("isIsolated", "TRUE")
 	}
@@ -0,0 +1,101 @@
+package binance
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type clientOptionsTestSuite struct {
+	suite.Suite
+}
+
+func TestClientOptions(t *testing.T) {
+	suite.Run(t, new(clientOptionsTestSuite))
+}
+
+func (s *clientOptionsTestSuite) TestDefault() {
+	c := NewClient("key", "secret")
+	s.Equal(baseAPIMainURL, c.BaseURL)
+	s.Equal(http.DefaultClient, c.HTTPClient)
+
+	ws := c.WsClient()
+	s.Equal(baseWsMainURL, ws.BaseURL)
+	s.Equal(baseCombinedMainURL, ws.CombinedURL)
+	s.Equal(WebsocketKeepalive, ws.Keepalive)
+	s.Equal(WebsocketTimeout, ws.Timeout)
+}
+
+func (s *clientOptionsTestSuite) TestTestnet() {
+	c := NewClient("key", "secret", WithTestnet())
+	s.Equal(baseAPITestnetURL, c.BaseURL)
+	s.Equal(baseWsTestnetURL, c.WsClient().BaseURL)
+	s.Equal(baseCombinedTestnetURL, c.WsClient().CombinedURL)
+	s.Equal(baseWsAPITestnetURL, NewWsAPIClient("key", "secret", WithTestnet()).Endpoint)
+
+	// the package variables are left untouched
+	s.False(UseTestnet)
+	s.Equal(baseAPIMainURL, NewClient("key", "secret").BaseURL)
+}
+
+func (s *clientOptionsTestSuite) TestOverrides() {
+	var buf bytes.Buffer
+	logger := log.New(&buf, "", 0)
+	httpClient := &http.Client{}
+	policy := NewWsReconnectPolicy()
+	c := NewClient("key", "secret",
+		WithTestnet(),
+		WithBaseURL("http://127.0.0.1:8080"),
+		WithWsURL("ws://127.0.0.1:8081/ws"),
+		WithTimeout(5*time.Second),
+		WithHTTPClient(httpClient),
+		WithLogger(logger),
+		WithWsKeepalive(10*time.Second),
+		WithWsReconnect(policy),
+	)
+	s.Equal("http://127.0.0.1:8080", c.BaseURL)
+	s.Equal(5*time.Second, c.HTTPClient.Timeout)
+	s.NotSame(httpClient, c.HTTPClient)
+	s.Zero(httpClient.Timeout)
+	s.Same(logger, c.Logger)
+
+	ws := c.WsClient()
+	s.Equal("ws://127.0.0.1:8081/ws", ws.BaseURL)
+	s.Equal("ws://127.0.0.1:8081/stream?streams=", ws.CombinedURL)
+	s.True(ws.Keepalive)
+	s.Equal(10*time.Second, ws.Timeout)
+	s.Same(policy, ws.Reconnect)
+	s.Zero(http.DefaultClient.Timeout)
+}
+
+func (s *clientOptionsTestSuite) TestWsClientServe() {
+	origWsServe := wsServe
+	defer func() { wsServe = origWsServe }()
+	var cfgs []*WsConfig
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		cfgs = append(cfgs, cfg)
+		return make(chan struct{}), make(chan struct{}), nil
+	}
+
+	policy := NewWsReconnectPolicy()
+	ws := NewWsClient(WithTestnet(), WithWsKeepalive(time.Second), WithWsReconnect(policy))
+	_, _, err := ws.WsDepthServe("BNBBTC", func(event *WsDepthEvent) {}, func(err error) {})
+	s.Require().NoError(err)
+	_, _, err = ws.WsCombinedDepthServe([]string{"BNBBTC", "ETHBTC"}, func(event *WsDepthEvent) {}, func(err error) {})
+	s.Require().NoError(err)
+	_, _, err = WsDepthServe("BNBBTC", func(event *WsDepthEvent) {}, func(err error) {})
+	s.Require().NoError(err)
+
+	s.Require().Len(cfgs, 3)
+	s.Equal(baseWsTestnetURL+"/bnbbtc@depth", cfgs[0].Endpoint)
+	s.True(cfgs[0].Keepalive)
+	s.Equal(time.Second, cfgs[0].Timeout)
+	s.Same(policy, cfgs[0].Reconnect)
+	s.Equal(baseCombinedTestnetURL+"bnbbtc@depth/ethbtc@depth", cfgs[1].Endpoint)
+	s.Equal(baseWsMainURL+"/bnbbtc@depth", cfgs[2].Endpoint)
+	s.Equal(WebsocketKeepalive, cfgs[2].Keepalive)
+}
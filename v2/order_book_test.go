@@ -0,0 +1,145 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) TestSync() {
+	data := []byte(`{
+		"lastUpdateId": 100,
+		"bids": [
+			["0.0024", "10"],
+			["0.0023", "5"],
+			["0.0022", "1"]
+		],
+		"asks": [
+			["0.0026", "100"],
+			["0.0027", "50"]
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": "LTCBTC",
+			"limit":  500,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	var errs []error
+	book := s.client.NewOrderBook("LTCBTC").SnapshotLimit(500)
+	handler := book.handleEvent(func(err error) {
+		errs = append(errs, err)
+	})
+	book.resync()
+	// buffered while waiting for the snapshot
+	handler(&WsDepthEvent{FirstUpdateID: 90, LastUpdateID: 95})
+	handler(&WsDepthEvent{
+		FirstUpdateID: 96,
+		LastUpdateID:  101,
+		Bids:          []Bid{{Price: "0.0024", Quantity: "0"}, {Price: "0.0025", Quantity: "3"}},
+	})
+	s.r().False(book.Synced())
+	_, ok := book.BestBid()
+	s.r().False(ok)
+
+	err := book.sync(newContext())
+	s.r().NoError(err)
+	s.r().True(book.Synced())
+	s.r().Equal(int64(101), book.LastUpdateID())
+
+	handler(&WsDepthEvent{
+		FirstUpdateID: 102,
+		LastUpdateID:  103,
+		Asks:          []Ask{{Price: "0.0026", Quantity: "0"}, {Price: "0.00265", Quantity: "7"}},
+	})
+	s.r().Empty(errs)
+
+	bid, ok := book.BestBid()
+	s.r().True(ok)
+	s.r().Equal(Bid{Price: "0.0025", Quantity: "3"}, bid)
+	ask, ok := book.BestAsk()
+	s.r().True(ok)
+	s.r().Equal(Ask{Price: "0.00265", Quantity: "7"}, ask)
+	s.r().Equal([]Bid{
+		{Price: "0.0025", Quantity: "3"},
+		{Price: "0.0023", Quantity: "5"},
+	}, book.Bids(2))
+	s.r().Len(book.Bids(0), 3)
+	s.r().Equal([]Ask{
+		{Price: "0.00265", Quantity: "7"},
+		{Price: "0.0027", Quantity: "50"},
+	}, book.Asks(5))
+
+	quantity, ok := book.QuantityAt(SideTypeBuy, "0.00230")
+	s.r().True(ok)
+	s.r().Equal("5", quantity)
+	_, ok = book.QuantityAt(SideTypeSell, "0.0026")
+	s.r().False(ok)
+}
+
+func (s *orderBookTestSuite) TestGap() {
+	data := []byte(`{
+		"lastUpdateId": 12,
+		"bids": [["0.0024", "10"]],
+		"asks": [["0.0026", "100"]]
+	}`)
+	s.mockDo(data, nil)
+
+	var errs []error
+	book := s.client.NewOrderBook("LTCBTC")
+	handler := book.handleEvent(func(err error) {
+		errs = append(errs, err)
+	})
+	s.r().NoError(book.applySnapshot(&DepthResponse{LastUpdateID: 10}))
+	s.r().True(book.Synced())
+
+	handler(&WsDepthEvent{FirstUpdateID: 11, LastUpdateID: 12})
+	handler(&WsDepthEvent{FirstUpdateID: 14, LastUpdateID: 15})
+	s.r().Equal([]error{ErrOrderBookGap}, errs)
+	s.r().False(book.Synced())
+	s.r().Len(book.resyncC, 1)
+
+	// the snapshot is still older than the event that revealed the gap
+	err := book.sync(newContext())
+	s.r().Equal(ErrOrderBookGap, err)
+	s.r().False(book.Synced())
+
+	s.r().NoError(book.applySnapshot(&DepthResponse{LastUpdateID: 14}))
+	s.r().True(book.Synced())
+	s.r().Equal(int64(15), book.LastUpdateID())
+}
+
+func (s *orderBookTestSuite) TestBufferLimit() {
+	book := s.client.NewOrderBook("LTCBTC").BufferLimit(4)
+	handler := book.handleEvent(func(err error) {
+		s.r().FailNow("unexpected error", err)
+	})
+	for i := int64(1); i <= 4; i++ {
+		handler(&WsDepthEvent{FirstUpdateID: i, LastUpdateID: i})
+	}
+	s.r().Len(book.buffer, 4)
+	s.r().Len(book.resyncC, 0)
+
+	// the oldest events are dropped and a new snapshot is requested
+	handler(&WsDepthEvent{FirstUpdateID: 5, LastUpdateID: 5})
+	s.r().Len(book.resyncC, 1)
+	s.r().Len(book.buffer, 2)
+	s.r().Equal(int64(4), book.buffer[0].FirstUpdateID)
+	s.r().Equal(int64(5), book.buffer[1].FirstUpdateID)
+
+	s.r().NoError(book.applySnapshot(&DepthResponse{LastUpdateID: 3}))
+	s.r().True(book.Synced())
+	s.r().Equal(int64(5), book.LastUpdateID())
+}
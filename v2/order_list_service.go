@@ -0,0 +1,732 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// orderListLeg define the parameters of an order of an order list, sent with the prefix of the leg
+type orderListLeg struct {
+	orderType     OrderType
+	side          SideType
+	clientOrderID *string
+	price         *string
+	stopPrice     *string
+	trailingDelta *string
+	quantity      *string
+	icebergQty    *string
+	timeInForce   *TimeInForceType
+}
+
+// setParams set the parameters of the leg in m
+func (l *orderListLeg) setParams(m params, prefix string) {
+	if l.orderType != "" {
+		m[prefix+"Type"] = l.orderType
+	}
+	if l.side != "" {
+		m[prefix+"Side"] = l.side
+	}
+	if l.clientOrderID != nil {
+		m[prefix+"ClientOrderId"] = *l.clientOrderID
+	}
+	if l.price != nil {
+		m[prefix+"Price"] = *l.price
+	}
+	if l.stopPrice != nil {
+		m[prefix+"StopPrice"] = *l.stopPrice
+	}
+	if l.trailingDelta != nil {
+		m[prefix+"TrailingDelta"] = *l.trailingDelta
+	}
+	if l.quantity != nil {
+		m[prefix+"Quantity"] = *l.quantity
+	}
+	if l.icebergQty != nil {
+		m[prefix+"IcebergQty"] = *l.icebergQty
+	}
+	if l.timeInForce != nil {
+		m[prefix+"TimeInForce"] = *l.timeInForce
+	}
+}
+
+// createOrderList send an order list to endpoint
+func createOrderList(ctx context.Context, c *Client, endpoint string, m params, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(m)
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateOrderListOCOService create an OCO order list, made of an above order and a below order
+// such that one is canceled when the other is filled
+type CreateOrderListOCOService struct {
+	c                       *Client
+	symbol                  string
+	listClientOrderID       *string
+	side                    SideType
+	quantity                string
+	above                   orderListLeg
+	below                   orderListLeg
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *SelfTradePreventionModeType
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOCOService) Symbol(symbol string) *CreateOrderListOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOrderListOCOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Side set side of both orders
+func (s *CreateOrderListOCOService) Side(side SideType) *CreateOrderListOCOService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity of both orders
+func (s *CreateOrderListOCOService) Quantity(quantity string) *CreateOrderListOCOService {
+	s.quantity = quantity
+	return s
+}
+
+// AboveType set aboveType, one of STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER, TAKE_PROFIT and TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) AboveType(aboveType OrderType) *CreateOrderListOCOService {
+	s.above.orderType = aboveType
+	return s
+}
+
+// AboveClientOrderID set aboveClientOrderId
+func (s *CreateOrderListOCOService) AboveClientOrderID(aboveClientOrderID string) *CreateOrderListOCOService {
+	s.above.clientOrderID = &aboveClientOrderID
+	return s
+}
+
+// AbovePrice set abovePrice
+func (s *CreateOrderListOCOService) AbovePrice(abovePrice string) *CreateOrderListOCOService {
+	s.above.price = &abovePrice
+	return s
+}
+
+// AboveStopPrice set aboveStopPrice
+func (s *CreateOrderListOCOService) AboveStopPrice(aboveStopPrice string) *CreateOrderListOCOService {
+	s.above.stopPrice = &aboveStopPrice
+	return s
+}
+
+// AboveTrailingDelta set aboveTrailingDelta
+func (s *CreateOrderListOCOService) AboveTrailingDelta(aboveTrailingDelta string) *CreateOrderListOCOService {
+	s.above.trailingDelta = &aboveTrailingDelta
+	return s
+}
+
+// AboveIcebergQuantity set aboveIcebergQty
+func (s *CreateOrderListOCOService) AboveIcebergQuantity(aboveIcebergQty string) *CreateOrderListOCOService {
+	s.above.icebergQty = &aboveIcebergQty
+	return s
+}
+
+// AboveTimeInForce set aboveTimeInForce
+func (s *CreateOrderListOCOService) AboveTimeInForce(aboveTimeInForce TimeInForceType) *CreateOrderListOCOService {
+	s.above.timeInForce = &aboveTimeInForce
+	return s
+}
+
+// BelowType set belowType, one of STOP_LOSS_LIMIT, STOP_LOSS, LIMIT_MAKER, TAKE_PROFIT and TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) BelowType(belowType OrderType) *CreateOrderListOCOService {
+	s.below.orderType = belowType
+	return s
+}
+
+// BelowClientOrderID set belowClientOrderId
+func (s *CreateOrderListOCOService) BelowClientOrderID(belowClientOrderID string) *CreateOrderListOCOService {
+	s.below.clientOrderID = &belowClientOrderID
+	return s
+}
+
+// BelowPrice set belowPrice
+func (s *CreateOrderListOCOService) BelowPrice(belowPrice string) *CreateOrderListOCOService {
+	s.below.price = &belowPrice
+	return s
+}
+
+// BelowStopPrice set belowStopPrice
+func (s *CreateOrderListOCOService) BelowStopPrice(belowStopPrice string) *CreateOrderListOCOService {
+	s.below.stopPrice = &belowStopPrice
+	return s
+}
+
+// BelowTrailingDelta set belowTrailingDelta
+func (s *CreateOrderListOCOService) BelowTrailingDelta(belowTrailingDelta string) *CreateOrderListOCOService {
+	s.below.trailingDelta = &belowTrailingDelta
+	return s
+}
+
+// BelowIcebergQuantity set belowIcebergQty
+func (s *CreateOrderListOCOService) BelowIcebergQuantity(belowIcebergQty string) *CreateOrderListOCOService {
+	s.below.icebergQty = &belowIcebergQty
+	return s
+}
+
+// BelowTimeInForce set belowTimeInForce
+func (s *CreateOrderListOCOService) BelowTimeInForce(belowTimeInForce TimeInForceType) *CreateOrderListOCOService {
+	s.below.timeInForce = &belowTimeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode of the orders of the list, the symbol default
+// applies when it is not set
+func (s *CreateOrderListOCOService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) *CreateOrderListOCOService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	s.above.setParams(m, "above")
+	s.below.setParams(m, "below")
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	return createOrderList(ctx, s.c, "/api/v3/orderList/oco", m, opts...)
+}
+
+// CreateOrderListOTOService create an OTO order list, made of a working order and a pending order
+// placed once the working order is fully filled
+type CreateOrderListOTOService struct {
+	c                       *Client
+	symbol                  string
+	listClientOrderID       *string
+	working                 orderListLeg
+	pending                 orderListLeg
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *SelfTradePreventionModeType
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOTOService) Symbol(symbol string) *CreateOrderListOTOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOrderListOTOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOTOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// WorkingType set workingType, LIMIT or LIMIT_MAKER
+func (s *CreateOrderListOTOService) WorkingType(workingType OrderType) *CreateOrderListOTOService {
+	s.working.orderType = workingType
+	return s
+}
+
+// WorkingSide set workingSide
+func (s *CreateOrderListOTOService) WorkingSide(workingSide SideType) *CreateOrderListOTOService {
+	s.working.side = workingSide
+	return s
+}
+
+// WorkingClientOrderID set workingClientOrderId
+func (s *CreateOrderListOTOService) WorkingClientOrderID(workingClientOrderID string) *CreateOrderListOTOService {
+	s.working.clientOrderID = &workingClientOrderID
+	return s
+}
+
+// WorkingPrice set workingPrice
+func (s *CreateOrderListOTOService) WorkingPrice(workingPrice string) *CreateOrderListOTOService {
+	s.working.price = &workingPrice
+	return s
+}
+
+// WorkingQuantity set workingQuantity
+func (s *CreateOrderListOTOService) WorkingQuantity(workingQuantity string) *CreateOrderListOTOService {
+	s.working.quantity = &workingQuantity
+	return s
+}
+
+// WorkingIcebergQuantity set workingIcebergQty
+func (s *CreateOrderListOTOService) WorkingIcebergQuantity(workingIcebergQty string) *CreateOrderListOTOService {
+	s.working.icebergQty = &workingIcebergQty
+	return s
+}
+
+// WorkingTimeInForce set workingTimeInForce
+func (s *CreateOrderListOTOService) WorkingTimeInForce(workingTimeInForce TimeInForceType) *CreateOrderListOTOService {
+	s.working.timeInForce = &workingTimeInForce
+	return s
+}
+
+// PendingType set pendingType
+func (s *CreateOrderListOTOService) PendingType(pendingType OrderType) *CreateOrderListOTOService {
+	s.pending.orderType = pendingType
+	return s
+}
+
+// PendingSide set pendingSide
+func (s *CreateOrderListOTOService) PendingSide(pendingSide SideType) *CreateOrderListOTOService {
+	s.pending.side = pendingSide
+	return s
+}
+
+// PendingClientOrderID set pendingClientOrderId
+func (s *CreateOrderListOTOService) PendingClientOrderID(pendingClientOrderID string) *CreateOrderListOTOService {
+	s.pending.clientOrderID = &pendingClientOrderID
+	return s
+}
+
+// PendingPrice set pendingPrice
+func (s *CreateOrderListOTOService) PendingPrice(pendingPrice string) *CreateOrderListOTOService {
+	s.pending.price = &pendingPrice
+	return s
+}
+
+// PendingStopPrice set pendingStopPrice
+func (s *CreateOrderListOTOService) PendingStopPrice(pendingStopPrice string) *CreateOrderListOTOService {
+	s.pending.stopPrice = &pendingStopPrice
+	return s
+}
+
+// PendingTrailingDelta set pendingTrailingDelta
+func (s *CreateOrderListOTOService) PendingTrailingDelta(pendingTrailingDelta string) *CreateOrderListOTOService {
+	s.pending.trailingDelta = &pendingTrailingDelta
+	return s
+}
+
+// PendingQuantity set pendingQuantity
+func (s *CreateOrderListOTOService) PendingQuantity(pendingQuantity string) *CreateOrderListOTOService {
+	s.pending.quantity = &pendingQuantity
+	return s
+}
+
+// PendingIcebergQuantity set pendingIcebergQty
+func (s *CreateOrderListOTOService) PendingIcebergQuantity(pendingIcebergQty string) *CreateOrderListOTOService {
+	s.pending.icebergQty = &pendingIcebergQty
+	return s
+}
+
+// PendingTimeInForce set pendingTimeInForce
+func (s *CreateOrderListOTOService) PendingTimeInForce(pendingTimeInForce TimeInForceType) *CreateOrderListOTOService {
+	s.pending.timeInForce = &pendingTimeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOTOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOTOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode of the orders of the list, the symbol default
+// applies when it is not set
+func (s *CreateOrderListOTOService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) *CreateOrderListOTOService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOTOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	s.working.setParams(m, "working")
+	s.pending.setParams(m, "pending")
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	return createOrderList(ctx, s.c, "/api/v3/orderList/oto", m, opts...)
+}
+
+// CreateOrderListOTOCOService create an OTOCO order list, made of a working order and a pending
+// OCO placed once the working order is fully filled
+type CreateOrderListOTOCOService struct {
+	c                       *Client
+	symbol                  string
+	listClientOrderID       *string
+	working                 orderListLeg
+	pendingSide             SideType
+	pendingQuantity         string
+	pendingAbove            orderListLeg
+	pendingBelow            orderListLeg
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *SelfTradePreventionModeType
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOTOCOService) Symbol(symbol string) *CreateOrderListOTOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOrderListOTOCOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOTOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// WorkingType set workingType, LIMIT or LIMIT_MAKER
+func (s *CreateOrderListOTOCOService) WorkingType(workingType OrderType) *CreateOrderListOTOCOService {
+	s.working.orderType = workingType
+	return s
+}
+
+// WorkingSide set workingSide
+func (s *CreateOrderListOTOCOService) WorkingSide(workingSide SideType) *CreateOrderListOTOCOService {
+	s.working.side = workingSide
+	return s
+}
+
+// WorkingClientOrderID set workingClientOrderId
+func (s *CreateOrderListOTOCOService) WorkingClientOrderID(workingClientOrderID string) *CreateOrderListOTOCOService {
+	s.working.clientOrderID = &workingClientOrderID
+	return s
+}
+
+// WorkingPrice set workingPrice
+func (s *CreateOrderListOTOCOService) WorkingPrice(workingPrice string) *CreateOrderListOTOCOService {
+	s.working.price = &workingPrice
+	return s
+}
+
+// WorkingQuantity set workingQuantity
+func (s *CreateOrderListOTOCOService) WorkingQuantity(workingQuantity string) *CreateOrderListOTOCOService {
+	s.working.quantity = &workingQuantity
+	return s
+}
+
+// WorkingIcebergQuantity set workingIcebergQty
+func (s *CreateOrderListOTOCOService) WorkingIcebergQuantity(workingIcebergQty string) *CreateOrderListOTOCOService {
+	s.working.icebergQty = &workingIcebergQty
+	return s
+}
+
+// WorkingTimeInForce set workingTimeInForce
+func (s *CreateOrderListOTOCOService) WorkingTimeInForce(workingTimeInForce TimeInForceType) *CreateOrderListOTOCOService {
+	s.working.timeInForce = &workingTimeInForce
+	return s
+}
+
+// PendingSide set pendingSide, the side of both pending orders
+func (s *CreateOrderListOTOCOService) PendingSide(pendingSide SideType) *CreateOrderListOTOCOService {
+	s.pendingSide = pendingSide
+	return s
+}
+
+// PendingQuantity set pendingQuantity, the quantity of both pending orders
+func (s *CreateOrderListOTOCOService) PendingQuantity(pendingQuantity string) *CreateOrderListOTOCOService {
+	s.pendingQuantity = pendingQuantity
+	return s
+}
+
+// PendingAboveType set pendingAboveType
+func (s *CreateOrderListOTOCOService) PendingAboveType(pendingAboveType OrderType) *CreateOrderListOTOCOService {
+	s.pendingAbove.orderType = pendingAboveType
+	return s
+}
+
+// PendingAboveClientOrderID set pendingAboveClientOrderId
+func (s *CreateOrderListOTOCOService) PendingAboveClientOrderID(pendingAboveClientOrderID string) *CreateOrderListOTOCOService {
+	s.pendingAbove.clientOrderID = &pendingAboveClientOrderID
+	return s
+}
+
+// PendingAbovePrice set pendingAbovePrice
+func (s *CreateOrderListOTOCOService) PendingAbovePrice(pendingAbovePrice string) *CreateOrderListOTOCOService {
+	s.pendingAbove.price = &pendingAbovePrice
+	return s
+}
+
+// PendingAboveStopPrice set pendingAboveStopPrice
+func (s *CreateOrderListOTOCOService) PendingAboveStopPrice(pendingAboveStopPrice string) *CreateOrderListOTOCOService {
+	s.pendingAbove.stopPrice = &pendingAboveStopPrice
+	return s
+}
+
+// PendingAboveTrailingDelta set pendingAboveTrailingDelta
+func (s *CreateOrderListOTOCOService) PendingAboveTrailingDelta(pendingAboveTrailingDelta string) *CreateOrderListOTOCOService {
+	s.pendingAbove.trailingDelta = &pendingAboveTrailingDelta
+	return s
+}
+
+// PendingAboveIcebergQuantity set pendingAboveIcebergQty
+func (s *CreateOrderListOTOCOService) PendingAboveIcebergQuantity(pendingAboveIcebergQty string) *CreateOrderListOTOCOService {
+	s.pendingAbove.icebergQty = &pendingAboveIcebergQty
+	return s
+}
+
+// PendingAboveTimeInForce set pendingAboveTimeInForce
+func (s *CreateOrderListOTOCOService) PendingAboveTimeInForce(pendingAboveTimeInForce TimeInForceType) *CreateOrderListOTOCOService {
+	s.pendingAbove.timeInForce = &pendingAboveTimeInForce
+	return s
+}
+
+// PendingBelowType set pendingBelowType
+func (s *CreateOrderListOTOCOService) PendingBelowType(pendingBelowType OrderType) *CreateOrderListOTOCOService {
+	s.pendingBelow.orderType = pendingBelowType
+	return s
+}
+
+// PendingBelowClientOrderID set pendingBelowClientOrderId
+func (s *CreateOrderListOTOCOService) PendingBelowClientOrderID(pendingBelowClientOrderID string) *CreateOrderListOTOCOService {
+	s.pendingBelow.clientOrderID = &pendingBelowClientOrderID
+	return s
+}
+
+// PendingBelowPrice set pendingBelowPrice
+func (s *CreateOrderListOTOCOService) PendingBelowPrice(pendingBelowPrice string) *CreateOrderListOTOCOService {
+	s.pendingBelow.price = &pendingBelowPrice
+	return s
+}
+
+// PendingBelowStopPrice set pendingBelowStopPrice
+func (s *CreateOrderListOTOCOService) PendingBelowStopPrice(pendingBelowStopPrice string) *CreateOrderListOTOCOService {
+	s.pendingBelow.stopPrice = &pendingBelowStopPrice
+	return s
+}
+
+// PendingBelowTrailingDelta set pendingBelowTrailingDelta
+func (s *CreateOrderListOTOCOService) PendingBelowTrailingDelta(pendingBelowTrailingDelta string) *CreateOrderListOTOCOService {
+	s.pendingBelow.trailingDelta = &pendingBelowTrailingDelta
+	return s
+}
+
+// PendingBelowIcebergQuantity set pendingBelowIcebergQty
+func (s *CreateOrderListOTOCOService) PendingBelowIcebergQuantity(pendingBelowIcebergQty string) *CreateOrderListOTOCOService {
+	s.pendingBelow.icebergQty = &pendingBelowIcebergQty
+	return s
+}
+
+// PendingBelowTimeInForce set pendingBelowTimeInForce
+func (s *CreateOrderListOTOCOService) PendingBelowTimeInForce(pendingBelowTimeInForce TimeInForceType) *CreateOrderListOTOCOService {
+	s.pendingBelow.timeInForce = &pendingBelowTimeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOTOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOTOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode of the orders of the list, the symbol default
+// applies when it is not set
+func (s *CreateOrderListOTOCOService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) *CreateOrderListOTOCOService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOTOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol":          s.symbol,
+		"pendingSide":     s.pendingSide,
+		"pendingQuantity": s.pendingQuantity,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	s.working.setParams(m, "working")
+	s.pendingAbove.setParams(m, "pendingAbove")
+	s.pendingBelow.setParams(m, "pendingBelow")
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	return createOrderList(ctx, s.c, "/api/v3/orderList/otoco", m, opts...)
+}
+
+// CreateOrderListResponse define create order list response
+type CreateOrderListResponse struct {
+	OrderListID       int64               `json:"orderListId"`
+	ContingencyType   ContingencyType     `json:"contingencyType"`
+	ListStatusType    ListStatusType      `json:"listStatusType"`
+	ListOrderStatus   ListOrderStatusType `json:"listOrderStatus"`
+	ListClientOrderID string              `json:"listClientOrderId"`
+	TransactionTime   int64               `json:"transactionTime"`
+	Symbol            string              `json:"symbol"`
+	Orders            []*OCOOrder         `json:"orders"`
+	OrderReports      []*OrderListReport  `json:"orderReports"`
+}
+
+// OrderListReport define the report of an order of an order list
+type OrderListReport struct {
+	Symbol                   string          `json:"symbol"`
+	OrderID                  int64           `json:"orderId"`
+	OrderListID              int64           `json:"orderListId"`
+	ClientOrderID            string          `json:"clientOrderId"`
+	TransactTime             int64           `json:"transactTime"`
+	Price                    string          `json:"price"`
+	OrigQuantity             string          `json:"origQty"`
+	ExecutedQuantity         string          `json:"executedQty"`
+	CummulativeQuoteQuantity string          `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType `json:"status"`
+	TimeInForce              TimeInForceType `json:"timeInForce"`
+	Type                     OrderType       `json:"type"`
+	Side                     SideType        `json:"side"`
+	StopPrice                string          `json:"stopPrice"`
+	TrailingDelta            int64           `json:"trailingDelta"`
+	IcebergQuantity          string          `json:"icebergQty"`
+	WorkingTime              int64           `json:"workingTime"`
+}
+
+// OrderList define order list info
+type OrderList struct {
+	OrderListID       int64               `json:"orderListId"`
+	ContingencyType   ContingencyType     `json:"contingencyType"`
+	ListStatusType    ListStatusType      `json:"listStatusType"`
+	ListOrderStatus   ListOrderStatusType `json:"listOrderStatus"`
+	ListClientOrderID string              `json:"listClientOrderId"`
+	TransactionTime   int64               `json:"transactionTime"`
+	Symbol            string              `json:"symbol"`
+	Orders            []*OCOOrder         `json:"orders"`
+}
+
+// GetOrderListService get an order list by orderListId or origClientOrderId
+type GetOrderListService struct {
+	c                 *Client
+	orderListID       *int64
+	origClientOrderID *string
+}
+
+// OrderListID set orderListId
+func (s *GetOrderListService) OrderListID(orderListID int64) *GetOrderListService {
+	s.orderListID = &orderListID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderId, the listClientOrderId of the order list
+func (s *GetOrderListService) OrigClientOrderID(origClientOrderID string) *GetOrderListService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetOrderListService) Do(ctx context.Context, opts ...RequestOption) (res *OrderList, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/orderList",
+		secType:  secTypeSigned,
+	}
+	if s.orderListID != nil {
+		r.setParam("orderListId", *s.orderListID)
+	}
+	if s.origClientOrderID != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OrderList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListAllOrderListsService list all order lists of the account
+type ListAllOrderListsService struct {
+	c         *Client
+	fromID    *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// FromID set fromId, the orderListId to list from, startTime and endTime can't be set with it
+func (s *ListAllOrderListsService) FromID(fromID int64) *ListAllOrderListsService {
+	s.fromID = &fromID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListAllOrderListsService) StartTime(startTime int64) *ListAllOrderListsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListAllOrderListsService) EndTime(endTime int64) *ListAllOrderListsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default 500 and max 1000
+func (s *ListAllOrderListsService) Limit(limit int) *ListAllOrderListsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListAllOrderListsService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderList, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/allOrderList",
+		secType:  secTypeSigned,
+	}
+	if s.fromID != nil {
+		r.setParam("fromId", *s.fromID)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OrderList{}, err
+	}
+	res = make([]*OrderList, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderList{}, err
+	}
+	return res, nil
+}
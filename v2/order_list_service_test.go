@@ -0,0 +1,305 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderListServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderListService(t *testing.T) {
+	suite.Run(t, new(orderListServiceTestSuite))
+}
+
+func (s *orderListServiceTestSuite) TestCreateOrderListOCO() {
+	data := []byte(`{
+		"orderListId": 1,
+		"contingencyType": "OCO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "lH1YDkuQKWiXVXHPSKYEIp",
+		"transactionTime": 1710485608839,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 10, "clientOrderId": "44nZvqpemY7sVYgPYbvPih"},
+			{"symbol": "LTCBTC", "orderId": 11, "clientOrderId": "NuMp0nVYnciDiFmVqfpBqK"}
+		],
+		"orderReports": [
+			{
+				"symbol": "LTCBTC",
+				"orderId": 10,
+				"orderListId": 1,
+				"clientOrderId": "44nZvqpemY7sVYgPYbvPih",
+				"transactTime": 1710485608839,
+				"price": "1.00000000",
+				"origQty": "5.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "STOP_LOSS_LIMIT",
+				"side": "SELL",
+				"stopPrice": "1.00000000",
+				"workingTime": -1,
+				"icebergQty": "1.00000000"
+			},
+			{
+				"symbol": "LTCBTC",
+				"orderId": 11,
+				"orderListId": 1,
+				"clientOrderId": "NuMp0nVYnciDiFmVqfpBqK",
+				"transactTime": 1710485608839,
+				"price": "3.00000000",
+				"origQty": "5.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "LIMIT_MAKER",
+				"side": "SELL",
+				"workingTime": 1710485608839
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "LTCBTC",
+			"side":                    SideTypeSell,
+			"quantity":                "5",
+			"listClientOrderId":       "lH1YDkuQKWiXVXHPSKYEIp",
+			"aboveType":               OrderTypeLimitMaker,
+			"aboveClientOrderId":      "NuMp0nVYnciDiFmVqfpBqK",
+			"abovePrice":              "3",
+			"belowType":               OrderTypeStopLossLimit,
+			"belowClientOrderId":      "44nZvqpemY7sVYgPYbvPih",
+			"belowPrice":              "1",
+			"belowStopPrice":          "1",
+			"belowIcebergQty":         "1",
+			"belowTimeInForce":        TimeInForceTypeGTC,
+			"newOrderRespType":        NewOrderRespTypeRESULT,
+			"selfTradePreventionMode": SelfTradePreventionModeTypeExpireBoth,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateOrderListOCOService().Symbol("LTCBTC").Side(SideTypeSell).
+		Quantity("5").ListClientOrderID("lH1YDkuQKWiXVXHPSKYEIp").
+		AboveType(OrderTypeLimitMaker).AboveClientOrderID("NuMp0nVYnciDiFmVqfpBqK").AbovePrice("3").
+		BelowType(OrderTypeStopLossLimit).BelowClientOrderID("44nZvqpemY7sVYgPYbvPih").BelowPrice("1").
+		BelowStopPrice("1").BelowIcebergQuantity("1").BelowTimeInForce(TimeInForceTypeGTC).
+		NewOrderRespType(NewOrderRespTypeRESULT).SelfTradePreventionMode(SelfTradePreventionModeTypeExpireBoth).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1), res.OrderListID)
+	r.Equal(ContingencyTypeOCO, res.ContingencyType)
+	r.Equal(ListStatusTypeExecStarted, res.ListStatusType)
+	r.Equal(ListOrderStatusTypeExecuting, res.ListOrderStatus)
+	r.Len(res.Orders, 2)
+	r.Equal(&OCOOrder{Symbol: "LTCBTC", OrderID: 11, ClientOrderID: "NuMp0nVYnciDiFmVqfpBqK"}, res.Orders[1])
+	r.Len(res.OrderReports, 2)
+	r.Equal(&OrderListReport{
+		Symbol:                   "LTCBTC",
+		OrderID:                  10,
+		OrderListID:              1,
+		ClientOrderID:            "44nZvqpemY7sVYgPYbvPih",
+		TransactTime:             1710485608839,
+		Price:                    "1.00000000",
+		OrigQuantity:             "5.00000000",
+		ExecutedQuantity:         "0.00000000",
+		CummulativeQuoteQuantity: "0.00000000",
+		Status:                   OrderStatusTypeNew,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeStopLossLimit,
+		Side:                     SideTypeSell,
+		StopPrice:                "1.00000000",
+		IcebergQuantity:          "1.00000000",
+		WorkingTime:              -1,
+	}, res.OrderReports[0])
+	r.Equal(OrderTypeLimitMaker, res.OrderReports[1].Type)
+}
+
+func (s *orderListServiceTestSuite) TestCreateOrderListOTO() {
+	data := []byte(`{
+		"orderListId": 0,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "yl2ERtcar1o25zcWtqVBTC",
+		"transactionTime": 1712289389158,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 4, "clientOrderId": "Bq17mn9fP6vyCn75Jw1xya"},
+			{"symbol": "LTCBTC", "orderId": 5, "clientOrderId": "arLFo0zGJVDE69cvGBaU0d"}
+		],
+		"orderReports": [
+			{"symbol": "LTCBTC", "orderId": 4, "orderListId": 0, "clientOrderId": "Bq17mn9fP6vyCn75Jw1xya", "status": "NEW", "type": "LIMIT", "side": "SELL", "price": "1.00000000"},
+			{"symbol": "LTCBTC", "orderId": 5, "orderListId": 0, "clientOrderId": "arLFo0zGJVDE69cvGBaU0d", "status": "PENDING_NEW", "type": "STOP_LOSS", "side": "BUY", "stopPrice": "0.90000000", "trailingDelta": 10}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":               "LTCBTC",
+			"workingType":          OrderTypeLimit,
+			"workingSide":          SideTypeSell,
+			"workingPrice":         "1",
+			"workingQuantity":      "1",
+			"workingTimeInForce":   TimeInForceTypeGTC,
+			"pendingType":          OrderTypeStopLoss,
+			"pendingSide":          SideTypeBuy,
+			"pendingStopPrice":     "0.9",
+			"pendingTrailingDelta": "10",
+			"pendingQuantity":      "1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateOrderListOTOService().Symbol("LTCBTC").
+		WorkingType(OrderTypeLimit).WorkingSide(SideTypeSell).WorkingPrice("1").WorkingQuantity("1").
+		WorkingTimeInForce(TimeInForceTypeGTC).PendingType(OrderTypeStopLoss).PendingSide(SideTypeBuy).
+		PendingStopPrice("0.9").PendingTrailingDelta("10").PendingQuantity("1").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(ContingencyTypeOTO, res.ContingencyType)
+	r.Len(res.OrderReports, 2)
+	r.Equal(OrderStatusTypePendingNew, res.OrderReports[1].Status)
+	r.Equal(int64(10), res.OrderReports[1].TrailingDelta)
+}
+
+func (s *orderListServiceTestSuite) TestCreateOrderListOTOCO() {
+	data := []byte(`{
+		"orderListId": 1,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "RumwQpBaDctlUu5jyG5rs0",
+		"transactionTime": 1712291372842,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 6, "clientOrderId": "fM9Y4m23IFJVCQmIrlUmMK"},
+			{"symbol": "LTCBTC", "orderId": 7, "clientOrderId": "6pcQbFIzTXGZQ1e2MkGDq4"},
+			{"symbol": "LTCBTC", "orderId": 8, "clientOrderId": "r4JMv9cwAYYUwwBZfbussx"}
+		],
+		"orderReports": [
+			{"symbol": "LTCBTC", "orderId": 6, "orderListId": 1, "status": "NEW", "type": "LIMIT", "side": "BUY"},
+			{"symbol": "LTCBTC", "orderId": 7, "orderListId": 1, "status": "PENDING_NEW", "type": "STOP_LOSS_LIMIT", "side": "SELL"},
+			{"symbol": "LTCBTC", "orderId": 8, "orderListId": 1, "status": "PENDING_NEW", "type": "LIMIT_MAKER", "side": "SELL"}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "LTCBTC",
+			"listClientOrderId":       "RumwQpBaDctlUu5jyG5rs0",
+			"workingType":             OrderTypeLimit,
+			"workingSide":             SideTypeBuy,
+			"workingClientOrderId":    "fM9Y4m23IFJVCQmIrlUmMK",
+			"workingPrice":            "1.5",
+			"workingQuantity":         "5",
+			"workingIcebergQty":       "1",
+			"workingTimeInForce":      TimeInForceTypeGTC,
+			"pendingSide":             SideTypeSell,
+			"pendingQuantity":         "5",
+			"pendingAboveType":        OrderTypeLimitMaker,
+			"pendingAbovePrice":       "5",
+			"pendingBelowType":        OrderTypeStopLossLimit,
+			"pendingBelowPrice":       "0.5",
+			"pendingBelowStopPrice":   "1",
+			"pendingBelowTimeInForce": TimeInForceTypeGTC,
+			"newOrderRespType":        NewOrderRespTypeFULL,
+			"selfTradePreventionMode": SelfTradePreventionModeTypeExpireTaker,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateOrderListOTOCOService().Symbol("LTCBTC").
+		ListClientOrderID("RumwQpBaDctlUu5jyG5rs0").WorkingType(OrderTypeLimit).WorkingSide(SideTypeBuy).
+		WorkingClientOrderID("fM9Y4m23IFJVCQmIrlUmMK").WorkingPrice("1.5").WorkingQuantity("5").
+		WorkingIcebergQuantity("1").WorkingTimeInForce(TimeInForceTypeGTC).
+		PendingSide(SideTypeSell).PendingQuantity("5").
+		PendingAboveType(OrderTypeLimitMaker).PendingAbovePrice("5").
+		PendingBelowType(OrderTypeStopLossLimit).PendingBelowPrice("0.5").PendingBelowStopPrice("1").
+		PendingBelowTimeInForce(TimeInForceTypeGTC).NewOrderRespType(NewOrderRespTypeFULL).
+		SelfTradePreventionMode(SelfTradePreventionModeTypeExpireTaker).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 3)
+	r.Len(res.OrderReports, 3)
+	r.Equal(OrderTypeStopLossLimit, res.OrderReports[1].Type)
+	r.Equal(SideTypeSell, res.OrderReports[2].Side)
+}
+
+func (s *orderListServiceTestSuite) TestGetOrderList() {
+	data := []byte(`{
+		"orderListId": 27,
+		"contingencyType": "OCO",
+		"listStatusType": "ALL_DONE",
+		"listOrderStatus": "ALL_DONE",
+		"listClientOrderId": "h2USkA5YQpaXHPIrkd96xE",
+		"transactionTime": 1565245656253,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 4, "clientOrderId": "qD1gy3kc3Gx0rihm9Y3xwS"},
+			{"symbol": "LTCBTC", "orderId": 5, "clientOrderId": "ARzZ9I00CPM8i3NhmU9Ega"}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"orderListId": 27,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetOrderListService().OrderListID(27).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&OrderList{
+		OrderListID:       27,
+		ContingencyType:   ContingencyTypeOCO,
+		ListStatusType:    ListStatusTypeAllDone,
+		ListOrderStatus:   ListOrderStatusTypeAllDone,
+		ListClientOrderID: "h2USkA5YQpaXHPIrkd96xE",
+		TransactionTime:   1565245656253,
+		Symbol:            "LTCBTC",
+		Orders: []*OCOOrder{
+			{Symbol: "LTCBTC", OrderID: 4, ClientOrderID: "qD1gy3kc3Gx0rihm9Y3xwS"},
+			{Symbol: "LTCBTC", OrderID: 5, ClientOrderID: "ARzZ9I00CPM8i3NhmU9Ega"},
+		},
+	}, res)
+}
+
+func (s *orderListServiceTestSuite) TestListAllOrderLists() {
+	data := []byte(`[
+		{"orderListId": 29, "contingencyType": "OCO", "listStatusType": "EXEC_STARTED", "listOrderStatus": "EXECUTING", "symbol": "LTCBTC", "orders": []},
+		{"orderListId": 28, "contingencyType": "OTO", "listStatusType": "ALL_DONE", "listOrderStatus": "REJECT", "symbol": "LTCBTC", "orders": []}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"startTime": 1565245913483,
+			"endTime":   1565245913484,
+			"limit":     5,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListAllOrderListsService().StartTime(1565245913483).EndTime(1565245913484).
+		Limit(5).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 2)
+	r.Equal(int64(29), res[0].OrderListID)
+	r.Equal(ContingencyTypeOTO, res[1].ContingencyType)
+	r.Equal(ListOrderStatusTypeReject, res[1].ListOrderStatus)
+}
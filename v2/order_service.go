@@ -4,23 +4,27 @@ import (
 	"context"
 	stdjson "encoding/json"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateOrderService create order
 type CreateOrderService struct {
-	c                *Client
-	symbol           string
-	side             SideType
-	orderType        OrderType
-	timeInForce      *TimeInForceType
-	newOrderRespType *NewOrderRespType
-	quantity         *string
-	quoteOrderQty    *string
-	price            *string
-	newClientOrderID *string
-	stopPrice        *string
-	trailingDelta    *string
-	icebergQuantity  *string
+	c                       *Client
+	ws                      *WsAPIClient
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	newOrderRespType        *NewOrderRespType
+	quantity                *string
+	quoteOrderQty           *string
+	price                   *string
+	newClientOrderID        *string
+	stopPrice               *string
+	trailingDelta           *string
+	icebergQuantity         *string
+	selfTradePreventionMode *SelfTradePreventionModeType
 }
 
 // Symbol set symbol
@@ -95,6 +99,12 @@ func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType)
 	return s
 }
 
+// SelfTradePreventionMode set selfTradePreventionMode, the symbol default applies when it is not set
+func (s *CreateOrderService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionModeType) *CreateOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
 		method:   http.MethodPost,
@@ -133,8 +143,15 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
 	r.setFormParams(m)
-	data, err = s.c.callAPI(ctx, r, opts...)
+	if s.ws != nil {
+		data, err = s.ws.callAPI(ctx, r, opts...)
+	} else {
+		data, err = s.c.callAPI(ctx, r, opts...)
+	}
 	if err != nil {
 		return []byte{}, err
 	}
@@ -182,6 +199,31 @@ type CreateOrderResponse struct {
 	Fills                 []*Fill `json:"fills"`
 	MarginBuyBorrowAmount string  `json:"marginBuyBorrowAmount"` // for margin
 	MarginBuyBorrowAsset  string  `json:"marginBuyBorrowAsset"`
+
+	// for orders expired by self-trade prevention
+	SelfTradePreventionMode SelfTradePreventionModeType `json:"selfTradePreventionMode"`
+	PreventedMatchID        int64                       `json:"preventedMatchId"`
+	PreventedQuantity       string                      `json:"preventedQuantity"`
+}
+
+// PriceDecimal return Price as a decimal
+func (c *CreateOrderResponse) PriceDecimal() common.Decimal {
+	return common.ToDecimal(c.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal
+func (c *CreateOrderResponse) OrigQuantityDecimal() common.Decimal {
+	return common.ToDecimal(c.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() common.Decimal {
+	return common.ToDecimal(c.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return CummulativeQuoteQuantity as a decimal
+func (c *CreateOrderResponse) CummulativeQuoteQuantityDecimal() common.Decimal {
+	return common.ToDecimal(c.CummulativeQuoteQuantity)
 }
 
 // Fill may be returned in an array of fills in a CreateOrderResponse.
@@ -193,6 +235,21 @@ type Fill struct {
 	CommissionAsset string `json:"commissionAsset"`
 }
 
+// PriceDecimal return Price as a decimal
+func (f *Fill) PriceDecimal() common.Decimal {
+	return common.ToDecimal(f.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal
+func (f *Fill) QuantityDecimal() common.Decimal {
+	return common.ToDecimal(f.Quantity)
+}
+
+// CommissionDecimal return Commission as a decimal
+func (f *Fill) CommissionDecimal() common.Decimal {
+	return common.ToDecimal(f.Commission)
+}
+
 // CreateOCOService create order
 type CreateOCOService struct {
 	c                    *Client
@@ -462,6 +519,7 @@ func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (
 // GetOrderService get an order
 type GetOrderService struct {
 	c                 *Client
+	ws                *WsAPIClient
 	symbol            string
 	orderID           *int64
 	origClientOrderID *string
@@ -499,7 +557,12 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 	if s.origClientOrderID != nil {
 		r.setParam("origClientOrderId", *s.origClientOrderID)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	var data []byte
+	if s.ws != nil {
+		data, err = s.ws.callAPI(ctx, r, opts...)
+	} else {
+		data, err = s.c.callAPI(ctx, r, opts...)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -513,25 +576,53 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 
 // Order define order info
 type Order struct {
-	Symbol                   string          `json:"symbol"`
-	OrderID                  int64           `json:"orderId"`
-	OrderListId              int64           `json:"orderListId"`
-	ClientOrderID            string          `json:"clientOrderId"`
-	Price                    string          `json:"price"`
-	OrigQuantity             string          `json:"origQty"`
-	ExecutedQuantity         string          `json:"executedQty"`
-	CummulativeQuoteQuantity string          `json:"cummulativeQuoteQty"`
-	Status                   OrderStatusType `json:"status"`
-	TimeInForce              TimeInForceType `json:"timeInForce"`
-	Type                     OrderType       `json:"type"`
-	Side                     SideType        `json:"side"`
-	StopPrice                string          `json:"stopPrice"`
-	IcebergQuantity          string          `json:"icebergQty"`
-	Time                     int64           `json:"time"`
-	UpdateTime               int64           `json:"updateTime"`
-	IsWorking                bool            `json:"isWorking"`
-	IsIsolated               bool            `json:"isIsolated"`
-	OrigQuoteOrderQuantity   string          `json:"origQuoteOrderQty"`
+	Symbol                   string                      `json:"symbol"`
+	OrderID                  int64                       `json:"orderId"`
+	OrderListId              int64                       `json:"orderListId"`
+	ClientOrderID            string                      `json:"clientOrderId"`
+	Price                    string                      `json:"price"`
+	OrigQuantity             string                      `json:"origQty"`
+	ExecutedQuantity         string                      `json:"executedQty"`
+	CummulativeQuoteQuantity string                      `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType             `json:"status"`
+	TimeInForce              TimeInForceType             `json:"timeInForce"`
+	Type                     OrderType                   `json:"type"`
+	Side                     SideType                    `json:"side"`
+	StopPrice                string                      `json:"stopPrice"`
+	IcebergQuantity          string                      `json:"icebergQty"`
+	Time                     int64                       `json:"time"`
+	UpdateTime               int64                       `json:"updateTime"`
+	IsWorking                bool                        `json:"isWorking"`
+	IsIsolated               bool                        `json:"isIsolated"`
+	OrigQuoteOrderQuantity   string                      `json:"origQuoteOrderQty"`
+	SelfTradePreventionMode  SelfTradePreventionModeType `json:"selfTradePreventionMode"`
+	PreventedMatchID         int64                       `json:"preventedMatchId"`
+	PreventedQuantity        string                      `json:"preventedQuantity"`
+}
+
+// PriceDecimal return Price as a decimal
+func (o *Order) PriceDecimal() common.Decimal {
+	return common.ToDecimal(o.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal
+func (o *Order) OrigQuantityDecimal() common.Decimal {
+	return common.ToDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (o *Order) ExecutedQuantityDecimal() common.Decimal {
+	return common.ToDecimal(o.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return CummulativeQuoteQuantity as a decimal
+func (o *Order) CummulativeQuoteQuantityDecimal() common.Decimal {
+	return common.ToDecimal(o.CummulativeQuoteQuantity)
+}
+
+// StopPriceDecimal return StopPrice as a decimal
+func (o *Order) StopPriceDecimal() common.Decimal {
+	return common.ToDecimal(o.StopPrice)
 }
 
 // ListOrdersService all account orders; active, canceled, or filled
@@ -609,6 +700,7 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
+	ws                *WsAPIClient
 	symbol            string
 	orderID           *int64
 	origClientOrderID *string
@@ -656,7 +748,12 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	if s.newClientOrderID != nil {
 		r.setFormParam("newClientOrderId", *s.newClientOrderID)
 	}
-	data, err := s.c.callAPI(ctx, r, opts...)
+	var data []byte
+	if s.ws != nil {
+		data, err = s.ws.callAPI(ctx, r, opts...)
+	} else {
+		data, err = s.c.callAPI(ctx, r, opts...)
+	}
 	if err != nil {
 		return nil, err
 	}
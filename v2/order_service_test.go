@@ -84,6 +84,65 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderExpiredInMatch() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 2,
+		"orderListId": -1,
+		"clientOrderId": "myOrder2",
+		"transactTime": 1669101687094,
+		"price": "1.10000000",
+		"origQty": "1.30000000",
+		"executedQty": "0.00000000",
+		"cummulativeQuoteQty": "0.00000000",
+		"status": "EXPIRED_IN_MATCH",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "SELL",
+		"workingTime": 1669101687094,
+		"selfTradePreventionMode": "EXPIRE_TAKER",
+		"preventedMatchId": 0,
+		"preventedQuantity": "1.30000000"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeSell,
+			"type":                    OrderTypeLimit,
+			"timeInForce":             TimeInForceTypeGTC,
+			"quantity":                "1.3",
+			"price":                   "1.1",
+			"newClientOrderId":        "myOrder2",
+			"selfTradePreventionMode": SelfTradePreventionModeTypeExpireTaker,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("1.3").Price("1.1").
+		NewClientOrderID("myOrder2").SelfTradePreventionMode(SelfTradePreventionModeTypeExpireTaker).
+		Do(newContext())
+	s.r().NoError(err)
+	e := &CreateOrderResponse{
+		Symbol:                   "BTCUSDT",
+		OrderID:                  2,
+		ClientOrderID:            "myOrder2",
+		TransactTime:             1669101687094,
+		Price:                    "1.10000000",
+		OrigQuantity:             "1.30000000",
+		ExecutedQuantity:         "0.00000000",
+		CummulativeQuoteQuantity: "0.00000000",
+		Status:                   OrderStatusTypeExpiredInMatch,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeSell,
+		SelfTradePreventionMode:  SelfTradePreventionModeTypeExpireTaker,
+		PreventedQuantity:        "1.30000000",
+	}
+	s.assertCreateOrderResponseEqual(e, res)
+}
+
 func (s *orderServiceTestSuite) TestCreateOrderFull() {
 	data := []byte(`{
 		"symbol": "LTCBTC",
@@ -183,6 +242,9 @@ func (s *baseOrderTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderRes
 	r.Equal(e.TimeInForce, a.TimeInForce, "TimeInForce")
 	r.Equal(e.Type, a.Type, "Type")
 	r.Equal(e.Side, a.Side, "Side")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+	r.Equal(e.PreventedMatchID, a.PreventedMatchID, "PreventedMatchID")
+	r.Equal(e.PreventedQuantity, a.PreventedQuantity, "PreventedQuantity")
 
 	r.Len(a.Fills, len(e.Fills))
 	for idx, fill := range e.Fills {
@@ -542,6 +604,9 @@ func (s *baseOrderTestSuite) assertOrderEqual(e, a *Order) {
 	r.Equal(e.UpdateTime, a.UpdateTime, "UpdateTime")
 	r.Equal(e.IsWorking, a.IsWorking, "IsWorking")
 	r.Equal(e.OrigQuoteOrderQuantity, a.OrigQuoteOrderQuantity, "OrigQuoteOrderQuantity")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+	r.Equal(e.PreventedMatchID, a.PreventedMatchID, "PreventedMatchID")
+	r.Equal(e.PreventedQuantity, a.PreventedQuantity, "PreventedQuantity")
 }
 
 func (s *orderServiceTestSuite) TestGetOrder() {
@@ -561,7 +626,10 @@ func (s *orderServiceTestSuite) TestGetOrder() {
 		"icebergQty": "0.0",
 		"time": 1499827319559,
 		"updateTime": 1499827319559,
-		"isWorking": true
+		"isWorking": true,
+		"selfTradePreventionMode": "EXPIRE_MAKER",
+		"preventedMatchId": 3,
+		"preventedQuantity": "0.5"
 	}`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
@@ -598,6 +666,9 @@ func (s *orderServiceTestSuite) TestGetOrder() {
 		Time:                     1499827319559,
 		UpdateTime:               1499827319559,
 		IsWorking:                true,
+		SelfTradePreventionMode:  SelfTradePreventionModeTypeExpireMaker,
+		PreventedMatchID:         3,
+		PreventedQuantity:        "0.5",
 	}
 	s.assertOrderEqual(e, order)
 }
@@ -0,0 +1,186 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/internal/ordertrack"
+)
+
+// TrackedOrder define the state of an order aggregated by an OrderTracker
+type TrackedOrder = ordertrack.Order
+
+// OrderFill define a trade of a TrackedOrder
+type OrderFill = ordertrack.Fill
+
+const (
+	// averagePricePlaces is the number of decimal places of the average price computed from the
+	// cumulative quote quantity
+	averagePricePlaces = 8
+	// executionTypeTrade is the execution type of the executionReport events of fills
+	executionTypeTrade = "TRADE"
+)
+
+// OrderTracker aggregate the executionReport events of the user data stream into the state of every
+// order, keyed by client order id and by order id. The status follows the NEW, PARTIALLY_FILLED then
+// FILLED, CANCELED, REJECTED or EXPIRED state machine, and fills are accumulated with their
+// commission by asset. Events may come out of order or more than once. After the stream
+// reconnects, call Backfill to fetch the state missed meanwhile.
+type OrderTracker struct {
+	c        *Client
+	margin   bool
+	isolated bool
+	tracker  *ordertrack.Tracker
+}
+
+// NewOrderTracker init an order tracker fed with HandleEvent
+func (c *Client) NewOrderTracker() *OrderTracker {
+	return &OrderTracker{c: c, tracker: ordertrack.New()}
+}
+
+// Margin backfill the orders of the cross margin account
+func (t *OrderTracker) Margin() *OrderTracker {
+	t.margin = true
+	return t
+}
+
+// IsolatedMargin backfill the orders of the isolated margin accounts
+func (t *OrderTracker) IsolatedMargin() *OrderTracker {
+	t.margin, t.isolated = true, true
+	return t
+}
+
+// HandleEvent apply an event of the user data stream, ignoring the events of other types than
+// executionReport
+func (t *OrderTracker) HandleEvent(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeExecutionReport {
+		return
+	}
+	t.tracker.Apply(orderUpdate(&event.OrderUpdate))
+}
+
+func orderUpdate(e *WsOrderUpdate) *ordertrack.Update {
+	u := &ordertrack.Update{
+		Symbol:         e.Symbol,
+		OrderID:        e.Id,
+		ClientOrderID:  e.ClientOrderId,
+		Side:           e.Side,
+		Type:           e.Type,
+		Price:          e.Price,
+		Quantity:       e.Volume,
+		Status:         e.Status,
+		FilledQuantity: e.FilledVolume,
+		AveragePrice:   averagePrice(e.FilledQuoteVolume, e.FilledVolume),
+		Time:           e.TransactionTime,
+	}
+	// the client order id of a cancellation is the one of the cancel request
+	if e.OrigCustomOrderId != "" {
+		u.ClientOrderID = e.OrigCustomOrderId
+	}
+	if e.ExecutionType == executionTypeTrade {
+		u.Fill = &OrderFill{
+			TradeID:         e.TradeId,
+			Price:           common.ToDecimal(e.LatestPrice),
+			Quantity:        common.ToDecimal(e.LatestVolume),
+			Commission:      common.ToDecimal(e.FeeCost),
+			CommissionAsset: e.FeeAsset,
+			IsMaker:         e.IsMaker,
+			Time:            e.TransactionTime,
+		}
+	}
+	return u
+}
+
+// averagePrice return the cumulative quote quantity divided by the cumulative quantity
+func averagePrice(quote, quantity string) common.Decimal {
+	q := common.ToDecimal(quantity)
+	if q.IsZero() {
+		return common.Decimal{}
+	}
+	return common.ToDecimal(quote).Div(q, averagePricePlaces, common.RoundHalfUp)
+}
+
+// Backfill fetch the orders that may have missed events, with their trades, and apply them
+func (t *OrderTracker) Backfill(ctx context.Context) error {
+	return t.tracker.Backfill(ctx, false, func(ctx context.Context, o *TrackedOrder) ([]*ordertrack.Update, error) {
+		var order *Order
+		var trades []*TradeV3
+		var err error
+		if t.margin {
+			order, err = t.c.NewGetMarginOrderService().Symbol(o.Symbol).OrderID(o.OrderID).IsIsolated(t.isolated).Do(ctx)
+		} else {
+			order, err = t.c.NewGetOrderService().Symbol(o.Symbol).OrderID(o.OrderID).Do(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if t.margin {
+			trades, err = t.c.NewListMarginTradesService().Symbol(o.Symbol).OrderID(o.OrderID).IsIsolated(t.isolated).Do(ctx)
+		} else {
+			trades, err = t.c.NewListTradesService().Symbol(o.Symbol).OrderId(o.OrderID).Do(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		updates := []*ordertrack.Update{{
+			Symbol:         order.Symbol,
+			OrderID:        order.OrderID,
+			ClientOrderID:  order.ClientOrderID,
+			Side:           string(order.Side),
+			Type:           string(order.Type),
+			Price:          order.Price,
+			Quantity:       order.OrigQuantity,
+			Status:         string(order.Status),
+			FilledQuantity: order.ExecutedQuantity,
+			AveragePrice:   averagePrice(order.CummulativeQuoteQuantity, order.ExecutedQuantity),
+			Time:           order.UpdateTime,
+		}}
+		for _, trade := range trades {
+			updates = append(updates, &ordertrack.Update{
+				Symbol:  trade.Symbol,
+				OrderID: trade.OrderID,
+				Fill: &OrderFill{
+					TradeID:         trade.ID,
+					Price:           common.ToDecimal(trade.Price),
+					Quantity:        common.ToDecimal(trade.Quantity),
+					Commission:      common.ToDecimal(trade.Commission),
+					CommissionAsset: trade.CommissionAsset,
+					IsMaker:         trade.IsMaker,
+					Time:            trade.Time,
+				},
+				Time: trade.Time,
+			})
+		}
+		return updates, nil
+	})
+}
+
+// Subscribe call f with the new state of every order that changes, until unsubscribe is called
+func (t *OrderTracker) Subscribe(f func(o *TrackedOrder)) (unsubscribe func()) {
+	return t.tracker.Subscribe(f)
+}
+
+// Order return the order with the client order id
+func (t *OrderTracker) Order(clientOrderID string) (*TrackedOrder, bool) {
+	return t.tracker.Order(clientOrderID)
+}
+
+// OrderByID return the order of symbol with the order id
+func (t *OrderTracker) OrderByID(symbol string, orderID int64) (*TrackedOrder, bool) {
+	return t.tracker.OrderByID(symbol, orderID)
+}
+
+// OpenOrders return the orders that haven't reached a final status, sorted by symbol and order id
+func (t *OrderTracker) OpenOrders() []*TrackedOrder {
+	return t.tracker.Orders(true)
+}
+
+// Orders return all the tracked orders, sorted by symbol and order id
+func (t *OrderTracker) Orders() []*TrackedOrder {
+	return t.tracker.Orders(false)
+}
+
+// Forget stop tracking the orders that reached a final status
+func (t *OrderTracker) Forget() {
+	t.tracker.Forget()
+}
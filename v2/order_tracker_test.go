@@ -0,0 +1,140 @@
+package binance
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderTrackerTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderTracker(t *testing.T) {
+	suite.Run(t, new(orderTrackerTestSuite))
+}
+
+func executionReport(executionType, status string, tradeID int64, last, filled, filledQuote string) *WsUserDataEvent {
+	return &WsUserDataEvent{
+		Event: UserDataEventTypeExecutionReport,
+		OrderUpdate: WsOrderUpdate{
+			Symbol:            "BTCUSDT",
+			ClientOrderId:     "client",
+			Side:              "BUY",
+			Type:              "LIMIT",
+			Volume:            "2.00000000",
+			Price:             "100.00000000",
+			ExecutionType:     executionType,
+			Status:            status,
+			Id:                1,
+			TradeId:           tradeID,
+			LatestVolume:      last,
+			LatestPrice:       "100.00000000",
+			FilledVolume:      filled,
+			FilledQuoteVolume: filledQuote,
+			FeeAsset:          "BNB",
+			FeeCost:           "0.00100000",
+			TransactionTime:   1000 + tradeID,
+		},
+	}
+}
+
+func (s *orderTrackerTestSuite) TestHandleEvent() {
+	tracker := s.client.NewOrderTracker()
+	var changes []*TrackedOrder
+	tracker.Subscribe(func(o *TrackedOrder) {
+		changes = append(changes, o)
+	})
+
+	tracker.HandleEvent(executionReport("NEW", "NEW", -1, "0.00000000", "0.00000000", "0.00000000"))
+	tracker.HandleEvent(&WsUserDataEvent{Event: UserDataEventTypeOutboundAccountPosition})
+	tracker.HandleEvent(executionReport("TRADE", "PARTIALLY_FILLED", 7, "0.50000000", "0.50000000", "49.00000000"))
+	tracker.HandleEvent(executionReport("TRADE", "PARTIALLY_FILLED", 7, "0.50000000", "0.50000000", "49.00000000"))
+	cancel := executionReport("CANCELED", "CANCELED", -1, "0.00000000", "0.50000000", "49.00000000")
+	cancel.OrderUpdate.ClientOrderId, cancel.OrderUpdate.OrigCustomOrderId = "cancel", "client"
+	tracker.HandleEvent(cancel)
+
+	s.Len(changes, 3)
+	o, ok := tracker.Order("client")
+	s.Require().True(ok)
+	s.Equal(string(OrderStatusTypeCanceled), o.Status)
+	s.True(o.FilledQuantity.Equal(common.ToDecimal("0.5")))
+	s.True(o.AveragePrice.Equal(common.ToDecimal("98")))
+	s.Require().Len(o.Fills, 1)
+	s.Equal(OrderFill{
+		TradeID:         7,
+		Price:           common.ToDecimal("100.00000000"),
+		Quantity:        common.ToDecimal("0.50000000"),
+		Commission:      common.ToDecimal("0.00100000"),
+		CommissionAsset: "BNB",
+		Time:            1007,
+	}, o.Fills[0])
+	s.True(o.Commissions["BNB"].Equal(common.ToDecimal("0.001")))
+	_, ok = tracker.Order("cancel")
+	s.False(ok)
+	s.Empty(tracker.OpenOrders())
+	s.Len(tracker.Orders(), 1)
+	tracker.Forget()
+	s.Empty(tracker.Orders())
+}
+
+func (s *orderTrackerTestSuite) TestBackfill() {
+	var requests []string
+	responses := map[string]string{
+		"/api/v3/order": `{"symbol":"BTCUSDT","orderId":1,"clientOrderId":"client","price":"100.00000000",
+			"origQty":"2.00000000","executedQty":"2.00000000","cummulativeQuoteQty":"199.00000000",
+			"status":"FILLED","type":"LIMIT","side":"BUY","updateTime":2000}`,
+		"/api/v3/myTrades": `[{"symbol":"BTCUSDT","id":7,"orderId":1,"price":"100.00000000","qty":"0.50000000",
+			"commission":"0.00100000","commissionAsset":"BNB","time":1007},
+			{"symbol":"BTCUSDT","id":8,"orderId":1,"price":"99.33333333","qty":"1.50000000",
+			"commission":"0.00200000","commissionAsset":"BNB","time":1500,"isMaker":true}]`,
+		"/sapi/v1/margin/order":    `{"symbol":"BTCUSDT","orderId":1,"status":"FILLED","executedQty":"2.00000000"}`,
+		"/sapi/v1/margin/myTrades": `[]`,
+	}
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		requests = append(requests, fmt.Sprintf("%s symbol=%s orderId=%s isIsolated=%s",
+			req.URL.Path, q.Get("symbol"), q.Get("orderId"), q.Get("isIsolated")))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(responses[req.URL.Path])),
+		}, nil
+	}
+
+	tracker := s.client.NewOrderTracker()
+	tracker.HandleEvent(executionReport("TRADE", "PARTIALLY_FILLED", 7, "0.50000000", "0.50000000", "50.00000000"))
+	s.Require().NoError(tracker.Backfill(newContext()))
+	s.Equal([]string{
+		"/api/v3/order symbol=BTCUSDT orderId=1 isIsolated=",
+		"/api/v3/myTrades symbol=BTCUSDT orderId=1 isIsolated=",
+	}, requests)
+	o, _ := tracker.OrderByID("BTCUSDT", 1)
+	s.Equal(string(OrderStatusTypeFilled), o.Status)
+	s.True(o.FilledQuantity.Equal(common.ToDecimal("2")))
+	s.True(o.AveragePrice.Equal(common.ToDecimal("99.5")))
+	s.Len(o.Fills, 2)
+	s.True(o.Fills[1].IsMaker)
+	s.True(o.Commissions["BNB"].Equal(common.ToDecimal("0.003")))
+	s.Equal(int64(2000), o.UpdateTime)
+
+	// complete orders are not fetched again
+	requests = nil
+	s.Require().NoError(tracker.Backfill(newContext()))
+	s.Empty(requests)
+
+	requests = nil
+	margin := s.client.NewOrderTracker().IsolatedMargin()
+	margin.HandleEvent(executionReport("NEW", "NEW", -1, "0.00000000", "0.00000000", "0.00000000"))
+	s.Require().NoError(margin.Backfill(newContext()))
+	s.Equal([]string{
+		"/sapi/v1/margin/order symbol=BTCUSDT orderId=1 isIsolated=TRUE",
+		"/sapi/v1/margin/myTrades symbol=BTCUSDT orderId=1 isIsolated=TRUE",
+	}, requests)
+	o, _ = margin.Order("client")
+	s.Equal(string(OrderStatusTypeFilled), o.Status)
+}
@@ -0,0 +1,92 @@
+package binance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderValidatorTestSuite struct {
+	baseTestSuite
+	validator *OrderValidator
+}
+
+func TestOrderValidator(t *testing.T) {
+	suite.Run(t, new(orderValidatorTestSuite))
+}
+
+func (s *orderValidatorTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	info := new(ExchangeInfo)
+	err := json.Unmarshal([]byte(`{
+		"symbols": [{
+			"symbol": "BTCUSDT",
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "0.01000000", "maxPrice": "1000000.00000000", "tickSize": "0.01000000"},
+				{"filterType": "LOT_SIZE", "minQty": "0.00001000", "maxQty": "9000.00000000", "stepSize": "0.00001000"},
+				{"filterType": "ICEBERG_PARTS", "limit": 10},
+				{"filterType": "MARKET_LOT_SIZE", "minQty": "0.00000000", "maxQty": "100.00000000", "stepSize": "0.00000000"},
+				{"filterType": "PERCENT_PRICE_BY_SIDE", "bidMultiplierUp": "5", "bidMultiplierDown": "0.2"},
+				{"filterType": "NOTIONAL", "minNotional": "10.00000000", "applyMinToMarket": true, "maxNotional": "9000000.00000000", "applyMaxToMarket": false, "avgPriceMins": 5},
+				{"filterType": "MAX_NUM_ORDERS", "maxNumOrders": 200},
+				{"filterType": "MAX_NUM_ALGO_ORDERS", "maxNumAlgoOrders": 5}
+			]
+		}]
+	}`), info)
+	s.r().NoError(err)
+	s.validator = NewOrderValidator(info)
+}
+
+func (s *orderValidatorTestSuite) TestValidateOrder() {
+	order := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).Price("20000.001").Quantity("0.001")
+	err := s.validator.ValidateOrder(order)
+	var validationErr *common.OrderValidationError
+	s.r().True(errors.As(err, &validationErr))
+	s.r().Equal([]*common.FilterError{
+		{Filter: common.FilterTypePrice, Field: "price", Violation: common.FilterViolationTickSize, Value: "20000.001", Bound: "0.01000000"},
+	}, validationErr.Errors)
+
+	s.validator.OpenOrders = func(symbol string) (int, int) {
+		s.r().Equal("BTCUSDT", symbol)
+		return 4, 5
+	}
+	order = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeStopLossLimit).Price("20000").StopPrice("20001").Quantity("0.0001")
+	err = s.validator.ValidateOrder(order)
+	s.r().True(errors.As(err, &validationErr))
+	s.r().True(validationErr.Has(common.FilterTypeMaxNumAlgoOrders))
+	s.r().False(validationErr.Has(common.FilterTypeMaxNumOrders))
+}
+
+func (s *orderValidatorTestSuite) TestValidateOrderAutoRound() {
+	s.validator.AutoRound = true
+	s.validator.ReferencePrice = func(symbol string) string {
+		return "20000"
+	}
+	order := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).Price("20000.019").Quantity("0.0012345")
+	s.r().NoError(s.validator.ValidateOrder(order))
+	s.r().Equal("20000.01", *order.price)
+	s.r().Equal("0.00123", *order.quantity)
+	s.r().Nil(order.stopPrice)
+
+	margin := s.client.NewCreateMarginOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("0.0004999")
+	err := s.validator.ValidateMarginOrder(margin)
+	s.r().Equal("0.00049", *margin.quantity)
+	var validationErr *common.OrderValidationError
+	s.r().True(errors.As(err, &validationErr))
+	s.r().Equal([]*common.FilterError{
+		{Filter: common.FilterTypeNotional, Field: "notional", Violation: common.FilterViolationBelowMin, Value: "9.80000", Bound: "10.00000000"},
+	}, validationErr.Errors)
+}
+
+func (s *orderValidatorTestSuite) TestValidateOrderUnknownSymbol() {
+	order := s.client.NewCreateOrderService().Symbol("XXXUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1")
+	err := s.validator.ValidateOrder(order)
+	s.r().True(errors.Is(err, common.ErrUnknownSymbol))
+}
@@ -0,0 +1,272 @@
+// Package paper runs strategies against live or recorded market data without sending orders to
+// Binance. An Exchange serves the order, account and user data stream endpoints of the spot and
+// USDⓈ-M futures APIs from the matching engine of binancetest, fed with the depth and trades of
+// the watched symbols, and proxies the unsigned market data requests and streams to Binance. Other
+// requests, such as margin orders, are refused so that API keys are never sent to Binance. Clients
+// created with its options run unchanged:
+//
+//	ex := paper.NewExchange()
+//	defer ex.Close()
+//	ex.AddAccount("key", "secret")
+//	ex.Spot.SetBalance("key", "USDT", "10000")
+//	if err := ex.WatchSpot("BTCUSDT"); err != nil {
+//		return err
+//	}
+//	client := binance.NewClient("key", "secret", ex.SpotOptions()...)
+package paper
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/binancetest"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// Endpoints of Binance
+const (
+	spotAPIURL    = "https://api.binance.com"
+	spotWsURL     = "wss://stream.binance.com:9443/ws"
+	futuresAPIURL = "https://fapi.binance.com"
+	futuresWsURL  = "wss://fstream.binance.com/ws"
+)
+
+// futuresWsPrefix is the path prefix of futures streams, which share their paths with spot ones
+const futuresWsPrefix = "/futures"
+
+// Option configure an Exchange
+type Option func(*options)
+
+type options struct {
+	spotAPIURL        string
+	spotWsURL         string
+	futuresAPIURL     string
+	futuresWsURL      string
+	spotCommission    [2]string
+	futuresCommission [2]string
+	recorder          *recorder
+	errHandler        func(err error)
+}
+
+// WithSpotEndpoints set the spot REST API and websocket endpoints to proxy and watch, such as
+// "https://testnet.binance.vision" and "wss://testnet.binance.vision/ws"
+func WithSpotEndpoints(apiURL, wsURL string) Option {
+	return func(o *options) {
+		o.spotAPIURL, o.spotWsURL = apiURL, wsURL
+	}
+}
+
+// WithFuturesEndpoints set the futures REST API and websocket endpoints to proxy and watch
+func WithFuturesEndpoints(apiURL, wsURL string) Option {
+	return func(o *options) {
+		o.futuresAPIURL, o.futuresWsURL = apiURL, wsURL
+	}
+}
+
+// WithSpotCommission set the commission rates of spot maker and taker trades, 0.1% by default
+func WithSpotCommission(maker, taker string) Option {
+	return func(o *options) {
+		o.spotCommission = [2]string{maker, taker}
+	}
+}
+
+// WithFuturesCommission set the commission rates of futures maker and taker trades,
+// 0.02% and 0.04% by default
+func WithFuturesCommission(maker, taker string) Option {
+	return func(o *options) {
+		o.futuresCommission = [2]string{maker, taker}
+	}
+}
+
+// WithErrHandler set the handler of the errors of watched streams, which are ignored by default
+func WithErrHandler(errHandler func(err error)) Option {
+	return func(o *options) {
+		o.errHandler = errHandler
+	}
+}
+
+// Exchange is a paper trading exchange, call Close when done
+type Exchange struct {
+	// Spot hold the symbols, balances and orders of the spot API
+	Spot *binancetest.Market
+	// Futures hold the symbols, balances, positions and orders of the futures API
+	Futures *binancetest.Market
+
+	srv          *binancetest.Server
+	opts         *options
+	spotProxy    *httputil.ReverseProxy
+	futuresProxy *httputil.ReverseProxy
+	clock        int64 // replayed time in milliseconds
+
+	mu       sync.Mutex
+	listings map[string]bool
+	stopCs   []chan struct{}
+}
+
+// NewExchange start a paper trading exchange
+func NewExchange(opts ...Option) *Exchange {
+	o := &options{
+		spotAPIURL:        spotAPIURL,
+		spotWsURL:         spotWsURL,
+		futuresAPIURL:     futuresAPIURL,
+		futuresWsURL:      futuresWsURL,
+		spotCommission:    [2]string{"0.001", "0.001"},
+		futuresCommission: [2]string{"0.0002", "0.0004"},
+		errHandler:        func(err error) {},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	srv := binancetest.NewServer()
+	e := &Exchange{
+		Spot:         srv.Spot,
+		Futures:      srv.Futures,
+		srv:          srv,
+		opts:         o,
+		spotProxy:    newReverseProxy(o.spotAPIURL),
+		futuresProxy: newReverseProxy(o.futuresAPIURL),
+		listings:     make(map[string]bool),
+	}
+	e.Spot.SetCommission(o.spotCommission[0], o.spotCommission[1])
+	e.Futures.SetCommission(o.futuresCommission[0], o.futuresCommission[1])
+	srv.SetFallback(http.HandlerFunc(e.proxy))
+	return e
+}
+
+// Close stop watching symbols and shut the exchange down
+func (e *Exchange) Close() {
+	e.mu.Lock()
+	for _, stopC := range e.stopCs {
+		close(stopC)
+	}
+	e.stopCs = nil
+	e.mu.Unlock()
+	e.srv.Close()
+}
+
+// AddAccount register an API key and the secret key signing its requests
+func (e *Exchange) AddAccount(apiKey, secretKey string) {
+	e.srv.AddAccount(apiKey, secretKey)
+}
+
+// SpotOptions return the options of a binance.Client or binance.WsClient trading on the exchange
+func (e *Exchange) SpotOptions() []binance.ClientOption {
+	return []binance.ClientOption{binance.WithBaseURL(e.srv.URL), binance.WithWsURL(e.srv.WsURL)}
+}
+
+// FuturesOptions return the options of a futures.Client or futures.WsClient trading on the exchange
+func (e *Exchange) FuturesOptions() []futures.ClientOption {
+	wsURL := strings.TrimSuffix(e.srv.WsURL, "/ws") + futuresWsPrefix + "/ws"
+	return []futures.ClientOption{futures.WithBaseURL(e.srv.URL), futures.WithWsURL(wsURL)}
+}
+
+func newReverseProxy(target string) *httputil.ReverseProxy {
+	u, err := url.Parse(target)
+	if err != nil {
+		panic(fmt.Sprintf("paper: invalid endpoint %q: %v", target, err))
+	}
+	p := httputil.NewSingleHostReverseProxy(u)
+	director := p.Director
+	p.Director = func(r *http.Request) {
+		director(r)
+		r.Host = u.Host
+	}
+	return p
+}
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// proxy forward the market data requests the matching engine does not serve to Binance
+func (e *Exchange) proxy(w http.ResponseWriter, r *http.Request) {
+	if !isMarketData(r) {
+		refuse(w, r)
+		return
+	}
+	if websocket.IsWebSocketUpgrade(r) {
+		e.proxyStream(w, r)
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, "/fapi/") {
+		e.futuresProxy.ServeHTTP(w, r)
+		return
+	}
+	e.spotProxy.ServeHTTP(w, r)
+}
+
+// isMarketData report whether r is an unsigned GET, which carries neither an API key nor a signature
+func isMarketData(r *http.Request) bool {
+	return r.Method == http.MethodGet && r.Header.Get("X-MBX-APIKEY") == "" &&
+		!r.URL.Query().Has("signature")
+}
+
+// refuse answer a request which is neither emulated nor safe to proxy with a Binance error
+func refuse(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotImplemented)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"code": binancetest.ErrorCodeUnknown,
+		"msg":  fmt.Sprintf("%s %s is not emulated by the paper exchange.", r.Method, r.URL.Path),
+	})
+}
+
+// proxyStream forward the messages of a market data stream of Binance to a websocket connection
+func (e *Exchange) proxyStream(w http.ResponseWriter, r *http.Request) {
+	endpoint, p := e.opts.spotWsURL, r.URL.Path
+	if strings.HasPrefix(p, futuresWsPrefix+"/") {
+		endpoint, p = e.opts.futuresWsURL, strings.TrimPrefix(p, futuresWsPrefix)
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/ws") + p
+	if r.URL.RawQuery != "" {
+		endpoint += "?" + r.URL.RawQuery
+	}
+	upstream, _, err := websocket.DefaultDialer.Dial(endpoint, nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer upstream.Close()
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	go func() {
+		// read to answer pings and close the upstream stream when the client leaves
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				upstream.Close()
+				return
+			}
+		}
+	}()
+	for {
+		messageType, msg, err := upstream.ReadMessage()
+		if err != nil {
+			return
+		}
+		if err := conn.WriteMessage(messageType, msg); err != nil {
+			return
+		}
+	}
+}
+
+// now return the replayed time, or the current time outside of replays
+func (e *Exchange) now() time.Time {
+	if ms := atomic.LoadInt64(&e.clock); ms != 0 {
+		return time.Unix(0, ms*int64(time.Millisecond))
+	}
+	return time.Now()
+}
@@ -0,0 +1,232 @@
+package paper
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/binancetest"
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const (
+	apiKey    = "paperKey"
+	secretKey = "paperSecret"
+)
+
+// syncBuffer is a bytes.Buffer written by the handlers of watched streams
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+type exchangeTestSuite struct {
+	suite.Suite
+	upstream *httptest.Server
+	trades   chan string
+}
+
+func TestExchange(t *testing.T) {
+	suite.Run(t, new(exchangeTestSuite))
+}
+
+// SetupTest start a fake Binance serving exchange info, klines and the streams of BTCUSDT
+func (s *exchangeTestSuite) SetupTest() {
+	s.trades = make(chan string, 1)
+	upgrader := websocket.Upgrader{}
+	stream := func(messages <-chan string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			go func() {
+				for {
+					if _, _, err := conn.ReadMessage(); err != nil {
+						return
+					}
+				}
+			}()
+			for msg := range messages {
+				if conn.WriteMessage(websocket.TextMessage, []byte(msg)) != nil {
+					return
+				}
+			}
+		}
+	}
+	message := func(msg string) <-chan string {
+		c := make(chan string, 1)
+		c <- msg
+		return c
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/exchangeInfo", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"symbols":[{"symbol":"BTCUSDT","baseAsset":"BTC","quoteAsset":"USDT","filters":[
+			{"filterType":"PRICE_FILTER","minPrice":"0.01","maxPrice":"1000000","tickSize":"0.01"},
+			{"filterType":"LOT_SIZE","minQty":"0.00001","maxQty":"9000","stepSize":"0.00001"},
+			{"filterType":"NOTIONAL","minNotional":"5"}]}]}`))
+	})
+	mux.HandleFunc("/api/v3/klines", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[[1499040000000,"0.01634790","0.80000000","0.01575800","0.01577100","148976.11427815",
+			1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","17928899.62484339"]]`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		s.Failf("request sent to Binance", "%s %s", r.Method, r.URL.Path)
+	})
+	mux.Handle("/ws/btcusdt@depth20@100ms", stream(message(`{"lastUpdateId":1,"bids":[["29990.00","1"]],"asks":[["30000.00","1"]]}`)))
+	mux.Handle("/ws/btcusdt@trade", stream(s.trades))
+	mux.Handle("/ws/btcusdt@aggTrade", stream(message(`{"e":"aggTrade","E":1,"s":"BTCUSDT","a":7,"p":"29995.00","q":"0.1"}`)))
+	s.upstream = httptest.NewServer(mux)
+}
+
+func (s *exchangeTestSuite) TearDownTest() {
+	s.upstream.Close()
+}
+
+func (s *exchangeTestSuite) TestWatchAndProxy() {
+	ctx := context.Background()
+	recording := new(syncBuffer)
+	ex := NewExchange(
+		WithSpotEndpoints(s.upstream.URL, "ws"+strings.TrimPrefix(s.upstream.URL, "http")+"/ws"),
+		WithRecorder(recording),
+	)
+	ex.AddAccount(apiKey, secretKey)
+	ex.Spot.SetBalance(apiKey, "USDT", "100000")
+	s.Require().NoError(ex.WatchSpot("BTCUSDT"))
+	c := binance.NewClient(apiKey, secretKey, ex.SpotOptions()...)
+
+	// the depth is matched locally
+	s.Eventually(func() bool {
+		depth, err := c.NewDepthService().Symbol("BTCUSDT").Do(ctx)
+		return err == nil && len(depth.Asks) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("0.1").Price("29500").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeNew, res.Status)
+
+	s.trades <- `{"e":"trade","E":1,"s":"BTCUSDT","t":1,"p":"29400.00","q":"1","T":1600000000000}`
+	s.Eventually(func() bool {
+		order, err := c.NewGetOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(ctx)
+		return err == nil && order.Status == binance.OrderStatusTypeFilled
+	}, 5*time.Second, 10*time.Millisecond)
+	free, _ := ex.Spot.Balance(apiKey, "BTC")
+	s.Equal("0.0999", free) // 0.1% commission
+
+	// market data is proxied to Binance
+	klines, err := c.NewKlinesService().Symbol("BTCUSDT").Interval("1d").Do(ctx)
+	s.Require().NoError(err)
+	s.Len(klines, 1)
+	events := make(chan *binance.WsAggTradeEvent, 1)
+	doneC, stopC, err := c.WsClient().WsAggTradeServe("BTCUSDT", func(event *binance.WsAggTradeEvent) {
+		events <- event
+	}, func(err error) {})
+	s.Require().NoError(err)
+	select {
+	case event := <-events:
+		s.Equal("29995.00", event.Price)
+	case <-time.After(5 * time.Second):
+		s.Fail("no proxied event")
+	}
+	close(stopC)
+	<-doneC
+
+	// signed requests which are not emulated never reach Binance
+	_, err = c.NewCreateMarginOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("0.1").Do(ctx)
+	var apiErr *common.APIError
+	s.Require().ErrorAs(err, &apiErr)
+	s.Equal(http.StatusNotImplemented, apiErr.StatusCode)
+	s.Equal(int64(binancetest.ErrorCodeUnknown), apiErr.Code)
+	ex.Close()
+
+	// the recording replays the listing, depth and trade
+	lines := strings.Split(strings.TrimSpace(recording.String()), "\n")
+	s.Require().Len(lines, 3)
+	s.Contains(lines[0], `"listing":{"Symbol":"BTCUSDT"`)
+	s.Contains(lines[2], `"price":"29400.00"`)
+	replay := NewExchange()
+	defer replay.Close()
+	s.Require().NoError(replay.Replay(ctx, strings.NewReader(recording.String()), 0))
+}
+
+func (s *exchangeTestSuite) TestReplay() {
+	ctx := context.Background()
+	ex := NewExchange(WithSpotCommission("0", "0"))
+	defer ex.Close()
+	ex.AddAccount(apiKey, secretKey)
+	ex.Spot.SetBalance(apiKey, "BTC", "1")
+	s.Require().NoError(ex.Replay(ctx, strings.NewReader(`
+{"time":1600000000000,"listing":{"Symbol":"BTCUSDT","BaseAsset":"BTC","QuoteAsset":"USDT"}}
+{"time":1600000000100,"symbol":"BTCUSDT","bids":[["29990","1"]],"asks":[["30000","1"]]}
+`), 0))
+
+	c := binance.NewClient(apiKey, secretKey, ex.SpotOptions()...)
+	key, err := c.NewStartUserStreamService().Do(ctx)
+	s.Require().NoError(err)
+	events := make(chan *binance.WsUserDataEvent, 10)
+	doneC, stopC, err := c.WsClient().WsUserDataServe(key, func(event *binance.WsUserDataEvent) {
+		events <- event
+	}, func(err error) {})
+	s.Require().NoError(err)
+	defer func() {
+		close(stopC)
+		<-doneC
+	}()
+
+	res, err := c.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("0.5").Price("30100").Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(int64(1600000000100), res.TransactTime)
+
+	start := time.Now()
+	s.Require().NoError(ex.Replay(ctx, strings.NewReader(`
+{"time":1600000000150,"symbol":"BTCUSDT","price":"30050","quantity":"1"}
+{"time":1600000000200,"symbol":"BTCUSDT","price":"30200","quantity":"2"}
+`), 2))
+	s.GreaterOrEqual(time.Since(start), 25*time.Millisecond)
+	order, err := c.NewGetOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(ctx)
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeFilled, order.Status)
+	s.Equal(int64(1600000000200), order.UpdateTime)
+
+	var fill *binance.WsUserDataEvent
+	for fill == nil {
+		select {
+		case event := <-events:
+			if event.Event == binance.UserDataEventTypeExecutionReport && event.OrderUpdate.ExecutionType == "TRADE" {
+				fill = event
+			}
+		case <-time.After(5 * time.Second):
+			s.FailNow("no fill event")
+		}
+	}
+	s.Equal("30100.00000000", fill.OrderUpdate.LatestPrice)
+
+	err = ex.Replay(ctx, strings.NewReader(`{"time":1600000000300,"symbol":"ETHUSDT","price":"2000","quantity":"1"}`), 0)
+	s.EqualError(err, "paper: symbol ETHUSDT is not listed")
+}
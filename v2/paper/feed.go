@@ -0,0 +1,245 @@
+package paper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/binancetest"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// partialDepthLevels is the number of levels of the watched depth
+const partialDepthLevels = 20
+
+// Event is a market data update of an Exchange: the listing of a symbol, its depth or a trade.
+// Watched updates are written by WithRecorder as JSON lines, which Replay reads.
+type Event struct {
+	Time     int64               `json:"time"`
+	Futures  bool                `json:"futures,omitempty"`
+	Listing  *binancetest.Symbol `json:"listing,omitempty"`
+	Symbol   string              `json:"symbol,omitempty"`
+	Bids     [][2]string         `json:"bids,omitempty"`
+	Asks     [][2]string         `json:"asks,omitempty"`
+	Price    string              `json:"price,omitempty"`
+	Quantity string              `json:"quantity,omitempty"`
+}
+
+type recorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// WithRecorder write the market data of watched symbols to w, to be replayed later
+func WithRecorder(w io.Writer) Option {
+	return func(o *options) {
+		o.recorder = &recorder{enc: json.NewEncoder(w)}
+	}
+}
+
+// Apply match the orders of the exchange against a market data update. The depth replaces the
+// one of the symbol, while a trade triggers the stop orders and fills the resting orders it reaches.
+func (e *Exchange) Apply(event *Event) error {
+	m, key := e.Spot, "spot:"
+	if event.Futures {
+		m, key = e.Futures, "futures:"
+	}
+	e.mu.Lock()
+	if event.Listing != nil {
+		e.listings[key+event.Listing.Symbol] = true
+	} else if !e.listings[key+event.Symbol] {
+		e.mu.Unlock()
+		return fmt.Errorf("paper: symbol %s is not listed", event.Symbol)
+	}
+	e.mu.Unlock()
+
+	switch {
+	case event.Listing != nil:
+		m.AddSymbol(*event.Listing)
+	case event.Price != "":
+		m.Trade(event.Symbol, event.Price, event.Quantity)
+	default:
+		m.SetDepth(event.Symbol, event.Bids, event.Asks)
+	}
+	if r := e.opts.recorder; r != nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return r.enc.Encode(event)
+	}
+	return nil
+}
+
+// Replay apply the events read from r, the exchange time following theirs. A positive speed
+// paces the events, 1 replaying them in real time, otherwise they are applied at once.
+// Signed requests are accepted whatever their timestamp while replaying.
+func (e *Exchange) Replay(ctx context.Context, r io.Reader, speed float64) error {
+	e.srv.SkipTimestampCheck(true)
+	e.srv.SetClock(e.now)
+	dec := json.NewDecoder(r)
+	var start time.Time
+	var first int64
+	for {
+		event := new(Event)
+		if err := dec.Decode(event); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if event.Time > 0 {
+			if first == 0 {
+				start, first = time.Now(), event.Time
+			}
+			if speed > 0 {
+				at := start.Add(time.Duration(float64(event.Time-first) * float64(time.Millisecond) / speed))
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(time.Until(at)):
+				}
+			}
+			atomic.StoreInt64(&e.clock, event.Time)
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := e.Apply(event); err != nil {
+			return err
+		}
+	}
+}
+
+// watch keep the stop channel of a watched stream, to be closed by Close
+func (e *Exchange) watch(stopC chan struct{}, err error) error {
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.stopCs = append(e.stopCs, stopC)
+	return nil
+}
+
+// apply apply a watched event, reporting the errors to the error handler
+func (e *Exchange) apply(event *Event) {
+	if err := e.Apply(event); err != nil {
+		e.opts.errHandler(err)
+	}
+}
+
+func levels(l []common.PriceLevel) [][2]string {
+	res := make([][2]string, len(l))
+	for i, level := range l {
+		res[i] = [2]string{level.Price, level.Quantity}
+	}
+	return res
+}
+
+// WatchSpot list spot symbols with their filters on Binance, then match orders against their
+// live depth and trades until Close
+func (e *Exchange) WatchSpot(symbols ...string) error {
+	c := binance.NewClient("", "", binance.WithBaseURL(e.opts.spotAPIURL))
+	info, err := c.NewExchangeInfoService().Symbols(symbols...).Do(context.Background())
+	if err != nil {
+		return err
+	}
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	for _, s := range info.Symbols {
+		listing := &binancetest.Symbol{Symbol: s.Symbol, BaseAsset: s.BaseAsset, QuoteAsset: s.QuoteAsset}
+		if f := s.PriceFilter(); f != nil {
+			listing.MinPrice, listing.MaxPrice, listing.TickSize = f.MinPrice, f.MaxPrice, f.TickSize
+		}
+		if f := s.LotSizeFilter(); f != nil {
+			listing.MinQty, listing.MaxQty, listing.StepSize = f.MinQuantity, f.MaxQuantity, f.StepSize
+		}
+		if f := s.NotionalFilter(); f != nil {
+			listing.MinNotional = f.MinNotional
+		} else if f := s.MinNotionalFilter(); f != nil {
+			listing.MinNotional = f.MinNotional
+		}
+		e.apply(&Event{Time: now, Listing: listing})
+	}
+
+	ws := binance.NewWsClient(binance.WithWsURL(e.opts.spotWsURL))
+	for _, symbol := range symbols {
+		symbol := symbol
+		_, stopC, err := ws.WsPartialDepthServe100Ms(symbol, fmt.Sprint(partialDepthLevels), func(event *binance.WsPartialDepthEvent) {
+			e.apply(&Event{
+				Time:   time.Now().UnixNano() / int64(time.Millisecond),
+				Symbol: symbol,
+				Bids:   levels(event.Bids),
+				Asks:   levels(event.Asks),
+			})
+		}, e.opts.errHandler)
+		if err := e.watch(stopC, err); err != nil {
+			return err
+		}
+		_, stopC, err = ws.WsTradeServe(symbol, func(event *binance.WsTradeEvent) {
+			e.apply(&Event{Time: event.TradeTime, Symbol: symbol, Price: event.Price, Quantity: event.Quantity})
+		}, e.opts.errHandler)
+		if err := e.watch(stopC, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WatchFutures list futures symbols with their filters on Binance, then match orders against
+// their live depth and trades until Close
+func (e *Exchange) WatchFutures(symbols ...string) error {
+	c := futures.NewClient("", "", futures.WithBaseURL(e.opts.futuresAPIURL))
+	info, err := c.NewExchangeInfoService().Do(context.Background())
+	if err != nil {
+		return err
+	}
+	watched := make(map[string]bool)
+	for _, symbol := range symbols {
+		watched[symbol] = true
+	}
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	for _, s := range info.Symbols {
+		if !watched[s.Symbol] {
+			continue
+		}
+		listing := &binancetest.Symbol{Symbol: s.Symbol, BaseAsset: s.BaseAsset, QuoteAsset: s.QuoteAsset}
+		if f := s.PriceFilter(); f != nil {
+			listing.MinPrice, listing.MaxPrice, listing.TickSize = f.MinPrice, f.MaxPrice, f.TickSize
+		}
+		if f := s.LotSizeFilter(); f != nil {
+			listing.MinQty, listing.MaxQty, listing.StepSize = f.MinQuantity, f.MaxQuantity, f.StepSize
+		}
+		if f := s.MinNotionalFilter(); f != nil {
+			listing.MinNotional = f.Notional
+		}
+		e.apply(&Event{Time: now, Futures: true, Listing: listing})
+	}
+
+	ws := futures.NewWsClient(futures.WithWsURL(e.opts.futuresWsURL))
+	for _, symbol := range symbols {
+		symbol := symbol
+		_, stopC, err := ws.WsPartialDepthServeWithRate(symbol, partialDepthLevels, 100*time.Millisecond, func(event *futures.WsDepthEvent) {
+			e.apply(&Event{
+				Time:    event.Time,
+				Futures: true,
+				Symbol:  symbol,
+				Bids:    levels(event.Bids),
+				Asks:    levels(event.Asks),
+			})
+		}, e.opts.errHandler)
+		if err := e.watch(stopC, err); err != nil {
+			return err
+		}
+		_, stopC, err = ws.WsAggTradeServe(symbol, func(event *futures.WsAggTradeEvent) {
+			e.apply(&Event{Time: event.TradeTime, Futures: true, Symbol: symbol, Price: event.Price, Quantity: event.Quantity})
+		}, e.opts.errHandler)
+		if err := e.watch(stopC, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
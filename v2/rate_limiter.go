@@ -0,0 +1,113 @@
+package binance
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// requestWeights define the weight of /api endpoints whose weight does not depend on parameters,
+// other /api endpoints weigh 1
+var requestWeights = map[string]int64{
+	http.MethodGet + " /api/v3/trades":                   25,
+	http.MethodGet + " /api/v3/historicalTrades":         25,
+	http.MethodGet + " /api/v3/aggTrades":                2,
+	http.MethodGet + " /api/v3/klines":                   2,
+	http.MethodGet + " /api/v3/avgPrice":                 2,
+	http.MethodGet + " /api/v3/exchangeInfo":             20,
+	http.MethodGet + " /api/v3/account":                  20,
+	http.MethodGet + " /api/v3/myTrades":                 20,
+	http.MethodGet + " /api/v3/allOrders":                20,
+	http.MethodGet + " /api/v3/order":                    4,
+	http.MethodGet + " /api/v3/orderList":                4,
+	http.MethodGet + " /api/v3/allOrderList":             20,
+	http.MethodGet + " /api/v3/openOrderList":            6,
+	http.MethodGet + " /api/v3/rateLimit/order":          40,
+	http.MethodPut + " /api/v3/order/amend/keepPriority": 4,
+	http.MethodPost + " /api/v3/userDataStream":          2,
+	http.MethodPut + " /api/v3/userDataStream":           2,
+	http.MethodDelete + " /api/v3/userDataStream":        2,
+}
+
+// orderEndpoints count against the ORDERS limits
+var orderEndpoints = map[string]bool{
+	http.MethodPost + " /api/v3/order":               true,
+	http.MethodPost + " /api/v3/order/oco":           true,
+	http.MethodPost + " /api/v3/order/cancelReplace": true,
+	http.MethodPost + " /api/v3/orderList/oco":       true,
+	http.MethodPost + " /api/v3/orderList/oto":       true,
+	http.MethodPost + " /api/v3/orderList/otoco":     true,
+}
+
+// NewRateLimiter init a limiter for Client.RateLimiter with the rate limits of exchangeInfo
+func NewRateLimiter(rateLimits []RateLimit) *common.RateLimiter {
+	limits := make([]common.RateLimit, len(rateLimits))
+	for i, l := range rateLimits {
+		limits[i] = common.RateLimit{
+			RateLimitType: l.RateLimitType,
+			Interval:      l.Interval,
+			IntervalNum:   l.IntervalNum,
+			Limit:         l.Limit,
+		}
+	}
+	return common.NewRateLimiter(limits, requestCost)
+}
+
+// requestCost return the weight of a spot request and the number of orders it places. /sapi endpoints are limited separately
+// and weigh nothing here.
+func requestCost(method, endpoint string, query url.Values) (weight, orders int64) {
+	if !strings.HasPrefix(endpoint, "/api/") {
+		return 0, 0
+	}
+	key := method + " " + endpoint
+	if orderEndpoints[key] {
+		orders = 1
+	}
+	_, hasSymbol := query["symbol"]
+	_, hasSymbols := query["symbols"]
+	switch key {
+	case http.MethodGet + " /api/v3/depth":
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		switch {
+		case limit == 0 || limit <= 100:
+			// the default limit is 100
+			return 5, orders
+		case limit <= 500:
+			return 25, orders
+		case limit <= 1000:
+			return 50, orders
+		default:
+			return 250, orders
+		}
+	case http.MethodGet + " /api/v3/ticker/24hr":
+		if hasSymbol {
+			return 2, orders
+		}
+		if hasSymbols {
+			return 40, orders
+		}
+		return 80, orders
+	case http.MethodGet + " /api/v3/ticker/price", http.MethodGet + " /api/v3/ticker/bookTicker":
+		if hasSymbol {
+			return 2, orders
+		}
+		return 4, orders
+	case http.MethodGet + " /api/v3/openOrders":
+		if hasSymbol {
+			return 6, orders
+		}
+		return 80, orders
+	case http.MethodGet + " /api/v3/myPreventedMatches":
+		if _, ok := query["preventedMatchId"]; ok {
+			return 2, orders
+		}
+		return 20, orders
+	}
+	if weight, ok := requestWeights[key]; ok {
+		return weight, orders
+	}
+	return 1, orders
+}
@@ -0,0 +1,70 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type rateLimiterTestSuite struct {
+	baseTestSuite
+}
+
+func TestRateLimiter(t *testing.T) {
+	suite.Run(t, new(rateLimiterTestSuite))
+}
+
+func (s *rateLimiterTestSuite) TestRequestCost() {
+	tests := []struct {
+		method   string
+		endpoint string
+		query    url.Values
+		weight   int64
+		orders   int64
+	}{
+		{http.MethodGet, "/api/v3/ping", nil, 1, 0},
+		{http.MethodGet, "/api/v3/depth", nil, 5, 0},
+		{http.MethodGet, "/api/v3/depth", url.Values{"limit": {"500"}}, 25, 0},
+		{http.MethodGet, "/api/v3/depth", url.Values{"limit": {"5000"}}, 250, 0},
+		{http.MethodGet, "/api/v3/ticker/24hr", url.Values{"symbol": {"BTCUSDT"}}, 2, 0},
+		{http.MethodGet, "/api/v3/ticker/24hr", nil, 80, 0},
+		{http.MethodGet, "/api/v3/openOrders", nil, 80, 0},
+		{http.MethodGet, "/api/v3/account", nil, 20, 0},
+		{http.MethodGet, "/api/v3/myPreventedMatches", url.Values{"preventedMatchId": {"1"}}, 2, 0},
+		{http.MethodGet, "/api/v3/myPreventedMatches", url.Values{"orderId": {"5"}}, 20, 0},
+		{http.MethodPost, "/api/v3/order", nil, 1, 1},
+		{http.MethodPost, "/api/v3/orderList/otoco", nil, 1, 1},
+		{http.MethodGet, "/api/v3/allOrderList", nil, 20, 0},
+		{http.MethodGet, "/sapi/v1/capital/config/getall", nil, 0, 0},
+	}
+	for _, tt := range tests {
+		weight, orders := requestCost(tt.method, tt.endpoint, tt.query)
+		s.r().Equal(tt.weight, weight, tt.endpoint)
+		s.r().Equal(tt.orders, orders, tt.endpoint)
+	}
+}
+
+func (s *rateLimiterTestSuite) TestClientRateLimiter() {
+	s.client.RateLimiter = NewRateLimiter([]RateLimit{{
+		RateLimitType: common.RateLimitTypeRequestWeight,
+		Interval:      common.RateLimitIntervalDay,
+		IntervalNum:   1,
+		Limit:         30,
+	}})
+	s.client.RateLimiter.FailFast = true
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	var rateLimitErr *common.RateLimitError
+	s.r().True(errors.As(err, &rateLimitErr))
+	assert.Equal(s.T(), int64(30), rateLimitErr.RateLimit.Limit)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
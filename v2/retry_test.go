@@ -0,0 +1,127 @@
+package binance
+
+import (
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type retryTestSuite struct {
+	baseTestSuite
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(retryTestSuite))
+}
+
+func (s *retryTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.client.Client.do = s.client.do
+	s.client.RetryPolicy = &common.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}
+}
+
+// mockResponses return each response once, in order
+func (s *retryTestSuite) mockResponses(responses ...*http.Response) {
+	for _, res := range responses {
+		if res == nil {
+			s.client.On("do", anyHTTPRequest()).Return((*http.Response)(nil), &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}).Once()
+			continue
+		}
+		s.client.On("do", anyHTTPRequest()).Return(res, nil).Once()
+	}
+}
+
+func (s *retryTestSuite) TestRetryRead() {
+	s.mockResponses(
+		nil,
+		newHTTPResponse([]byte(`<html>Bad Gateway</html>`), http.StatusBadGateway),
+		newHTTPResponse([]byte(`{"serverTime": 1499827319559}`), http.StatusOK),
+	)
+	serverTime, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1499827319559), serverTime)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *retryTestSuite) TestRetryAttemptsExhausted() {
+	s.mockResponses(
+		newHTTPResponse([]byte(`{"code":-1001,"msg":"Internal error"}`), http.StatusBadRequest),
+		newHTTPResponse([]byte(`{"code":-1001,"msg":"Internal error"}`), http.StatusBadRequest),
+		newHTTPResponse([]byte(`{"code":-1001,"msg":"Internal error"}`), http.StatusBadRequest),
+	)
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().True(common.IsAPIErrorCode(err, common.ErrorCodeInternal))
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *retryTestSuite) TestNoRetryOnRejection() {
+	s.mockResponses(
+		newHTTPResponse([]byte(`{"code":-1121,"msg":"Invalid symbol."}`), http.StatusBadRequest),
+	)
+	_, err := s.client.NewDepthService().Symbol("XXX").Do(newContext())
+	s.r().True(common.IsAPIErrorCode(err, -1121))
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *retryTestSuite) TestRetryTimestamp() {
+	s.mockResponses(
+		newHTTPResponse([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`), http.StatusBadRequest),
+		newHTTPResponse([]byte(`{"serverTime": 1499827319559}`), http.StatusOK),
+		newHTTPResponse([]byte(`{"orderId": 1}`), http.StatusOK),
+	)
+	order, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(1).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1), order.OrderID)
+	s.r().NotZero(s.client.TimeOffset)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *retryTestSuite) TestNoRetryOrderWithoutClientOrderID() {
+	s.mockResponses(
+		newHTTPResponse([]byte(`{"code":-1001,"msg":"Internal error"}`), http.StatusServiceUnavailable),
+	)
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	s.r().Error(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *retryTestSuite) TestRetryOrderNotPlaced() {
+	s.mockResponses(
+		nil,
+		newHTTPResponse([]byte(`{"code":-2013,"msg":"Order does not exist."}`), http.StatusBadRequest),
+		newHTTPResponse([]byte(`{"symbol":"BTCUSDT","orderId":2,"clientOrderId":"myOrder"}`), http.StatusOK),
+	)
+	var requests []*request
+	s.assertReq(func(r *request) {
+		requests = append(requests, r)
+	})
+	order, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderID("myOrder").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(2), order.OrderID)
+	s.r().Len(requests, 3)
+	s.r().Equal("myOrder", requests[1].query.Get("origClientOrderId"))
+	s.r().Equal("myOrder", requests[2].form.Get("newClientOrderId"))
+}
+
+func (s *retryTestSuite) TestRetryOrderPlaced() {
+	s.mockResponses(
+		newHTTPResponse([]byte(`{"code":-1001,"msg":"Internal error"}`), http.StatusServiceUnavailable),
+		newHTTPResponse([]byte(`{"symbol":"BTCUSDT","orderId":3,"clientOrderId":"myOrder","status":"FILLED"}`), http.StatusOK),
+	)
+	order, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderID("myOrder").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(3), order.OrderID)
+	s.r().Equal(OrderStatusTypeFilled, order.Status)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
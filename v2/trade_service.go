@@ -3,6 +3,8 @@ package binance
 import (
 	"context"
 	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ListTradesService list trades
@@ -152,6 +154,21 @@ type Trade struct {
 	IsIsolated    bool   `json:"isIsolated"`
 }
 
+// PriceDecimal return Price as a decimal
+func (t *Trade) PriceDecimal() common.Decimal {
+	return common.ToDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal
+func (t *Trade) QuantityDecimal() common.Decimal {
+	return common.ToDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as a decimal
+func (t *Trade) QuoteQuantityDecimal() common.Decimal {
+	return common.ToDecimal(t.QuoteQuantity)
+}
+
 // TradeV3 define v3 trade info
 type TradeV3 struct {
 	ID              int64  `json:"id"`
@@ -170,6 +187,26 @@ type TradeV3 struct {
 	IsIsolated      bool   `json:"isIsolated"`
 }
 
+// PriceDecimal return Price as a decimal
+func (t *TradeV3) PriceDecimal() common.Decimal {
+	return common.ToDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal
+func (t *TradeV3) QuantityDecimal() common.Decimal {
+	return common.ToDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as a decimal
+func (t *TradeV3) QuoteQuantityDecimal() common.Decimal {
+	return common.ToDecimal(t.QuoteQuantity)
+}
+
+// CommissionDecimal return Commission as a decimal
+func (t *TradeV3) CommissionDecimal() common.Decimal {
+	return common.ToDecimal(t.Commission)
+}
+
 // AggTradesService list aggregate trades
 type AggTradesService struct {
 	c         *Client
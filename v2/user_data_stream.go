@@ -0,0 +1,148 @@
+package binance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/internal/transport"
+)
+
+// DefaultUserDataStreamKeepalive is the delay between the keepalive requests of a UserDataStream
+const DefaultUserDataStreamKeepalive = 30 * time.Minute
+
+// UserDataStream keep the user data stream of the spot, margin or isolated margin account open.
+// It creates the listen key and keeps it alive, and reconnects when the connection drops, with a
+// new listen key if it expired. Events sent while reconnecting are lost, so the OnResync hook is
+// called once reconnected to reconcile the local state, such as open orders and balances.
+type UserDataStream struct {
+	c                 *Client
+	handler           WsUserDataHandler
+	errHandler        ErrHandler
+	margin            bool
+	isolatedSymbol    string
+	onResync          func()
+	keepaliveInterval time.Duration
+
+	mu     sync.Mutex
+	stream *transport.UserStream
+}
+
+// Margin stream the events of the cross margin account
+func (s *UserDataStream) Margin() *UserDataStream {
+	s.margin = true
+	return s
+}
+
+// IsolatedMargin stream the events of the isolated margin account of symbol
+func (s *UserDataStream) IsolatedMargin(symbol string) *UserDataStream {
+	s.isolatedSymbol = symbol
+	return s
+}
+
+// OnResync set the hook called after reconnecting
+func (s *UserDataStream) OnResync(f func()) *UserDataStream {
+	s.onResync = f
+	return s
+}
+
+// KeepaliveInterval set the delay between keepalive requests, DefaultUserDataStreamKeepalive by default
+func (s *UserDataStream) KeepaliveInterval(d time.Duration) *UserDataStream {
+	s.keepaliveInterval = d
+	return s
+}
+
+// Start create the listen key and connect, the stream being kept open until ctx is done or Stop is called
+func (s *UserDataStream) Start(ctx context.Context) error {
+	ws := *s.c.WsClient()
+	reconnect := ws.Reconnect
+	ws.Reconnect = nil // reconnections are made by the stream, which may need a new listen key
+	cfg := &transport.UserStreamConfig{
+		Start:     s.startListenKey,
+		Keepalive: s.keepaliveListenKey,
+		Close:     s.closeListenKey,
+		Serve: func(listenKey string, expired func()) (doneC, stopC chan struct{}, err error) {
+			return ws.WsUserDataServe(listenKey, func(event *WsUserDataEvent) {
+				if event.Event == UserDataEventTypeListenKeyExpired {
+					expired()
+				}
+				s.handler(event)
+			}, s.errHandler)
+		},
+		KeepaliveInterval: s.keepaliveInterval,
+		Reconnect:         reconnect,
+		ErrHandler:        s.errHandler,
+		OnResync:          s.onResync,
+	}
+	if cfg.KeepaliveInterval <= 0 {
+		cfg.KeepaliveInterval = DefaultUserDataStreamKeepalive
+	}
+	stream := transport.NewUserStream(cfg)
+	s.mu.Lock()
+	s.stream = stream
+	s.mu.Unlock()
+	return stream.Start(ctx)
+}
+
+// Stop close the connection and the listen key, it does nothing if the stream wasn't started
+func (s *UserDataStream) Stop() {
+	if stream := s.current(); stream != nil {
+		stream.Stop()
+	}
+}
+
+// Done is closed once the stream is stopped, or gives up reconnecting. It is closed already if
+// the stream wasn't started.
+func (s *UserDataStream) Done() <-chan struct{} {
+	stream := s.current()
+	if stream == nil {
+		doneC := make(chan struct{})
+		close(doneC)
+		return doneC
+	}
+	return stream.Done()
+}
+
+// ListenKey return the current listen key, empty if the stream wasn't started
+func (s *UserDataStream) ListenKey() string {
+	if stream := s.current(); stream != nil {
+		return stream.ListenKey()
+	}
+	return ""
+}
+
+func (s *UserDataStream) current() *transport.UserStream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream
+}
+
+func (s *UserDataStream) startListenKey(ctx context.Context) (string, error) {
+	switch {
+	case s.isolatedSymbol != "":
+		return s.c.NewStartIsolatedMarginUserStreamService().Symbol(s.isolatedSymbol).Do(ctx)
+	case s.margin:
+		return s.c.NewStartMarginUserStreamService().Do(ctx)
+	}
+	return s.c.NewStartUserStreamService().Do(ctx)
+}
+
+func (s *UserDataStream) keepaliveListenKey(ctx context.Context, listenKey string) error {
+	switch {
+	case s.isolatedSymbol != "":
+		return s.c.NewKeepaliveIsolatedMarginUserStreamService().Symbol(s.isolatedSymbol).ListenKey(listenKey).Do(ctx)
+	case s.margin:
+		return s.c.NewKeepaliveMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+	}
+	return s.c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+}
+
+func (s *UserDataStream) closeListenKey(ctx context.Context, listenKey string) error {
+	switch {
+	case s.isolatedSymbol != "":
+		return s.c.NewCloseIsolatedMarginUserStreamService().Symbol(s.isolatedSymbol).ListenKey(listenKey).Do(ctx)
+	case s.margin:
+		return s.c.NewCloseMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+	}
+	return s.c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+}
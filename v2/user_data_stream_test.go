@@ -0,0 +1,146 @@
+package binance
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type userDataStreamTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+	mu          sync.Mutex
+	requests    []string
+	endpoints   []string
+	handlers    []WsHandler
+	keys        int
+}
+
+func TestUserDataStream(t *testing.T) {
+	suite.Run(t, new(userDataStreamTestSuite))
+}
+
+func (s *userDataStreamTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServe
+	s.requests, s.endpoints, s.handlers, s.keys = nil, nil, nil, 0
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		params := req.URL.Query()
+		if req.Body != nil {
+			body, _ := ioutil.ReadAll(req.Body)
+			form, _ := url.ParseQuery(string(body))
+			for k, v := range form {
+				params[k] = v
+			}
+		}
+		request := fmt.Sprintf("%s %s", req.Method, req.URL.Path)
+		for _, k := range []string{"listenKey", "symbol"} {
+			if v := params.Get(k); v != "" {
+				request += " " + v
+			}
+		}
+		s.requests = append(s.requests, request)
+		if req.Method != http.MethodPost {
+			return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+		}
+		s.keys++
+		return newHTTPResponse([]byte(fmt.Sprintf(`{"listenKey":"key%d"}`, s.keys)), http.StatusOK), nil
+	}
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.endpoints = append(s.endpoints, cfg.Endpoint)
+		s.handlers = append(s.handlers, handler)
+		doneC, stopC = make(chan struct{}), make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+}
+
+func (s *userDataStreamTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+func (s *userDataStreamTestSuite) handler(i int) WsHandler {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.handlers[i]
+}
+
+func (s *userDataStreamTestSuite) served() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.endpoints)
+}
+
+func (s *userDataStreamTestSuite) TestListenKeyExpired() {
+	var events []*WsUserDataEvent
+	resynced := make(chan struct{})
+	stream := s.client.NewUserDataStream(func(event *WsUserDataEvent) {
+		events = append(events, event)
+	}, func(err error) {}).OnResync(func() { close(resynced) })
+	s.Require().NoError(stream.Start(newContext()))
+	s.Equal("key1", stream.ListenKey())
+
+	s.handler(0)([]byte(`{"e":"balanceUpdate","E":1573200697110,"a":"BTC","d":"100.00000000","T":1573200697068}`))
+	s.handler(0)([]byte(`{"e":"listenKeyExpired","E":1576653824250}`))
+	select {
+	case <-resynced:
+	case <-time.After(time.Second):
+		s.FailNow("not resynced")
+	}
+	s.Equal(2, s.served())
+	s.Equal("key2", stream.ListenKey())
+	stream.Stop()
+
+	s.Require().Len(events, 2)
+	s.Equal(UserDataEventTypeBalanceUpdate, events[0].Event)
+	s.Equal("100.00000000", events[0].BalanceUpdate.Change)
+	s.Equal(UserDataEventTypeListenKeyExpired, events[1].Event)
+	s.Equal([]string{baseWsMainURL + "/key1", baseWsMainURL + "/key2"}, s.endpoints)
+	s.Equal([]string{
+		"POST /api/v3/userDataStream",
+		"POST /api/v3/userDataStream",
+		"DELETE /api/v3/userDataStream key1",
+		"DELETE /api/v3/userDataStream key2",
+	}, s.requests)
+}
+
+func (s *userDataStreamTestSuite) TestIsolatedMargin() {
+	stream := s.client.NewUserDataStream(func(event *WsUserDataEvent) {}, func(err error) {}).
+		IsolatedMargin("BTCUSDT").KeepaliveInterval(time.Millisecond)
+	s.Require().NoError(stream.Start(newContext()))
+	s.Eventually(func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.requests) >= 2
+	}, time.Second, time.Millisecond)
+	stream.Stop()
+	<-stream.Done()
+
+	s.Equal("POST /sapi/v1/userDataStream/isolated BTCUSDT", s.requests[0])
+	s.Equal("PUT /sapi/v1/userDataStream/isolated key1 BTCUSDT", s.requests[1])
+	s.Equal("DELETE /sapi/v1/userDataStream/isolated key1 BTCUSDT", s.requests[len(s.requests)-1])
+}
+
+func (s *userDataStreamTestSuite) TestNotStarted() {
+	stream := s.client.NewUserDataStream(func(event *WsUserDataEvent) {}, func(err error) {})
+	s.Empty(stream.ListenKey())
+	stream.Stop()
+	select {
+	case <-stream.Done():
+	default:
+		s.Fail("done channel not closed")
+	}
+}
@@ -0,0 +1,345 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	stdjson "encoding/json"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Endpoints
+const (
+	baseWsAPIMainURL    = "wss://ws-api.binance.com:443/ws-api/v3"
+	baseWsAPITestnetURL = "wss://testnet.binance.vision/ws-api/v3"
+)
+
+// ErrWsAPIClosed is returned by requests sent on a closed websocket API connection
+var ErrWsAPIClosed = errors.New("websocket api: connection closed")
+
+// wsAPIMethods map REST endpoints to the websocket API methods serving them
+var wsAPIMethods = map[string]string{
+	http.MethodPost + " /api/v3/order":      "order.place",
+	http.MethodPost + " /api/v3/order/test": "order.test",
+	http.MethodDelete + " /api/v3/order":    "order.cancel",
+	http.MethodGet + " /api/v3/order":       "order.status",
+	http.MethodGet + " /api/v3/account":     "account.status",
+	http.MethodGet + " /api/v3/depth":       "depth",
+}
+
+// wsAPIIntParams are sent as JSON numbers, other parameters are sent as strings
+var wsAPIIntParams = map[string]bool{
+	timestampKey:    true,
+	recvWindowKey:   true,
+	"limit":         true,
+	"orderId":       true,
+	"trailingDelta": true,
+}
+
+// WsAPIClient send requests over a single persistent connection to the websocket API.
+// Services created from it mirror the REST services of Client and return the same responses.
+type WsAPIClient struct {
+	APIKey     string
+	SecretKey  string
+	Signer     common.Signer // signs SIGNED requests instead of the HMAC of SecretKey if set
+	Endpoint   string
+	TimeOffset int64
+	// Timeout bounds each request whose context has no deadline, 0 means no timeout
+	Timeout time.Duration
+
+	mu     sync.Mutex
+	conn   *wsAPIConn
+	nextID uint64
+}
+
+// wsAPIConn is a connection of a WsAPIClient, with the requests waiting for a response on it
+type wsAPIConn struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	pending map[string]chan *wsAPIResponse // nil once the connection is lost
+	doneC   chan struct{}
+	err     error
+}
+
+type wsAPIRequest struct {
+	ID     string                 `json:"id"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+type wsAPIResponse struct {
+	ID     string             `json:"id"`
+	Status int                `json:"status"`
+	Result stdjson.RawMessage `json:"result"`
+	Error  *common.APIError   `json:"error"`
+}
+
+// NewWsAPIClient init a websocket API client, call Connect before sending requests.
+// WithTestnet and WithTimeout apply to it.
+func NewWsAPIClient(apiKey, secretKey string, opts ...ClientOption) *WsAPIClient {
+	o := newClientOptions(opts)
+	c := &WsAPIClient{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		Endpoint:  baseWsAPIMainURL,
+		Timeout:   10 * time.Second,
+	}
+	if o.testnet {
+		c.Endpoint = baseWsAPITestnetURL
+	}
+	if o.timeout > 0 {
+		c.Timeout = o.timeout
+	}
+	return c
+}
+
+// signer return the signer of SIGNED requests
+func (c *WsAPIClient) signer() common.Signer {
+	if c.Signer != nil {
+		return c.Signer
+	}
+	return common.NewHMACSigner(c.SecretKey)
+}
+
+// Connect dial the websocket API. An existing connection is closed, failing the requests still
+// waiting for a response on it with ErrWsAPIClosed.
+func (c *WsAPIClient) Connect(ctx context.Context) error {
+	dialer := websocket.Dialer{
+		Proxy:             http.ProxyFromEnvironment,
+		HandshakeTimeout:  45 * time.Second,
+		EnableCompression: false,
+	}
+	conn, _, err := dialer.DialContext(ctx, c.Endpoint, nil)
+	if err != nil {
+		return err
+	}
+	wc := &wsAPIConn{
+		conn:    conn,
+		pending: make(map[string]chan *wsAPIResponse),
+		doneC:   make(chan struct{}),
+	}
+	c.mu.Lock()
+	prev := c.conn
+	c.conn = wc
+	c.mu.Unlock()
+	if prev != nil {
+		prev.conn.Close()
+	}
+	go wc.readLoop()
+	return nil
+}
+
+// Close close the connection, failing the requests still waiting for a response
+func (c *WsAPIClient) Close() error {
+	c.mu.Lock()
+	wc := c.conn
+	c.mu.Unlock()
+	if wc == nil {
+		return nil
+	}
+	return wc.conn.Close()
+}
+
+// Done return a channel closed when the connection is lost or closed
+func (c *WsAPIClient) Done() <-chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.doneC
+}
+
+// readLoop pass the responses to the requests waiting for them until the connection is lost
+func (wc *wsAPIConn) readLoop() {
+	var err error
+	for {
+		var message []byte
+		_, message, err = wc.conn.ReadMessage()
+		if err != nil {
+			break
+		}
+		res := new(wsAPIResponse)
+		if e := json.Unmarshal(message, res); e != nil || res.ID == "" {
+			continue
+		}
+		wc.mu.Lock()
+		resC, ok := wc.pending[res.ID]
+		delete(wc.pending, res.ID)
+		wc.mu.Unlock()
+		if ok {
+			resC <- res
+		}
+	}
+	wc.mu.Lock()
+	wc.err = fmt.Errorf("%w: %v", ErrWsAPIClosed, err)
+	wc.pending = nil
+	close(wc.doneC)
+	wc.mu.Unlock()
+	wc.conn.Close()
+}
+
+// NewCreateOrderService init creating order service
+func (c *WsAPIClient) NewCreateOrderService() *CreateOrderService {
+	return &CreateOrderService{ws: c}
+}
+
+// NewCancelOrderService init cancel order service
+func (c *WsAPIClient) NewCancelOrderService() *CancelOrderService {
+	return &CancelOrderService{ws: c}
+}
+
+// NewGetOrderService init get order service
+func (c *WsAPIClient) NewGetOrderService() *GetOrderService {
+	return &GetOrderService{ws: c}
+}
+
+// NewGetAccountService init getting account service
+func (c *WsAPIClient) NewGetAccountService() *GetAccountService {
+	return &GetAccountService{ws: c}
+}
+
+// NewDepthService init depth service
+func (c *WsAPIClient) NewDepthService() *DepthService {
+	return &DepthService{ws: c}
+}
+
+// params convert r to websocket API parameters, signing them if needed
+func (c *WsAPIClient) params(r *request, opts ...RequestOption) (map[string]interface{}, error) {
+	for _, opt := range opts {
+		opt(r)
+	}
+	err := r.validate()
+	if err != nil {
+		return nil, err
+	}
+	if r.recvWindow > 0 {
+		r.setParam(recvWindowKey, r.recvWindow)
+	}
+	values := url.Values{}
+	for k, v := range r.query {
+		values[k] = v
+	}
+	for k, v := range r.form {
+		values[k] = v
+	}
+	if r.secType == secTypeAPIKey || r.secType == secTypeSigned {
+		values.Set("apiKey", c.APIKey)
+	}
+	if r.secType == secTypeSigned {
+		values.Set(timestampKey, strconv.FormatInt(currentTimestamp()-c.TimeOffset, 10))
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		payload := make([]string, len(keys))
+		for i, k := range keys {
+			payload[i] = fmt.Sprintf("%s=%s", k, values.Get(k))
+		}
+		signature, err := c.signer().Sign([]byte(strings.Join(payload, "&")))
+		if err != nil {
+			return nil, err
+		}
+		values.Set(signatureKey, signature)
+	}
+	params := make(map[string]interface{}, len(values))
+	for k := range values {
+		v := values.Get(k)
+		if wsAPIIntParams[k] {
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				params[k] = i
+				continue
+			}
+		}
+		params[k] = v
+	}
+	return params, nil
+}
+
+// callAPI send r as a websocket API request and wait for its result
+func (c *WsAPIClient) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	method, ok := wsAPIMethods[r.method+" "+r.endpoint]
+	if !ok {
+		return nil, fmt.Errorf("websocket api: no method for %s %s", r.method, r.endpoint)
+	}
+	params, err := c.params(r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := ctx.Deadline(); !ok && c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	c.mu.Lock()
+	wc := c.conn
+	c.nextID++
+	id := strconv.FormatUint(c.nextID, 10)
+	c.mu.Unlock()
+	if wc == nil {
+		return nil, ErrWsAPIClosed
+	}
+	resC := make(chan *wsAPIResponse, 1)
+	wc.mu.Lock()
+	if wc.pending == nil {
+		err = wc.err
+		wc.mu.Unlock()
+		return nil, err
+	}
+	wc.pending[id] = resC
+	wc.mu.Unlock()
+	defer func() {
+		wc.mu.Lock()
+		if wc.pending != nil {
+			delete(wc.pending, id)
+		}
+		wc.mu.Unlock()
+	}()
+
+	message, err := json.Marshal(&wsAPIRequest{ID: id, Method: method, Params: params})
+	if err != nil {
+		return nil, err
+	}
+	wc.writeMu.Lock()
+	if deadline, ok := ctx.Deadline(); ok {
+		wc.conn.SetWriteDeadline(deadline)
+	}
+	err = wc.conn.WriteMessage(websocket.TextMessage, message)
+	wc.writeMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-wc.doneC:
+		wc.mu.Lock()
+		err = wc.err
+		wc.mu.Unlock()
+		return nil, err
+	case res := <-resC:
+		if res.Status >= http.StatusBadRequest || res.Error != nil {
+			apiErr := new(common.APIError)
+			if res.Error != nil {
+				*apiErr = *res.Error
+			}
+			return nil, apiErr
+		}
+		return res.Result, nil
+	}
+}
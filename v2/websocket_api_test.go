@@ -0,0 +1,318 @@
+package binance
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	stdjson "encoding/json"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type websocketAPITestSuite struct {
+	suite.Suite
+	server    *httptest.Server
+	client    *WsAPIClient
+	apiKey    string
+	secretKey string
+	// requests receive every request decoded by the server
+	requests chan *wsAPIRequest
+	// reply build the response frame for a request, nil means no response
+	reply func(req *wsAPIRequest) []byte
+	// handlers wait for the connections of the server to be closed
+	handlers sync.WaitGroup
+}
+
+func TestWebsocketAPI(t *testing.T) {
+	suite.Run(t, new(websocketAPITestSuite))
+}
+
+func (s *websocketAPITestSuite) SetupTest() {
+	s.apiKey = "dummyAPIKey"
+	s.secretKey = "dummySecretKey"
+	s.requests = make(chan *wsAPIRequest, 10)
+	requests := s.requests
+	reply := &s.reply
+	s.reply = nil
+	upgrader := websocket.Upgrader{}
+	handlers := &s.handlers
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlers.Add(1)
+		defer handlers.Done()
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for {
+			_, message, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+			req := new(wsAPIRequest)
+			d := stdjson.NewDecoder(bytes.NewReader(message))
+			d.UseNumber()
+			if err := d.Decode(req); err != nil {
+				return
+			}
+			requests <- req
+			if *reply == nil {
+				continue
+			}
+			if res := (*reply)(req); res != nil {
+				c.WriteMessage(websocket.TextMessage, res)
+			}
+		}
+	}))
+	s.client = NewWsAPIClient(s.apiKey, s.secretKey)
+	s.client.Endpoint = "ws" + strings.TrimPrefix(s.server.URL, "http")
+}
+
+func (s *websocketAPITestSuite) TearDownTest() {
+	s.client.Close()
+	s.server.Close()
+	s.handlers.Wait()
+}
+
+func (s *websocketAPITestSuite) connect() {
+	s.Require().NoError(s.client.Connect(context.Background()))
+}
+
+func (s *websocketAPITestSuite) result(id string, result string) []byte {
+	return []byte(fmt.Sprintf(`{"id":%q,"status":200,"result":%s,"rateLimits":[]}`, id, result))
+}
+
+// assertSignature check the signature like the exchange does: HMAC of the
+// alphabetically sorted parameters
+func (s *websocketAPITestSuite) assertSignature(params map[string]interface{}) {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k != signatureKey {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	payload := make([]string, len(keys))
+	for i, k := range keys {
+		payload[i] = fmt.Sprintf("%s=%v", k, params[k])
+	}
+	mac := hmac.New(sha256.New, []byte(s.secretKey))
+	mac.Write([]byte(strings.Join(payload, "&")))
+	s.Equal(fmt.Sprintf("%x", mac.Sum(nil)), params[signatureKey])
+}
+
+func (s *websocketAPITestSuite) TestCreateOrder() {
+	s.reply = func(req *wsAPIRequest) []byte {
+		return s.result(req.ID, `{
+			"symbol": "BTCUSDT",
+			"orderId": 12569099453,
+			"orderListId": -1,
+			"clientOrderId": "myOrder1",
+			"transactTime": 1660801715639,
+			"price": "23416.10000000",
+			"origQty": "0.00847000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL"
+		}`)
+	}
+	s.connect()
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.00847000").
+		Price("23416.10000000").NewClientOrderID("myOrder1").
+		Do(context.Background(), WithRecvWindow(5000))
+	s.Require().NoError(err)
+	s.Equal(int64(12569099453), res.OrderID)
+	s.Equal("myOrder1", res.ClientOrderID)
+	s.Equal(OrderStatusTypeNew, res.Status)
+
+	req := <-s.requests
+	s.Equal("order.place", req.Method)
+	s.Equal("BTCUSDT", req.Params["symbol"])
+	s.Equal("SELL", req.Params["side"])
+	s.Equal("23416.10000000", req.Params["price"])
+	s.Equal(s.apiKey, req.Params["apiKey"])
+	s.Equal(stdjson.Number("5000"), req.Params[recvWindowKey])
+	s.IsType(stdjson.Number(""), req.Params[timestampKey])
+	s.assertSignature(req.Params)
+}
+
+func (s *websocketAPITestSuite) TestCancelOrder() {
+	s.reply = func(req *wsAPIRequest) []byte {
+		return s.result(req.ID, `{
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "myOrder1",
+			"orderId": 12569099453,
+			"orderListId": -1,
+			"clientOrderId": "cancel1",
+			"price": "23416.10000000",
+			"origQty": "0.00847000",
+			"executedQty": "0.00001000",
+			"cummulativeQuoteQty": "0.23416100",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL"
+		}`)
+	}
+	s.connect()
+	res, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(12569099453).
+		NewClientOrderID("cancel1").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(OrderStatusTypeCanceled, res.Status)
+	s.Equal("myOrder1", res.OrigClientOrderID)
+
+	req := <-s.requests
+	s.Equal("order.cancel", req.Method)
+	s.Equal(stdjson.Number("12569099453"), req.Params["orderId"])
+	s.assertSignature(req.Params)
+}
+
+func (s *websocketAPITestSuite) TestGetAccount() {
+	s.reply = func(req *wsAPIRequest) []byte {
+		return s.result(req.ID, `{
+			"makerCommission": 15,
+			"takerCommission": 15,
+			"canTrade": true,
+			"accountType": "SPOT",
+			"balances": [
+				{"asset": "BNB", "free": "0.00000000", "locked": "0.00000000"},
+				{"asset": "BTC", "free": "1.3447112", "locked": "0.08600000"}
+			],
+			"permissions": ["SPOT"]
+		}`)
+	}
+	s.connect()
+	res, err := s.client.NewGetAccountService().Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(int64(15), res.MakerCommission)
+	s.Equal([]Balance{
+		{Asset: "BNB", Free: "0.00000000", Locked: "0.00000000"},
+		{Asset: "BTC", Free: "1.3447112", Locked: "0.08600000"},
+	}, res.Balances)
+
+	req := <-s.requests
+	s.Equal("account.status", req.Method)
+	s.assertSignature(req.Params)
+}
+
+func (s *websocketAPITestSuite) TestDepth() {
+	s.reply = func(req *wsAPIRequest) []byte {
+		return s.result(req.ID, `{
+			"lastUpdateId": 2731179239,
+			"bids": [["0.01379900", "3.43200000"]],
+			"asks": [["0.01380000", "5.91700000"]]
+		}`)
+	}
+	s.connect()
+	res, err := s.client.NewDepthService().Symbol("BNBBTC").Limit(5).Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(int64(2731179239), res.LastUpdateID)
+	s.Equal([]Bid{{Price: "0.01379900", Quantity: "3.43200000"}}, res.Bids)
+	s.Equal([]Ask{{Price: "0.01380000", Quantity: "5.91700000"}}, res.Asks)
+
+	req := <-s.requests
+	s.Equal("depth", req.Method)
+	s.Equal(map[string]interface{}{"symbol": "BNBBTC", "limit": stdjson.Number("5")}, req.Params)
+}
+
+func (s *websocketAPITestSuite) TestAPIError() {
+	s.reply = func(req *wsAPIRequest) []byte {
+		return []byte(fmt.Sprintf(`{"id":%q,"status":400,"error":{"code":-2010,"msg":"Account has insufficient balance for requested action."}}`, req.ID))
+	}
+	s.connect()
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(context.Background())
+	s.Require().Error(err)
+	apiErr, ok := err.(*common.APIError)
+	s.Require().True(ok)
+	s.Equal(int64(-2010), apiErr.Code)
+}
+
+func (s *websocketAPITestSuite) TestTimeout() {
+	s.connect()
+	s.client.Timeout = 50 * time.Millisecond
+	_, err := s.client.NewDepthService().Symbol("BNBBTC").Do(context.Background())
+	s.Equal(context.DeadlineExceeded, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.client.NewDepthService().Symbol("BNBBTC").Do(ctx)
+	s.Equal(context.Canceled, err)
+}
+
+func (s *websocketAPITestSuite) TestClosed() {
+	_, err := s.client.NewDepthService().Symbol("BNBBTC").Do(context.Background())
+	s.Equal(ErrWsAPIClosed, err)
+
+	s.connect()
+	s.client.Close()
+	select {
+	case <-s.client.Done():
+	case <-time.After(5 * time.Second):
+		s.FailNow("connection not closed")
+	}
+	_, err = s.client.NewDepthService().Symbol("BNBBTC").Do(context.Background())
+	s.True(errors.Is(err, ErrWsAPIClosed))
+}
+
+func (s *websocketAPITestSuite) TestReconnect() {
+	// requests for LTCBTC only are answered
+	s.reply = func(req *wsAPIRequest) []byte {
+		if req.Params["symbol"] != "LTCBTC" {
+			return nil
+		}
+		return s.result(req.ID, `{"lastUpdateId":1,"bids":[],"asks":[]}`)
+	}
+	s.connect()
+	first := s.client.Done()
+	errC := make(chan error, 1)
+	go func() {
+		_, err := s.client.NewDepthService().Symbol("BNBBTC").Do(context.Background())
+		errC <- err
+	}()
+	<-s.requests
+
+	// the request in flight fails with the first connection, the second one keeps working
+	s.connect()
+	select {
+	case err := <-errC:
+		s.True(errors.Is(err, ErrWsAPIClosed), "got %v", err)
+	case <-time.After(5 * time.Second):
+		s.FailNow("request on the first connection not failed")
+	}
+	<-first
+	go func() {
+		_, err := s.client.NewDepthService().Symbol("BNBBTC").Do(context.Background())
+		errC <- err
+	}()
+	<-s.requests
+	res, err := s.client.NewDepthService().Symbol("LTCBTC").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(int64(1), res.LastUpdateID)
+	select {
+	case <-s.client.Done():
+		s.Fail("second connection closed")
+	default:
+	}
+
+	s.client.Close()
+	s.True(errors.Is(<-errC, ErrWsAPIClosed))
+}
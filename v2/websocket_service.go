@@ -21,24 +21,10 @@ var (
 	WebsocketTimeout = time.Second * 60
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = false
+	// WebsocketReconnect enables redialing dropped streams with the given policy, nil disables it
+	WebsocketReconnect *WsReconnectPolicy
 )
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
-func getWsEndpoint() string {
-	if UseTestnet {
-		return baseWsTestnetURL
-	}
-	return baseWsMainURL
-}
-
-// getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
-func getCombinedEndpoint() string {
-	if UseTestnet {
-		return baseCombinedTestnetURL
-	}
-	return baseCombinedMainURL
-}
-
 // WsPartialDepthEvent define websocket partial depth book event
 type WsPartialDepthEvent struct {
 	Symbol       string
@@ -50,21 +36,31 @@ type WsPartialDepthEvent struct {
 // WsPartialDepthHandler handle websocket partial depth event
 type WsPartialDepthHandler func(event *WsPartialDepthEvent)
 
-// WsPartialDepthServe serve websocket partial depth handler with a symbol, using 1sec updates
+// WsPartialDepthServe is WsClient.WsPartialDepthServe with the endpoints and settings of the package variables
 func WsPartialDepthServe(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth%s", getWsEndpoint(), strings.ToLower(symbol), levels)
-	return wsPartialDepthServe(endpoint, symbol, handler, errHandler)
+	return defaultWsClient().WsPartialDepthServe(symbol, levels, handler, errHandler)
 }
 
-// WsPartialDepthServe100Ms serve websocket partial depth handler with a symbol, using 100msec updates
+// WsPartialDepthServe serve websocket partial depth handler with a symbol, using 1sec updates
+func (c *WsClient) WsPartialDepthServe(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@depth%s", c.BaseURL, strings.ToLower(symbol), levels)
+	return c.wsPartialDepthServe(endpoint, symbol, handler, errHandler)
+}
+
+// WsPartialDepthServe100Ms is WsClient.WsPartialDepthServe100Ms with the endpoints and settings of the package variables
 func WsPartialDepthServe100Ms(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth%s@100ms", getWsEndpoint(), strings.ToLower(symbol), levels)
-	return wsPartialDepthServe(endpoint, symbol, handler, errHandler)
+	return defaultWsClient().WsPartialDepthServe100Ms(symbol, levels, handler, errHandler)
+}
+
+// WsPartialDepthServe100Ms serve websocket partial depth handler with a symbol, using 100msec updates
+func (c *WsClient) WsPartialDepthServe100Ms(symbol string, levels string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@depth%s@100ms", c.BaseURL, strings.ToLower(symbol), levels)
+	return c.wsPartialDepthServe(endpoint, symbol, handler, errHandler)
 }
 
 // WsPartialDepthServe serve websocket partial depth handler with a symbol
-func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func (c *WsClient) wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -97,14 +93,19 @@ func wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthH
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsCombinedPartialDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
+// WsCombinedPartialDepthServe is WsClient.WsCombinedPartialDepthServe with the endpoints and settings of the package variables
 func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedPartialDepthServe(symbolLevels, handler, errHandler)
+}
+
+// WsCombinedPartialDepthServe is similar to WsPartialDepthServe, but it for multiple symbols
+func (c *WsClient) WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for s, l := range symbolLevels {
 		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -144,21 +145,31 @@ func WsCombinedPartialDepthServe(symbolLevels map[string]string, handler WsParti
 // WsDepthHandler handle websocket depth event
 type WsDepthHandler func(event *WsDepthEvent)
 
-// WsDepthServe serve websocket depth handler with a symbol, using 1sec updates
+// WsDepthServe is WsClient.WsDepthServe with the endpoints and settings of the package variables
 func WsDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth", getWsEndpoint(), strings.ToLower(symbol))
-	return wsDepthServe(endpoint, handler, errHandler)
+	return defaultWsClient().WsDepthServe(symbol, handler, errHandler)
 }
 
-// WsDepthServe100Ms serve websocket depth handler with a symbol, using 100msec updates
+// WsDepthServe serve websocket depth handler with a symbol, using 1sec updates
+func (c *WsClient) WsDepthServe(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@depth", c.BaseURL, strings.ToLower(symbol))
+	return c.wsDepthServe(endpoint, handler, errHandler)
+}
+
+// WsDepthServe100Ms is WsClient.WsDepthServe100Ms with the endpoints and settings of the package variables
 func WsDepthServe100Ms(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@depth@100ms", getWsEndpoint(), strings.ToLower(symbol))
-	return wsDepthServe(endpoint, handler, errHandler)
+	return defaultWsClient().WsDepthServe100Ms(symbol, handler, errHandler)
+}
+
+// WsDepthServe100Ms serve websocket depth handler with a symbol, using 100msec updates
+func (c *WsClient) WsDepthServe100Ms(symbol string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@depth@100ms", c.BaseURL, strings.ToLower(symbol))
+	return c.wsDepthServe(endpoint, handler, errHandler)
 }
 
 // WsDepthServe serve websocket depth handler with an arbitrary endpoint address
-func wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func (c *WsClient) wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -205,27 +216,37 @@ type WsDepthEvent struct {
 	Asks          []Ask  `json:"a"`
 }
 
-// WsCombinedDepthServe is similar to WsDepthServe, but it for multiple symbols
+// WsCombinedDepthServe is WsClient.WsCombinedDepthServe with the endpoints and settings of the package variables
 func WsCombinedDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedDepthServe(symbols, handler, errHandler)
+}
+
+// WsCombinedDepthServe is similar to WsDepthServe, but it for multiple symbols
+func (c *WsClient) WsCombinedDepthServe(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	return wsCombinedDepthServe(endpoint, handler, errHandler)
+	return c.wsCombinedDepthServe(endpoint, handler, errHandler)
 }
 
+// WsCombinedDepthServe100Ms is WsClient.WsCombinedDepthServe100Ms with the endpoints and settings of the package variables
 func WsCombinedDepthServe100Ms(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedDepthServe100Ms(symbols, handler, errHandler)
+}
+
+func (c *WsClient) WsCombinedDepthServe100Ms(symbols []string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@depth@100ms", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	return wsCombinedDepthServe(endpoint, handler, errHandler)
+	return c.wsCombinedDepthServe(endpoint, handler, errHandler)
 }
 
-func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint)
+func (c *WsClient) wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -267,14 +288,19 @@ func wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler Er
 // WsKlineHandler handle websocket kline event
 type WsKlineHandler func(event *WsKlineEvent)
 
-// WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
+// WsCombinedKlineServe is WsClient.WsCombinedKlineServe with the endpoints and settings of the package variables
 func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedKlineServe(symbolIntervalPair, handler, errHandler)
+}
+
+// WsCombinedKlineServe is similar to WsKlineServe, but it handles multiple symbols with it interval
+func (c *WsClient) WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for symbol, interval := range symbolIntervalPair {
 		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -302,10 +328,15 @@ func WsCombinedKlineServe(symbolIntervalPair map[string]string, handler WsKlineH
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
+// WsKlineServe is WsClient.WsKlineServe with the endpoints and settings of the package variables
 func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsKlineServe(symbol, interval, handler, errHandler)
+}
+
+// WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
+func (c *WsClient) WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@kline_%s", c.BaseURL, strings.ToLower(symbol), interval)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -349,10 +380,15 @@ type WsKline struct {
 // WsAggTradeHandler handle websocket aggregate trade event
 type WsAggTradeHandler func(event *WsAggTradeEvent)
 
-// WsAggTradeServe serve websocket aggregate handler with a symbol
+// WsAggTradeServe is WsClient.WsAggTradeServe with the endpoints and settings of the package variables
 func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@aggTrade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAggTradeServe(symbol, handler, errHandler)
+}
+
+// WsAggTradeServe serve websocket aggregate handler with a symbol
+func (c *WsClient) WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@aggTrade", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -365,14 +401,19 @@ func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHan
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbolx
+// WsCombinedAggTradeServe is WsClient.WsCombinedAggTradeServe with the endpoints and settings of the package variables
 func WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedAggTradeServe(symbols, handler, errHandler)
+}
+
+// WsCombinedAggTradeServe is similar to WsAggTradeServe, but it handles multiple symbolx
+func (c *WsClient) WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for s := range symbols {
 		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(symbols[s])) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -420,10 +461,15 @@ type WsAggTradeEvent struct {
 type WsTradeHandler func(event *WsTradeEvent)
 type WsCombinedTradeHandler func(event *WsCombinedTradeEvent)
 
-// WsTradeServe serve websocket handler with a symbol
+// WsTradeServe is WsClient.WsTradeServe with the endpoints and settings of the package variables
 func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@trade", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsTradeServe(symbol, handler, errHandler)
+}
+
+// WsTradeServe serve websocket handler with a symbol
+func (c *WsClient) WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@trade", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -436,13 +482,18 @@ func WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler)
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// WsCombinedTradeServe is WsClient.WsCombinedTradeServe with the endpoints and settings of the package variables
 func WsCombinedTradeServe(symbols []string, handler WsCombinedTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedTradeServe(symbols, handler, errHandler)
+}
+
+func (c *WsClient) WsCombinedTradeServe(symbols []string, handler WsCombinedTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@trade/", strings.ToLower(s))
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsCombinedTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -504,40 +555,45 @@ type WsBalanceUpdate struct {
 }
 
 type WsOrderUpdate struct {
-	Symbol                  string          `json:"s"`
-	ClientOrderId           string          `json:"c"`
-	Side                    string          `json:"S"`
-	Type                    string          `json:"o"`
-	TimeInForce             TimeInForceType `json:"f"`
-	Volume                  string          `json:"q"`
-	Price                   string          `json:"p"`
-	StopPrice               string          `json:"P"`
-	TrailingDelta           int64           `json:"d"` // Trailing Delta
-	IceBergVolume           string          `json:"F"`
-	OrderListId             int64           `json:"g"` // for OCO
-	OrigCustomOrderId       string          `json:"C"` // customized order ID for the original order
-	ExecutionType           string          `json:"x"` // execution type for this event NEW/TRADE...
-	Status                  string          `json:"X"` // order status
-	RejectReason            string          `json:"r"`
-	Id                      int64           `json:"i"` // order id
-	LatestVolume            string          `json:"l"` // quantity for the latest trade
-	FilledVolume            string          `json:"z"`
-	LatestPrice             string          `json:"L"` // price for the latest trade
-	FeeAsset                string          `json:"N"`
-	FeeCost                 string          `json:"n"`
-	TransactionTime         int64           `json:"T"`
-	TradeId                 int64           `json:"t"`
-	IsInOrderBook           bool            `json:"w"` // is the order in the order book?
-	IsMaker                 bool            `json:"m"` // is this order maker?
-	CreateTime              int64           `json:"O"`
-	FilledQuoteVolume       string          `json:"Z"` // the quote volume that already filled
-	LatestQuoteVolume       string          `json:"Y"` // the quote volume for the latest trade
-	QuoteVolume             string          `json:"Q"`
-	TrailingTime            int64           `json:"D"` // Trailing Time
-	StrategyId              int64           `json:"j"` // Strategy ID
-	StrategyType            int64           `json:"J"` // Strategy Type
-	WorkingTime             int64           `json:"W"` // Working Time
-	SelfTradePreventionMode string          `json:"V"`
+	Symbol                  string                      `json:"s"`
+	ClientOrderId           string                      `json:"c"`
+	Side                    string                      `json:"S"`
+	Type                    string                      `json:"o"`
+	TimeInForce             TimeInForceType             `json:"f"`
+	Volume                  string                      `json:"q"`
+	Price                   string                      `json:"p"`
+	StopPrice               string                      `json:"P"`
+	TrailingDelta           int64                       `json:"d"` // Trailing Delta
+	IceBergVolume           string                      `json:"F"`
+	OrderListId             int64                       `json:"g"` // for OCO
+	OrigCustomOrderId       string                      `json:"C"` // customized order ID for the original order
+	ExecutionType           string                      `json:"x"` // execution type for this event NEW/TRADE...
+	Status                  string                      `json:"X"` // order status
+	RejectReason            string                      `json:"r"`
+	Id                      int64                       `json:"i"` // order id
+	LatestVolume            string                      `json:"l"` // quantity for the latest trade
+	FilledVolume            string                      `json:"z"`
+	LatestPrice             string                      `json:"L"` // price for the latest trade
+	FeeAsset                string                      `json:"N"`
+	FeeCost                 string                      `json:"n"`
+	TransactionTime         int64                       `json:"T"`
+	TradeId                 int64                       `json:"t"`
+	IsInOrderBook           bool                        `json:"w"` // is the order in the order book?
+	IsMaker                 bool                        `json:"m"` // is this order maker?
+	CreateTime              int64                       `json:"O"`
+	FilledQuoteVolume       string                      `json:"Z"` // the quote volume that already filled
+	LatestQuoteVolume       string                      `json:"Y"` // the quote volume for the latest trade
+	QuoteVolume             string                      `json:"Q"`
+	TrailingTime            int64                       `json:"D"` // Trailing Time
+	StrategyId              int64                       `json:"j"` // Strategy ID
+	StrategyType            int64                       `json:"J"` // Strategy Type
+	WorkingTime             int64                       `json:"W"` // Working Time
+	SelfTradePreventionMode SelfTradePreventionModeType `json:"V"`
+	TradeGroupId            int64                       `json:"u"` // for orders expired by self-trade prevention
+	PreventedMatchId        int64                       `json:"v"`
+	CounterOrderId          int64                       `json:"U"`
+	PreventedVolume         string                      `json:"A"`
+	LastPreventedVolume     string                      `json:"B"`
 }
 
 type WsOCOUpdate struct {
@@ -564,10 +620,15 @@ type WsOCOOrder struct {
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
-// WsUserDataServe serve user data handler with listen key
+// WsUserDataServe is WsClient.WsUserDataServe with the endpoints and settings of the package variables
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsUserDataServe(listenKey, handler, errHandler)
+}
+
+// WsUserDataServe serve user data handler with listen key
+func (c *WsClient) WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", c.BaseURL, listenKey)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -576,8 +637,13 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 		}
 
 		event := new(WsUserDataEvent)
-
-		err = json.Unmarshal(message, event)
+		// u is the time of the update of an account position, but the trade group id of an
+		// execution report, so it is decoded aside
+		header := struct {
+			*WsUserDataEvent
+			U int64 `json:"u"`
+		}{WsUserDataEvent: event}
+		err = json.Unmarshal(message, &header)
 		if err != nil {
 			errHandler(err)
 			return
@@ -585,6 +651,7 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 
 		switch UserDataEventType(j.Get("e").MustString()) {
 		case UserDataEventTypeOutboundAccountPosition:
+			event.AccountUpdateTime = header.U
 			err = json.Unmarshal(message, &event.AccountUpdate)
 			if err != nil {
 				errHandler(err)
@@ -608,6 +675,12 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 			event.OrderUpdate.Id = j.Get("i").MustInt64()
 			event.OrderUpdate.TradeId = j.Get("t").MustInt64()
 			event.OrderUpdate.FeeAsset = j.Get("N").MustString()
+			event.OrderUpdate.SelfTradePreventionMode = SelfTradePreventionModeType(j.Get("V").MustString())
+			event.OrderUpdate.TradeGroupId = j.Get("u").MustInt64()
+			event.OrderUpdate.PreventedMatchId = j.Get("v").MustInt64()
+			event.OrderUpdate.CounterOrderId = j.Get("U").MustInt64()
+			event.OrderUpdate.PreventedVolume = j.Get("A").MustString()
+			event.OrderUpdate.LastPreventedVolume = j.Get("B").MustString()
 		case UserDataEventTypeListStatus:
 			err = json.Unmarshal(message, &event.OCOUpdate)
 			if err != nil {
@@ -624,14 +697,19 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 // WsMarketStatHandler handle websocket that push single market statistics for 24hr
 type WsMarketStatHandler func(event *WsMarketStatEvent)
 
-// WsCombinedMarketStatServe is similar to WsMarketStatServe, but it handles multiple symbolx
+// WsCombinedMarketStatServe is WsClient.WsCombinedMarketStatServe with the endpoints and settings of the package variables
 func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := getCombinedEndpoint()
+	return defaultWsClient().WsCombinedMarketStatServe(symbols, handler, errHandler)
+}
+
+// WsCombinedMarketStatServe is similar to WsMarketStatServe, but it handles multiple symbolx
+func (c *WsClient) WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := c.CombinedURL
 	for s := range symbols {
 		endpoint += fmt.Sprintf("%s@ticker", strings.ToLower(symbols[s])) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
@@ -661,10 +739,15 @@ func WsCombinedMarketStatServe(symbols []string, handler WsMarketStatHandler, er
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsMarketStatServe serve websocket that push 24hr statistics for single market every second
+// WsMarketStatServe is WsClient.WsMarketStatServe with the endpoints and settings of the package variables
 func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@ticker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsMarketStatServe(symbol, handler, errHandler)
+}
+
+// WsMarketStatServe serve websocket that push 24hr statistics for single market every second
+func (c *WsClient) WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@ticker", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsMarketStatEvent
 		err := json.Unmarshal(message, &event)
@@ -680,10 +763,15 @@ func WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler Er
 // WsAllMarketsStatHandler handle websocket that push all markets statistics for 24hr
 type WsAllMarketsStatHandler func(event WsAllMarketsStatEvent)
 
-// WsAllMarketsStatServe serve websocket that push 24hr statistics for all market every second
+// WsAllMarketsStatServe is WsClient.WsAllMarketsStatServe with the endpoints and settings of the package variables
 func WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!ticker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllMarketsStatServe(handler, errHandler)
+}
+
+// WsAllMarketsStatServe serve websocket that push 24hr statistics for all market every second
+func (c *WsClient) WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!ticker@arr", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -729,10 +817,15 @@ type WsMarketStatEvent struct {
 // WsAllMiniMarketsStatServeHandler handle websocket that push all mini-ticker market statistics for 24hr
 type WsAllMiniMarketsStatServeHandler func(event WsAllMiniMarketsStatEvent)
 
-// WsAllMiniMarketsStatServe serve websocket that push mini version of 24hr statistics for all market every second
+// WsAllMiniMarketsStatServe is WsClient.WsAllMiniMarketsStatServe with the endpoints and settings of the package variables
 func WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!miniTicker@arr", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllMiniMarketsStatServe(handler, errHandler)
+}
+
+// WsAllMiniMarketsStatServe serve websocket that push mini version of 24hr statistics for all market every second
+func (c *WsClient) WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!miniTicker@arr", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -779,10 +872,15 @@ type WsCombinedBookTickerEvent struct {
 // WsBookTickerHandler handle websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
 type WsBookTickerHandler func(event *WsBookTickerEvent)
 
-// WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
+// WsBookTickerServe is WsClient.WsBookTickerServe with the endpoints and settings of the package variables
 func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s@bookTicker", getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsBookTickerServe(symbol, handler, errHandler)
+}
+
+// WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
+func (c *WsClient) WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s@bookTicker", c.BaseURL, strings.ToLower(symbol))
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -795,14 +893,19 @@ func WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler Er
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsCombinedBookTickerServe is similar to WsBookTickerServe, but it is for multiple symbols
+// WsCombinedBookTickerServe is WsClient.WsCombinedBookTickerServe with the endpoints and settings of the package variables
 func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return defaultWsClient().WsCombinedBookTickerServe(symbols, handler, errHandler)
+}
+
+// WsCombinedBookTickerServe is similar to WsBookTickerServe, but it is for multiple symbols
+func (c *WsClient) WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := baseCombinedMainURL
 	for _, s := range symbols {
 		endpoint += fmt.Sprintf("%s@bookTicker", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsCombinedBookTickerEvent)
 		err := json.Unmarshal(message, event)
@@ -815,10 +918,15 @@ func WsCombinedBookTickerServe(symbols []string, handler WsBookTickerHandler, er
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
-// WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
+// WsAllBookTickerServe is WsClient.WsAllBookTickerServe with the endpoints and settings of the package variables
 func WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/!bookTicker", getWsEndpoint())
-	cfg := newWsConfig(endpoint)
+	return defaultWsClient().WsAllBookTickerServe(handler, errHandler)
+}
+
+// WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
+func (c *WsClient) WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/!bookTicker", c.BaseURL)
+	cfg := c.newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
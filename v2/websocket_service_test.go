@@ -773,6 +773,12 @@ func (s *websocketServiceTestSuite) assertOrderUpdate(e, a *WsOrderUpdate) {
 	r.Equal(e.LatestVolume, a.LatestVolume, "OrigCustomOrderId")
 	r.Equal(e.OrigCustomOrderId, a.OrigCustomOrderId, "OrigCustomOrderId")
 	r.Equal(e.RejectReason, a.RejectReason, "RejectReason")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+	r.Equal(e.TradeGroupId, a.TradeGroupId, "TradeGroupId")
+	r.Equal(e.PreventedMatchId, a.PreventedMatchId, "PreventedMatchId")
+	r.Equal(e.CounterOrderId, a.CounterOrderId, "CounterOrderId")
+	r.Equal(e.PreventedVolume, a.PreventedVolume, "PreventedVolume")
+	r.Equal(e.LastPreventedVolume, a.LastPreventedVolume, "LastPreventedVolume")
 }
 
 func (s *websocketServiceTestSuite) assertBalanceUpdate(e, a *WsBalanceUpdate) {
@@ -914,6 +920,88 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeExpiredInMatch() {
+	data := []byte(`{
+	   "e":"executionReport",
+	   "E":1669101687094,
+	   "s":"BTCUSDT",
+	   "c":"myOrder2",
+	   "S":"SELL",
+	   "o":"LIMIT",
+	   "f":"GTC",
+	   "q":"1.30000000",
+	   "p":"1.10000000",
+	   "P":"0.00000000",
+	   "F":"0.00000000",
+	   "g":-1,
+	   "C":"",
+	   "x":"TRADE_PREVENTION",
+	   "X":"EXPIRED_IN_MATCH",
+	   "r":"NONE",
+	   "i":5,
+	   "l":"0.00000000",
+	   "z":"0.00000000",
+	   "L":"0.00000000",
+	   "n":"0",
+	   "N":null,
+	   "T":1669101687093,
+	   "t":-1,
+	   "v":3,
+	   "I":17,
+	   "w":false,
+	   "m":false,
+	   "M":false,
+	   "O":1669101687093,
+	   "Z":"0.00000000",
+	   "Y":"0.00000000",
+	   "Q":"0.00000000",
+	   "W":1669101687093,
+	   "V":"EXPIRE_TAKER",
+	   "u":1,
+	   "U":4,
+	   "A":"1.30000000",
+	   "B":"1.30000000"
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "executionReport",
+		Time:            1669101687094,
+		TransactionTime: 1669101687093,
+		OrderUpdate: WsOrderUpdate{
+			Symbol:                  "BTCUSDT",
+			ClientOrderId:           "myOrder2",
+			Side:                    "SELL",
+			Type:                    "LIMIT",
+			TimeInForce:             "GTC",
+			Volume:                  "1.30000000",
+			Price:                   "1.10000000",
+			StopPrice:               "0.00000000",
+			IceBergVolume:           "0.00000000",
+			OrderListId:             -1,
+			ExecutionType:           "TRADE_PREVENTION",
+			Status:                  string(OrderStatusTypeExpiredInMatch),
+			RejectReason:            "NONE",
+			Id:                      5,
+			LatestVolume:            "0.00000000",
+			FilledVolume:            "0.00000000",
+			LatestPrice:             "0.00000000",
+			FeeCost:                 "0",
+			TransactionTime:         1669101687093,
+			TradeId:                 -1,
+			CreateTime:              1669101687093,
+			FilledQuoteVolume:       "0.00000000",
+			LatestQuoteVolume:       "0.00000000",
+			QuoteVolume:             "0.00000000",
+			SelfTradePreventionMode: SelfTradePreventionModeTypeExpireTaker,
+			TradeGroupId:            1,
+			PreventedMatchId:        3,
+			CounterOrderId:          4,
+			PreventedVolume:         "1.30000000",
+			LastPreventedVolume:     "1.30000000",
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
 func (s *websocketServiceTestSuite) TestWsMarketStatServe() {
 	data := []byte(`{
   		"e": "24hrTicker",